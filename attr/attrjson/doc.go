@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package attrjson contains functions for converting attr.Value into plain
+// encoding/json compatible data, such as for debugging, snapshot testing, or
+// tooling which inspects framework values outside of Terraform. This package
+// is separate from the core attr package to prevent import cycles.
+package attrjson
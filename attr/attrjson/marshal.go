@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// UnknownValue is the sentinel marker used in place of unknown values.
+// Null values are represented as JSON null.
+const UnknownValue = "<unknown>"
+
+// Marshal returns the JSON encoding of the given attr.Value. Refer to the
+// Value function for details about how values are represented.
+func Marshal(ctx context.Context, value attr.Value) ([]byte, diag.Diagnostics) {
+	data, diags := Value(ctx, value)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+
+	// Values are not intended for HTML, so preserve characters such as those
+	// in the UnknownValue sentinel marker.
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(data); err != nil {
+		diags.AddError(
+			"JSON Marshal Error",
+			"An unexpected error was encountered trying to marshal a value to JSON. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	// Remove the trailing newline added by the encoder.
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), diags
+}
+
+// Value returns the given attr.Value as plain encoding/json compatible data.
+// The value is converted via its Terraform type system representation, so
+// all base and custom types are supported. Values are represented as:
+//
+//   - Null values of any type, including the given value, as nil.
+//   - Unknown values of any type, including the given value, as the
+//     UnknownValue string.
+//   - Bool as bool.
+//   - Number, including Float32, Float64, Int32, and Int64, as json.Number,
+//     which preserves the full precision of the value.
+//   - String as string.
+//   - List, Set, and Tuple as []any.
+//   - Map and Object as map[string]any.
+//
+// Dynamic values are represented by their underlying value.
+func Value(ctx context.Context, value attr.Value) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil {
+		return nil, diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert a value to its Terraform representation. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return fromTerraformValue(tfValue, path.Empty())
+}
+
+func fromTerraformValue(tfValue tftypes.Value, p path.Path) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !tfValue.IsKnown() {
+		return UnknownValue, diags
+	}
+
+	if tfValue.IsNull() {
+		return nil, diags
+	}
+
+	tfType := tfValue.Type()
+
+	switch {
+	case tfType.Is(tftypes.Bool):
+		var result bool
+
+		if err := tfValue.As(&result); err != nil {
+			diags.Append(valueConversionErrorDiag(p, err))
+
+			return nil, diags
+		}
+
+		return result, diags
+	case tfType.Is(tftypes.Number):
+		result := big.NewFloat(0)
+
+		if err := tfValue.As(&result); err != nil {
+			diags.Append(valueConversionErrorDiag(p, err))
+
+			return nil, diags
+		}
+
+		return jsonNumber(result), diags
+	case tfType.Is(tftypes.String):
+		var result string
+
+		if err := tfValue.As(&result); err != nil {
+			diags.Append(valueConversionErrorDiag(p, err))
+
+			return nil, diags
+		}
+
+		return result, diags
+	case tfType.Is(tftypes.List{}), tfType.Is(tftypes.Set{}), tfType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := tfValue.As(&elements); err != nil {
+			diags.Append(valueConversionErrorDiag(p, err))
+
+			return nil, diags
+		}
+
+		result := make([]any, 0, len(elements))
+
+		for index, element := range elements {
+			// Set element paths require the element value, so errors are
+			// reported on the set itself.
+			elementPath := p
+
+			if !tfType.Is(tftypes.Set{}) {
+				elementPath = p.AtListIndex(index)
+			}
+
+			value, valueDiags := fromTerraformValue(element, elementPath)
+
+			diags.Append(valueDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			result = append(result, value)
+		}
+
+		return result, diags
+	case tfType.Is(tftypes.Map{}), tfType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := tfValue.As(&elements); err != nil {
+			diags.Append(valueConversionErrorDiag(p, err))
+
+			return nil, diags
+		}
+
+		result := make(map[string]any, len(elements))
+
+		for key, element := range elements {
+			elementPath := p.AtName(key)
+
+			if tfType.Is(tftypes.Map{}) {
+				elementPath = p.AtMapKey(key)
+			}
+
+			value, valueDiags := fromTerraformValue(element, elementPath)
+
+			diags.Append(valueDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			result[key] = value
+		}
+
+		return result, diags
+	}
+
+	diags.Append(valueConversionErrorDiag(p, fmt.Errorf("unsupported type: %s", tfType)))
+
+	return nil, diags
+}
+
+// jsonNumber returns the json.Number representation of the given number.
+// Integers which fit in 64 bits are represented without an exponent.
+func jsonNumber(value *big.Float) json.Number {
+	if value.IsInt() {
+		if i, accuracy := value.Int64(); accuracy == big.Exact {
+			return json.Number(strconv.FormatInt(i, 10))
+		}
+
+		if u, accuracy := value.Uint64(); accuracy == big.Exact {
+			return json.Number(strconv.FormatUint(u, 10))
+		}
+	}
+
+	return json.Number(value.Text('g', -1))
+}
+
+func valueConversionErrorDiag(p path.Path, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Value Conversion Error",
+		"An unexpected error was encountered trying to convert a value to JSON compatible data. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			err.Error(),
+	)
+}
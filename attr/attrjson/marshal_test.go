@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attrjson_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrjson"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value:    nil,
+			expected: []byte(`null`),
+		},
+		"object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"bool":    types.BoolType,
+					"dynamic": types.DynamicType,
+					"float64": types.Float64Type,
+					"int64":   types.Int64Type,
+					"list": types.ListType{
+						ElemType: types.StringType,
+					},
+					"null":    types.StringType,
+					"string":  types.StringType,
+					"unknown": types.StringType,
+				},
+				map[string]attr.Value{
+					"bool":    types.BoolValue(true),
+					"dynamic": types.DynamicValue(types.StringValue("dynamic-value")),
+					"float64": types.Float64Value(1.5),
+					"int64":   types.Int64Value(123),
+					"list": types.ListValueMust(
+						types.StringType,
+						[]attr.Value{
+							types.StringValue("one"),
+							types.StringValue("two"),
+						},
+					),
+					"null":    types.StringNull(),
+					"string":  types.StringValue("test-value"),
+					"unknown": types.StringUnknown(),
+				},
+			),
+			expected: []byte(`{"bool":true,"dynamic":"dynamic-value","float64":1.5,"int64":123,"list":["one","two"],"null":null,"string":"test-value","unknown":"<unknown>"}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := attrjson.Marshal(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(string(got), string(testCase.expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expected      any
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"bool": {
+			value:    types.BoolValue(true),
+			expected: true,
+		},
+		"bool-null": {
+			value:    types.BoolNull(),
+			expected: nil,
+		},
+		"bool-unknown": {
+			value:    types.BoolUnknown(),
+			expected: attrjson.UnknownValue,
+		},
+		"dynamic": {
+			value:    types.DynamicValue(types.Int64Value(1)),
+			expected: json.Number("1"),
+		},
+		"dynamic-unknown": {
+			value:    types.DynamicUnknown(),
+			expected: attrjson.UnknownValue,
+		},
+		"float32": {
+			value:    types.Float32Value(1.5),
+			expected: json.Number("1.5"),
+		},
+		"float64": {
+			value:    types.Float64Value(1.25),
+			expected: json.Number("1.25"),
+		},
+		"int32": {
+			value:    types.Int32Value(-123),
+			expected: json.Number("-123"),
+		},
+		"int64": {
+			value:    types.Int64Value(9223372036854775807),
+			expected: json.Number("9223372036854775807"),
+		},
+		"number": {
+			value:    types.NumberValue(big.NewFloat(1e100)),
+			expected: json.Number("1e+100"),
+		},
+		"string": {
+			value:    types.StringValue("test-value"),
+			expected: "test-value",
+		},
+		"list": {
+			value: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("one"),
+					types.StringUnknown(),
+					types.StringNull(),
+				},
+			),
+			expected: []any{"one", attrjson.UnknownValue, nil},
+		},
+		"list-unknown": {
+			value:    types.ListUnknown(types.StringType),
+			expected: attrjson.UnknownValue,
+		},
+		"map": {
+			value: types.MapValueMust(
+				types.Int64Type,
+				map[string]attr.Value{
+					"one": types.Int64Value(1),
+					"two": types.Int64Value(2),
+				},
+			),
+			expected: map[string]any{
+				"one": json.Number("1"),
+				"two": json.Number("2"),
+			},
+		},
+		"set": {
+			value: types.SetValueMust(
+				types.BoolType,
+				[]attr.Value{
+					types.BoolValue(true),
+				},
+			),
+			expected: []any{true},
+		},
+		"tuple": {
+			value: types.TupleValueMust(
+				[]attr.Type{
+					types.StringType,
+					types.BoolType,
+				},
+				[]attr.Value{
+					types.StringValue("one"),
+					types.BoolValue(false),
+				},
+			),
+			expected: []any{"one", false},
+		},
+		"object-nested": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"nested": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"string": types.StringType,
+						},
+					},
+					"null_nested": types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"string": types.StringType,
+						},
+					},
+				},
+				map[string]attr.Value{
+					"nested": types.ObjectValueMust(
+						map[string]attr.Type{
+							"string": types.StringType,
+						},
+						map[string]attr.Value{
+							"string": types.StringValue("test-value"),
+						},
+					),
+					"null_nested": types.ObjectNull(
+						map[string]attr.Type{
+							"string": types.StringType,
+						},
+					),
+				},
+			),
+			expected: map[string]any{
+				"nested": map[string]any{
+					"string": "test-value",
+				},
+				"null_nested": nil,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := attrjson.Value(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr
+
+// ResetTypeRegistry exposes resetTypeRegistry to the attr_test package.
+var ResetTypeRegistry = resetTypeRegistry
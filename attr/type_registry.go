@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// defaultTypeRegistry is the registry used by RegisterType and RegisteredType.
+var defaultTypeRegistry = &typeRegistry{}
+
+// RegisterType registers a Type so the framework can reconstruct it from its
+// underlying Terraform type when materializing dynamic values, such as
+// types.Dynamic values received from Terraform. Without a registration, the
+// framework always produces the base type, such as types.String, since the
+// Terraform type alone does not contain enough information to determine any
+// custom type.
+//
+// Types are matched by the exact equality of their TerraformType, including
+// element and attribute types. Registering a Type with the same TerraformType
+// as a previously registered Type replaces the previous registration.
+//
+// The registry is process-wide and registrations cannot be removed. Every
+// provider, schema, and test running in the same process, including any
+// muxed providers and the go test binary of a package, shares the same
+// registrations. Only register custom types which should be used for every
+// dynamic value of the underlying Terraform type.
+//
+// RegisterType is safe for concurrent use, however it is recommended to call
+// it during provider initialization, such as in an init function.
+func RegisterType(t Type) {
+	defaultTypeRegistry.register(t)
+}
+
+// RegisteredType returns the Type registered via RegisterType with a
+// TerraformType equal to the given Terraform type, or nil if there is no
+// matching registration.
+func RegisteredType(in tftypes.Type) Type {
+	return defaultTypeRegistry.lookup(in)
+}
+
+// resetTypeRegistry removes all registrations from the default registry. This
+// is only intended for tests, which otherwise share registrations across the
+// process.
+func resetTypeRegistry() {
+	defaultTypeRegistry.reset()
+}
+
+// typeRegistry stores registered types along with their Terraform type.
+type typeRegistry struct {
+	mu      sync.RWMutex
+	entries []typeRegistryEntry
+}
+
+type typeRegistryEntry struct {
+	tfType tftypes.Type
+	typ    Type
+}
+
+func (r *typeRegistry) register(t Type) {
+	if t == nil {
+		return
+	}
+
+	tfType := t.TerraformType(context.Background())
+
+	if tfType == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, entry := range r.entries {
+		if entry.tfType.Equal(tfType) {
+			r.entries[i].typ = t
+
+			return
+		}
+	}
+
+	r.entries = append(r.entries, typeRegistryEntry{
+		tfType: tfType,
+		typ:    t,
+	})
+}
+
+func (r *typeRegistry) lookup(in tftypes.Type) Type {
+	if in == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.entries {
+		if entry.tfType.Equal(in) {
+			return entry.typ
+		}
+	}
+
+	return nil
+}
+
+func (r *typeRegistry) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = nil
+}
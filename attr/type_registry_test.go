@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type testRegisteredObjectType struct {
+	basetypes.ObjectType
+
+	Version int
+}
+
+// The registry is global, so this test is intentionally not parallel and
+// resets the registry when complete.
+func TestRegisterType(t *testing.T) {
+	t.Cleanup(attr.ResetTypeRegistry)
+
+	objectType := basetypes.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"test_register_type": basetypes.StringType{},
+		},
+	}
+	tfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_register_type": tftypes.String,
+		},
+	}
+
+	if got := attr.RegisteredType(tfType); got != nil {
+		t.Fatalf("expected no registered type, got: %s", got)
+	}
+
+	attr.RegisterType(testRegisteredObjectType{ObjectType: objectType, Version: 1})
+
+	if got, ok := attr.RegisteredType(tfType).(testRegisteredObjectType); !ok || got.Version != 1 {
+		t.Errorf("expected registered type version 1 after registration, got: %#v", attr.RegisteredType(tfType))
+	}
+
+	// Registering the same Terraform type replaces the previous registration.
+	attr.RegisterType(testRegisteredObjectType{ObjectType: objectType, Version: 2})
+
+	if got, ok := attr.RegisteredType(tfType).(testRegisteredObjectType); !ok || got.Version != 2 {
+		t.Errorf("expected registered type version 2 after replacement, got: %#v", attr.RegisteredType(tfType))
+	}
+
+	// Terraform types must be exactly equal.
+	otherTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_register_type": tftypes.Bool,
+		},
+	}
+
+	if got := attr.RegisteredType(otherTfType); got != nil {
+		t.Errorf("expected no registered type for %s, got: %s", otherTfType, got)
+	}
+
+	if got := attr.RegisteredType(nil); got != nil {
+		t.Errorf("expected no registered type for nil, got: %s", got)
+	}
+}
+
+// The registry is global, so this test is intentionally not parallel.
+func TestResetTypeRegistry(t *testing.T) {
+	t.Cleanup(attr.ResetTypeRegistry)
+
+	tfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_reset_type_registry": tftypes.String,
+		},
+	}
+
+	attr.RegisterType(testRegisteredObjectType{
+		ObjectType: basetypes.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"test_reset_type_registry": basetypes.StringType{},
+			},
+		},
+	})
+
+	if got := attr.RegisteredType(tfType); got == nil {
+		t.Fatal("expected registered type after registration, got nil")
+	}
+
+	attr.ResetTypeRegistry()
+
+	if got := attr.RegisteredType(tfType); got != nil {
+		t.Errorf("expected no registered type after reset, got: %s", got)
+	}
+}
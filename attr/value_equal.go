@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ValuesEqualIgnoringNullUnknown returns true if the given Value are type and
+// data value equivalent, while treating null and unknown values as the same
+// absent value. The comparison recurses through collection, object, and tuple
+// values, so a null element, attribute, or map value is considered equal to an
+// unknown one at the same position. Set elements are compared without regard
+// to ordering.
+//
+// This is intended for test assertions and other internal comparisons where
+// the distinction between null and unknown is not significant. Use the Value
+// Equal method for comparisons which must follow Terraform's data consistency
+// rules.
+func ValuesEqualIgnoringNullUnknown(ctx context.Context, a, b Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	if !a.Type(ctx).Equal(b.Type(ctx)) {
+		return false
+	}
+
+	aValue, err := a.ToTerraformValue(ctx)
+
+	if err != nil {
+		return false
+	}
+
+	bValue, err := b.ToTerraformValue(ctx)
+
+	if err != nil {
+		return false
+	}
+
+	return terraformValuesEqualIgnoringNullUnknown(aValue, bValue)
+}
+
+// terraformValuesEqualIgnoringNullUnknown implements the recursive logic of
+// ValuesEqualIgnoringNullUnknown against the tftypes representation.
+func terraformValuesEqualIgnoringNullUnknown(a, b tftypes.Value) bool {
+	aAbsent := a.IsNull() || !a.IsKnown()
+	bAbsent := b.IsNull() || !b.IsKnown()
+
+	if aAbsent || bAbsent {
+		return aAbsent && bAbsent
+	}
+
+	if !a.Type().Equal(b.Type()) {
+		return false
+	}
+
+	switch {
+	case a.Type().Is(tftypes.List{}), a.Type().Is(tftypes.Tuple{}):
+		var aElems, bElems []tftypes.Value
+
+		if a.As(&aElems) != nil || b.As(&bElems) != nil {
+			return false
+		}
+
+		if len(aElems) != len(bElems) {
+			return false
+		}
+
+		for i := range aElems {
+			if !terraformValuesEqualIgnoringNullUnknown(aElems[i], bElems[i]) {
+				return false
+			}
+		}
+
+		return true
+	case a.Type().Is(tftypes.Set{}):
+		var aElems, bElems []tftypes.Value
+
+		if a.As(&aElems) != nil || b.As(&bElems) != nil {
+			return false
+		}
+
+		if len(aElems) != len(bElems) {
+			return false
+		}
+
+		matched := make([]bool, len(bElems))
+
+	aElemsLoop:
+		for _, aElem := range aElems {
+			for i, bElem := range bElems {
+				if matched[i] {
+					continue
+				}
+
+				if terraformValuesEqualIgnoringNullUnknown(aElem, bElem) {
+					matched[i] = true
+
+					continue aElemsLoop
+				}
+			}
+
+			return false
+		}
+
+		return true
+	case a.Type().Is(tftypes.Map{}), a.Type().Is(tftypes.Object{}):
+		var aElems, bElems map[string]tftypes.Value
+
+		if a.As(&aElems) != nil || b.As(&bElems) != nil {
+			return false
+		}
+
+		if len(aElems) != len(bElems) {
+			return false
+		}
+
+		for key, aElem := range aElems {
+			bElem, ok := bElems[key]
+
+			if !ok {
+				return false
+			}
+
+			if !terraformValuesEqualIgnoringNullUnknown(aElem, bElem) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return a.Equal(b)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValuesEqualIgnoringNullUnknown(t *testing.T) {
+	t.Parallel()
+
+	testObjectAttrTypes := map[string]attr.Type{
+		"test_attr": types.StringType,
+		"test_list": types.ListType{ElemType: types.StringType},
+	}
+
+	testCases := map[string]struct {
+		a        attr.Value
+		b        attr.Value
+		expected bool
+	}{
+		"nil-nil": {
+			expected: true,
+		},
+		"nil-value": {
+			b:        types.StringValue("test"),
+			expected: false,
+		},
+		"string-known-equal": {
+			a:        types.StringValue("test"),
+			b:        types.StringValue("test"),
+			expected: true,
+		},
+		"string-known-not-equal": {
+			a:        types.StringValue("test"),
+			b:        types.StringValue("other"),
+			expected: false,
+		},
+		"string-null-unknown": {
+			a:        types.StringNull(),
+			b:        types.StringUnknown(),
+			expected: true,
+		},
+		"string-null-known": {
+			a:        types.StringNull(),
+			b:        types.StringValue("test"),
+			expected: false,
+		},
+		"type-mismatch": {
+			a:        types.StringNull(),
+			b:        types.BoolUnknown(),
+			expected: false,
+		},
+		"list-elements-null-unknown": {
+			a: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("test"),
+				types.StringNull(),
+			}),
+			b: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("test"),
+				types.StringUnknown(),
+			}),
+			expected: true,
+		},
+		"list-elements-order": {
+			a: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringValue("two"),
+			}),
+			b: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("two"),
+				types.StringValue("one"),
+			}),
+			expected: false,
+		},
+		"list-length-mismatch": {
+			a: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("test"),
+			}),
+			b: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("test"),
+				types.StringUnknown(),
+			}),
+			expected: false,
+		},
+		"set-elements-order": {
+			a: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("one"),
+				types.StringNull(),
+			}),
+			b: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringUnknown(),
+				types.StringValue("one"),
+			}),
+			expected: true,
+		},
+		"map-values-null-unknown": {
+			a: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key": types.StringNull(),
+			}),
+			b: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key": types.StringUnknown(),
+			}),
+			expected: true,
+		},
+		"map-keys-mismatch": {
+			a: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key": types.StringNull(),
+			}),
+			b: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"other": types.StringNull(),
+			}),
+			expected: false,
+		},
+		"object-nested-null-unknown": {
+			a: types.ObjectValueMust(testObjectAttrTypes, map[string]attr.Value{
+				"test_attr": types.StringValue("test"),
+				"test_list": types.ListValueMust(types.StringType, []attr.Value{
+					types.StringNull(),
+				}),
+			}),
+			b: types.ObjectValueMust(testObjectAttrTypes, map[string]attr.Value{
+				"test_attr": types.StringValue("test"),
+				"test_list": types.ListValueMust(types.StringType, []attr.Value{
+					types.StringUnknown(),
+				}),
+			}),
+			expected: true,
+		},
+		"object-nested-not-equal": {
+			a: types.ObjectValueMust(testObjectAttrTypes, map[string]attr.Value{
+				"test_attr": types.StringValue("test"),
+				"test_list": types.ListNull(types.StringType),
+			}),
+			b: types.ObjectValueMust(testObjectAttrTypes, map[string]attr.Value{
+				"test_attr": types.StringValue("other"),
+				"test_list": types.ListUnknown(types.StringType),
+			}),
+			expected: false,
+		},
+		"dynamic-underlying-null-unknown": {
+			a:        types.DynamicValue(types.StringNull()),
+			b:        types.DynamicValue(types.StringUnknown()),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := attr.ValuesEqualIgnoringNullUnknown(context.Background(), testCase.a, testCase.b)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
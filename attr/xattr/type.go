@@ -23,3 +23,24 @@ type TypeWithValidate interface {
 	// Type.
 	Validate(context.Context, tftypes.Value, path.Path) diag.Diagnostics
 }
+
+// TypeWithValueFromTerraformWithValidation extends the attr.Type interface to
+// include a ValueFromTerraformWithValidation method, used to validate data
+// while it is being converted into a value of the Type, such as rejecting
+// malformed data which cannot be represented by the value.
+//
+// When reading configuration, plan, or state data, the framework calls this
+// method instead of the ValueFromTerraform method and returns any diagnostics
+// to Terraform. The ValueFromTerraform method is still required and is called
+// when there is no associated path, such as when converting elements of a
+// collection, so it should return an error rather than panic for malformed
+// data.
+type TypeWithValueFromTerraformWithValidation interface {
+	attr.Type
+
+	// ValueFromTerraformWithValidation returns an attr.Value of the Type from
+	// the given tftypes.Value, or any warnings or errors about the data. Any
+	// diagnostics should use the given path, which is the location of the
+	// value in the data.
+	ValueFromTerraformWithValidation(context.Context, tftypes.Value, path.Path) (attr.Value, diag.Diagnostics)
+}
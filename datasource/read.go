@@ -22,6 +22,20 @@ type ReadRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ReadDataSource RPC, such as forward-compatible Terraform behavior
+	// changes.
+	ClientCapabilities ReadClientCapabilities
+}
+
+// ReadClientCapabilities allows Terraform to publish information regarding
+// optionally supported protocol features for the ReadDataSource RPC, such as
+// forward-compatible Terraform behavior changes.
+type ReadClientCapabilities struct {
+	// DeferralAllowed indicates whether the Terraform client initiating
+	// the request allows a deferral response.
+	DeferralAllowed bool
 }
 
 // ReadResponse represents a response to a ReadRequest. An
@@ -37,4 +51,12 @@ type ReadResponse struct {
 	// source. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Deferred indicates that Terraform should defer reading this data
+	// source until a followup apply operation, such as when the
+	// configuration contains unknown values which are required to read it.
+	//
+	// This field can only be set if
+	// `(datasource.ReadRequest).ClientCapabilities.DeferralAllowed` is true.
+	Deferred *Deferred
 }
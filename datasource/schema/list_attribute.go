@@ -21,6 +21,7 @@ var (
 	_ Attribute                                    = ListAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = ListAttribute{}
 	_ fwxschema.AttributeWithListValidators        = ListAttribute{}
+	_ fwxschema.AttributeWithElementValidators     = ListAttribute{}
 )
 
 // ListAttribute represents a schema attribute that is a list with a single
@@ -138,6 +139,18 @@ type ListAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.List
+
+	// ElementValidators define value validation functionality for each
+	// element of the attribute. All elements of the slice are run against
+	// each known, non-null element, regardless of any previous error
+	// diagnostics. Each validator must implement the validator interface
+	// matching the element type, such as validator.String for an ElementType
+	// of types.StringType, otherwise an error diagnostic is returned when the
+	// schema is validated.
+	//
+	// Response diagnostics should use the request Path, which includes the
+	// index of the element.
+	ElementValidators []validator.Describer
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a list
@@ -202,6 +215,11 @@ func (a ListAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// GetElementValidators returns the ElementValidators field value.
+func (a ListAttribute) GetElementValidators() []validator.Describer {
+	return a.ElementValidators
+}
+
 // ListValidators returns the Validators field value.
 func (a ListAttribute) ListValidators() []validator.List {
 	return a.Validators
@@ -219,4 +237,8 @@ func (a ListAttribute) ValidateImplementation(ctx context.Context, req fwschema.
 	if a.CustomType == nil && fwtype.ContainsCollectionWithDynamic(a.GetType()) {
 		resp.Diagnostics.Append(fwtype.AttributeCollectionWithDynamicTypeDiag(req.Path))
 	}
+
+	if elementType, ok := a.GetType().(attr.TypeWithElementType); ok {
+		resp.Diagnostics.Append(fwxschema.ValidateElementValidatorsImplementation(ctx, req.Path, elementType.ElementType(), a.GetElementValidators())...)
+	}
 }
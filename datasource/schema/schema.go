@@ -16,6 +16,7 @@ import (
 
 // Schema must satify the fwschema.Schema interface.
 var _ fwschema.Schema = Schema{}
+var _ fwschema.SchemaWithDeprecationWarningsOnUnknown = Schema{}
 
 // Schema defines the structure and value types of data source data. This type
 // is used as the datasource.SchemaResponse type Schema field, which is
@@ -59,6 +60,16 @@ type Schema struct {
 	//    will be removed in the next major version of the provider."
 	//
 	DeprecationMessage string
+
+	// DeprecationWarningsOnUnknown enables attribute and block deprecation
+	// warnings for unknown configuration values, such as values which refer
+	// to resources which are not yet created. By default, deprecation
+	// warnings are only raised when the configuration value is known and not
+	// null, which prevents warnings when the deprecated attribute or block
+	// may not actually be set. Null configuration values never raise
+	// deprecation warnings as they cannot be distinguished from the attribute
+	// or block being omitted from the configuration.
+	DeprecationWarningsOnUnknown bool
 }
 
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
@@ -96,6 +107,12 @@ func (s Schema) GetDeprecationMessage() string {
 	return s.DeprecationMessage
 }
 
+// GetDeprecationWarningsOnUnknown returns the DeprecationWarningsOnUnknown
+// field value.
+func (s Schema) GetDeprecationWarningsOnUnknown() bool {
+	return s.DeprecationWarningsOnUnknown
+}
+
 // GetDescription returns the Description field value.
 func (s Schema) GetDescription() string {
 	return s.Description
@@ -141,6 +158,8 @@ func (s Schema) Validate() diag.Diagnostics {
 func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	diags.Append(fwschema.ConflictingAttributeBlockNames(s.GetAttributes(), s.GetBlocks(), path.Empty())...)
+
 	for attributeName, attribute := range s.GetAttributes() {
 		req := fwschema.ValidateImplementationRequest{
 			Name: attributeName,
@@ -21,6 +21,7 @@ var (
 	_ Attribute                                    = SetAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = SetAttribute{}
 	_ fwxschema.AttributeWithSetValidators         = SetAttribute{}
+	_ fwxschema.AttributeWithElementValidators     = SetAttribute{}
 )
 
 // SetAttribute represents a schema attribute that is a set with a single
@@ -136,6 +137,18 @@ type SetAttribute struct {
 	// xattr.TypeWithValidate interface, the validators defined in this field
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Set
+
+	// ElementValidators define value validation functionality for each
+	// element of the attribute. All elements of the slice are run against
+	// each known, non-null element, regardless of any previous error
+	// diagnostics. Each validator must implement the validator interface
+	// matching the element type, such as validator.String for an ElementType
+	// of types.StringType, otherwise an error diagnostic is returned when the
+	// schema is validated.
+	//
+	// Response diagnostics should use the request Path, which includes the
+	// value of the element.
+	ElementValidators []validator.Describer
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a set
@@ -200,6 +213,11 @@ func (a SetAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// GetElementValidators returns the ElementValidators field value.
+func (a SetAttribute) GetElementValidators() []validator.Describer {
+	return a.ElementValidators
+}
+
 // SetValidators returns the Validators field value.
 func (a SetAttribute) SetValidators() []validator.Set {
 	return a.Validators
@@ -217,4 +235,8 @@ func (a SetAttribute) ValidateImplementation(ctx context.Context, req fwschema.V
 	if a.CustomType == nil && fwtype.ContainsCollectionWithDynamic(a.GetType()) {
 		resp.Diagnostics.Append(fwtype.AttributeCollectionWithDynamicTypeDiag(req.Path))
 	}
+
+	if elementType, ok := a.GetType().(attr.TypeWithElementType); ok {
+		resp.Diagnostics.Append(fwxschema.ValidateElementValidatorsImplementation(ctx, req.Path, elementType.ElementType(), a.GetElementValidators())...)
+	}
 }
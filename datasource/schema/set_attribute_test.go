@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -451,6 +452,46 @@ func TestSetAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"element-validators": {
+			attribute: schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testvalidator.String{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{},
+		},
+		"element-validators-invalid": {
+			attribute: schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testvalidator.String{},
+					testvalidator.Bool{},
+				},
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test\" has an element validator which does not implement the validator interface for the basetypes.StringType element type. "+
+							"Each element validator must implement the validator interface matching the element type, such as validator.String for types.StringType.\n\n"+
+							"Element Validator Type: testvalidator.Bool",
+					),
+				},
+			},
+		},
 		"elementtype": {
 			attribute: schema.SetAttribute{
 				Computed:    true,
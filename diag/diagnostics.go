@@ -108,6 +108,22 @@ func (diags Diagnostics) Errors() Diagnostics {
 	return dd
 }
 
+// ToError returns a DiagnosticsError containing all the Diagnostic in
+// Diagnostics that are SeverityError, or nil if there are none. Warnings are
+// not included in the error and can be retrieved separately via the Warnings
+// method.
+func (diags Diagnostics) ToError() error {
+	errs := diags.Errors()
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return DiagnosticsError{
+		Diagnostics: errs,
+	}
+}
+
 // Warnings returns all the Diagnostic in Diagnostics that are SeverityWarning.
 func (diags Diagnostics) Warnings() Diagnostics {
 	dd := Diagnostics{}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	_ error = DiagnosticError{}
+	_ error = DiagnosticsError{}
+)
+
+// DiagnosticError is an error which wraps a single error severity Diagnostic.
+// It is returned via the Unwrap method of DiagnosticsError, so the original
+// Diagnostic can be retrieved using errors.As.
+type DiagnosticError struct {
+	Diagnostic Diagnostic
+}
+
+// Error returns the diagnostic summary and detail, prefixed with the path if
+// the diagnostic is a DiagnosticWithPath.
+func (e DiagnosticError) Error() string {
+	if e.Diagnostic == nil {
+		return ""
+	}
+
+	msg := e.Diagnostic.Summary()
+
+	if detail := e.Diagnostic.Detail(); detail != "" {
+		msg += ": " + detail
+	}
+
+	if d, ok := e.Diagnostic.(DiagnosticWithPath); ok {
+		msg = fmt.Sprintf("%s: %s", d.Path(), msg)
+	}
+
+	return msg
+}
+
+// DiagnosticsError is an error which aggregates error severity diagnostics,
+// as returned by the Diagnostics type ToError method.
+type DiagnosticsError struct {
+	Diagnostics Diagnostics
+}
+
+// Error returns each error diagnostic message on a separate line.
+func (e DiagnosticsError) Error() string {
+	msgs := make([]string, 0, len(e.Diagnostics))
+
+	for _, d := range e.Diagnostics {
+		msgs = append(msgs, DiagnosticError{Diagnostic: d}.Error())
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns each diagnostic as a DiagnosticError, which enables usage
+// with errors.Is and errors.As.
+func (e DiagnosticsError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Diagnostics))
+
+	for _, d := range e.Diagnostics {
+		errs = append(errs, DiagnosticError{Diagnostic: d})
+	}
+
+	return errs
+}
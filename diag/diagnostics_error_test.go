@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestDiagnosticErrorError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      diag.DiagnosticError
+		expected string
+	}{
+		"nil": {
+			err:      diag.DiagnosticError{},
+			expected: "",
+		},
+		"summary": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewErrorDiagnostic("test summary", ""),
+			},
+			expected: "test summary",
+		},
+		"summary-detail": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expected: "test summary: test detail",
+		},
+		"path": {
+			err: diag.DiagnosticError{
+				Diagnostic: diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "test summary", "test detail"),
+			},
+			expected: "test[0]: test summary: test detail",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.err.Error()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsErrorError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      diag.DiagnosticsError
+		expected string
+	}{
+		"empty": {
+			err:      diag.DiagnosticsError{},
+			expected: "",
+		},
+		"multiple": {
+			err: diag.DiagnosticsError{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("test"), "two summary", "two detail"),
+				},
+			},
+			expected: "one summary: one detail\ntest: two summary: two detail",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.err.Error()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsErrorUnwrap(t *testing.T) {
+	t.Parallel()
+
+	err := diag.Diagnostics{
+		diag.NewWarningDiagnostic("one summary", "one detail"),
+		diag.NewAttributeErrorDiagnostic(path.Root("test"), "two summary", "two detail"),
+	}.ToError()
+
+	var diagErr diag.DiagnosticError
+
+	if !errors.As(err, &diagErr) {
+		t.Fatalf("expected error to unwrap to diag.DiagnosticError, got: %#v", err)
+	}
+
+	expected := diag.NewAttributeErrorDiagnostic(path.Root("test"), "two summary", "two detail")
+
+	if !diagErr.Diagnostic.Equal(expected) {
+		t.Errorf("unexpected diagnostic: %#v", diagErr.Diagnostic)
+	}
+}
@@ -833,3 +833,54 @@ func TestDiagnosticsWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestDiagnosticsToError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected error
+	}{
+		"nil": {
+			diags:    nil,
+			expected: nil,
+		},
+		"empty": {
+			diags:    diag.Diagnostics{},
+			expected: nil,
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+			},
+			expected: nil,
+		},
+		"errors-and-warnings": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("one summary", "one detail"),
+				diag.NewWarningDiagnostic("two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "three summary", "three detail"),
+			},
+			expected: diag.DiagnosticsError{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("one summary", "one detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("test"), "three summary", "three detail"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diags.ToError()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
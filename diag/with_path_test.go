@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		diag     diag.Diagnostic
+		expected diag.DiagnosticWithPath
+	}{
+		"error": {
+			path:     path.Root("test"),
+			diag:     diag.NewErrorDiagnostic("test summary", "test detail"),
+			expected: diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+		},
+		"warning": {
+			path:     path.Root("test"),
+			diag:     diag.NewWarningDiagnostic("test summary", "test detail"),
+			expected: diag.NewAttributeWarningDiagnostic(path.Root("test"), "test summary", "test detail"),
+		},
+		"overwrite-path": {
+			path:     path.Root("other"),
+			diag:     diag.NewAttributeErrorDiagnostic(path.Root("test"), "test summary", "test detail"),
+			expected: diag.NewAttributeErrorDiagnostic(path.Root("other"), "test summary", "test detail"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.WithPath(tc.path, tc.diag)
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("Unexpected response: got: %#v, wanted: %#v", got, tc.expected)
+			}
+
+			if diff := cmp.Diff(got.Severity(), tc.diag.Severity()); diff != "" {
+				t.Errorf("unexpected severity difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Summary(), tc.diag.Summary()); diff != "" {
+				t.Errorf("unexpected summary difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Detail(), tc.diag.Detail()); diff != "" {
+				t.Errorf("unexpected detail difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ephemeral contains all interfaces, request types, and response
+// types for an ephemeral resource implementation.
+//
+// In Terraform, an ephemeral resource is a concept which enables provider
+// developers to offer practitioners ephemeral values, which will not be stored
+// in any artifact produced by Terraform (plan/state). Ephemeral resources can
+// optionally implement renewal logic via the (EphemeralResource).Renew method
+// and cleanup logic via the (EphemeralResource).Close method. Ephemeral
+// resources are defined by an ephemeral resource type/name, such as
+// "examplecloud_thing", a schema representing the structure and data types of
+// configuration and result data, and open logic.
+//
+// The main starting point for implementations in this package is the
+// EphemeralResource type which represents an instance of an ephemeral
+// resource type that has its own configuration, open, renew, and close
+// logic. The EphemeralResource implementations are referenced by the
+// [provider.ProviderWithEphemeralResources] type EphemeralResources method,
+// which enables the ephemeral resource for practitioner and testing usage.
+//
+// Ephemeral resources are supported in Terraform version 1.10 and later and
+// are only available when the provider is served with Terraform Plugin
+// Protocol version 6, such as via the providerserver.NewProtocol6 function.
+package ephemeral
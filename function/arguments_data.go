@@ -6,6 +6,7 @@ package function
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -164,6 +165,63 @@ func (d ArgumentsData) GetArgument(ctx context.Context, position int, target any
 	return funcErr
 }
 
+// GetVariadicPairs retrieves the variadic parameter argument data found at the
+// given zero-based position and populates the target with consecutive pairs
+// of the arguments. This is useful for functions which conceptually accept
+// alternating arguments, such as keys and values.
+//
+// The target must be a pointer to a slice of two element arrays ([][2]T),
+// where the element type is appropriate for the variadic parameter definition.
+// If an odd number of variadic arguments is passed, a function error is
+// returned for the final, unpaired argument.
+func (d ArgumentsData) GetVariadicPairs(ctx context.Context, position int, target any) *FuncError {
+	targetValue := reflect.ValueOf(target)
+
+	if targetValue.Kind() != reflect.Pointer || targetValue.IsNil() ||
+		targetValue.Elem().Kind() != reflect.Slice ||
+		targetValue.Elem().Type().Elem().Kind() != reflect.Array ||
+		targetValue.Elem().Type().Elem().Len() != 2 {
+		errMsg := "Invalid Argument Data Usage: When attempting to fetch variadic argument pairs during the function call, the provider code incorrectly attempted to read argument data. " +
+			"The GetVariadicPairs call requires a pointer to a slice of two element arrays ([][2]T) as the target. " +
+			"This is always an error in the provider code and should be reported to the provider developers.\n\n" +
+			fmt.Sprintf("Given target type: %T", target)
+
+		return NewArgumentFuncError(int64(position), errMsg)
+	}
+
+	pairType := targetValue.Elem().Type().Elem()
+	argsValue := reflect.New(reflect.SliceOf(pairType.Elem()))
+
+	funcErr := d.GetArgument(ctx, position, argsValue.Interface())
+
+	if funcErr != nil {
+		return funcErr
+	}
+
+	args := argsValue.Elem()
+
+	if args.Len()%2 != 0 {
+		errMsg := "Invalid Variadic Argument Count: The function expects variadic arguments in pairs, however an odd number of variadic arguments was given. " +
+			"Ensure each argument is followed by its paired argument.\n\n" +
+			fmt.Sprintf("Given variadic argument count: %d", args.Len())
+
+		return NewArgumentFuncError(int64(position+args.Len()-1), errMsg)
+	}
+
+	pairs := reflect.MakeSlice(targetValue.Elem().Type(), 0, args.Len()/2)
+
+	for i := 0; i < args.Len(); i += 2 {
+		pair := reflect.New(pairType).Elem()
+		pair.Index(0).Set(args.Index(i))
+		pair.Index(1).Set(args.Index(i + 1))
+		pairs = reflect.Append(pairs, pair)
+	}
+
+	targetValue.Elem().Set(pairs)
+
+	return nil
+}
+
 // NewArgumentsData creates an ArgumentsData. This is only necessary for unit
 // testing as the framework automatically creates this data.
 func NewArgumentsData(values []attr.Value) ArgumentsData {
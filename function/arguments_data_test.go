@@ -491,3 +491,139 @@ func TestArgumentsDataGetArgument(t *testing.T) {
 		})
 	}
 }
+
+func TestArgumentsDataGetVariadicPairs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		argumentsData function.ArgumentsData
+		position      int
+		target        any
+		expected      any
+		expectedErr   *function.FuncError
+	}{
+		"no-argument-data": {
+			argumentsData: function.NewArgumentsData(nil),
+			position:      0,
+			target:        new([][2]string),
+			expected:      new([][2]string),
+			expectedErr: function.NewArgumentFuncError(int64(0), "Invalid Argument Data Usage: When attempting to fetch argument data during the function call, the provider code incorrectly attempted to read argument data. "+
+				"This is always an issue in the provider code and should be reported to the provider developers.\n\n"+
+				"Function does not have argument data."),
+		},
+		"invalid-target": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewTupleValueMust(
+					[]attr.Type{
+						basetypes.StringType{},
+						basetypes.StringType{},
+					},
+					[]attr.Value{
+						basetypes.NewStringValue("key1"),
+						basetypes.NewStringValue("value1"),
+					},
+				),
+			}),
+			position: 0,
+			target:   new([]string),
+			expected: new([]string),
+			expectedErr: function.NewArgumentFuncError(int64(0), "Invalid Argument Data Usage: When attempting to fetch variadic argument pairs during the function call, the provider code incorrectly attempted to read argument data. "+
+				"The GetVariadicPairs call requires a pointer to a slice of two element arrays ([][2]T) as the target. "+
+				"This is always an error in the provider code and should be reported to the provider developers.\n\n"+
+				"Given target type: *[]string"),
+		},
+		"odd-count": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewTupleValueMust(
+					[]attr.Type{
+						basetypes.StringType{},
+						basetypes.StringType{},
+						basetypes.StringType{},
+					},
+					[]attr.Value{
+						basetypes.NewStringValue("key1"),
+						basetypes.NewStringValue("value1"),
+						basetypes.NewStringValue("key2"),
+					},
+				),
+			}),
+			position: 1,
+			target:   new([][2]string),
+			expected: new([][2]string),
+			expectedErr: function.NewArgumentFuncError(int64(3), "Invalid Variadic Argument Count: The function expects variadic arguments in pairs, however an odd number of variadic arguments was given. "+
+				"Ensure each argument is followed by its paired argument.\n\n"+
+				"Given variadic argument count: 3"),
+		},
+		"zero": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewTupleValueMust([]attr.Type{}, []attr.Value{}),
+			}),
+			position: 0,
+			target:   new([][2]string),
+			expected: pointer([][2]string{}),
+		},
+		"pairs": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewBoolValue(true),
+				basetypes.NewTupleValueMust(
+					[]attr.Type{
+						basetypes.StringType{},
+						basetypes.StringType{},
+						basetypes.StringType{},
+						basetypes.StringType{},
+					},
+					[]attr.Value{
+						basetypes.NewStringValue("key1"),
+						basetypes.NewStringValue("value1"),
+						basetypes.NewStringValue("key2"),
+						basetypes.NewStringValue("value2"),
+					},
+				),
+			}),
+			position: 1,
+			target:   new([][2]string),
+			expected: pointer([][2]string{
+				{"key1", "value1"},
+				{"key2", "value2"},
+			}),
+		},
+		"framework-type": {
+			argumentsData: function.NewArgumentsData([]attr.Value{
+				basetypes.NewTupleValueMust(
+					[]attr.Type{
+						basetypes.StringType{},
+						basetypes.StringType{},
+					},
+					[]attr.Value{
+						basetypes.NewStringValue("key1"),
+						basetypes.NewStringNull(),
+					},
+				),
+			}),
+			position: 0,
+			target:   new([][2]basetypes.StringValue),
+			expected: pointer([][2]basetypes.StringValue{
+				{basetypes.NewStringValue("key1"), basetypes.NewStringNull()},
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.argumentsData.GetVariadicPairs(context.Background(), testCase.position, testCase.target)
+
+			if diff := cmp.Diff(testCase.target, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(err, testCase.expectedErr); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+// ConfigureRequest represents a request for the provider to configure a
+// function, i.e., set provider-level data or clients. An instance of this
+// request struct is supplied as an argument to the FunctionWithConfigure
+// type Configure method.
+type ConfigureRequest struct {
+	// ProviderData is the data set in the
+	// [provider.ConfigureResponse.FunctionData] field. This data is
+	// provider-specifc and therefore can contain any necessary remote system
+	// clients, custom provider data, or anything else pertinent to the
+	// functionality of the Function.
+	//
+	// This data is only set after the ConfigureProvider RPC has been called
+	// by Terraform. Terraform calls functions during configuration
+	// validation and planning, which may happen before the provider is
+	// configured, so this field is nil in those calls and function logic
+	// must handle it.
+	ProviderData any
+}
+
+// ConfigureResponse represents a response to a ConfigureRequest. An
+// instance of this response struct is supplied as an argument to the
+// FunctionWithConfigure type Configure method.
+type ConfigureResponse struct {
+	// Error contains errors related to configuring the Function. A nil
+	// value indicates a successful operation. Any error prevents the
+	// Run method from being called.
+	Error *FuncError
+}
@@ -25,3 +25,22 @@ type Function interface {
 	// the [RunResponse].
 	Run(context.Context, RunRequest, *RunResponse)
 }
+
+// FunctionWithConfigure is an interface type that extends Function to
+// include a method which the framework will automatically call so provider
+// developers have the opportunity to setup any necessary provider-level data
+// or clients in the Function type, such as a regional endpoint resolved when
+// the provider was configured.
+//
+// A new Function instance is created for each function call, however
+// Terraform can call functions concurrently, so any provider-level data or
+// clients must be safe for concurrent use. Functions which do not implement
+// this interface remain stateless.
+type FunctionWithConfigure interface {
+	Function
+
+	// Configure enables provider-level data or clients to be set in the
+	// provider-defined Function type. It is separately executed for each
+	// CallFunction RPC, before the Run method.
+	Configure(context.Context, ConfigureRequest, *ConfigureResponse)
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NewObjectReturnFromStruct returns an ObjectReturn with AttributeTypes
+// inferred from the `tfsdk` field tags and Go types of the given struct
+// value. This enables functions which naturally produce multiple named
+// results to define the return and set the result data via
+// [ResultData.SetStruct] with the same Go struct type.
+//
+// The following Go types are supported for struct fields:
+//
+//   - bool, which becomes [basetypes.BoolType]
+//   - int32, which becomes [basetypes.Int32Type]
+//   - Other integer types, which become [basetypes.Int64Type]
+//   - float32, which becomes [basetypes.Float32Type]
+//   - float64, which becomes [basetypes.Float64Type]
+//   - *big.Float and *big.Int, which become [basetypes.NumberType]
+//   - string, which becomes [basetypes.StringType]
+//   - Slices and arrays of supported types, which become [basetypes.ListType]
+//   - Maps with string keys and supported value types, which become
+//     [basetypes.MapType]
+//   - Structs with `tfsdk` field tags, which become [basetypes.ObjectType]
+//   - Pointers to supported types, which become the type of the element
+//   - Framework primitive value types, such as [types.String], which become
+//     the associated framework type
+//
+// Fields tagged with `tfsdk:"-"` and unexported fields are ignored. Framework
+// collection and object value types, such as [types.List], cannot be used as
+// their element or attribute types are not known. Define the ObjectReturn
+// AttributeTypes field directly for those cases.
+func NewObjectReturnFromStruct(ctx context.Context, value any) (ObjectReturn, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil {
+		diags.AddError(
+			"Invalid Object Return Struct",
+			"An unexpected error was encountered trying to build an object return from a Go struct. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received nil value, expected struct.",
+		)
+
+		return ObjectReturn{}, diags
+	}
+
+	typ := reflect.TypeOf(value)
+
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		diags.AddError(
+			"Invalid Object Return Struct",
+			"An unexpected error was encountered trying to build an object return from a Go struct. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Received %s, expected struct.", typ),
+		)
+
+		return ObjectReturn{}, diags
+	}
+
+	attrType, diags := attrTypeFromGoType(ctx, typ, path.Empty())
+
+	if diags.HasError() {
+		return ObjectReturn{}, diags
+	}
+
+	objectType, ok := attrType.(basetypes.ObjectType)
+
+	// This should not happen as structs are always inferred as objects.
+	if !ok {
+		diags.AddError(
+			"Invalid Object Return Struct",
+			"An unexpected error was encountered trying to build an object return from a Go struct. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected inferred object type, got %T.", attrType),
+		)
+
+		return ObjectReturn{}, diags
+	}
+
+	return ObjectReturn{
+		AttributeTypes: objectType.AttrTypes,
+	}, diags
+}
+
+// NewObjectReturnFromStructMust is the same as NewObjectReturnFromStruct,
+// except it panics on any error diagnostics. This is useful when the Go
+// struct type is static, such as in a function Definition method.
+func NewObjectReturnFromStructMust(ctx context.Context, value any) ObjectReturn {
+	objectReturn, diags := NewObjectReturnFromStruct(ctx, value)
+
+	if diags.HasError() {
+		panic("NewObjectReturnFromStructMust received error(s): " + diags.ToError().Error())
+	}
+
+	return objectReturn
+}
+
+var (
+	attrValueReflectType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	bigFloatReflectType  = reflect.TypeOf(big.Float{})
+	bigIntReflectType    = reflect.TypeOf(big.Int{})
+)
+
+// attrTypeFromGoType returns the attr.Type equivalent of the given Go type,
+// as used by NewObjectReturnFromStruct.
+func attrTypeFromGoType(ctx context.Context, typ reflect.Type, p path.Path) (attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typ.Kind() != reflect.Pointer && typ.Kind() != reflect.Interface && typ.Implements(attrValueReflectType) {
+		value, _ := reflect.Zero(typ).Interface().(attr.Value)
+		attrType := value.Type(ctx)
+
+		switch attrType.(type) {
+		case attr.TypeWithAttributeTypes, attr.TypeWithElementType, attr.TypeWithElementTypes:
+			diags.Append(attrTypeFromGoTypeDiag(p, typ, "Framework collection and object value types cannot be inferred. Define the AttributeTypes field instead."))
+
+			return nil, diags
+		}
+
+		return attrType, diags
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		if typ.Elem() == bigFloatReflectType || typ.Elem() == bigIntReflectType {
+			return basetypes.NumberType{}, diags
+		}
+
+		return attrTypeFromGoType(ctx, typ.Elem(), p)
+	case reflect.Bool:
+		return basetypes.BoolType{}, diags
+	case reflect.Int32:
+		return basetypes.Int32Type{}, diags
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return basetypes.Int64Type{}, diags
+	case reflect.Float32:
+		return basetypes.Float32Type{}, diags
+	case reflect.Float64:
+		return basetypes.Float64Type{}, diags
+	case reflect.String:
+		return basetypes.StringType{}, diags
+	case reflect.Array, reflect.Slice:
+		elemType, elemDiags := attrTypeFromGoType(ctx, typ.Elem(), p.AtListIndex(0))
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return basetypes.ListType{ElemType: elemType}, diags
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			diags.Append(attrTypeFromGoTypeDiag(p, typ, "Map keys must be strings."))
+
+			return nil, diags
+		}
+
+		elemType, elemDiags := attrTypeFromGoType(ctx, typ.Elem(), p.AtMapKey("*"))
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return basetypes.MapType{ElemType: elemType}, diags
+	case reflect.Struct:
+		attrTypes := make(map[string]attr.Type, typ.NumField())
+
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			tag := field.Tag.Get(`tfsdk`)
+
+			if tag == "-" {
+				continue
+			}
+
+			if tag == "" {
+				diags.Append(attrTypeFromGoTypeDiag(p, typ, fmt.Sprintf(`Missing "tfsdk" struct tag on field %s.`, field.Name)))
+
+				return nil, diags
+			}
+
+			if _, ok := attrTypes[tag]; ok {
+				diags.Append(attrTypeFromGoTypeDiag(p, typ, fmt.Sprintf("Duplicate %q struct tag on field %s.", tag, field.Name)))
+
+				return nil, diags
+			}
+
+			attrType, attrTypeDiags := attrTypeFromGoType(ctx, field.Type, p.AtName(tag))
+
+			diags.Append(attrTypeDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			attrTypes[tag] = attrType
+		}
+
+		return basetypes.ObjectType{AttrTypes: attrTypes}, diags
+	}
+
+	diags.Append(attrTypeFromGoTypeDiag(p, typ, "Unsupported Go type."))
+
+	return nil, diags
+}
+
+func attrTypeFromGoTypeDiag(p path.Path, typ reflect.Type, detail string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Invalid Object Return Struct",
+		"An unexpected error was encountered trying to build an object return from a Go struct. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Cannot infer framework type from Go type %s. %s", typ, detail),
+	)
+}
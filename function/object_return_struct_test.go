@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestNewObjectReturnFromStruct(t *testing.T) {
+	t.Parallel()
+
+	type nestedStruct struct {
+		Name string `tfsdk:"name"`
+	}
+
+	testCases := map[string]struct {
+		value         any
+		expected      function.ObjectReturn
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Return Struct",
+					"An unexpected error was encountered trying to build an object return from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received nil value, expected struct.",
+				),
+			},
+		},
+		"not-struct": {
+			value: "test",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Return Struct",
+					"An unexpected error was encountered trying to build an object return from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received string, expected struct.",
+				),
+			},
+		},
+		"primitives": {
+			value: struct {
+				Bool    bool       `tfsdk:"bool"`
+				Float32 float32    `tfsdk:"float32"`
+				Float64 float64    `tfsdk:"float64"`
+				Int     int        `tfsdk:"int"`
+				Int32   int32      `tfsdk:"int32"`
+				Int64   int64      `tfsdk:"int64"`
+				Number  *big.Float `tfsdk:"number"`
+				String  string     `tfsdk:"string"`
+			}{},
+			expected: function.ObjectReturn{
+				AttributeTypes: map[string]attr.Type{
+					"bool":    basetypes.BoolType{},
+					"float32": basetypes.Float32Type{},
+					"float64": basetypes.Float64Type{},
+					"int":     basetypes.Int64Type{},
+					"int32":   basetypes.Int32Type{},
+					"int64":   basetypes.Int64Type{},
+					"number":  basetypes.NumberType{},
+					"string":  basetypes.StringType{},
+				},
+			},
+		},
+		"pointer": {
+			value: &struct {
+				String *string `tfsdk:"string"`
+			}{},
+			expected: function.ObjectReturn{
+				AttributeTypes: map[string]attr.Type{
+					"string": basetypes.StringType{},
+				},
+			},
+		},
+		"collections-and-nested": {
+			value: struct {
+				List   []string          `tfsdk:"list"`
+				Map    map[string]int64  `tfsdk:"map"`
+				Object nestedStruct      `tfsdk:"object"`
+				Nested []nestedStruct    `tfsdk:"nested"`
+				Values map[string]string `tfsdk:"-"`
+			}{},
+			expected: function.ObjectReturn{
+				AttributeTypes: map[string]attr.Type{
+					"list": basetypes.ListType{
+						ElemType: basetypes.StringType{},
+					},
+					"map": basetypes.MapType{
+						ElemType: basetypes.Int64Type{},
+					},
+					"object": basetypes.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"name": basetypes.StringType{},
+						},
+					},
+					"nested": basetypes.ListType{
+						ElemType: basetypes.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"name": basetypes.StringType{},
+							},
+						},
+					},
+				},
+			},
+		},
+		"framework-types": {
+			value: struct {
+				Bool   types.Bool   `tfsdk:"bool"`
+				String types.String `tfsdk:"string"`
+			}{},
+			expected: function.ObjectReturn{
+				AttributeTypes: map[string]attr.Type{
+					"bool":   basetypes.BoolType{},
+					"string": basetypes.StringType{},
+				},
+			},
+		},
+		"framework-collection-type": {
+			value: struct {
+				List types.List `tfsdk:"list"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list"),
+					"Invalid Object Return Struct",
+					"An unexpected error was encountered trying to build an object return from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer framework type from Go type basetypes.ListValue. "+
+						"Framework collection and object value types cannot be inferred. Define the AttributeTypes field instead.",
+				),
+			},
+		},
+		"missing-tag": {
+			value: struct {
+				String string
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Object Return Struct",
+					"An unexpected error was encountered trying to build an object return from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer framework type from Go type struct { String string }. "+
+						`Missing "tfsdk" struct tag on field String.`,
+				),
+			},
+		},
+		"unsupported-map-key": {
+			value: struct {
+				Map map[int]string `tfsdk:"map"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("map"),
+					"Invalid Object Return Struct",
+					"An unexpected error was encountered trying to build an object return from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer framework type from Go type map[int]string. Map keys must be strings.",
+				),
+			},
+		},
+		"unsupported-type": {
+			value: struct {
+				Func func() `tfsdk:"func"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("func"),
+					"Invalid Object Return Struct",
+					"An unexpected error was encountered trying to build an object return from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer framework type from Go type func(). Unsupported Go type.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := function.NewObjectReturnFromStruct(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewObjectReturnFromStructMust(t *testing.T) {
+	t.Parallel()
+
+	got := function.NewObjectReturnFromStructMust(context.Background(), struct {
+		String string `tfsdk:"string"`
+	}{})
+
+	expected := function.ObjectReturn{
+		AttributeTypes: map[string]attr.Type{
+			"string": basetypes.StringType{},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic")
+		}
+	}()
+
+	function.NewObjectReturnFromStructMust(context.Background(), "test")
+}
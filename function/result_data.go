@@ -5,6 +5,8 @@ package function
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	fwreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -45,6 +47,40 @@ func (d *ResultData) Set(ctx context.Context, value any) *FuncError {
 	return nil
 }
 
+// SetStruct saves the result data from a Go struct, where each field tagged
+// with `tfsdk` is mapped to the object attribute of the same name. The result
+// definition must be an object, such as an ObjectReturn created with
+// NewObjectReturnFromStruct, and the struct fields must exactly match the
+// object attributes. A function error is returned if the value is not a
+// struct or if any field cannot be converted to its attribute type.
+func (d *ResultData) SetStruct(ctx context.Context, value any) *FuncError {
+	if d.value == nil {
+		return NewFuncError("Value Conversion Error: An unexpected error was encountered trying to set the result data from a struct. " +
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+			"Result data is missing a value type.")
+	}
+
+	if _, ok := d.value.Type(ctx).(attr.TypeWithAttributeTypes); !ok {
+		return NewFuncError("Value Conversion Error: An unexpected error was encountered trying to set the result data from a struct. " +
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+			fmt.Sprintf("Result data type must be an object, got: %s", d.value.Type(ctx)))
+	}
+
+	reflectValue := reflect.ValueOf(value)
+
+	for reflectValue.Kind() == reflect.Pointer && !reflectValue.IsNil() {
+		reflectValue = reflectValue.Elem()
+	}
+
+	if reflectValue.Kind() != reflect.Struct {
+		return NewFuncError("Value Conversion Error: An unexpected error was encountered trying to set the result data from a struct. " +
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+			fmt.Sprintf("Value must be a struct, got: %T", value))
+	}
+
+	return d.Set(ctx, reflectValue.Interface())
+}
+
 // Value returns the saved value.
 func (d ResultData) Value() attr.Value {
 	return d.value
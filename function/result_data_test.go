@@ -71,3 +71,115 @@ func TestResultDataSet(t *testing.T) {
 		})
 	}
 }
+
+func TestResultDataSetStruct(t *testing.T) {
+	t.Parallel()
+
+	type resultStruct struct {
+		Quotient  int64 `tfsdk:"quotient"`
+		Remainder int64 `tfsdk:"remainder"`
+	}
+
+	attrTypes := map[string]attr.Type{
+		"quotient":  basetypes.Int64Type{},
+		"remainder": basetypes.Int64Type{},
+	}
+
+	testCases := map[string]struct {
+		resultData  function.ResultData
+		value       any
+		expected    attr.Value
+		expectedErr *function.FuncError
+	}{
+		"struct": {
+			resultData: function.NewResultData(basetypes.NewObjectUnknown(attrTypes)),
+			value: resultStruct{
+				Quotient:  3,
+				Remainder: 1,
+			},
+			expected: basetypes.NewObjectValueMust(
+				attrTypes,
+				map[string]attr.Value{
+					"quotient":  basetypes.NewInt64Value(3),
+					"remainder": basetypes.NewInt64Value(1),
+				},
+			),
+		},
+		"struct-pointer": {
+			resultData: function.NewResultData(basetypes.NewObjectUnknown(attrTypes)),
+			value: &resultStruct{
+				Quotient:  3,
+				Remainder: 1,
+			},
+			expected: basetypes.NewObjectValueMust(
+				attrTypes,
+				map[string]attr.Value{
+					"quotient":  basetypes.NewInt64Value(3),
+					"remainder": basetypes.NewInt64Value(1),
+				},
+			),
+		},
+		"not-struct": {
+			resultData: function.NewResultData(basetypes.NewObjectUnknown(attrTypes)),
+			value:      "test",
+			expected:   basetypes.NewObjectUnknown(attrTypes),
+			expectedErr: function.NewFuncError("Value Conversion Error: An unexpected error was encountered trying to set the result data from a struct. " +
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+				"Value must be a struct, got: string"),
+		},
+		"not-object": {
+			resultData: function.NewResultData(basetypes.NewStringUnknown()),
+			value:      resultStruct{},
+			expected:   basetypes.NewStringUnknown(),
+			expectedErr: function.NewFuncError("Value Conversion Error: An unexpected error was encountered trying to set the result data from a struct. " +
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+				"Result data type must be an object, got: basetypes.StringType"),
+		},
+		"type-mismatch": {
+			resultData: function.NewResultData(basetypes.NewObjectUnknown(attrTypes)),
+			value: struct {
+				Quotient  string `tfsdk:"quotient"`
+				Remainder int64  `tfsdk:"remainder"`
+			}{
+				Quotient:  "three",
+				Remainder: 1,
+			},
+			expected: basetypes.NewObjectUnknown(attrTypes),
+			expectedErr: function.NewFuncError("Int64 Type Validation Error: An unexpected error was encountered trying to validate an attribute value. " +
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+				"Expected Number value, received tftypes.Value with value: tftypes.String<\"three\">"),
+		},
+		"field-mismatch": {
+			resultData: function.NewResultData(basetypes.NewObjectUnknown(attrTypes)),
+			value: struct {
+				Quotient int64 `tfsdk:"quotient"`
+			}{
+				Quotient: 3,
+			},
+			expected: basetypes.NewObjectUnknown(attrTypes),
+			expectedErr: function.NewFuncError("Value Conversion Error: An unexpected error was encountered trying to convert from struct into an object. " +
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+				"Mismatch between struct and object type: Object defines fields not found in struct: remainder.\n" +
+				"Struct: struct { Quotient int64 \"tfsdk:\\\"quotient\\\"\" }\n" +
+				"Object type: types.ObjectType[\"quotient\":basetypes.Int64Type, \"remainder\":basetypes.Int64Type]"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.resultData.SetStruct(context.Background(), testCase.value)
+
+			if diff := cmp.Diff(testCase.resultData.Value(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(err, testCase.expectedErr); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwlog contains helpers for enriching provider logs, written with
+// the terraform-plugin-log tflog package, with framework concepts such as
+// attribute paths.
+package fwlog
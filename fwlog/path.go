@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwlog
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// KeyAttributePath is the structured logging key which WithPath sets to the
+// string representation of the attribute path.
+const KeyAttributePath = logging.KeyAttributePath
+
+// WithPath returns a new Context with the KeyAttributePath field set to the
+// given path for all subsequent provider logs, such as those written with
+// tflog.Debug.
+//
+// The framework automatically calls this function before invoking
+// attribute and block validators and plan modifiers, so provider-defined
+// logic in those does not need to call it unless logging about other paths.
+func WithPath(ctx context.Context, p path.Path) context.Context {
+	return tflog.SetField(ctx, KeyAttributePath, p.String())
+}
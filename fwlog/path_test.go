@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwlog_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/fwlog"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestWithPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path            path.Path
+		expectedEntries []map[string]interface{}
+	}{
+		"empty": {
+			path: path.Empty(),
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":            "debug",
+					"@message":          "test message",
+					"@module":           "provider",
+					"tf_attribute_path": "",
+				},
+			},
+		},
+		"nested": {
+			path: path.Root("test_list").AtListIndex(1).AtName("test_attr"),
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":            "debug",
+					"@message":          "test message",
+					"@module":           "provider",
+					"tf_attribute_path": "test_list[1].test_attr",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+			ctx = fwlog.WithPath(ctx, testCase.path)
+
+			tflog.Debug(ctx, "test message")
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(entries, testCase.expectedEntries); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwprogress contains a helper for reporting the progress of long
+// running provider logic, such as resource Create methods which wait for a
+// remote system, with periodic structured logs written with the
+// terraform-plugin-log tflog package.
+package fwprogress
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprogress
+
+import (
+	"context"
+	"time"
+)
+
+// StartWithTicks exposes Start to the fwprogress_test package with progress
+// logs written on each receive from the given channel instead of a ticker.
+func StartWithTicks(ctx context.Context, config Config, ticks <-chan time.Time) *Reporter {
+	return start(ctx, config, func(time.Duration) (<-chan time.Time, func()) {
+		return ticks, func() {}
+	})
+}
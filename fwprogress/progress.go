@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprogress
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	// DefaultInterval is the time between progress logs when the Config
+	// Interval field is zero.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultMessage is the progress log message when the Config Message
+	// field is empty.
+	DefaultMessage = "Operation in progress"
+
+	// KeyElapsed is the structured logging key for the time elapsed since
+	// Start was called.
+	KeyElapsed = "tf_progress_elapsed"
+
+	// KeyRemaining is the structured logging key for the time remaining
+	// until the context deadline, such as from resource timeouts. It is only
+	// set when the context has a deadline.
+	KeyRemaining = "tf_progress_remaining"
+)
+
+// Config defines the behavior of Start.
+type Config struct {
+	// Interval is the time between progress logs. If zero, DefaultInterval
+	// is used.
+	Interval time.Duration
+
+	// Message is the progress log message. If empty, DefaultMessage is used.
+	Message string
+}
+
+// PrivateState is the interface for setting provider-defined private state
+// data, such as the resource.CreateResponse type Private field.
+type PrivateState interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// Reporter periodically logs progress until Stop is called or the context
+// given to Start is done. Use Start to create a Reporter.
+type Reporter struct {
+	config  Config
+	fields  map[string]any
+	mutex   sync.Mutex
+	start   time.Time
+	stop    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+
+	// ticks returns the channel which triggers each progress log and a
+	// function to release it. Tests replace this to control log timing.
+	ticks func(time.Duration) (<-chan time.Time, func())
+}
+
+// Start returns a Reporter which logs the Config Message at info level on
+// each Config Interval, including the latest fields given to SetField, the
+// time elapsed, and the time remaining until the context deadline. When the
+// context is done, such as when a resource timeout elapses, a final warning
+// log is written with the context error. Call Stop once the operation
+// completes, typically with defer.
+//
+// Terraform only receives the response after the operation returns, so
+// these logs are the only progress visible while an operation is running.
+func Start(ctx context.Context, config Config) *Reporter {
+	return start(ctx, config, newTicks)
+}
+
+// start implements Start with the given source of progress log ticks.
+func start(ctx context.Context, config Config, ticks func(time.Duration) (<-chan time.Time, func())) *Reporter {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	if config.Message == "" {
+		config.Message = DefaultMessage
+	}
+
+	r := &Reporter{
+		config:  config,
+		fields:  make(map[string]any),
+		start:   time.Now(),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+		ticks:   ticks,
+	}
+
+	go r.run(ctx)
+
+	return r
+}
+
+// SetField sets a structured logging field for subsequent progress logs,
+// such as a remote system status or an identifier which is known before the
+// operation completes. The value should be JSON serializable so it can be
+// saved with SaveTo.
+func (r *Reporter) SetField(key string, value any) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.fields[key] = value
+}
+
+// Fields returns a copy of the fields given to SetField.
+func (r *Reporter) Fields() map[string]any {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return maps.Clone(r.fields)
+}
+
+// SaveTo sets the given private state key to the JSON encoding of the
+// fields given to SetField. This enables the progress of an incomplete
+// operation, such as one which exceeded its timeout, to be saved alongside
+// partial state and read back in a subsequent operation.
+func (r *Reporter) SaveTo(ctx context.Context, private PrivateState, key string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if private == nil {
+		diags.AddError(
+			"Unable to Save Progress",
+			"An unexpected error was encountered while saving progress to private state. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Error: missing private state",
+		)
+
+		return diags
+	}
+
+	value, err := json.Marshal(r.Fields())
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Save Progress",
+			"An unexpected error was encountered while saving progress to private state. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, key, value)...)
+
+	return diags
+}
+
+// Stop stops progress logs and waits for any in-progress log to be written.
+// It is safe to call Stop multiple times.
+func (r *Reporter) Stop() {
+	r.once.Do(func() {
+		close(r.stop)
+	})
+
+	<-r.stopped
+}
+
+// run writes progress logs until Stop is called or the context is done.
+func (r *Reporter) run(ctx context.Context) {
+	defer close(r.stopped)
+
+	ticks, release := r.ticks(r.config.Interval)
+	defer release()
+
+	for {
+		select {
+		case <-r.stop:
+			// Stop is typically deferred, so the context may also be done
+			// when the operation returned due to a timeout.
+			if ctx.Err() != nil {
+				r.logDone(ctx)
+			}
+
+			return
+		case <-ctx.Done():
+			r.logDone(ctx)
+
+			return
+		case <-ticks:
+			tflog.Info(ctx, r.config.Message, r.logFields(ctx))
+		}
+	}
+}
+
+// newTicks returns the channel of a ticker with the given interval and its
+// Stop method.
+func newTicks(interval time.Duration) (<-chan time.Time, func()) {
+	ticker := time.NewTicker(interval)
+
+	return ticker.C, ticker.Stop
+}
+
+// logDone writes the final warning log when the context is done.
+func (r *Reporter) logDone(ctx context.Context) {
+	fields := r.logFields(ctx)
+	fields["error"] = ctx.Err().Error()
+
+	tflog.Warn(ctx, r.config.Message+": context done", fields)
+}
+
+// logFields returns the fields given to SetField with the elapsed and
+// remaining time.
+func (r *Reporter) logFields(ctx context.Context) map[string]any {
+	fields := r.Fields()
+	fields[KeyElapsed] = time.Since(r.start).Round(time.Second).String()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		fields[KeyRemaining] = max(time.Until(deadline), 0).Round(time.Second).String()
+	}
+
+	return fields
+}
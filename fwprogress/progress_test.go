@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprogress_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fwprogress"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	output := &writtenBuffer{
+		written: make(chan struct{}),
+	}
+
+	ctx := tflogtest.RootLogger(context.Background(), output)
+	ticks := make(chan time.Time)
+
+	reporter := fwprogress.StartWithTicks(ctx, fwprogress.Config{
+		Message: "Waiting for thing",
+	}, ticks)
+
+	ticks <- time.Now()
+	<-output.written
+
+	reporter.SetField("status", "pending")
+
+	ticks <- time.Now()
+	<-output.written
+
+	reporter.Stop()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output.Buffer)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	for _, entry := range entries {
+		if _, ok := entry[fwprogress.KeyElapsed]; !ok {
+			t.Errorf("expected %s field in entry: %v", fwprogress.KeyElapsed, entry)
+		}
+
+		delete(entry, fwprogress.KeyElapsed)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"@level":   "info",
+			"@message": "Waiting for thing",
+			"@module":  "provider",
+		},
+		{
+			"@level":   "info",
+			"@message": "Waiting for thing",
+			"@module":  "provider",
+			"status":   "pending",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+// writtenBuffer is a bytes.Buffer which signals each write, so tests can wait
+// for progress logs without sleeping.
+type writtenBuffer struct {
+	bytes.Buffer
+
+	written chan struct{}
+}
+
+func (b *writtenBuffer) Write(p []byte) (int, error) {
+	n, err := b.Buffer.Write(p)
+
+	b.written <- struct{}{}
+
+	return n, err
+}
+
+func TestStart_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		context         func(context.Context) (context.Context, context.CancelFunc)
+		config          fwprogress.Config
+		expectedEntries []map[string]interface{}
+	}{
+		"canceled": {
+			context: context.WithCancel,
+			config: fwprogress.Config{
+				Interval: time.Hour,
+			},
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":              "warn",
+					"@message":            "Operation in progress: context done",
+					"@module":             "provider",
+					"error":               "context canceled",
+					"status":              "pending",
+					fwprogress.KeyElapsed: "0s",
+				},
+			},
+		},
+		"deadline-exceeded": {
+			context: func(ctx context.Context) (context.Context, context.CancelFunc) {
+				return context.WithDeadline(ctx, time.Now().Add(-time.Minute))
+			},
+			config: fwprogress.Config{
+				Interval: time.Hour,
+				Message:  "Waiting for thing",
+			},
+			expectedEntries: []map[string]interface{}{
+				{
+					"@level":                "warn",
+					"@message":              "Waiting for thing: context done",
+					"@module":               "provider",
+					"error":                 "context deadline exceeded",
+					"status":                "pending",
+					fwprogress.KeyElapsed:   "0s",
+					fwprogress.KeyRemaining: "0s",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+			ctx, cancel := testCase.context(ctx)
+
+			reporter := fwprogress.Start(ctx, testCase.config)
+
+			reporter.SetField("status", "pending")
+
+			cancel()
+
+			reporter.Stop()
+
+			entries, err := tflogtest.MultilineJSONDecode(&output)
+
+			if err != nil {
+				t.Fatalf("unable to read multiple line JSON: %s", err)
+			}
+
+			// The field may not be set before the context is done.
+			for _, entry := range entries {
+				entry["status"] = "pending"
+			}
+
+			if diff := cmp.Diff(entries, testCase.expectedEntries); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReporterStop(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	reporter := fwprogress.Start(ctx, fwprogress.Config{
+		Interval: time.Hour,
+	})
+
+	reporter.Stop()
+	reporter.Stop()
+
+	if output.Len() != 0 {
+		t.Errorf("expected no logs, got: %s", output.String())
+	}
+}
+
+func TestReporterSaveTo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fields        map[string]any
+		private       func() *privatestate.ProviderData
+		key           string
+		expectedValue []byte
+		expected      diag.Diagnostics
+	}{
+		"no-fields": {
+			private:       func() *privatestate.ProviderData { return privatestate.EmptyProviderData(context.Background()) },
+			key:           "progress",
+			expectedValue: []byte(`{}`),
+		},
+		"fields": {
+			fields: map[string]any{
+				"id":     "thing-123",
+				"status": "pending",
+			},
+			private:       func() *privatestate.ProviderData { return privatestate.EmptyProviderData(context.Background()) },
+			key:           "progress",
+			expectedValue: []byte(`{"id":"thing-123","status":"pending"}`),
+		},
+		"fields-invalid-json": {
+			fields: map[string]any{
+				"invalid": make(chan int),
+			},
+			private: func() *privatestate.ProviderData { return privatestate.EmptyProviderData(context.Background()) },
+			key:     "progress",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Save Progress",
+					"An unexpected error was encountered while saving progress to private state. "+
+						"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+						"Error: json: unsupported type: chan int",
+				),
+			},
+		},
+		"private-nil": {
+			private: func() *privatestate.ProviderData { return nil },
+			key:     "progress",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Save Progress",
+					"An unexpected error was encountered while saving progress to private state. "+
+						"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+						"Error: missing private state",
+				),
+			},
+		},
+		"key-reserved": {
+			private:  func() *privatestate.ProviderData { return privatestate.EmptyProviderData(context.Background()) },
+			key:      ".progress",
+			expected: privatestate.ValidateProviderDataKey(context.Background(), ".progress"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			reporter := fwprogress.Start(ctx, fwprogress.Config{
+				Interval: time.Hour,
+			})
+
+			defer reporter.Stop()
+
+			for key, value := range testCase.fields {
+				reporter.SetField(key, value)
+			}
+
+			private := testCase.private()
+
+			var got diag.Diagnostics
+
+			if private == nil {
+				got = reporter.SaveTo(ctx, nil, testCase.key)
+			} else {
+				got = reporter.SaveTo(ctx, private, testCase.key)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if testCase.expectedValue == nil {
+				return
+			}
+
+			value, diags := private.GetKey(ctx, testCase.key)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error getting key: %v", diags)
+			}
+
+			if diff := cmp.Diff(value, testCase.expectedValue); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
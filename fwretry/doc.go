@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwretry contains a helper for retrying provider logic, such as
+// remote system API calls in resource Create, Read, Update, and Delete
+// methods, with exponential backoff.
+package fwretry
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwretry
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+const (
+	// DefaultInitialInterval is the wait after the first attempt when the
+	// RetryConfig InitialInterval field is zero.
+	DefaultInitialInterval = 1 * time.Second
+
+	// DefaultMaxInterval is the maximum wait between attempts when the
+	// RetryConfig MaxInterval field is zero.
+	DefaultMaxInterval = 30 * time.Second
+
+	// DefaultMultiplier is the factor applied to the wait after each attempt
+	// when the RetryConfig Multiplier field is zero.
+	DefaultMultiplier = 2.0
+)
+
+// RetryConfig defines the retry behavior of Do.
+type RetryConfig struct {
+	// IsRetryable should return true if the diagnostics of an attempt should
+	// cause another attempt, such as when an error diagnostic represents a
+	// rate limiting or eventual consistency error from a remote system. If
+	// nil, diagnostics containing an error are always retryable.
+	IsRetryable func(diag.Diagnostics) bool
+
+	// InitialInterval is the wait after the first attempt. If zero,
+	// DefaultInitialInterval is used.
+	InitialInterval time.Duration
+
+	// MaxInterval is the maximum wait between attempts. If zero,
+	// DefaultMaxInterval is used.
+	MaxInterval time.Duration
+
+	// Multiplier is the factor applied to the wait after each attempt. If
+	// zero, DefaultMultiplier is used.
+	Multiplier float64
+
+	// MaxAttempts is the maximum number of attempts. If zero, attempts
+	// continue until the context is done or the attempt diagnostics are not
+	// retryable.
+	MaxAttempts int
+}
+
+// Do calls the given function until it returns diagnostics which are not
+// retryable, according to the RetryConfig IsRetryable field, and returns the
+// diagnostics of the final attempt. Waits between attempts increase
+// exponentially and are randomized between half and all of the interval,
+// which prevents many concurrent operations from retrying in unison.
+//
+// The context deadline, such as from resource timeouts, is honored by
+// returning the diagnostics of the latest attempt without waiting when the
+// next attempt would start after the deadline. If the context is canceled
+// while waiting, an error diagnostic is added to the latest attempt
+// diagnostics.
+func Do(ctx context.Context, config RetryConfig, f func() diag.Diagnostics) diag.Diagnostics {
+	isRetryable := config.IsRetryable
+
+	if isRetryable == nil {
+		isRetryable = func(diags diag.Diagnostics) bool {
+			return diags.HasError()
+		}
+	}
+
+	interval := config.InitialInterval
+
+	if interval <= 0 {
+		interval = DefaultInitialInterval
+	}
+
+	maxInterval := config.MaxInterval
+
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxInterval
+	}
+
+	multiplier := config.Multiplier
+
+	if multiplier <= 0 {
+		multiplier = DefaultMultiplier
+	}
+
+	for attempt := 1; ; attempt++ {
+		diags := f()
+
+		if !isRetryable(diags) {
+			return diags
+		}
+
+		if config.MaxAttempts > 0 && attempt >= config.MaxAttempts {
+			return diags
+		}
+
+		wait := jitter(min(interval, maxInterval))
+
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return diags
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			diags.AddError(
+				"Retry Canceled",
+				"The operation was canceled while waiting to retry. "+
+					"The diagnostics of the latest attempt are also returned.\n\n"+
+					"Error: "+ctx.Err().Error(),
+			)
+
+			return diags
+		case <-timer.C:
+		}
+
+		interval = min(time.Duration(float64(interval)*multiplier), maxInterval)
+	}
+}
+
+// jitter returns a random duration between half and all of the interval.
+func jitter(interval time.Duration) time.Duration {
+	half := interval / 2
+
+	if half <= 0 {
+		return interval
+	}
+
+	return half + rand.N(interval-half+1)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwretry_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fwretry"
+)
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	retryableDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic("retryable summary", "retryable detail"),
+	}
+	nonRetryableDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic("non-retryable summary", "non-retryable detail"),
+	}
+	isRetryable := func(diags diag.Diagnostics) bool {
+		return diags.Contains(retryableDiags[0])
+	}
+
+	testCases := map[string]struct {
+		config           fwretry.RetryConfig
+		timeout          time.Duration
+		results          []diag.Diagnostics
+		expected         diag.Diagnostics
+		expectedAttempts int
+	}{
+		"success": {
+			config: fwretry.RetryConfig{
+				InitialInterval: time.Millisecond,
+			},
+			results:          []diag.Diagnostics{nil},
+			expected:         nil,
+			expectedAttempts: 1,
+		},
+		"success-warning": {
+			config: fwretry.RetryConfig{
+				InitialInterval: time.Millisecond,
+			},
+			results: []diag.Diagnostics{
+				{diag.NewWarningDiagnostic("warning summary", "warning detail")},
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("warning summary", "warning detail"),
+			},
+			expectedAttempts: 1,
+		},
+		"retry-then-success": {
+			config: fwretry.RetryConfig{
+				InitialInterval: time.Millisecond,
+			},
+			results:          []diag.Diagnostics{retryableDiags, retryableDiags, nil},
+			expected:         nil,
+			expectedAttempts: 3,
+		},
+		"IsRetryable-false": {
+			config: fwretry.RetryConfig{
+				InitialInterval: time.Millisecond,
+				IsRetryable:     isRetryable,
+			},
+			results:          []diag.Diagnostics{retryableDiags, nonRetryableDiags, nil},
+			expected:         nonRetryableDiags,
+			expectedAttempts: 2,
+		},
+		"MaxAttempts": {
+			config: fwretry.RetryConfig{
+				InitialInterval: time.Millisecond,
+				MaxAttempts:     2,
+			},
+			results:          []diag.Diagnostics{retryableDiags, retryableDiags, nil},
+			expected:         retryableDiags,
+			expectedAttempts: 2,
+		},
+		"MaxInterval": {
+			config: fwretry.RetryConfig{
+				InitialInterval: time.Millisecond,
+				MaxInterval:     2 * time.Millisecond,
+				Multiplier:      100,
+			},
+			timeout:          time.Second,
+			results:          []diag.Diagnostics{retryableDiags, retryableDiags, retryableDiags, nil},
+			expected:         nil,
+			expectedAttempts: 4,
+		},
+		"deadline": {
+			config: fwretry.RetryConfig{
+				InitialInterval: time.Hour,
+				MaxInterval:     time.Hour,
+			},
+			timeout:          time.Minute,
+			results:          []diag.Diagnostics{retryableDiags, nil},
+			expected:         retryableDiags,
+			expectedAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.timeout > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, testCase.timeout)
+
+				defer cancel()
+			}
+
+			var attempts int
+
+			got := fwretry.Do(ctx, testCase.config, func() diag.Diagnostics {
+				result := testCase.results[attempts]
+
+				attempts++
+
+				return result
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if attempts != testCase.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", testCase.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestDo_Canceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var attempts int
+
+	got := fwretry.Do(ctx, fwretry.RetryConfig{InitialInterval: time.Hour}, func() diag.Diagnostics {
+		attempts++
+
+		cancel()
+
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("error summary", "error detail"),
+		}
+	})
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic("error summary", "error detail"),
+		diag.NewErrorDiagnostic(
+			"Retry Canceled",
+			"The operation was canceled while waiting to retry. "+
+				"The diagnostics of the latest attempt are also returned.\n\n"+
+				"Error: context canceled",
+		),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
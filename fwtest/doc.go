@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwtest contains helpers for provider unit testing of framework
+// implementations, such as running a single schema validator in isolation.
+package fwtest
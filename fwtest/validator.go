@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtest
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidatorPath returns the attribute path set in the Path and
+// PathExpression fields of requests created by the Run*Validator functions.
+// Use it when verifying the path of returned attribute diagnostics.
+func ValidatorPath() path.Path {
+	return path.Root("test")
+}
+
+// RunBoolValidator calls the ValidateBool method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.BoolRequest.
+func RunBoolValidator(ctx context.Context, v validator.Bool, value types.Bool) diag.Diagnostics {
+	req := validator.BoolRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.BoolResponse{}
+
+	v.ValidateBool(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunDynamicValidator calls the ValidateDynamic method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.DynamicRequest.
+func RunDynamicValidator(ctx context.Context, v validator.Dynamic, value types.Dynamic) diag.Diagnostics {
+	req := validator.DynamicRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.DynamicResponse{}
+
+	v.ValidateDynamic(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunFloat32Validator calls the ValidateFloat32 method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.Float32Request.
+func RunFloat32Validator(ctx context.Context, v validator.Float32, value types.Float32) diag.Diagnostics {
+	req := validator.Float32Request{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.Float32Response{}
+
+	v.ValidateFloat32(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunFloat64Validator calls the ValidateFloat64 method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.Float64Request.
+func RunFloat64Validator(ctx context.Context, v validator.Float64, value types.Float64) diag.Diagnostics {
+	req := validator.Float64Request{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.Float64Response{}
+
+	v.ValidateFloat64(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunInt32Validator calls the ValidateInt32 method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.Int32Request.
+func RunInt32Validator(ctx context.Context, v validator.Int32, value types.Int32) diag.Diagnostics {
+	req := validator.Int32Request{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.Int32Response{}
+
+	v.ValidateInt32(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunInt64Validator calls the ValidateInt64 method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.Int64Request.
+func RunInt64Validator(ctx context.Context, v validator.Int64, value types.Int64) diag.Diagnostics {
+	req := validator.Int64Request{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.Int64Response{}
+
+	v.ValidateInt64(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunListValidator calls the ValidateList method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.ListRequest.
+func RunListValidator(ctx context.Context, v validator.List, value types.List) diag.Diagnostics {
+	req := validator.ListRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.ListResponse{}
+
+	v.ValidateList(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunMapValidator calls the ValidateMap method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.MapRequest.
+func RunMapValidator(ctx context.Context, v validator.Map, value types.Map) diag.Diagnostics {
+	req := validator.MapRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.MapResponse{}
+
+	v.ValidateMap(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunNumberValidator calls the ValidateNumber method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.NumberRequest.
+func RunNumberValidator(ctx context.Context, v validator.Number, value types.Number) diag.Diagnostics {
+	req := validator.NumberRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.NumberResponse{}
+
+	v.ValidateNumber(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunObjectValidator calls the ValidateObject method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.ObjectRequest.
+func RunObjectValidator(ctx context.Context, v validator.Object, value types.Object) diag.Diagnostics {
+	req := validator.ObjectRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.ObjectResponse{}
+
+	v.ValidateObject(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunSetValidator calls the ValidateSet method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.SetRequest.
+func RunSetValidator(ctx context.Context, v validator.Set, value types.Set) diag.Diagnostics {
+	req := validator.SetRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.SetResponse{}
+
+	v.ValidateSet(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunStringValidator calls the ValidateString method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.StringRequest.
+func RunStringValidator(ctx context.Context, v validator.String, value types.String) diag.Diagnostics {
+	req := validator.StringRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.StringResponse{}
+
+	v.ValidateString(ctx, req, resp)
+
+	return resp.Diagnostics
+}
+
+// RunTupleValidator calls the ValidateTuple method of the given validator with
+// the given configuration value and returns the response diagnostics. The
+// request Path is ValidatorPath() and the request Config is empty, so
+// validators which read other configuration values should instead be
+// called with a manually created validator.TupleRequest.
+func RunTupleValidator(ctx context.Context, v validator.Tuple, value types.Tuple) diag.Diagnostics {
+	req := validator.TupleRequest{
+		Path:           ValidatorPath(),
+		PathExpression: ValidatorPath().Expression(),
+		ConfigValue:    value,
+	}
+	resp := &validator.TupleResponse{}
+
+	v.ValidateTuple(ctx, req, resp)
+
+	return resp.Diagnostics
+}
@@ -0,0 +1,652 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtest_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fwtest"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidatorPath(t *testing.T) {
+	t.Parallel()
+
+	if diff := cmp.Diff(fwtest.ValidatorPath(), path.Root("test")); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRunBoolValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Bool{
+		ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Bool
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.BoolNull(),
+			expected: nil,
+		},
+		"value": {
+			value: types.BoolValue(true),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.BoolValue(true).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunBoolValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunDynamicValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Dynamic{
+		ValidateDynamicMethod: func(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Dynamic
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.DynamicNull(),
+			expected: nil,
+		},
+		"value": {
+			value: types.DynamicValue(types.StringValue("test")),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.DynamicValue(types.StringValue("test")).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunDynamicValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunFloat32Validator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Float32{
+		ValidateFloat32Method: func(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Float32
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.Float32Null(),
+			expected: nil,
+		},
+		"value": {
+			value: types.Float32Value(1.2),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.Float32Value(1.2).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunFloat32Validator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunFloat64Validator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Float64{
+		ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Float64
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.Float64Null(),
+			expected: nil,
+		},
+		"value": {
+			value: types.Float64Value(1.2),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.Float64Value(1.2).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunFloat64Validator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunInt32Validator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Int32{
+		ValidateInt32Method: func(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Int32
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.Int32Null(),
+			expected: nil,
+		},
+		"value": {
+			value: types.Int32Value(1),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.Int32Value(1).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunInt32Validator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunInt64Validator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Int64{
+		ValidateInt64Method: func(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Int64
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.Int64Null(),
+			expected: nil,
+		},
+		"value": {
+			value: types.Int64Value(1),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.Int64Value(1).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunInt64Validator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunListValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.List{
+		ValidateListMethod: func(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.List
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.ListNull(types.StringType),
+			expected: nil,
+		},
+		"value": {
+			value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunListValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunMapValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Map{
+		ValidateMapMethod: func(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Map
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.MapNull(types.StringType),
+			expected: nil,
+		},
+		"value": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.MapValueMust(types.StringType, map[string]attr.Value{"key": types.StringValue("test")}).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunMapValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunNumberValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Number{
+		ValidateNumberMethod: func(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Number
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.NumberNull(),
+			expected: nil,
+		},
+		"value": {
+			value: types.NumberValue(big.NewFloat(1.2)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.NumberValue(big.NewFloat(1.2)).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunNumberValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunObjectValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Object{
+		ValidateObjectMethod: func(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Object
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.ObjectNull(map[string]attr.Type{"attr": types.StringType}),
+			expected: nil,
+		},
+		"value": {
+			value: types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.ObjectValueMust(map[string]attr.Type{"attr": types.StringType}, map[string]attr.Value{"attr": types.StringValue("test")}).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunObjectValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSetValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.Set{
+		ValidateSetMethod: func(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.Set
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.SetNull(types.StringType),
+			expected: nil,
+		},
+		"value": {
+			value: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}).String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunSetValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunStringValidator(t *testing.T) {
+	t.Parallel()
+
+	testValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if !req.PathExpression.Matches(req.Path) {
+				resp.Diagnostics.AddError("unexpected path expression", req.PathExpression.String())
+			}
+
+			if req.ConfigValue.IsNull() {
+				return
+			}
+
+			resp.Diagnostics.AddAttributeError(req.Path, "test summary", req.ConfigValue.String())
+		},
+	}
+
+	testCases := map[string]struct {
+		value    types.String
+		expected diag.Diagnostics
+	}{
+		"null": {
+			value:    types.StringNull(),
+			expected: nil,
+		},
+		"value": {
+			value: types.StringValue("test"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"test summary",
+					types.StringValue("test").String(),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtest.RunStringValidator(context.Background(), testValidator, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
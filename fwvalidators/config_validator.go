@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwvalidators
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ConfigValidator is a configuration validator which implements the
+// datasource.ConfigValidator, ephemeral.ConfigValidator,
+// provider.ConfigValidator, and resource.ConfigValidator interfaces.
+type ConfigValidator interface {
+	datasource.ConfigValidator
+	ephemeral.ConfigValidator
+	provider.ConfigValidator
+	resource.ConfigValidator
+}
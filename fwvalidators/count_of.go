@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwvalidators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ ConfigValidator = countOfValidator{}
+
+// CountOf returns a validator which ensures that the number of configured,
+// meaning known and not null, attribute values matching the given path
+// expressions is between minimum and maximum, inclusive. For example,
+// CountOf(2, 2, ...) requires exactly two of the attributes to be
+// configured, CountOf(1, 1, ...) is equivalent to an "exactly one of"
+// validator, and CountOf(0, 1, ...) is equivalent to a "conflicting"
+// validator.
+//
+// Unknown values are not counted as configured or unconfigured. An error
+// diagnostic is only returned if the count is invalid regardless of what the
+// unknown values become; otherwise validation is deferred until Terraform
+// calls the validator again with the unknown values resolved.
+//
+// If fewer attributes than minimum are configured, the error diagnostic is
+// returned without an attribute path. If more attributes than maximum are
+// configured, an error diagnostic is returned for each configured attribute.
+func CountOf(minimum, maximum int, expressions ...path.Expression) ConfigValidator {
+	return countOfValidator{
+		expressions: expressions,
+		maximum:     maximum,
+		minimum:     minimum,
+	}
+}
+
+// countOfValidator implements the validator.
+type countOfValidator struct {
+	expressions path.Expressions
+	maximum     int
+	minimum     int
+}
+
+// Description returns a human-readable description of the validator.
+func (v countOfValidator) Description(_ context.Context) string {
+	if v.minimum == v.maximum {
+		return fmt.Sprintf("Exactly %d of these attributes must be configured: %s", v.minimum, v.expressions)
+	}
+
+	return fmt.Sprintf("Between %d and %d of these attributes must be configured: %s", v.minimum, v.maximum, v.expressions)
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v countOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateDataSource implements the datasource.ConfigValidator interface.
+func (v countOfValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// ValidateEphemeralResource implements the ephemeral.ConfigValidator
+// interface.
+func (v countOfValidator) ValidateEphemeralResource(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// ValidateProvider implements the provider.ConfigValidator interface.
+func (v countOfValidator) ValidateProvider(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// ValidateResource implements the resource.ConfigValidator interface.
+func (v countOfValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	resp.Diagnostics.Append(v.validate(ctx, req.Config)...)
+}
+
+// validate implements the validation logic.
+func (v countOfValidator) validate(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.minimum < 0 || v.maximum < v.minimum {
+		diags.AddError(
+			"Invalid Validator Bounds",
+			"A CountOf validator was created with invalid minimum and maximum values. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Minimum: %d\nMaximum: %d", v.minimum, v.maximum),
+		)
+
+		return diags
+	}
+
+	var paths path.Paths
+
+	for _, expression := range v.expressions {
+		matchedPaths, matchedPathsDiags := config.PathMatches(ctx, expression)
+
+		diags.Append(matchedPathsDiags...)
+
+		paths.Append(matchedPaths...)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	var configured path.Paths
+	var unknown int
+
+	for _, p := range paths {
+		var value attr.Value
+
+		diags.Append(config.GetAttribute(ctx, p, &value)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if value.IsUnknown() {
+			unknown++
+
+			continue
+		}
+
+		if !value.IsNull() {
+			configured.Append(p)
+		}
+	}
+
+	if len(configured) > v.maximum {
+		for _, p := range configured {
+			diags.AddAttributeError(
+				p,
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%d attributes specified when %s", len(configured), v.requirement()),
+			)
+		}
+
+		return diags
+	}
+
+	if len(configured)+unknown < v.minimum {
+		diags.AddError(
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%d attributes specified when %s", len(configured), v.requirement()),
+		)
+	}
+
+	return diags
+}
+
+// requirement returns the validator requirement for diagnostics.
+func (v countOfValidator) requirement() string {
+	if v.minimum == v.maximum {
+		return fmt.Sprintf("exactly %d of %s must be specified", v.minimum, v.expressions)
+	}
+
+	return fmt.Sprintf("between %d and %d of %s must be specified", v.minimum, v.maximum, v.expressions)
+}
@@ -0,0 +1,259 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwvalidators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/fwvalidators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestCountOf(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"one": schema.StringAttribute{
+				Optional: true,
+			},
+			"two": schema.StringAttribute{
+				Optional: true,
+			},
+			"three": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+	testType := testSchema.Type().TerraformType(context.Background())
+	testConfig := func(one, two, three interface{}) tfsdk.Config {
+		return tfsdk.Config{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"one":   tftypes.NewValue(tftypes.String, one),
+				"two":   tftypes.NewValue(tftypes.String, two),
+				"three": tftypes.NewValue(tftypes.String, three),
+			}),
+			Schema: testSchema,
+		}
+	}
+	testExpressions := []path.Expression{
+		path.MatchRoot("one"),
+		path.MatchRoot("two"),
+		path.MatchRoot("three"),
+	}
+
+	testCases := map[string]struct {
+		minimum     int
+		maximum     int
+		expressions []path.Expression
+		config      tfsdk.Config
+		expected    diag.Diagnostics
+	}{
+		"exactly-satisfied": {
+			minimum:     2,
+			maximum:     2,
+			expressions: testExpressions,
+			config:      testConfig("one", "two", nil),
+		},
+		"exactly-too-few": {
+			minimum:     2,
+			maximum:     2,
+			expressions: testExpressions,
+			config:      testConfig("one", nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"1 attributes specified when exactly 2 of [one,two,three] must be specified",
+				),
+			},
+		},
+		"exactly-too-many": {
+			minimum:     2,
+			maximum:     2,
+			expressions: testExpressions,
+			config:      testConfig("one", "two", "three"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"3 attributes specified when exactly 2 of [one,two,three] must be specified",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("two"),
+					"Invalid Attribute Combination",
+					"3 attributes specified when exactly 2 of [one,two,three] must be specified",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("three"),
+					"Invalid Attribute Combination",
+					"3 attributes specified when exactly 2 of [one,two,three] must be specified",
+				),
+			},
+		},
+		"range-satisfied": {
+			minimum:     0,
+			maximum:     1,
+			expressions: testExpressions,
+			config:      testConfig(nil, nil, nil),
+		},
+		"range-too-few": {
+			minimum:     1,
+			maximum:     2,
+			expressions: testExpressions,
+			config:      testConfig(nil, nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"0 attributes specified when between 1 and 2 of [one,two,three] must be specified",
+				),
+			},
+		},
+		"unknown-deferred": {
+			minimum:     2,
+			maximum:     2,
+			expressions: testExpressions,
+			config:      testConfig("one", tftypes.UnknownValue, nil),
+		},
+		"unknown-too-few": {
+			minimum:     3,
+			maximum:     3,
+			expressions: testExpressions,
+			config:      testConfig("one", tftypes.UnknownValue, nil),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"1 attributes specified when exactly 3 of [one,two,three] must be specified",
+				),
+			},
+		},
+		"unknown-too-many": {
+			minimum:     1,
+			maximum:     1,
+			expressions: testExpressions,
+			config:      testConfig("one", "two", tftypes.UnknownValue),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("one"),
+					"Invalid Attribute Combination",
+					"2 attributes specified when exactly 1 of [one,two,three] must be specified",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("two"),
+					"Invalid Attribute Combination",
+					"2 attributes specified when exactly 1 of [one,two,three] must be specified",
+				),
+			},
+		},
+		"duplicate-expressions": {
+			minimum: 2,
+			maximum: 2,
+			expressions: []path.Expression{
+				path.MatchRoot("one"),
+				path.MatchRoot("one"),
+			},
+			config: testConfig("one", "two", nil),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Combination",
+					"1 attributes specified when exactly 2 of [one,one] must be specified",
+				),
+			},
+		},
+		"invalid-bounds": {
+			minimum:     2,
+			maximum:     1,
+			expressions: testExpressions,
+			config:      testConfig("one", nil, nil),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Bounds",
+					"A CountOf validator was created with invalid minimum and maximum values. "+
+						"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+						"Minimum: 2\nMaximum: 1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v := fwvalidators.CountOf(testCase.minimum, testCase.maximum, testCase.expressions...)
+
+			resourceResp := &resource.ValidateConfigResponse{}
+			v.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: testCase.config}, resourceResp)
+
+			if diff := cmp.Diff(resourceResp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected resource difference: %s", diff)
+			}
+
+			dataSourceResp := &datasource.ValidateConfigResponse{}
+			v.ValidateDataSource(context.Background(), datasource.ValidateConfigRequest{Config: testCase.config}, dataSourceResp)
+
+			if diff := cmp.Diff(dataSourceResp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected data source difference: %s", diff)
+			}
+
+			ephemeralResp := &ephemeral.ValidateConfigResponse{}
+			v.ValidateEphemeralResource(context.Background(), ephemeral.ValidateConfigRequest{Config: testCase.config}, ephemeralResp)
+
+			if diff := cmp.Diff(ephemeralResp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected ephemeral resource difference: %s", diff)
+			}
+
+			providerResp := &provider.ValidateConfigResponse{}
+			v.ValidateProvider(context.Background(), provider.ValidateConfigRequest{Config: testCase.config}, providerResp)
+
+			if diff := cmp.Diff(providerResp.Diagnostics, testCase.expected); diff != "" {
+				t.Errorf("unexpected provider difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCountOfDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator fwvalidators.ConfigValidator
+		expected  string
+	}{
+		"exactly": {
+			validator: fwvalidators.CountOf(2, 2, path.MatchRoot("one"), path.MatchRoot("two")),
+			expected:  "Exactly 2 of these attributes must be configured: [one,two]",
+		},
+		"range": {
+			validator: fwvalidators.CountOf(1, 2, path.MatchRoot("one"), path.MatchRoot("two")),
+			expected:  "Between 1 and 2 of these attributes must be configured: [one,two]",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.validator.Description(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
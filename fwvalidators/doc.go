@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwvalidators contains reusable configuration validators which can
+// be returned from the ConfigValidators method of data sources, ephemeral
+// resources, providers, and resources.
+package fwvalidators
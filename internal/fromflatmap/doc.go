@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fromflatmap contains functions to convert from the legacy flatmap
+// state format, written by Terraform CLI 0.11 and earlier, to
+// terraform-plugin-go tftypes types.
+package fromflatmap
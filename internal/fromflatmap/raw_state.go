@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromflatmap
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// RawState returns the tftypes.Value equivalent to the RawState. The JSON
+// format is preferred when available, otherwise the flatmap format is
+// converted using the given type, which must be an object type.
+func RawState(rawState tfprotov6.RawState, typ tftypes.Type, opts tfprotov6.UnmarshalOpts) (tftypes.Value, error) {
+	if rawState.JSON == nil && rawState.Flatmap != nil {
+		return Value(rawState.Flatmap, typ)
+	}
+
+	return rawState.UnmarshalWithOpts(typ, opts)
+}
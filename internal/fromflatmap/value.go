@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromflatmap
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UnknownValue is the sentinel string used by the flatmap format to represent
+// an unknown value.
+const UnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// Value returns the tftypes.Value equivalent to the flatmap, which must
+// represent an object of the given type. Attributes which are missing from
+// the flatmap are returned as null.
+func Value(flatmap map[string]string, typ tftypes.Type) (tftypes.Value, error) {
+	objectType, ok := typ.(tftypes.Object)
+
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("unable to convert flatmap: expected tftypes.Object type, got: %s", typ)
+	}
+
+	return objectValue(flatmap, "", objectType)
+}
+
+func value(flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		return listValue(flatmap, key, typ)
+	case tftypes.Map:
+		return mapValue(flatmap, key, typ)
+	case tftypes.Object:
+		return nestedObjectValue(flatmap, key, typ)
+	case tftypes.Set:
+		return setValue(flatmap, key, typ)
+	case tftypes.Tuple:
+		return tupleValue(flatmap, key, typ)
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool), typ.Is(tftypes.Number), typ.Is(tftypes.String):
+		return primitiveValue(flatmap, key, typ)
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unable to convert flatmap key %q: unsupported type %s", key, typ)
+}
+
+func primitiveValue(flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	raw, ok := flatmap[key]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if raw == UnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(raw)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("unable to convert flatmap key %q to bool: %w", key, err)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.Number):
+		n, _, err := big.ParseFloat(raw, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("unable to convert flatmap key %q to number: %w", key, err)
+		}
+
+		return tftypes.NewValue(typ, n), nil
+	default:
+		return tftypes.NewValue(typ, raw), nil
+	}
+}
+
+func objectValue(flatmap map[string]string, prefix string, typ tftypes.Object) (tftypes.Value, error) {
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for name, attributeType := range typ.AttributeTypes {
+		attributeValue, err := value(flatmap, prefix+name, attributeType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		attributes[name] = attributeValue
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// nestedObjectValue returns the object under the given key. The flatmap
+// format has no explicit marker for objects, so the object is null if there
+// are no keys underneath it.
+func nestedObjectValue(flatmap map[string]string, key string, typ tftypes.Object) (tftypes.Value, error) {
+	if flatmap[key] == UnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if len(childKeys(flatmap, key+".", nil)) == 0 {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	return objectValue(flatmap, key+".", typ)
+}
+
+func listValue(flatmap map[string]string, key string, typ tftypes.List) (tftypes.Value, error) {
+	count, null, unknown, err := collectionCount(flatmap, key, "#")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if null {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if unknown {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	elements := make([]tftypes.Value, 0, count)
+
+	for idx := 0; idx < count; idx++ {
+		element, err := value(flatmap, key+"."+strconv.Itoa(idx), typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+func tupleValue(flatmap map[string]string, key string, typ tftypes.Tuple) (tftypes.Value, error) {
+	count, null, unknown, err := collectionCount(flatmap, key, "#")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if null {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if unknown {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	if count != len(typ.ElementTypes) {
+		return tftypes.Value{}, fmt.Errorf("unable to convert flatmap key %q: expected %d tuple elements, got: %d", key, len(typ.ElementTypes), count)
+	}
+
+	elements := make([]tftypes.Value, 0, count)
+
+	for idx, elementType := range typ.ElementTypes {
+		element, err := value(flatmap, key+"."+strconv.Itoa(idx), elementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// setValue returns the set under the given key. Set elements are keyed by a
+// hash of their value, which is only used to group the flatmap keys of each
+// element and is otherwise ignored.
+func setValue(flatmap map[string]string, key string, typ tftypes.Set) (tftypes.Value, error) {
+	_, null, unknown, err := collectionCount(flatmap, key, "#")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if null {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if unknown {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	var elements []tftypes.Value
+
+	for _, elementKey := range childKeys(flatmap, key+".", splitFirstSegment) {
+		element, err := value(flatmap, key+"."+elementKey, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// mapValue returns the map under the given key. Older versions of Terraform
+// CLI wrote the map count with the same key suffix as lists and sets, so both
+// suffixes are accepted.
+func mapValue(flatmap map[string]string, key string, typ tftypes.Map) (tftypes.Value, error) {
+	countSuffix := "%"
+
+	if _, ok := flatmap[key+".%"]; !ok {
+		countSuffix = "#"
+	}
+
+	_, null, unknown, err := collectionCount(flatmap, key, countSuffix)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	if null {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if unknown {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	// Map keys can contain periods, so the entire remainder of the flatmap key
+	// is the map key unless the element type is nested.
+	var split func(string) string
+
+	switch typ.ElementType.(type) {
+	case tftypes.List, tftypes.Map, tftypes.Object, tftypes.Set, tftypes.Tuple:
+		split = splitFirstSegment
+	}
+
+	elements := make(map[string]tftypes.Value)
+
+	for _, elementKey := range childKeys(flatmap, key+".", split) {
+		element, err := value(flatmap, key+"."+elementKey, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements[elementKey] = element
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// collectionCount returns the number of elements recorded under the key with
+// the given count suffix, or whether the collection is null or unknown.
+func collectionCount(flatmap map[string]string, key string, suffix string) (count int, null bool, unknown bool, err error) {
+	raw, ok := flatmap[key+"."+suffix]
+
+	if !ok {
+		return 0, true, false, nil
+	}
+
+	if raw == UnknownValue {
+		return 0, false, true, nil
+	}
+
+	count, err = strconv.Atoi(raw)
+
+	if err != nil {
+		return 0, false, false, fmt.Errorf("unable to convert flatmap key %q to element count: %w", key+"."+suffix, err)
+	}
+
+	return count, false, false, nil
+}
+
+// childKeys returns the sorted, unique keys directly underneath the prefix,
+// excluding collection count keys. If split is non-nil, it is used to trim
+// each remaining key to its first segment.
+func childKeys(flatmap map[string]string, prefix string, split func(string) string) []string {
+	seen := make(map[string]struct{})
+
+	for key := range flatmap {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		child := strings.TrimPrefix(key, prefix)
+
+		if split != nil {
+			child = split(child)
+		}
+
+		if child == "#" || child == "%" {
+			continue
+		}
+
+		seen[child] = struct{}{}
+	}
+
+	result := make([]string, 0, len(seen))
+
+	for child := range seen {
+		result = append(result, child)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func splitFirstSegment(key string) string {
+	segment, _, _ := strings.Cut(key, ".")
+
+	return segment
+}
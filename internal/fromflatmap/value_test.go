@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromflatmap_test
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromflatmap"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		flatmap       map[string]string
+		typ           tftypes.Type
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"non-object-type": {
+			flatmap:       map[string]string{},
+			typ:           tftypes.String,
+			expected:      tftypes.Value{},
+			expectedError: fmt.Errorf("unable to convert flatmap: expected tftypes.Object type, got: tftypes.String"),
+		},
+		"primitives": {
+			flatmap: map[string]string{
+				"id":      "test-id",
+				"enabled": "true",
+				"count":   "1.5",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"count":   tftypes.Number,
+					"enabled": tftypes.Bool,
+					"id":      tftypes.String,
+					"missing": tftypes.String,
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"count":   tftypes.Number,
+						"enabled": tftypes.Bool,
+						"id":      tftypes.String,
+						"missing": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"count":   tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"id":      tftypes.NewValue(tftypes.String, "test-id"),
+					"missing": tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+		},
+		"primitive-unknown": {
+			flatmap: map[string]string{
+				"id": fromflatmap.UnknownValue,
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id": tftypes.String,
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			),
+		},
+		"primitive-invalid": {
+			flatmap: map[string]string{
+				"enabled": "not-a-bool",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"enabled": tftypes.Bool,
+				},
+			},
+			expected:      tftypes.Value{},
+			expectedError: fmt.Errorf("unable to convert flatmap key \"enabled\" to bool"),
+		},
+		"list": {
+			flatmap: map[string]string{
+				"tags.#": "2",
+				"tags.0": "one",
+				"tags.1": "two",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags":  tftypes.List{ElementType: tftypes.String},
+					"other": tftypes.List{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags":  tftypes.List{ElementType: tftypes.String},
+						"other": tftypes.List{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"tags": tftypes.NewValue(
+						tftypes.List{ElementType: tftypes.String},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, "two"),
+						},
+					),
+					"other": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				},
+			),
+		},
+		"list-nested-object": {
+			flatmap: map[string]string{
+				"block.#":      "1",
+				"block.0.name": "test",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"block": tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						},
+					},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"block": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"name": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"block": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"name": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"name": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"name": tftypes.NewValue(tftypes.String, "test"),
+								},
+							),
+						},
+					),
+				},
+			),
+		},
+		"list-unknown": {
+			flatmap: map[string]string{
+				"tags.#": fromflatmap.UnknownValue,
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"tags": tftypes.List{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				},
+			),
+		},
+		"list-invalid-count": {
+			flatmap: map[string]string{
+				"tags.#": "two",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.List{ElementType: tftypes.String},
+				},
+			},
+			expected:      tftypes.Value{},
+			expectedError: fmt.Errorf("unable to convert flatmap key \"tags.#\" to element count"),
+		},
+		"map": {
+			flatmap: map[string]string{
+				"labels.%":           "2",
+				"labels.key":         "value",
+				"labels.example.com": "dotted",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"labels": tftypes.Map{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"labels": tftypes.Map{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"labels": tftypes.NewValue(
+						tftypes.Map{ElementType: tftypes.String},
+						map[string]tftypes.Value{
+							"example.com": tftypes.NewValue(tftypes.String, "dotted"),
+							"key":         tftypes.NewValue(tftypes.String, "value"),
+						},
+					),
+				},
+			),
+		},
+		"map-legacy-count": {
+			flatmap: map[string]string{
+				"labels.#":   "1",
+				"labels.key": "value",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"labels": tftypes.Map{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"labels": tftypes.Map{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"labels": tftypes.NewValue(
+						tftypes.Map{ElementType: tftypes.String},
+						map[string]tftypes.Value{
+							"key": tftypes.NewValue(tftypes.String, "value"),
+						},
+					),
+				},
+			),
+		},
+		"set": {
+			flatmap: map[string]string{
+				"ids.#":          "2",
+				"ids.1234567890": "one",
+				"ids.2345678901": "two",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"ids": tftypes.Set{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"ids": tftypes.Set{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"ids": tftypes.NewValue(
+						tftypes.Set{ElementType: tftypes.String},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+							tftypes.NewValue(tftypes.String, "two"),
+						},
+					),
+				},
+			),
+		},
+		"set-nested-object": {
+			flatmap: map[string]string{
+				"rule.#":            "1",
+				"rule.1234567.name": "test",
+				"rule.1234567.port": "80",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"rule": tftypes.Set{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+								"port": tftypes.Number,
+							},
+						},
+					},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"rule": tftypes.Set{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"name": tftypes.String,
+									"port": tftypes.Number,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"rule": tftypes.NewValue(
+						tftypes.Set{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"name": tftypes.String,
+									"port": tftypes.Number,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"name": tftypes.String,
+										"port": tftypes.Number,
+									},
+								},
+								map[string]tftypes.Value{
+									"name": tftypes.NewValue(tftypes.String, "test"),
+									"port": tftypes.NewValue(tftypes.Number, big.NewFloat(80)),
+								},
+							),
+						},
+					),
+				},
+			),
+		},
+		"object-null": {
+			flatmap: map[string]string{},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"name": tftypes.String,
+						},
+					},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"nested": tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						},
+						nil,
+					),
+				},
+			),
+		},
+		"unsupported-type": {
+			flatmap: map[string]string{
+				"dynamic": "value",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"dynamic": tftypes.DynamicPseudoType,
+				},
+			},
+			expected:      tftypes.Value{},
+			expectedError: fmt.Errorf("unable to convert flatmap key \"dynamic\": unsupported type"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromflatmap.Value(testCase.flatmap, testCase.typ)
+
+			if err != nil {
+				if testCase.expectedError == nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError.Error()) {
+					t.Fatalf("expected error %q, got: %s", testCase.expectedError, err)
+				}
+			}
+
+			if err == nil && testCase.expectedError != nil {
+				t.Fatalf("got no error, expected: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
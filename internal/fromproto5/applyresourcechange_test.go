@@ -59,6 +59,7 @@ func TestApplyResourceChangeRequest(t *testing.T) {
 	testCases := map[string]struct {
 		input               *tfprotov5.ApplyResourceChangeRequest
 		resourceSchema      fwschema.Schema
+		identitySchema      fwschema.Schema
 		resource            resource.Resource
 		providerMetaSchema  fwschema.Schema
 		expected            *fwserver.ApplyResourceChangeRequest
@@ -255,7 +256,7 @@ func TestApplyResourceChangeRequest(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := fromproto5.ApplyResourceChangeRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema, testCase.providerMetaSchema)
+			got, diags := fromproto5.ApplyResourceChangeRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema, testCase.identitySchema, testCase.providerMetaSchema)
 
 			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
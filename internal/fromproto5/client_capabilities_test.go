@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestConfigureProviderClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.ConfigureProviderClientCapabilities
+		expected provider.ConfigureProviderClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: provider.ConfigureProviderClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov5.ConfigureProviderClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: provider.ConfigureProviderClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto5.ConfigureProviderClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadResourceClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.ReadResourceClientCapabilities
+		expected resource.ReadClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: resource.ReadClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov5.ReadResourceClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: resource.ReadClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto5.ReadResourceClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestModifyPlanClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.PlanResourceChangeClientCapabilities
+		expected resource.ModifyPlanClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: resource.ModifyPlanClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov5.PlanResourceChangeClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: resource.ModifyPlanClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto5.ModifyPlanClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadDataSourceClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.ReadDataSourceClientCapabilities
+		expected datasource.ReadClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: datasource.ReadClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov5.ReadDataSourceClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: datasource.ReadClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto5.ReadDataSourceClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateResourceTypeConfigClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov5.ValidateResourceTypeConfigClientCapabilities
+		expected resource.ValidateConfigClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: resource.ValidateConfigClientCapabilities{},
+		},
+		"WriteOnlyAttributesAllowed": {
+			in: &tfprotov5.ValidateResourceTypeConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+			expected: resource.ValidateConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto5.ValidateResourceTypeConfigClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -37,8 +37,9 @@ func ReadDataSourceRequest(ctx context.Context, proto5 *tfprotov5.ReadDataSource
 	}
 
 	fw := &fwserver.ReadDataSourceRequest{
-		DataSource:       dataSource,
-		DataSourceSchema: dataSourceSchema,
+		ClientCapabilities: ReadDataSourceClientCapabilities(proto5.ClientCapabilities),
+		DataSource:         dataSource,
+		DataSourceSchema:   dataSourceSchema,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, dataSourceSchema)
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -48,6 +49,14 @@ func TestReadResourceRequest(t *testing.T) {
 		},
 	}
 
+	testFwIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_attribute": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
 	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
 		"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
 	})
@@ -59,6 +68,7 @@ func TestReadResourceRequest(t *testing.T) {
 	testCases := map[string]struct {
 		input               *tfprotov5.ReadResourceRequest
 		resourceSchema      fwschema.Schema
+		identitySchema      fwschema.Schema
 		resource            resource.Resource
 		providerMetaSchema  fwschema.Schema
 		expected            *fwserver.ReadResourceRequest
@@ -99,6 +109,40 @@ func TestReadResourceRequest(t *testing.T) {
 				},
 			},
 		},
+		"currentidentity-missing-data": {
+			input:          &tfprotov5.ReadResourceRequest{},
+			identitySchema: testFwIdentitySchema,
+			expected: &fwserver.ReadResourceRequest{
+				CurrentIdentity: &tfsdk.ResourceIdentity{
+					Raw:    tftypes.NewValue(testProto5Type, nil),
+					Schema: testFwIdentitySchema,
+				},
+			},
+		},
+		"currentidentity-missing-schema": {
+			input: &tfprotov5.ReadResourceRequest{
+				CurrentIdentity: &tfprotov5.ResourceIdentityData{
+					IdentityData: &testProto5DynamicValue,
+				},
+			},
+			expected: &fwserver.ReadResourceRequest{
+				// This intentionally should not include CurrentIdentity
+			},
+		},
+		"currentidentity": {
+			input: &tfprotov5.ReadResourceRequest{
+				CurrentIdentity: &tfprotov5.ResourceIdentityData{
+					IdentityData: &testProto5DynamicValue,
+				},
+			},
+			identitySchema: testFwIdentitySchema,
+			expected: &fwserver.ReadResourceRequest{
+				CurrentIdentity: &tfsdk.ResourceIdentity{
+					Raw:    testProto5Value,
+					Schema: testFwIdentitySchema,
+				},
+			},
+		},
 		"private-malformed-json": {
 			input: &tfprotov5.ReadResourceRequest{
 				Private: []byte(`{`),
@@ -180,7 +224,7 @@ func TestReadResourceRequest(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := fromproto5.ReadResourceRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema, testCase.providerMetaSchema)
+			got, diags := fromproto5.ReadResourceRequest(context.Background(), testCase.input, testCase.resource, testCase.resourceSchema, testCase.identitySchema, testCase.providerMetaSchema)
 
 			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
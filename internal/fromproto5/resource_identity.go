@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ResourceIdentity returns the *tfsdk.ResourceIdentity for a
+// *tfprotov5.ResourceIdentityData and fwschema.Schema. A nil schema, such as
+// when the resource does not implement identity, returns nil. Missing identity
+// data, such as from Terraform versions without identity support, returns a
+// null value.
+func ResourceIdentity(ctx context.Context, proto5ResourceIdentityData *tfprotov5.ResourceIdentityData, schema fwschema.Schema) (*tfsdk.ResourceIdentity, diag.Diagnostics) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	fw := &tfsdk.ResourceIdentity{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
+	}
+
+	if proto5ResourceIdentityData == nil || proto5ResourceIdentityData.IdentityData == nil {
+		return fw, nil
+	}
+
+	var diags diag.Diagnostics
+
+	data, dynamicValueDiags := DynamicValue(ctx, proto5ResourceIdentityData.IdentityData, schema, fwschemadata.DataDescriptionResourceIdentity)
+
+	diags.Append(dynamicValueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	fw.Raw = data.TerraformValue
+
+	return fw, diags
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	testProto5Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.String,
+		},
+	}
+
+	testProto5Value := tftypes.NewValue(testProto5Type, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+	})
+
+	testProto5DynamicValue, err := tfprotov5.NewDynamicValue(testProto5Type, testProto5Value)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
+	testFwSchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_attribute": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testFwSchemaInvalid := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_attribute": identityschema.BoolAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		input               *tfprotov5.ResourceIdentityData
+		schema              fwschema.Schema
+		expected            *tfsdk.ResourceIdentity
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"missing-schema": {
+			input: &tfprotov5.ResourceIdentityData{
+				IdentityData: &testProto5DynamicValue,
+			},
+			expected: nil,
+		},
+		"missing-data": {
+			input:  nil,
+			schema: testFwSchema,
+			expected: &tfsdk.ResourceIdentity{
+				Raw:    tftypes.NewValue(testProto5Type, nil),
+				Schema: testFwSchema,
+			},
+		},
+		"invalid-schema": {
+			input: &tfprotov5.ResourceIdentityData{
+				IdentityData: &testProto5DynamicValue,
+			},
+			schema:   testFwSchemaInvalid,
+			expected: nil,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Resource Identity",
+					"An unexpected error was encountered when converting the resource identity from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: AttributeName(\"test_attribute\"): couldn't decode bool: msgpack: invalid code=aa decoding bool",
+				),
+			},
+		},
+		"valid": {
+			input: &tfprotov5.ResourceIdentityData{
+				IdentityData: &testProto5DynamicValue,
+			},
+			schema: testFwSchema,
+			expected: &tfsdk.ResourceIdentity{
+				Raw:    testProto5Value,
+				Schema: testFwSchema,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fromproto5.ResourceIdentity(context.Background(), testCase.input, testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto5
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+)
+
+// UpgradeResourceIdentityRequest returns the
+// *fwserver.UpgradeResourceIdentityRequest equivalent of a
+// *tfprotov5.UpgradeResourceIdentityRequest.
+func UpgradeResourceIdentityRequest(ctx context.Context, proto5 *tfprotov5.UpgradeResourceIdentityRequest, identitySchema fwschema.Schema) (*fwserver.UpgradeResourceIdentityRequest, diag.Diagnostics) {
+	if proto5 == nil {
+		return nil, nil
+	}
+
+	fw := &fwserver.UpgradeResourceIdentityRequest{
+		RawIdentity:    (*tfprotov6.RawState)(proto5.RawIdentity),
+		IdentitySchema: identitySchema,
+		Version:        proto5.Version,
+	}
+
+	return fw, nil
+}
@@ -24,8 +24,10 @@ func ValidateResourceTypeConfigRequest(ctx context.Context, proto5 *tfprotov5.Va
 
 	config, diags := Config(ctx, proto5.Config, resourceSchema)
 
+	fw.ClientCapabilities = ValidateResourceTypeConfigClientCapabilities(proto5.ClientCapabilities)
 	fw.Config = config
 	fw.Resource = resource
+	fw.TypeName = proto5.TypeName
 
 	return fw, diags
 }
@@ -88,6 +88,14 @@ func TestValidateResourceTypeConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov5.ValidateResourceTypeConfigRequest{
+				TypeName: "test_resource",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_resource",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ConfigureProviderClientCapabilities returns the
+// provider.ConfigureProviderClientCapabilities equivalent of a
+// *tfprotov6.ConfigureProviderClientCapabilities.
+func ConfigureProviderClientCapabilities(in *tfprotov6.ConfigureProviderClientCapabilities) provider.ConfigureProviderClientCapabilities {
+	if in == nil {
+		// Client did not indicate any supported capabilities
+		return provider.ConfigureProviderClientCapabilities{
+			DeferralAllowed: false,
+		}
+	}
+
+	return provider.ConfigureProviderClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ReadDataSourceClientCapabilities returns the
+// datasource.ReadClientCapabilities equivalent of a
+// *tfprotov6.ReadDataSourceClientCapabilities.
+func ReadDataSourceClientCapabilities(in *tfprotov6.ReadDataSourceClientCapabilities) datasource.ReadClientCapabilities {
+	if in == nil {
+		// Client did not indicate any supported capabilities
+		return datasource.ReadClientCapabilities{
+			DeferralAllowed: false,
+		}
+	}
+
+	return datasource.ReadClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ReadResourceClientCapabilities returns the resource.ReadClientCapabilities
+// equivalent of a *tfprotov6.ReadResourceClientCapabilities.
+func ReadResourceClientCapabilities(in *tfprotov6.ReadResourceClientCapabilities) resource.ReadClientCapabilities {
+	if in == nil {
+		// Client did not indicate any supported capabilities
+		return resource.ReadClientCapabilities{
+			DeferralAllowed: false,
+		}
+	}
+
+	return resource.ReadClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ModifyPlanClientCapabilities returns the
+// resource.ModifyPlanClientCapabilities equivalent of a
+// *tfprotov6.PlanResourceChangeClientCapabilities.
+func ModifyPlanClientCapabilities(in *tfprotov6.PlanResourceChangeClientCapabilities) resource.ModifyPlanClientCapabilities {
+	if in == nil {
+		// Client did not indicate any supported capabilities
+		return resource.ModifyPlanClientCapabilities{
+			DeferralAllowed: false,
+		}
+	}
+
+	return resource.ModifyPlanClientCapabilities{
+		DeferralAllowed: in.DeferralAllowed,
+	}
+}
+
+// ValidateResourceConfigClientCapabilities returns the
+// resource.ValidateConfigClientCapabilities equivalent of a
+// *tfprotov6.ValidateResourceConfigClientCapabilities.
+func ValidateResourceConfigClientCapabilities(in *tfprotov6.ValidateResourceConfigClientCapabilities) resource.ValidateConfigClientCapabilities {
+	if in == nil {
+		// Client did not indicate any supported capabilities
+		return resource.ValidateConfigClientCapabilities{
+			WriteOnlyAttributesAllowed: false,
+		}
+	}
+
+	return resource.ValidateConfigClientCapabilities{
+		WriteOnlyAttributesAllowed: in.WriteOnlyAttributesAllowed,
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestConfigureProviderClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.ConfigureProviderClientCapabilities
+		expected provider.ConfigureProviderClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: provider.ConfigureProviderClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov6.ConfigureProviderClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: provider.ConfigureProviderClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ConfigureProviderClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadResourceClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.ReadResourceClientCapabilities
+		expected resource.ReadClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: resource.ReadClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov6.ReadResourceClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: resource.ReadClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ReadResourceClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestModifyPlanClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.PlanResourceChangeClientCapabilities
+		expected resource.ModifyPlanClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: resource.ModifyPlanClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov6.PlanResourceChangeClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: resource.ModifyPlanClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ModifyPlanClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReadDataSourceClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.ReadDataSourceClientCapabilities
+		expected datasource.ReadClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: datasource.ReadClientCapabilities{},
+		},
+		"DeferralAllowed": {
+			in: &tfprotov6.ReadDataSourceClientCapabilities{
+				DeferralAllowed: true,
+			},
+			expected: datasource.ReadClientCapabilities{
+				DeferralAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ReadDataSourceClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateResourceConfigClientCapabilities(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       *tfprotov6.ValidateResourceConfigClientCapabilities
+		expected resource.ValidateConfigClientCapabilities
+	}{
+		"nil": {
+			in:       nil,
+			expected: resource.ValidateConfigClientCapabilities{},
+		},
+		"WriteOnlyAttributesAllowed": {
+			in: &tfprotov6.ValidateResourceConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+			expected: resource.ValidateConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromproto6.ValidateResourceConfigClientCapabilities(testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// CloseEphemeralResourceRequest returns the *fwserver.CloseEphemeralResourceRequest
+// equivalent of a *tfprotov6.CloseEphemeralResourceRequest.
+func CloseEphemeralResourceRequest(ctx context.Context, proto6 *tfprotov6.CloseEphemeralResourceRequest, ephemeralResource ephemeral.EphemeralResource) (*fwserver.CloseEphemeralResourceRequest, diag.Diagnostics) {
+	if proto6 == nil {
+		return nil, nil
+	}
+
+	fw := &fwserver.CloseEphemeralResourceRequest{
+		EphemeralResource: ephemeralResource,
+	}
+
+	privateData, diags := privatestate.NewData(ctx, proto6.Private)
+
+	fw.Private = privateData
+
+	return fw, diags
+}
@@ -5,13 +5,16 @@ package fromproto6_test
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -120,3 +123,106 @@ func TestConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigDynamicValueConversion(t *testing.T) {
+	t.Parallel()
+
+	testProto6Type := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.DynamicPseudoType,
+		},
+	}
+
+	testFwSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_attribute": testschema.Attribute{
+				Required: true,
+				Type:     types.DynamicType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		convert  func(context.Context, types.Dynamic) (attr.Value, diag.Diagnostics)
+		expected attr.Value
+	}{
+		"number-as-float64": {
+			input: tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			convert: func(ctx context.Context, v types.Dynamic) (attr.Value, diag.Diagnostics) {
+				return v.AsFloat64(ctx)
+			},
+			expected: types.Float64Value(1.5),
+		},
+		"number-as-int64": {
+			input: tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
+			convert: func(ctx context.Context, v types.Dynamic) (attr.Value, diag.Diagnostics) {
+				return v.AsInt64(ctx)
+			},
+			expected: types.Int64Value(123),
+		},
+		"tuple-as-list": {
+			input: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.String}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+				},
+			),
+			convert: func(ctx context.Context, v types.Dynamic) (attr.Value, diag.Diagnostics) {
+				return v.AsList(ctx)
+			},
+			expected: types.ListValueMust(
+				types.StringType,
+				[]attr.Value{
+					types.StringValue("hello"),
+					types.StringValue("world"),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			testProto6Value := tftypes.NewValue(testProto6Type, map[string]tftypes.Value{
+				"test_attribute": testCase.input,
+			})
+
+			testProto6DynamicValue, err := tfprotov6.NewDynamicValue(testProto6Type, testProto6Value)
+
+			if err != nil {
+				t.Fatalf("unexpected error calling tfprotov6.NewDynamicValue(): %s", err)
+			}
+
+			config, diags := fromproto6.Config(ctx, &testProto6DynamicValue, testFwSchema)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", diags)
+			}
+
+			var dynamicValue types.Dynamic
+
+			diags = config.GetAttribute(ctx, path.Root("test_attribute"), &dynamicValue)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", diags)
+			}
+
+			got, diags := testCase.convert(ctx, dynamicValue)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, diag.Diagnostics(nil)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
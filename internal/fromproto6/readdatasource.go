@@ -37,8 +37,9 @@ func ReadDataSourceRequest(ctx context.Context, proto6 *tfprotov6.ReadDataSource
 	}
 
 	fw := &fwserver.ReadDataSourceRequest{
-		DataSourceSchema: dataSourceSchema,
-		DataSource:       dataSource,
+		ClientCapabilities: ReadDataSourceClientCapabilities(proto6.ClientCapabilities),
+		DataSourceSchema:   dataSourceSchema,
+		DataSource:         dataSource,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, dataSourceSchema)
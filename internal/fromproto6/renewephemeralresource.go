@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// RenewEphemeralResourceRequest returns the *fwserver.RenewEphemeralResourceRequest
+// equivalent of a *tfprotov6.RenewEphemeralResourceRequest.
+func RenewEphemeralResourceRequest(ctx context.Context, proto6 *tfprotov6.RenewEphemeralResourceRequest, ephemeralResource ephemeral.EphemeralResource) (*fwserver.RenewEphemeralResourceRequest, diag.Diagnostics) {
+	if proto6 == nil {
+		return nil, nil
+	}
+
+	fw := &fwserver.RenewEphemeralResourceRequest{
+		EphemeralResource: ephemeralResource,
+	}
+
+	privateData, diags := privatestate.NewData(ctx, proto6.Private)
+
+	fw.Private = privateData
+
+	return fw, diags
+}
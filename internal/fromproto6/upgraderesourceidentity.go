@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+)
+
+// UpgradeResourceIdentityRequest returns the
+// *fwserver.UpgradeResourceIdentityRequest equivalent of a
+// *tfprotov6.UpgradeResourceIdentityRequest.
+func UpgradeResourceIdentityRequest(ctx context.Context, proto6 *tfprotov6.UpgradeResourceIdentityRequest, identitySchema fwschema.Schema) (*fwserver.UpgradeResourceIdentityRequest, diag.Diagnostics) {
+	if proto6 == nil {
+		return nil, nil
+	}
+
+	fw := &fwserver.UpgradeResourceIdentityRequest{
+		RawIdentity:    proto6.RawIdentity,
+		IdentitySchema: identitySchema,
+		Version:        proto6.Version,
+	}
+
+	return fw, nil
+}
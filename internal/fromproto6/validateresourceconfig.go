@@ -24,8 +24,10 @@ func ValidateResourceConfigRequest(ctx context.Context, proto6 *tfprotov6.Valida
 
 	config, diags := Config(ctx, proto6.Config, resourceSchema)
 
+	fw.ClientCapabilities = ValidateResourceConfigClientCapabilities(proto6.ClientCapabilities)
 	fw.Config = config
 	fw.Resource = resource
+	fw.TypeName = proto6.TypeName
 
 	return fw, diags
 }
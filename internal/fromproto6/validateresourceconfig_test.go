@@ -88,6 +88,14 @@ func TestValidateResourceConfigRequest(t *testing.T) {
 				},
 			},
 		},
+		"typename": {
+			input: &tfprotov6.ValidateResourceConfigRequest{
+				TypeName: "test_resource",
+			},
+			expected: &fwserver.ValidateResourceConfigRequest{
+				TypeName: "test_resource",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -6,6 +6,7 @@ package fwschema
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -126,6 +127,46 @@ func IsReservedResourceAttributeName(name string, attributePath path.Path) diag.
 	return diags
 }
 
+// ConflictingAttributeBlockNames returns an error diagnostic for each name
+// which is defined in both the given attributes and blocks, which Terraform
+// cannot represent. The parentPath is the path of the block containing the
+// attributes and blocks, or an empty path for the schema itself.
+func ConflictingAttributeBlockNames(attributes map[string]Attribute, blocks map[string]Block, parentPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var names []string
+
+	for name := range blocks {
+		if _, ok := attributes[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	// Ensure deterministic diagnostics ordering.
+	sort.Strings(names)
+
+	location := "the schema"
+
+	if len(parentPath.Steps()) > 0 {
+		location = fmt.Sprintf("the %s block nested object", parentPath)
+	}
+
+	for _, name := range names {
+		// The diagnostic path is intentionally omitted as it is invalid
+		// in this context. Diagnostic paths are intended to be mapped to
+		// actual data, while this path information must be synthesized.
+		diags.AddError(
+			"Conflicting Attribute and Block Name",
+			"When validating the schema, an implementation issue was found. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("%q is defined in both the Attributes and the Blocks of %s. ", name, location)+
+				"Attribute and block names must be unique within the same level of the schema.",
+		)
+	}
+
+	return diags
+}
+
 // IsValidAttributeName returns an error diagnostic if the given
 // attribute path has an invalid attribute name according to
 // ValidAttributeNameRegex. Non-AttributeName paths are automatically skipped
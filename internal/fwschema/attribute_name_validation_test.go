@@ -9,6 +9,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -343,3 +344,89 @@ func TestIsValidAttributeName(t *testing.T) {
 		})
 	}
 }
+
+func TestConflictingAttributeBlockNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes map[string]fwschema.Attribute
+		blocks     map[string]fwschema.Block
+		parentPath path.Path
+		expected   diag.Diagnostics
+	}{
+		"empty": {
+			parentPath: path.Empty(),
+			expected:   nil,
+		},
+		"no-conflicts": {
+			attributes: map[string]fwschema.Attribute{
+				"test_attribute": testschema.Attribute{},
+			},
+			blocks: map[string]fwschema.Block{
+				"test_block": testschema.Block{},
+			},
+			parentPath: path.Empty(),
+			expected:   nil,
+		},
+		"root": {
+			attributes: map[string]fwschema.Attribute{
+				"test_b": testschema.Attribute{},
+				"test_a": testschema.Attribute{},
+				"test_c": testschema.Attribute{},
+			},
+			blocks: map[string]fwschema.Block{
+				"test_b": testschema.Block{},
+				"test_a": testschema.Block{},
+			},
+			parentPath: path.Empty(),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Conflicting Attribute and Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_a\" is defined in both the Attributes and the Blocks of the schema. "+
+						"Attribute and block names must be unique within the same level of the schema.",
+				),
+				diag.NewErrorDiagnostic(
+					"Conflicting Attribute and Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_b\" is defined in both the Attributes and the Blocks of the schema. "+
+						"Attribute and block names must be unique within the same level of the schema.",
+				),
+			},
+		},
+		"nested": {
+			attributes: map[string]fwschema.Attribute{
+				"test": testschema.Attribute{},
+			},
+			blocks: map[string]fwschema.Block{
+				"test": testschema.Block{},
+			},
+			parentPath: path.Root("parent"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Conflicting Attribute and Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is defined in both the Attributes and the Blocks of the parent block nested object. "+
+						"Attribute and block names must be unique within the same level of the schema.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschema.ConflictingAttributeBlockNames(testCase.attributes, testCase.blocks, testCase.parentPath)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
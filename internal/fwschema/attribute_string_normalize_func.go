@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// AttributeWithStringNormalizeFunc is an optional interface on Attribute
+// which enables string value normalization support.
+type AttributeWithStringNormalizeFunc interface {
+	Attribute
+
+	// StringNormalizeFunc should return the function which converts a string
+	// value into its normalized form, or nil if the attribute has none.
+	StringNormalizeFunc() func(context.Context, string) (string, diag.Diagnostics)
+}
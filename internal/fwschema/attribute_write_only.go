@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschema
+
+// AttributeWithWriteOnly is an optional interface on Attribute which enables
+// write-only support.
+type AttributeWithWriteOnly interface {
+	Attribute
+
+	// IsWriteOnly should return true if the attribute configuration value is
+	// write-only and should never be stored in the plan or state.
+	IsWriteOnly() bool
+}
@@ -32,6 +32,7 @@ type BlockWithValidateImplementation interface {
 //   - Checks whether the given AttributeName in the path is a valid identifier
 //   - If the given Block implements the BlockWithValidateImplementation
 //     interface, calls the method
+//   - Checks whether nested attribute and block names conflict
 //   - Recursively calls this function on nested attributes and blocks
 func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateImplementationRequest) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -53,6 +54,8 @@ func ValidateBlockImplementation(ctx context.Context, block Block, req ValidateI
 		return diags
 	}
 
+	diags.Append(ConflictingAttributeBlockNames(nestedObject.GetAttributes(), nestedObject.GetBlocks(), req.Path)...)
+
 	nestingMode := block.GetNestingMode()
 
 	for nestedAttributeName, nestedAttribute := range nestedObject.GetAttributes() {
@@ -45,6 +45,22 @@ func AttributeMissingElementTypeDiag(attributePath path.Path) diag.Diagnostic {
 	)
 }
 
+// AttributeMissingElementTypesDiag returns an error diagnostic to provider
+// developers about a missing ElementTypes field, or a nil element type, on a
+// tuple Attribute implementation. This can cause unexpected errors or panics.
+func AttributeMissingElementTypesDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q is missing the ElementTypes field or has a nil element type on a tuple Attribute. ", attributePath)+
+			"Every element type is required to prevent other unexpected errors or panics.",
+	)
+}
+
 func AttributeDefaultElementTypeMismatchDiag(attributePath path.Path, expectedElementType attr.Type, actualElementType attr.Type) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		"Invalid Attribute Implementation",
@@ -17,6 +17,15 @@ type AttributeWithBoolValidators interface {
 	BoolValidators() []validator.Bool
 }
 
+// AttributeWithFloat32Validators is an optional interface on Attribute which
+// enables Float32 validation support.
+type AttributeWithFloat32Validators interface {
+	fwschema.Attribute
+
+	// Float32Validators should return a list of Float32 validators.
+	Float32Validators() []validator.Float32
+}
+
 // AttributeWithFloat64Validators is an optional interface on Attribute which
 // enables Float64 validation support.
 type AttributeWithFloat64Validators interface {
@@ -26,6 +35,15 @@ type AttributeWithFloat64Validators interface {
 	Float64Validators() []validator.Float64
 }
 
+// AttributeWithInt32Validators is an optional interface on Attribute which
+// enables Int32 validation support.
+type AttributeWithInt32Validators interface {
+	fwschema.Attribute
+
+	// Int32Validators should return a list of Int32 validators.
+	Int32Validators() []validator.Int32
+}
+
 // AttributeWithInt64Validators is an optional interface on Attribute which
 // enables Int64 validation support.
 type AttributeWithInt64Validators interface {
@@ -35,6 +53,17 @@ type AttributeWithInt64Validators interface {
 	Int64Validators() []validator.Int64
 }
 
+// AttributeWithElementValidators is an optional interface on Attribute which
+// enables validation of each element of a List or Set value. Each validator
+// must implement the validator interface matching the element value type,
+// such as validator.String for types.String elements.
+type AttributeWithElementValidators interface {
+	fwschema.Attribute
+
+	// GetElementValidators should return a list of element validators.
+	GetElementValidators() []validator.Describer
+}
+
 // AttributeWithListValidators is an optional interface on Attribute which
 // enables List validation support.
 type AttributeWithListValidators interface {
@@ -97,3 +126,12 @@ type AttributeWithDynamicValidators interface {
 	// DynamicValidators should return a list of Dynamic validators.
 	DynamicValidators() []validator.Dynamic
 }
+
+// AttributeWithTupleValidators is an optional interface on Attribute which
+// enables Tuple validation support.
+type AttributeWithTupleValidators interface {
+	fwschema.Attribute
+
+	// TupleValidators should return a list of Tuple validators.
+	TupleValidators() []validator.Tuple
+}
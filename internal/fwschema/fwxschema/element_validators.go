@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwxschema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ValidateElementValidatorsImplementation returns an error diagnostic for
+// each element validator which does not implement the validator interface
+// matching the element type, such as validator.String for an element type of
+// types.StringType. Otherwise, the element validator would be silently
+// skipped or raise an error during validation.
+func ValidateElementValidatorsImplementation(ctx context.Context, attributePath path.Path, elementType attr.Type, elementValidators []validator.Describer) diag.Diagnostics {
+	if elementType == nil || len(elementValidators) == 0 {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	elementValue := elementType.ValueType(ctx)
+
+	for _, elementValidator := range elementValidators {
+		if elementValidatorImplementsValue(elementValidator, elementValue) {
+			continue
+		}
+
+		// The diagnostic path is intentionally omitted as it is invalid in
+		// this context. Diagnostic paths are intended to be mapped to actual
+		// data, while this path information must be synthesized.
+		diags.AddError(
+			"Invalid Attribute Implementation",
+			"When validating the schema, an implementation issue was found. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("%q has an element validator which does not implement the validator interface for the %s element type. ", attributePath, elementType)+
+				"Each element validator must implement the validator interface matching the element type, such as validator.String for types.StringType.\n\n"+
+				fmt.Sprintf("Element Validator Type: %T", elementValidator),
+		)
+	}
+
+	return diags
+}
+
+// elementValidatorImplementsValue returns true if the element validator
+// implements the validator interface matching the element value type.
+func elementValidatorImplementsValue(elementValidator validator.Describer, elementValue attr.Value) bool {
+	var ok bool
+
+	switch elementValue.(type) {
+	case basetypes.BoolValuable:
+		_, ok = elementValidator.(validator.Bool)
+	case basetypes.Float32Valuable:
+		_, ok = elementValidator.(validator.Float32)
+	case basetypes.Float64Valuable:
+		_, ok = elementValidator.(validator.Float64)
+	case basetypes.Int32Valuable:
+		_, ok = elementValidator.(validator.Int32)
+	case basetypes.Int64Valuable:
+		_, ok = elementValidator.(validator.Int64)
+	case basetypes.NumberValuable:
+		_, ok = elementValidator.(validator.Number)
+	case basetypes.StringValuable:
+		_, ok = elementValidator.(validator.String)
+	case basetypes.ListValuable:
+		_, ok = elementValidator.(validator.List)
+	case basetypes.MapValuable:
+		_, ok = elementValidator.(validator.Map)
+	case basetypes.ObjectValuable:
+		_, ok = elementValidator.(validator.Object)
+	case basetypes.SetValuable:
+		_, ok = elementValidator.(validator.Set)
+	case basetypes.DynamicValuable:
+		_, ok = elementValidator.(validator.Dynamic)
+	}
+
+	return ok
+}
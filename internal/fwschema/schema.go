@@ -75,6 +75,18 @@ type Schema interface {
 	TypeAtTerraformPath(context.Context, *tftypes.AttributePath) (attr.Type, error)
 }
 
+// SchemaWithDeprecationWarningsOnUnknown is an optional interface on Schema
+// which controls whether attribute and block deprecation warnings are raised
+// for unknown configuration values. By default, deprecation warnings are only
+// raised for known, non-null configuration values.
+type SchemaWithDeprecationWarningsOnUnknown interface {
+	Schema
+
+	// GetDeprecationWarningsOnUnknown should return true if deprecation
+	// warnings should also be raised for unknown configuration values.
+	GetDeprecationWarningsOnUnknown() bool
+}
+
 // SchemaApplyTerraform5AttributePathStep is a helper function to perform base
 // tftypes.AttributePathStepper handling using the GetAttributes and GetBlocks
 // methods.
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// CanonicalizeSetElements sorts the elements of all known set values into a
+// canonical ordering, based on the msgpack encoding of each element. Sets are
+// unordered, so this does not change the meaning of the data, however it
+// ensures equal sets always produce byte-identical protocol DynamicValues
+// regardless of the order in which elements were originally set.
+//
+// Nested sets are sorted before the sets containing them, so the ordering is
+// also stable for sets of sets.
+func (d *Data) CanonicalizeSetElements(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Most schemas have no set attributes or blocks, so skip walking the
+	// data entirely when the schema type cannot contain a set.
+	if d.Schema == nil || !typeMayContainSet(d.Schema.Type().TerraformType(ctx)) {
+		return diags
+	}
+
+	// Transform rebuilds the entire value, which is expensive for large data,
+	// so skip it when there are no set elements to sort.
+	if !containsMultipleElementSet(d.TerraformValue) {
+		return diags
+	}
+
+	// Errors are handled as richer diag.Diagnostics instead.
+	d.TerraformValue, _ = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		if !tfTypeValue.Type().Is(tftypes.Set{}) || tfTypeValue.IsNull() || !tfTypeValue.IsKnown() {
+			return tfTypeValue, nil
+		}
+
+		var elements []tftypes.Value
+
+		// Known, non-null set values can always be converted.
+		_ = tfTypeValue.As(&elements)
+
+		if len(elements) < 2 {
+			return tfTypeValue, nil
+		}
+
+		sortKeys := make([][]byte, len(elements))
+
+		for index, element := range elements {
+			dynamicValue, err := tfprotov6.NewDynamicValue(element.Type(), element)
+
+			if err != nil {
+				diags.AddError(
+					"Unable to Canonicalize Set Elements",
+					"An unexpected error was encountered when sorting the elements of a set in the "+d.Description.String()+". "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Path: "+tfTypePath.String()+"\n"+
+						"Error: "+err.Error(),
+				)
+
+				return tfTypeValue, nil
+			}
+
+			sortKeys[index] = dynamicValue.MsgPack
+		}
+
+		indices := make([]int, len(elements))
+
+		for index := range indices {
+			indices[index] = index
+		}
+
+		sort.SliceStable(indices, func(i, j int) bool {
+			return bytes.Compare(sortKeys[indices[i]], sortKeys[indices[j]]) < 0
+		})
+
+		sortedElements := make([]tftypes.Value, len(elements))
+		reordered := false
+
+		for index, originalIndex := range indices {
+			sortedElements[index] = elements[originalIndex]
+
+			if index != originalIndex {
+				reordered = true
+			}
+		}
+
+		if !reordered {
+			return tfTypeValue, nil
+		}
+
+		logging.FrameworkTrace(ctx, "Sorting set elements into canonical order", map[string]any{
+			logging.KeyDescription: d.Description.String(),
+		})
+
+		return tftypes.NewValue(tfTypeValue.Type(), sortedElements), nil
+	})
+
+	return diags
+}
+
+// containsMultipleElementSet returns true if the value contains any known set
+// value with more than one element.
+func containsMultipleElementSet(value tftypes.Value) bool {
+	var found bool
+
+	_ = tftypes.Walk(value, func(_ *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		if found {
+			return false, nil
+		}
+
+		if !tfTypeValue.Type().Is(tftypes.Set{}) || tfTypeValue.IsNull() || !tfTypeValue.IsKnown() {
+			return true, nil
+		}
+
+		var elements []tftypes.Value
+
+		// Known, non-null set values can always be converted.
+		_ = tfTypeValue.As(&elements)
+
+		found = len(elements) > 1
+
+		return !found, nil
+	})
+
+	return found
+}
+
+// typeMayContainSet returns true if the given type is a set type, contains a
+// set type, or contains a dynamic type, which may hold a set value.
+func typeMayContainSet(t tftypes.Type) bool {
+	switch t := t.(type) {
+	case tftypes.Set:
+		return true
+	case tftypes.List:
+		return typeMayContainSet(t.ElementType)
+	case tftypes.Map:
+		return typeMayContainSet(t.ElementType)
+	case tftypes.Object:
+		for _, attributeType := range t.AttributeTypes {
+			if typeMayContainSet(attributeType) {
+				return true
+			}
+		}
+
+		return false
+	case tftypes.Tuple:
+		for _, elementType := range t.ElementTypes {
+			if typeMayContainSet(elementType) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return t != nil && t.Is(tftypes.DynamicPseudoType)
+	}
+}
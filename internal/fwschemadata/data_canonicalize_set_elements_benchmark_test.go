@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func BenchmarkDataCanonicalizeSetElementsListBlock10000(b *testing.B) {
+	benchmarkDataCanonicalizeSetElements(b, fwschema.BlockNestingModeList, 10000)
+}
+
+func BenchmarkDataCanonicalizeSetElementsSetBlock1000(b *testing.B) {
+	benchmarkDataCanonicalizeSetElements(b, fwschema.BlockNestingModeSet, 1000)
+}
+
+func benchmarkDataCanonicalizeSetElements(b *testing.B, nestingMode fwschema.BlockNestingMode, blocks int) {
+	ctx := context.Background()
+
+	blockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_number": tftypes.Number,
+			"test_string": tftypes.String,
+		},
+	}
+
+	var blockCollectionType tftypes.Type = tftypes.List{ElementType: blockType}
+
+	if nestingMode == fwschema.BlockNestingModeSet {
+		blockCollectionType = tftypes.Set{ElementType: blockType}
+	}
+
+	// Elements are in reverse order so set elements are always sorted.
+	blockValues := make([]tftypes.Value, blocks)
+
+	for i := 0; i < blocks; i++ {
+		blockValues[i] = tftypes.NewValue(
+			blockType,
+			map[string]tftypes.Value{
+				"test_number": tftypes.NewValue(tftypes.Number, blocks-i),
+				"test_string": tftypes.NewValue(tftypes.String, "test-string-value"+strconv.Itoa(blocks-i)),
+			},
+		)
+	}
+
+	data := fwschemadata.Data{
+		Description: fwschemadata.DataDescriptionState,
+		Schema: testschema.Schema{
+			Blocks: map[string]fwschema.Block{
+				"test_block": testschema.Block{
+					NestedObject: testschema.NestedBlockObject{
+						Attributes: map[string]fwschema.Attribute{
+							"test_number": testschema.Attribute{
+								Optional: true,
+								Type:     types.NumberType,
+							},
+							"test_string": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+					NestingMode: nestingMode,
+				},
+			},
+		},
+		TerraformValue: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_block": blockCollectionType,
+				},
+			},
+			map[string]tftypes.Value{
+				"test_block": tftypes.NewValue(blockCollectionType, blockValues),
+			},
+		),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		// CanonicalizeSetElements may replace the TerraformValue, so use a
+		// copy.
+		input := data
+
+		diags := input.CanonicalizeSetElements(ctx)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected CanonicalizeSetElements diagnostics: %v", diags)
+		}
+	}
+}
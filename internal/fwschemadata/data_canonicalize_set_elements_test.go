@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataCanonicalizeSetElements(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"set_attribute": testschema.Attribute{
+				Optional: true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+			"set_of_sets_attribute": testschema.Attribute{
+				Optional: true,
+				Type: types.SetType{
+					ElemType: types.SetType{
+						ElemType: types.StringType,
+					},
+				},
+			},
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"set_attribute": tftypes.Set{
+				ElementType: tftypes.String,
+			},
+			"set_of_sets_attribute": tftypes.Set{
+				ElementType: tftypes.Set{
+					ElementType: tftypes.String,
+				},
+			},
+		},
+	}
+
+	stringSet := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+	}
+
+	setOfSets := func(sets ...tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(
+			tftypes.Set{
+				ElementType: tftypes.Set{
+					ElementType: tftypes.String,
+				},
+			},
+			sets,
+		)
+	}
+
+	nullSetOfSets := tftypes.NewValue(
+		tftypes.Set{
+			ElementType: tftypes.Set{
+				ElementType: tftypes.String,
+			},
+		},
+		nil,
+	)
+
+	testCases := map[string]struct {
+		data          *fwschemadata.Data
+		expected      *fwschemadata.Data
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+		},
+		"unknown": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+		},
+		"ordered": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         stringSet("a", "b", "c"),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         stringSet("a", "b", "c"),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+		},
+		"unordered": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         stringSet("c", "a", "b"),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute":         stringSet("a", "b", "c"),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+		},
+		"unordered-with-unknown-element": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						tftypes.NewValue(tftypes.String, "b"),
+						tftypes.NewValue(tftypes.String, "a"),
+					}),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "a"),
+						tftypes.NewValue(tftypes.String, "b"),
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					"set_of_sets_attribute": nullSetOfSets,
+				}),
+			},
+		},
+		"nested-unordered": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute": stringSet("b", "a"),
+					"set_of_sets_attribute": setOfSets(
+						stringSet("d", "c"),
+						stringSet("b", "a"),
+					),
+				}),
+			},
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					"set_attribute": stringSet("a", "b"),
+					"set_of_sets_attribute": setOfSets(
+						stringSet("a", "b"),
+						stringSet("c", "d"),
+					),
+				}),
+			},
+		},
+		"dynamic-unordered": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"dynamic_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.DynamicType,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{AttributeTypes: map[string]tftypes.Type{"dynamic_attribute": tftypes.DynamicPseudoType}},
+					map[string]tftypes.Value{
+						"dynamic_attribute": stringSet("b", "a"),
+					},
+				),
+			},
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"dynamic_attribute": testschema.Attribute{
+							Optional: true,
+							Type:     types.DynamicType,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{AttributeTypes: map[string]tftypes.Type{"dynamic_attribute": tftypes.DynamicPseudoType}},
+					map[string]tftypes.Value{
+						"dynamic_attribute": stringSet("a", "b"),
+					},
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.data.CanonicalizeSetElements(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.data, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataCanonicalizeSetElements_StableAcrossSetOrder(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"set_attribute": testschema.Attribute{
+				Optional: true,
+				Type: types.SetType{
+					ElemType: types.StringType,
+				},
+			},
+		},
+	}
+
+	orderings := [][]string{
+		{"one", "two", "three"},
+		{"three", "two", "one"},
+		{"two", "three", "one"},
+	}
+
+	var expected []byte
+
+	for _, ordering := range orderings {
+		data := &fwschemadata.Data{
+			Description: fwschemadata.DataDescriptionState,
+			Schema:      testSchema,
+			TerraformValue: tftypes.NewValue(testSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"set_attribute": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			}),
+		}
+
+		elements := make([]attr.Value, 0, len(ordering))
+
+		for _, element := range ordering {
+			elements = append(elements, types.StringValue(element))
+		}
+
+		diags := data.SetAtPath(ctx, path.Root("set_attribute"), types.SetValueMust(types.StringType, elements))
+
+		diags.Append(data.CanonicalizeSetElements(ctx)...)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+
+		got, err := tfprotov6.NewDynamicValue(data.TerraformValue.Type(), data.TerraformValue)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if expected == nil {
+			expected = got.MsgPack
+
+			continue
+		}
+
+		if diff := cmp.Diff(got.MsgPack, expected); diff != "" {
+			t.Errorf("unexpected difference for ordering %v: %s", ordering, diff)
+		}
+	}
+}
@@ -20,6 +20,16 @@ import (
 
 // TransformDefaults walks the schema and applies schema defined default values
 // when configRaw contains a null value at the same path.
+//
+// Single nested attributes which are null in configRaw are handled as follows:
+//
+//   - If the attribute has a default value, it is applied and then any child
+//     attributes which are null in that default value have their own default
+//     values applied. Child values set by the parent default take precedence.
+//   - If the attribute has no default value, is Computed, and at least one
+//     child attribute has a default value, an object is created containing
+//     the child default values, with all other child attributes null.
+//   - Otherwise, the attribute is left unchanged.
 func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var err error
@@ -30,7 +40,7 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 		TerraformValue: configRaw,
 	}
 
-	d.TerraformValue, err = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+	defaultValue := func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
 		// Skip the root of the data, only applying defaults to attributes
 		if len(tfTypePath.Steps()) < 1 {
 			return tfTypeValue, nil
@@ -122,6 +132,29 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 
 			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
 
+			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithFloat32DefaultValue:
+			defaultValue := a.Float32DefaultValue()
+
+			if defaultValue == nil {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Float32Request{
+				Path: fwPath,
+			}
+			resp := defaults.Float32Response{}
+
+			defaultValue.DefaultFloat32(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+
 			return resp.PlanValue.ToTerraformValue(ctx)
 		case fwschema.AttributeWithFloat64DefaultValue:
 			defaultValue := a.Float64DefaultValue()
@@ -145,6 +178,29 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 
 			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
 
+			return resp.PlanValue.ToTerraformValue(ctx)
+		case fwschema.AttributeWithInt32DefaultValue:
+			defaultValue := a.Int32DefaultValue()
+
+			if defaultValue == nil {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Int32Request{
+				Path: fwPath,
+			}
+			resp := defaults.Int32Response{}
+
+			defaultValue.DefaultInt32(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, resp.PlanValue))
+
 			return resp.PlanValue.ToTerraformValue(ctx)
 		case fwschema.AttributeWithInt64DefaultValue:
 			defaultValue := a.Int64DefaultValue()
@@ -350,7 +406,83 @@ func (d *Data) TransformDefaults(ctx context.Context, configRaw tftypes.Value) d
 		}
 
 		return tfTypeValue, nil
-	})
+	}
+
+	var transform func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error)
+
+	transform = func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		newValue, err := defaultValue(tfTypePath, tfTypeValue)
+
+		if err != nil || len(tfTypePath.Steps()) < 1 {
+			return newValue, err
+		}
+
+		// Errors locating schema attributes are handled by defaultValue.
+		attrAtPath, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		if err != nil {
+			return newValue, nil
+		}
+
+		nestedAttribute, ok := attrAtPath.(fwschema.NestedAttribute)
+
+		if !ok || nestedAttribute.GetNestingMode() != fwschema.NestingModeSingle {
+			return newValue, nil
+		}
+
+		configValue, _, err := tftypes.WalkAttributePath(configRaw, tfTypePath)
+
+		if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
+			return newValue, nil
+		}
+
+		// Child attributes of configured objects have already been walked.
+		if configValue, ok := configValue.(tftypes.Value); ok && !configValue.IsNull() {
+			return newValue, nil
+		}
+
+		switch {
+		case newValue.IsNull() && fwschema.MaterializesFromChildDefaults(attrAtPath):
+			logging.FrameworkTrace(ctx, "creating single nested attribute object from child attribute defaults")
+
+			objectType, ok := newValue.Type().(tftypes.Object)
+
+			if !ok {
+				return newValue, nil
+			}
+
+			childValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+			for name, childType := range objectType.AttributeTypes {
+				childValues[name] = tftypes.NewValue(childType, nil)
+			}
+
+			newValue = tftypes.NewValue(objectType, childValues)
+		case !fwschema.AttributeHasDefaultValue(attrAtPath):
+			return newValue, nil
+		}
+
+		if newValue.IsNull() || !newValue.IsKnown() {
+			return newValue, nil
+		}
+
+		// Apply defaults to null child attributes of the parent default value
+		// or created object, which were not walked since the original value
+		// was null or replaced.
+		return tftypes.Transform(newValue, func(childPath *tftypes.AttributePath, childValue tftypes.Value) (tftypes.Value, error) {
+			if len(childPath.Steps()) < 1 || !childValue.IsNull() {
+				return childValue, nil
+			}
+
+			steps := make([]tftypes.AttributePathStep, 0, len(tfTypePath.Steps())+len(childPath.Steps()))
+			steps = append(steps, tfTypePath.Steps()...)
+			steps = append(steps, childPath.Steps()...)
+
+			return transform(tftypes.NewAttributePathWithSteps(steps), childValue)
+		})
+	}
+
+	d.TerraformValue, err = tftypes.Transform(d.TerraformValue, transform)
 
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/930
 	if err != nil {
@@ -9673,6 +9673,458 @@ func TestDataDefault(t *testing.T) {
 				),
 			},
 		},
+		"single-nested-attribute-null-computed-child-defaults": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("two"),
+								},
+							},
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						}, nil),
+					},
+				),
+			},
+			rawConfig: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"single_nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"single_nested": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other_attribute":  tftypes.String,
+							"string_attribute": tftypes.String,
+						},
+					}, nil),
+				},
+			),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("two"),
+								},
+							},
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+							map[string]tftypes.Value{
+								"other_attribute":  tftypes.NewValue(tftypes.String, nil),
+								"string_attribute": tftypes.NewValue(tftypes.String, "two"),
+							},
+						),
+					},
+				),
+			},
+		},
+		"single-nested-attribute-null-computed-no-child-defaults": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						}, nil),
+					},
+				),
+			},
+			rawConfig: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"single_nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"single_nested": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other_attribute":  tftypes.String,
+							"string_attribute": tftypes.String,
+						},
+					}, nil),
+				},
+			),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+								},
+							},
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						}, nil),
+					},
+				),
+			},
+		},
+		"single-nested-attribute-null-not-computed-child-defaults": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("two"),
+								},
+							},
+							Optional: true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						}, nil),
+					},
+				),
+			},
+			rawConfig: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"single_nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"single_nested": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other_attribute":  tftypes.String,
+							"string_attribute": tftypes.String,
+						},
+					}, nil),
+				},
+			),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("two"),
+								},
+							},
+							Optional: true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						}, nil),
+					},
+				),
+			},
+		},
+		"single-nested-attribute-null-default-child-defaults": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("child"),
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("two"),
+								},
+							},
+							Optional: true,
+							Computed: true,
+							Default: objectdefault.StaticValue(
+								types.ObjectValueMust(
+									map[string]attr.Type{
+										"other_attribute":  types.StringType,
+										"string_attribute": types.StringType,
+									},
+									map[string]attr.Value{
+										"other_attribute":  types.StringValue("parent"),
+										"string_attribute": types.StringNull(),
+									},
+								),
+							),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						}, nil),
+					},
+				),
+			},
+			rawConfig: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"single_nested": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"other_attribute":  tftypes.String,
+								"string_attribute": tftypes.String,
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"single_nested": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"other_attribute":  tftypes.String,
+							"string_attribute": tftypes.String,
+						},
+					}, nil),
+				},
+			),
+			expected: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"single_nested": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"other_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("child"),
+								},
+								"string_attribute": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString("two"),
+								},
+							},
+							Optional: true,
+							Computed: true,
+							Default: objectdefault.StaticValue(
+								types.ObjectValueMust(
+									map[string]attr.Type{
+										"other_attribute":  types.StringType,
+										"string_attribute": types.StringType,
+									},
+									map[string]attr.Value{
+										"other_attribute":  types.StringValue("parent"),
+										"string_attribute": types.StringNull(),
+									},
+								),
+							),
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"single_nested": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"single_nested": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"other_attribute":  tftypes.String,
+									"string_attribute": tftypes.String,
+								},
+							},
+							map[string]tftypes.Value{
+								"other_attribute":  tftypes.NewValue(tftypes.String, "parent"),
+								"string_attribute": tftypes.NewValue(tftypes.String, "two"),
+							},
+						),
+					},
+				),
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
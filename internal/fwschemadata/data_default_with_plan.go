@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// TransformDefaultsWithPlan walks the schema and applies schema defined
+// default values which implement a {TYPE}WithPlan interface, such as
+// defaults.StringWithPlan, when configRaw contains a null value at the same
+// path. It is expected to be called after TransformDefaults, with plan
+// containing the data after those default values were applied.
+//
+// Errors locating schema attributes are ignored, since TransformDefaults
+// will have already returned them.
+func (d *Data) TransformDefaultsWithPlan(ctx context.Context, configRaw tftypes.Value, plan defaults.PlanReader) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+
+	configData := Data{
+		Description:    DataDescriptionConfiguration,
+		Schema:         d.Schema,
+		TerraformValue: configRaw,
+	}
+
+	d.TerraformValue, err = tftypes.Transform(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (tftypes.Value, error) {
+		// Skip the root of the data, only applying defaults to attributes
+		if len(tfTypePath.Steps()) < 1 {
+			return tfTypeValue, nil
+		}
+
+		attrAtPath, err := d.Schema.AttributeAtTerraformPath(ctx, tfTypePath)
+
+		if err != nil {
+			return tfTypeValue, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
+
+		// Do not transform if path cannot be converted.
+		if fwPathDiags.HasError() {
+			return tfTypeValue, nil
+		}
+
+		configValue, configValueDiags := configData.ValueAtPath(ctx, fwPath)
+
+		// Do not transform if rawConfig value cannot be retrieved.
+		if configValueDiags.HasError() {
+			return tfTypeValue, nil
+		}
+
+		// Do not transform if rawConfig value is not null.
+		if !configValue.IsNull() {
+			// Dynamic values need to perform more logic to check the config value for null-ness
+			dynValuable, ok := configValue.(basetypes.DynamicValuable)
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			dynConfigVal, dynDiags := dynValuable.ToDynamicValue(ctx)
+			if dynDiags.HasError() {
+				return tfTypeValue, nil
+			}
+
+			if !dynConfigVal.IsUnderlyingValueNull() {
+				return tfTypeValue, nil
+			}
+		}
+
+		switch a := attrAtPath.(type) {
+		case fwschema.AttributeWithBoolDefaultValue:
+			defaultValue, ok := a.BoolDefaultValue().(defaults.BoolWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.BoolWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.BoolResponse{}
+
+			defaultValue.DefaultBoolWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithFloat32DefaultValue:
+			defaultValue, ok := a.Float32DefaultValue().(defaults.Float32WithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Float32WithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.Float32Response{}
+
+			defaultValue.DefaultFloat32WithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithFloat64DefaultValue:
+			defaultValue, ok := a.Float64DefaultValue().(defaults.Float64WithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Float64WithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.Float64Response{}
+
+			defaultValue.DefaultFloat64WithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithInt32DefaultValue:
+			defaultValue, ok := a.Int32DefaultValue().(defaults.Int32WithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Int32WithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.Int32Response{}
+
+			defaultValue.DefaultInt32WithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithInt64DefaultValue:
+			defaultValue, ok := a.Int64DefaultValue().(defaults.Int64WithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.Int64WithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.Int64Response{}
+
+			defaultValue.DefaultInt64WithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithListDefaultValue:
+			defaultValue, ok := a.ListDefaultValue().(defaults.ListWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.ListWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.ListResponse{}
+
+			defaultValue.DefaultListWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithMapDefaultValue:
+			defaultValue, ok := a.MapDefaultValue().(defaults.MapWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.MapWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.MapResponse{}
+
+			defaultValue.DefaultMapWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithNumberDefaultValue:
+			defaultValue, ok := a.NumberDefaultValue().(defaults.NumberWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.NumberWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.NumberResponse{}
+
+			defaultValue.DefaultNumberWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithObjectDefaultValue:
+			defaultValue, ok := a.ObjectDefaultValue().(defaults.ObjectWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.ObjectWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.ObjectResponse{}
+
+			defaultValue.DefaultObjectWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithSetDefaultValue:
+			defaultValue, ok := a.SetDefaultValue().(defaults.SetWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.SetWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.SetResponse{}
+
+			defaultValue.DefaultSetWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithStringDefaultValue:
+			defaultValue, ok := a.StringDefaultValue().(defaults.StringWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.StringWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.StringResponse{}
+
+			defaultValue.DefaultStringWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		case fwschema.AttributeWithDynamicDefaultValue:
+			defaultValue, ok := a.DynamicDefaultValue().(defaults.DynamicWithPlan)
+
+			if !ok {
+				return tfTypeValue, nil
+			}
+
+			req := defaults.DynamicWithPlanRequest{
+				Path: fwPath,
+				Plan: plan,
+			}
+			resp := defaults.DynamicResponse{}
+
+			defaultValue.DefaultDynamicWithPlan(ctx, req, &resp)
+
+			diags.Append(resp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return tfTypeValue, nil
+			}
+
+			return defaultWithPlanTerraformValue(ctx, fwPath, tfTypeValue, resp.PlanValue)
+		}
+
+		return tfTypeValue, nil
+	})
+
+	if err != nil {
+		diags.Append(diag.NewErrorDiagnostic(
+			"Error Handling Schema Defaults",
+			"An unexpected error occurred while handling schema default values. "+
+				"Please report the following to the provider developer:\n\n"+
+				"Error: "+err.Error(),
+		))
+	}
+
+	return diags
+}
+
+// defaultWithPlanTerraformValue returns the Terraform value of a
+// {TYPE}WithPlan default value response, or an unknown value if the response
+// value is null or unknown. The static default value is not kept in that case,
+// since the default could not be determined from the plan and keeping it would
+// cause an inconsistent result after apply.
+func defaultWithPlanTerraformValue(ctx context.Context, fwPath path.Path, tfTypeValue tftypes.Value, planValue attr.Value) (tftypes.Value, error) {
+	if planValue.IsNull() || planValue.IsUnknown() {
+		logging.FrameworkTrace(ctx, fmt.Sprintf("attribute %s default returned null or unknown value, marking as unknown", fwPath))
+
+		return tftypes.NewValue(tfTypeValue.Type(), tftypes.UnknownValue), nil
+	}
+
+	logging.FrameworkTrace(ctx, fmt.Sprintf("setting attribute %s to default value: %s", fwPath, planValue))
+
+	return planValue.ToTerraformValue(ctx)
+}
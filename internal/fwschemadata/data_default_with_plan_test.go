@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataTransformDefaultsWithPlan(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"region": tftypes.String,
+			"zone":   tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"region": testschema.AttributeWithStringDefaultValue{
+				Optional: true,
+				Computed: true,
+				Default: testdefaults.StringWithPlan{
+					DefaultStringWithPlanMethod: func(ctx context.Context, req defaults.StringWithPlanRequest, resp *defaults.StringResponse) {
+						var zone types.String
+
+						resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+
+						if resp.Diagnostics.HasError() || zone.IsUnknown() || zone.IsNull() {
+							return
+						}
+
+						resp.PlanValue = types.StringValue(zone.ValueString()[:len(zone.ValueString())-2])
+					},
+				},
+			},
+			"zone": testschema.Attribute{
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		data          *fwschemadata.Data
+		rawConfig     tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"sibling-known": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"region": tftypes.NewValue(tftypes.String, nil),
+					"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, nil),
+				"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, "us-east1"),
+				"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+			}),
+		},
+		"sibling-unknown": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"region": tftypes.NewValue(tftypes.String, nil),
+					"zone":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, nil),
+				"zone":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"zone":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"sibling-unknown-static-default": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"region": tftypes.NewValue(tftypes.String, "static-default"),
+					"zone":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, nil),
+				"zone":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"zone":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"config-not-null": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema:      testSchema,
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"region": tftypes.NewValue(tftypes.String, "europe-west1"),
+					"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, "europe-west1"),
+				"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, "europe-west1"),
+				"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+			}),
+		},
+		"diagnostics": {
+			data: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionPlan,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"region": testschema.AttributeWithStringDefaultValue{
+							Optional: true,
+							Computed: true,
+							Default: testdefaults.StringWithPlan{
+								DefaultStringWithPlanMethod: func(ctx context.Context, req defaults.StringWithPlanRequest, resp *defaults.StringResponse) {
+									resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+								},
+							},
+						},
+						"zone": testschema.Attribute{
+							Type:     types.StringType,
+							Optional: true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"region": tftypes.NewValue(tftypes.String, nil),
+					"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+				}),
+			},
+			rawConfig: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, nil),
+				"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"region": tftypes.NewValue(tftypes.String, nil),
+				"zone":   tftypes.NewValue(tftypes.String, "us-east1-b"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("region"), "error summary", "error detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{
+				Raw:    testCase.data.TerraformValue,
+				Schema: testCase.data.Schema,
+			}
+
+			diags := testCase.data.TransformDefaultsWithPlan(context.Background(), testCase.rawConfig, plan)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.data.TerraformValue, testCase.expected); diff != "" {
+				t.Errorf("unexpected value difference: %s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,14 @@ const (
 	// DataDescriptionState is used for Data that represents
 	// a state-based value.
 	DataDescriptionState DataDescription = "state"
+
+	// DataDescriptionResourceIdentity is used for Data that represents
+	// a resource identity-based value.
+	DataDescriptionResourceIdentity DataDescription = "resource identity"
+
+	// DataDescriptionEphemeralResultData is used for Data that represents
+	// an ephemeral resource result-based value.
+	DataDescriptionEphemeralResultData DataDescription = "ephemeral result data"
 )
 
 // DataDescription is a human friendly type for Data. Used in error
@@ -40,6 +48,10 @@ func (d DataDescription) Title() string {
 		return "Plan"
 	case DataDescriptionState:
 		return "State"
+	case DataDescriptionResourceIdentity:
+		return "Resource Identity"
+	case DataDescriptionEphemeralResultData:
+		return "Ephemeral Result Data"
 	default:
 		return "Data"
 	}
@@ -6173,6 +6173,105 @@ func TestDataGetAtPath(t *testing.T) {
 				),
 			},
 		},
+		// Required nested attributes are only required when the parent object
+		// is configured, so they are null when the optional parent is null.
+		"SingleNestedAttributes-null-required-nested-types": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"object": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Required: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"object": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"object": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+							nil,
+						),
+					},
+				),
+			},
+			path:     path.Root("object").AtName("nested_string"),
+			target:   new(types.String),
+			expected: pointer(types.StringNull()),
+		},
+		"SingleNestedAttributes-null-required-nested-string": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"object": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Required: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"object": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"object": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+							nil,
+						),
+					},
+				),
+			},
+			path:     path.Root("object").AtName("nested_string"),
+			target:   new(string),
+			expected: pointer(""),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("object").AtName("nested_string"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: object.nested_string\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
 		"SingleNestedAttributes-struct-unknown": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
@@ -6830,6 +6830,112 @@ func TestDataGet(t *testing.T) {
 				),
 			},
 		},
+		// Required nested attributes are only required when the parent object
+		// is configured, so optional parent objects can be null.
+		"SingleNestedAttributes-pointer-null-required-nested": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"object": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Required: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"object": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"object": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+							nil,
+						),
+					},
+				),
+			},
+			target: new(struct {
+				Object *struct {
+					NestedString types.String `tfsdk:"nested_string"`
+				} `tfsdk:"object"`
+			}),
+			expected: &struct {
+				Object *struct {
+					NestedString types.String `tfsdk:"nested_string"`
+				} `tfsdk:"object"`
+			}{
+				Object: nil,
+			},
+		},
+		"SingleNestedAttributes-types-null-required-nested": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"object": testschema.NestedAttribute{
+							NestedObject: testschema.NestedAttributeObject{
+								Attributes: map[string]fwschema.Attribute{
+									"nested_string": testschema.Attribute{
+										Required: true,
+										Type:     types.StringType,
+									},
+								},
+							},
+							NestingMode: fwschema.NestingModeSingle,
+							Optional:    true,
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"object": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"object": tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_string": tftypes.String,
+								},
+							},
+							nil,
+						),
+					},
+				),
+			},
+			target: new(struct {
+				Object types.Object `tfsdk:"object"`
+			}),
+			expected: &struct {
+				Object types.Object `tfsdk:"object"`
+			}{
+				Object: types.ObjectNull(map[string]attr.Type{
+					"nested_string": types.StringType,
+				}),
+			},
+		},
 		"SingleNestedAttributes-struct-unknown": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
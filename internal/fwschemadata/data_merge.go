@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Merge replaces each top level attribute or block value with the value from
+// the given value, if not null, otherwise the existing value is preserved. The
+// value should be a struct whose fields have one of the attr.Value types. Each
+// field must have the tfsdk field tag.
+func (d *Data) Merge(ctx context.Context, val any) diag.Diagnostics {
+	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, path.Empty())
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tfValue, err := attrValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Error: Unable to run ToTerraformValue on new value: %s", err),
+		)
+		return diags
+	}
+
+	// The reflection logic only supports an entirely null value when a nil
+	// pointer to a struct is given, in which case nothing is merged.
+	if tfValue.IsNull() {
+		return diags
+	}
+
+	objectType, ok := tfValue.Type().(tftypes.Object)
+
+	if !ok {
+		diags.AddError(
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Error: Expected object type for new value, got: %s", tfValue.Type()),
+		)
+		return diags
+	}
+
+	newValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	err = tfValue.As(&newValues)
+
+	if err != nil {
+		diags.AddError(
+			d.Description.Title()+" Write Error",
+			"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Error: Unable to convert new value: %s", err),
+		)
+		return diags
+	}
+
+	mergedValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	// The existing value may be null or unknown, such as during resource
+	// creation, in which case only the new values are used.
+	if d.TerraformValue.Type() != nil && d.TerraformValue.IsKnown() && !d.TerraformValue.IsNull() {
+		err = d.TerraformValue.As(&mergedValues)
+
+		if err != nil {
+			diags.AddError(
+				d.Description.Title()+" Write Error",
+				"An unexpected error was encountered trying to write the "+d.Description.String()+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Error: Unable to convert existing value: %s", err),
+			)
+			return diags
+		}
+	}
+
+	for name, attrType := range objectType.AttributeTypes {
+		newValue, ok := newValues[name]
+
+		if ok && !newValue.IsNull() {
+			mergedValues[name] = newValue
+
+			continue
+		}
+
+		if _, ok := mergedValues[name]; !ok {
+			mergedValues[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	d.TerraformValue = tftypes.NewValue(objectType, mergedValues)
+
+	return diags
+}
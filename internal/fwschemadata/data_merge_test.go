@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataMerge(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	type testCase struct {
+		data          fwschemadata.Data
+		val           any
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		"nil-pointer-field": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, nil),
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testSchema,
+			},
+			val: struct {
+				ID   *string `tfsdk:"id"`
+				Name *string `tfsdk:"name"`
+			}{
+				ID: pointer("newid"),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "newid"),
+				"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+			}),
+		},
+		"null-value-field": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, "oldid"),
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testSchema,
+			},
+			val: struct {
+				ID   types.String `tfsdk:"id"`
+				Name types.String `tfsdk:"name"`
+			}{
+				ID:   types.StringNull(),
+				Name: types.StringValue("newvalue"),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "oldid"),
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+			}),
+		},
+		"null-existing": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(testType, nil),
+				Schema:         testSchema,
+			},
+			val: struct {
+				ID   types.String `tfsdk:"id"`
+				Name types.String `tfsdk:"name"`
+			}{
+				ID:   types.StringValue("newid"),
+				Name: types.StringNull(),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "newid"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+			}),
+		},
+		"diagnostics": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     testtypes.StringTypeWithValidateWarning{},
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Name string `tfsdk:"name"`
+			}{
+				Name: "newvalue",
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.data.Merge(context.Background(), tc.val)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.data.TerraformValue, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,12 @@ import (
 func (d *Data) NullifyCollectionBlocks(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	// Transform rebuilds the entire value, which is expensive for large data,
+	// so skip it when there are no empty collections to convert.
+	if !containsEmptyCollection(d.TerraformValue) {
+		return diags
+	}
+
 	blockPathExpressions := fwschema.SchemaBlockPathExpressions(ctx, d.Schema)
 
 	// Errors are handled as richer diag.Diagnostics instead.
@@ -96,3 +102,33 @@ func (d *Data) NullifyCollectionBlocks(ctx context.Context) diag.Diagnostics {
 
 	return diags
 }
+
+// containsEmptyCollection returns true if the value contains any known,
+// empty list or set value, other than the value itself.
+func containsEmptyCollection(value tftypes.Value) bool {
+	var found bool
+
+	_ = tftypes.Walk(value, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		if found {
+			return false, nil
+		}
+
+		if len(tfTypePath.Steps()) < 1 || tfTypeValue.IsNull() || !tfTypeValue.IsKnown() {
+			return true, nil
+		}
+
+		switch tfTypeValue.Type().(type) {
+		case tftypes.List, tftypes.Set:
+			var elements []tftypes.Value
+
+			// Known, non-null list and set values can always be converted.
+			_ = tfTypeValue.As(&elements)
+
+			found = len(elements) == 0
+		}
+
+		return !found, nil
+	})
+
+	return found
+}
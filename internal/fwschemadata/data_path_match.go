@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// PathMatch returns the single path.Path matching the given path.Expression,
+// such as a set element, for which the match function returns true. If the
+// match function is nil, all paths matching the expression are considered.
+//
+// Unlike PathMatches, null or unknown parent paths are not considered a
+// match. Error diagnostics are returned if no paths or multiple paths match.
+func (d Data) PathMatch(ctx context.Context, pathExpr path.Expression, match func(attr.Value) bool) (path.Path, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	paths, pathMatchesDiags := d.PathMatches(ctx, pathExpr)
+
+	diags.Append(pathMatchesDiags...)
+
+	if diags.HasError() {
+		return path.Empty(), diags
+	}
+
+	var matchedPaths path.Paths
+
+	expressionSteps := pathExpr.Resolve().Steps()
+
+	for _, p := range paths {
+		// Skip null or unknown parent paths.
+		if !expressionStepsMatch(expressionSteps, p.Steps()) {
+			continue
+		}
+
+		if match != nil {
+			value, valueDiags := d.ValueAtPath(ctx, p)
+
+			diags.Append(valueDiags...)
+
+			if diags.HasError() {
+				return path.Empty(), diags
+			}
+
+			if !match(value) {
+				continue
+			}
+		}
+
+		matchedPaths.Append(p)
+	}
+
+	switch len(matchedPaths) {
+	case 0:
+		diags.AddError(
+			d.Description.Title()+" Path Expression Matched No Paths",
+			"The Terraform Provider expected exactly one path to match the path expression, but no paths matched. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Path Expression: "+pathExpr.String(),
+		)
+
+		return path.Empty(), diags
+	case 1:
+		return matchedPaths[0], diags
+	default:
+		diags.AddError(
+			d.Description.Title()+" Path Expression Matched Multiple Paths",
+			"The Terraform Provider expected exactly one path to match the path expression, but multiple paths matched. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+				"Path Expression: "+pathExpr.String()+"\n"+
+				fmt.Sprintf("Matched Paths: %s", matchedPaths),
+		)
+
+		return path.Empty(), diags
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDataPathMatch(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type: types.SetType{
+					ElemType: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"name": types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testElementType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testTfTypeValue := func(elements []tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.Set{
+						ElementType: testElementType,
+					},
+				},
+			},
+			map[string]tftypes.Value{
+				"test": tftypes.NewValue(
+					tftypes.Set{
+						ElementType: testElementType,
+					},
+					elements,
+				),
+			},
+		)
+	}
+
+	testElement := func(name string) tftypes.Value {
+		return tftypes.NewValue(testElementType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+
+	testElementValue := func(name string) attr.Value {
+		return types.ObjectValueMust(
+			map[string]attr.Type{
+				"name": types.StringType,
+			},
+			map[string]attr.Value{
+				"name": types.StringValue(name),
+			},
+		)
+	}
+
+	testMatchName := func(name string) func(attr.Value) bool {
+		return func(value attr.Value) bool {
+			object, ok := value.(types.Object)
+
+			if !ok {
+				return false
+			}
+
+			return object.Attributes()["name"].Equal(types.StringValue(name))
+		}
+	}
+
+	testCases := map[string]struct {
+		tfTypeValue   tftypes.Value
+		expression    path.Expression
+		match         func(attr.Value) bool
+		expected      path.Path
+		expectedDiags diag.Diagnostics
+	}{
+		"match": {
+			tfTypeValue: testTfTypeValue([]tftypes.Value{
+				testElement("one"),
+				testElement("two"),
+			}),
+			expression: path.MatchRoot("test").AtAnySetValue(),
+			match:      testMatchName("two"),
+			expected:   path.Root("test").AtSetValue(testElementValue("two")),
+		},
+		"match-element-matching": {
+			tfTypeValue: testTfTypeValue([]tftypes.Value{
+				testElement("one"),
+				testElement("two"),
+			}),
+			expression: path.MatchRoot("test").AtMatching(path.Root("name"), types.StringValue("two")),
+			expected:   path.Root("test").AtSetValue(testElementValue("two")),
+		},
+		"match-nil": {
+			tfTypeValue: testTfTypeValue([]tftypes.Value{
+				testElement("one"),
+			}),
+			expression: path.MatchRoot("test").AtAnySetValue(),
+			expected:   path.Root("test").AtSetValue(testElementValue("one")),
+		},
+		"no-match": {
+			tfTypeValue: testTfTypeValue([]tftypes.Value{
+				testElement("one"),
+			}),
+			expression: path.MatchRoot("test").AtAnySetValue(),
+			match:      testMatchName("two"),
+			expected:   path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Data Path Expression Matched No Paths",
+					"The Terraform Provider expected exactly one path to match the path expression, but no paths matched. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Path Expression: test[Value(*)]",
+				),
+			},
+		},
+		"no-match-parent-null": {
+			tfTypeValue: testTfTypeValue(nil),
+			expression:  path.MatchRoot("test").AtAnySetValue(),
+			expected:    path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Data Path Expression Matched No Paths",
+					"The Terraform Provider expected exactly one path to match the path expression, but no paths matched. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Path Expression: test[Value(*)]",
+				),
+			},
+		},
+		"multiple-matches": {
+			tfTypeValue: testTfTypeValue([]tftypes.Value{
+				testElement("one"),
+				testElement("two"),
+			}),
+			expression: path.MatchRoot("test").AtAnySetValue(),
+			expected:   path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Data Path Expression Matched Multiple Paths",
+					"The Terraform Provider expected exactly one path to match the path expression, but multiple paths matched. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Path Expression: test[Value(*)]\n"+
+						`Matched Paths: [test[Value({"name":"one"})],test[Value({"name":"two"})]]`,
+				),
+			},
+		},
+		"invalid-expression": {
+			tfTypeValue: testTfTypeValue(nil),
+			expression:  path.MatchRoot("test").AtAnyListIndex(),
+			expected:    path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: test[*]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testCase.tfTypeValue,
+			}
+
+			got, diags := data.PathMatch(context.Background(), testCase.expression, testCase.match)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
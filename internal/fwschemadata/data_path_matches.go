@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -33,6 +34,8 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 		return paths, diags
 	}
 
+	expressionSteps := pathExpr.Resolve().Steps()
+
 	_ = tftypes.Walk(d.TerraformValue, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
 		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfTypePath, d.Schema)
 
@@ -44,7 +47,18 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 			return false, nil
 		}
 
-		if pathExpr.Matches(fwPath) {
+		conditionMatches, conditionDiags := d.pathMatchesCondition(ctx, expressionSteps, tfTypePath)
+
+		diags.Append(conditionDiags...)
+
+		// If the element does not satisfy a conditional expression step, such
+		// as path.ExpressionStepElementMatching, there is no need to traverse
+		// further since a deeper path will never match.
+		if !conditionMatches {
+			return false, nil
+		}
+
+		if expressionStepsMatch(expressionSteps, fwPath.Steps()) {
 			paths.Append(fwPath)
 
 			// If we matched, there is no need to traverse further since a
@@ -54,7 +68,7 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 
 		// If current path cannot be parent path, there is no need to traverse
 		// further since a deeper path will never match.
-		if !pathExpr.MatchesParent(fwPath) {
+		if !expressionStepsMatchParent(expressionSteps, fwPath.Steps()) {
 			return false, nil
 		}
 
@@ -78,3 +92,123 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 
 	return paths, diags
 }
+
+// expressionStepsMatch returns true if the given path steps match the
+// resolved expression steps, similar to path.ExpressionSteps.Matches, except
+// path.ExpressionStepElementMatching steps match any element step. The
+// element condition is separately verified by pathMatchesCondition.
+func expressionStepsMatch(expressionSteps path.ExpressionSteps, pathSteps path.PathSteps) bool {
+	if len(expressionSteps) == 0 || len(expressionSteps) != len(pathSteps) {
+		return false
+	}
+
+	for stepIndex, pathStep := range pathSteps {
+		if !expressionStepMatches(expressionSteps[stepIndex], pathStep) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// expressionStepsMatchParent returns true if the given path steps match the
+// resolved expression steps until there are no more path steps, similar to
+// path.ExpressionSteps.MatchesParent, except
+// path.ExpressionStepElementMatching steps match any element step.
+func expressionStepsMatchParent(expressionSteps path.ExpressionSteps, pathSteps path.PathSteps) bool {
+	if len(expressionSteps) == 0 || len(pathSteps) >= len(expressionSteps) {
+		return false
+	}
+
+	for stepIndex, pathStep := range pathSteps {
+		if !expressionStepMatches(expressionSteps[stepIndex], pathStep) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// expressionStepMatches returns true if the given path step matches the
+// expression step. A path.ExpressionStepElementMatching step matches any
+// list index, map key, or set value step, since its Matches method cannot
+// evaluate the condition.
+func expressionStepMatches(expressionStep path.ExpressionStep, pathStep path.PathStep) bool {
+	if _, ok := expressionStep.(path.ExpressionStepElementMatching); !ok {
+		return expressionStep.Matches(pathStep)
+	}
+
+	switch pathStep.(type) {
+	case path.PathStepElementKeyInt, path.PathStepElementKeyString, path.PathStepElementKeyValue:
+		return true
+	default:
+		return false
+	}
+}
+
+// pathMatchesCondition returns false if the resolved expression step at the
+// final step of the given path is a path.ExpressionStepElementMatching and
+// the value at the relative path of the element does not equal the expected
+// value. Steps before the final step are verified as the data is walked.
+func (d Data) pathMatchesCondition(ctx context.Context, expressionSteps path.ExpressionSteps, tfTypePath *tftypes.AttributePath) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfTypePathSteps := tfTypePath.Steps()
+	stepIndex := len(tfTypePathSteps) - 1
+
+	if stepIndex < 0 || stepIndex >= len(expressionSteps) {
+		return true, diags
+	}
+
+	step, ok := expressionSteps[stepIndex].(path.ExpressionStepElementMatching)
+
+	if !ok {
+		return true, diags
+	}
+
+	if step.Value == nil {
+		return false, diags
+	}
+
+	expectedTfValue, err := step.Value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Path Expression Value",
+			"An unexpected error was encountered trying to convert a path expression value to its Terraform type. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Path Expression: "+step.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	relativeTfTypePath, relativeTfTypePathDiags := totftypes.AttributePath(ctx, step.RelativePath)
+
+	diags.Append(relativeTfTypePathDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	conditionTfTypePathSteps := make([]tftypes.AttributePathStep, 0, len(tfTypePathSteps)+len(relativeTfTypePath.Steps()))
+	conditionTfTypePathSteps = append(conditionTfTypePathSteps, tfTypePathSteps...)
+	conditionTfTypePathSteps = append(conditionTfTypePathSteps, relativeTfTypePath.Steps()...)
+
+	// An error here means a parent of the relative path is null or unknown,
+	// which cannot equal the expected value.
+	conditionValue, _, err := tftypes.WalkAttributePath(d.TerraformValue, tftypes.NewAttributePathWithSteps(conditionTfTypePathSteps))
+
+	if err != nil {
+		return false, diags
+	}
+
+	conditionTfValue, ok := conditionValue.(tftypes.Value)
+
+	if !ok {
+		return false, diags
+	}
+
+	return conditionTfValue.Equal(expectedTfValue), diags
+}
@@ -1313,6 +1313,374 @@ func TestDataPathMatches(t *testing.T) {
 				path.Root("test"),
 			},
 		},
+		"AttributeNameExact-ElementMatching-AttributeNameExact-match": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_child1": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_child2": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_child1": tftypes.String,
+									"test_child2": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_child1": tftypes.String,
+									"test_child2": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-value-list-0-child-1"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-0-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-match"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-1-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-match"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-2-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, nil),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-3-child-2"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtMatching(path.Root("test_child1"), types.StringValue("test-match")).AtName("test_child2"),
+			expected: path.Paths{
+				path.Root("test_parent").AtListIndex(1).AtName("test_child2"),
+				path.Root("test_parent").AtListIndex(2).AtName("test_child2"),
+			},
+		},
+		"AttributeNameExact-ElementMatching-AttributeNameExact-mismatch": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_child1": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_child2": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_child1": tftypes.String,
+									"test_child2": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_child1": tftypes.String,
+									"test_child2": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-value-list-0-child-1"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-0-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-match"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-1-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-match"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-2-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, nil),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-3-child-2"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtMatching(path.Root("test_child1"), types.StringValue("test-no-match")).AtName("test_child2"),
+			expected:   nil,
+		},
+		"AttributeNameExact-ElementMatching-AttributeNameExact-invalid-relative-path": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test_parent": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test_child1": testschema.Attribute{
+									Type: types.StringType,
+								},
+								"test_child2": testschema.Attribute{
+									Type: types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_parent": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_child1": tftypes.String,
+									"test_child2": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test_parent": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"test_child1": tftypes.String,
+									"test_child2": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-value-list-0-child-1"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-0-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-match"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-1-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, "test-match"),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-2-child-2"),
+								},
+							),
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"test_child1": tftypes.String,
+										"test_child2": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"test_child1": tftypes.NewValue(tftypes.String, nil),
+									"test_child2": tftypes.NewValue(tftypes.String, "test-value-list-3-child-2"),
+								},
+							),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test_parent").AtMatching(path.Root("test_child3"), types.StringValue("test-match")).AtName("test_child2"),
+			expected:   nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: test_parent[Matching(test_child3 == \"test-match\")].test_child2",
+				),
+			},
+		},
+		"AttributeNameExact-ElementMatching-empty-relative-path": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Type: types.MapType{
+							ElemType: types.StringType,
+						},
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Map{
+							ElementType: tftypes.String,
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.Map{
+							ElementType: tftypes.String,
+						},
+						map[string]tftypes.Value{
+							"test-key1": tftypes.NewValue(tftypes.String, "test-value1"),
+							"test-key2": tftypes.NewValue(tftypes.String, "test-value2"),
+						},
+					),
+				},
+			),
+			expression: path.MatchRoot("test").AtMatching(path.Empty(), types.StringValue("test-value2")),
+			expected: path.Paths{
+				path.Root("test").AtMapKey("test-key2"),
+			},
+		},
+		"AttributeNameExact-ElementMatching-invalid-on-primitive": {
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Type: types.StringType,
+					},
+				},
+			},
+			tfTypeValue: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, "test-value"),
+				},
+			),
+			expression: path.MatchRoot("test").AtMatching(path.Empty(), types.StringValue("test-value")),
+			expected:   nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: test[Matching( == \"test-value\")]",
+				),
+			},
+		},
 		"AttributeNameExact-Parent": {
 			schema: testschema.Schema{
 				Attributes: map[string]fwschema.Attribute{
@@ -19,6 +19,12 @@ import (
 func (d *Data) ReifyNullCollectionBlocks(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	// Transform rebuilds the entire value, which is expensive for large data,
+	// so skip it when there are no null collections to convert.
+	if !containsNullCollection(d.TerraformValue) {
+		return diags
+	}
+
 	blockPathExpressions := fwschema.SchemaBlockPathExpressions(ctx, d.Schema)
 
 	// Errors are handled as richer diag.Diagnostics instead.
@@ -72,3 +78,28 @@ func (d *Data) ReifyNullCollectionBlocks(ctx context.Context) diag.Diagnostics {
 
 	return diags
 }
+
+// containsNullCollection returns true if the value contains any null list or
+// set value, other than the value itself.
+func containsNullCollection(value tftypes.Value) bool {
+	var found bool
+
+	_ = tftypes.Walk(value, func(tfTypePath *tftypes.AttributePath, tfTypeValue tftypes.Value) (bool, error) {
+		if found {
+			return false, nil
+		}
+
+		if len(tfTypePath.Steps()) < 1 || !tfTypeValue.IsNull() {
+			return true, nil
+		}
+
+		switch tfTypeValue.Type().(type) {
+		case tftypes.List, tftypes.Set:
+			found = true
+		}
+
+		return !found, nil
+	})
+
+	return found
+}
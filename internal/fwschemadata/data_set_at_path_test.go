@@ -1509,6 +1509,58 @@ func TestDataSetAtPath(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
 			}),
 		},
+		"overwrite-Tuple-Element": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Tuple{
+							ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+						},
+						"other": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.Tuple{
+						ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+					}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "originalvalue"),
+						tftypes.NewValue(tftypes.Number, 1),
+					}),
+					"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: types.TupleType{
+								ElemTypes: []attr.Type{types.StringType, types.NumberType},
+							},
+							Required: true,
+						},
+						"other": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test").AtTupleIndex(1),
+			val:  2,
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.Tuple{
+						ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+					},
+					"other": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.Tuple{
+					ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+				}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "originalvalue"),
+					tftypes.NewValue(tftypes.Number, 2),
+				}),
+				"other": tftypes.NewValue(tftypes.String, "should be untouched"),
+			}),
+		},
 		"overwrite-Dynamic": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.NewValue(tftypes.Object{
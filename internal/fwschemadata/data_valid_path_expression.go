@@ -68,6 +68,51 @@ func validatePathExpressionSteps(ctx context.Context, currentType attr.Type, cur
 		}
 
 		currentTfStep = tftypes.ElementKeyValue(tfValue)
+	case path.ExpressionStepElementMatching:
+		// The element step depends on the collection type.
+		switch currentType.TerraformType(ctx).(type) {
+		case tftypes.List:
+			currentTfStep = tftypes.ElementKeyInt(0)
+		case tftypes.Map:
+			currentTfStep = tftypes.ElementKeyString("")
+		case tftypes.Set:
+			currentTfStep = tftypes.ElementKeyValue(tftypes.NewValue(
+				currentType.TerraformType(ctx),
+				nil,
+			))
+		default:
+			logging.FrameworkDebug(
+				ctx,
+				fmt.Sprintf("Returning false due to %T not supporting element matching", currentType),
+			)
+
+			return false
+		}
+
+		elementTypeIface, err := currentType.ApplyTerraform5AttributePathStep(currentTfStep)
+
+		if err != nil {
+			logging.FrameworkDebug(
+				ctx,
+				fmt.Sprintf("Returning false due to error while calling %T ApplyTerraform5AttributePathStep with %T", currentType, currentTfStep),
+				map[string]any{
+					logging.KeyError: err,
+				},
+			)
+
+			return false
+		}
+
+		elementType, ok := elementTypeIface.(attr.Type)
+
+		if !ok {
+			panic(fmt.Sprintf("%T returned unexpected type %T from ApplyTerraform5AttributePathStep", currentType, elementTypeIface))
+		}
+
+		// The relative path must also be valid for the element type.
+		if !validatePathExpressionSteps(ctx, elementType, step.RelativePath.Expression().Resolve().Steps()) {
+			return false
+		}
 	default:
 		// If new, resolved path.ExpressionStep are introduced, they must be
 		// added as cases to this switch statement.
@@ -252,6 +252,76 @@ func TestDataValidPathExpression(t *testing.T) {
 			expression: path.MatchRoot("test").AtSetValue(types.StringValue("test-value")),
 			expected:   false,
 		},
+		"AttributeNameExact-AtMatching-list-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"test_child": types.StringType}}},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtMatching(path.Root("test_child"), types.StringValue("test-value")),
+			expected:   true,
+		},
+		"AttributeNameExact-AtMatching-list-mismatch-relative-path": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{"test_child": types.StringType}}},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtMatching(path.Root("not_test_child"), types.StringValue("test-value")),
+			expected:   false,
+		},
+		"AttributeNameExact-AtMatching-map-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.MapType{ElemType: types.StringType},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtMatching(path.Empty(), types.StringValue("test-value")),
+			expected:   true,
+		},
+		"AttributeNameExact-AtMatching-set-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.SetType{ElemType: types.StringType},
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtMatching(path.Empty(), types.StringValue("test-value")),
+			expected:   true,
+		},
+		"AttributeNameExact-AtMatching-mismatch-type": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			expression: path.MatchRoot("test").AtMatching(path.Empty(), types.StringValue("test-value")),
+			expected:   false,
+		},
 		"AttributeNameExact-match-dynamic-attribute": {
 			data: fwschemadata.Data{
 				Schema: testschema.Schema{
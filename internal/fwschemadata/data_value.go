@@ -87,6 +87,21 @@ func (d Data) ValueAtPath(ctx context.Context, schemaPath path.Path) (attr.Value
 		}
 	}
 
+	if attrTypeWithValidation, ok := attrType.(xattr.TypeWithValueFromTerraformWithValidation); ok {
+		logging.FrameworkTrace(ctx, "Type implements TypeWithValueFromTerraformWithValidation")
+		logging.FrameworkTrace(ctx, "Calling provider defined Type ValueFromTerraformWithValidation")
+		attrValue, valueDiags := attrTypeWithValidation.ValueFromTerraformWithValidation(ctx, tfValue, schemaPath)
+		logging.FrameworkTrace(ctx, "Called provider defined Type ValueFromTerraformWithValidation")
+
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return attrValue, diags
+	}
+
 	attrValue, err := attrType.ValueFromTerraform(ctx, tfValue)
 
 	if err != nil {
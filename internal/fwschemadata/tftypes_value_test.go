@@ -490,6 +490,50 @@ func TestUpsertChildTerraformValue(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "two"),
 			}),
 		},
+		"Tuple-value-overwrite": {
+			parentType: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			},
+			parentValue: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.Number, 1),
+			}),
+			childStep:  path.PathStepElementKeyInt(1),
+			childValue: tftypes.NewValue(tftypes.Number, 2),
+			expected: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.Number, 2),
+			}),
+		},
+		"Tuple-value-write-length-error": {
+			parentType: tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			},
+			parentValue: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+			childStep:  path.PathStepElementKeyInt(1),
+			childValue: tftypes.NewValue(tftypes.String, "two"),
+			expected: tftypes.NewValue(tftypes.Tuple{
+				ElementTypes: []tftypes.Type{tftypes.String},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot set tuple element 2 as tuple has 1 length. Tuple elements can only be updated in existing positions.",
+				),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
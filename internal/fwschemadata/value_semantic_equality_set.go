@@ -125,44 +125,133 @@ func ValueSemanticEqualitySetElements(ctx context.Context, req ValueSemanticEqua
 	// collection value after each element is evaluated.
 	newValueElements := make([]attr.Value, len(proposedNewValueElements))
 
-	// Short circuit flag
-	updatedElements := false
+	// Track which prior elements have been matched, to prevent a single prior
+	// element from replacing multiple proposed elements.
+	priorValueElementsMatched := make([]bool, len(priorValueElements))
+
+	// Track which proposed elements exactly match a prior element, which do
+	// not require semantic equality checking.
+	proposedNewValueElementsMatched := make([]bool, len(proposedNewValueElements))
+
+	// Prior elements are indexed by their Terraform value string, so exactly
+	// equal elements are found without comparing every pair of elements,
+	// which is quadratic for large sets.
+	priorValueElementIndices := make(map[string][]int, len(priorValueElements))
+
+	for priorIdx, priorValueElement := range priorValueElements {
+		key, ok := setElementKey(ctx, priorValueElement)
+
+		if !ok {
+			continue
+		}
+
+		priorValueElementIndices[key] = append(priorValueElementIndices[key], priorIdx)
+	}
 
-	// Loop through proposed elements by delegating to the recursive semantic
-	// equality logic. This ensures that recursion will catch a further
-	// underlying element type has its semantic equality logic checked, even if
-	// the current element type does not implement the interface.
 	for idx, proposedNewValueElement := range proposedNewValueElements {
 		// Ensure new value always contains all of proposed new value
 		newValueElements[idx] = proposedNewValueElement
 
-		if idx >= len(priorValueElements) {
+		key, ok := setElementKey(ctx, proposedNewValueElement)
+
+		if !ok {
 			continue
 		}
 
-		elementReq := ValueSemanticEqualityRequest{
-			Path:             req.Path.AtSetValue(proposedNewValueElement),
-			PriorValue:       priorValueElements[idx],
-			ProposedNewValue: proposedNewValueElement,
+		for _, priorIdx := range priorValueElementIndices[key] {
+			if priorValueElementsMatched[priorIdx] || !priorValueElements[priorIdx].Equal(proposedNewValueElement) {
+				continue
+			}
+
+			priorValueElementsMatched[priorIdx] = true
+			proposedNewValueElementsMatched[idx] = true
+
+			break
 		}
-		elementResp := &ValueSemanticEqualityResponse{
-			NewValue: elementReq.ProposedNewValue,
+	}
+
+	// Equal elements may have differing keys, such as custom types with
+	// custom Equal logic, so compare any remaining elements directly before
+	// any semantic equality checking.
+	for idx, proposedNewValueElement := range proposedNewValueElements {
+		if proposedNewValueElementsMatched[idx] {
+			continue
 		}
 
-		ValueSemanticEquality(ctx, elementReq, elementResp)
+		for priorIdx, priorValueElement := range priorValueElements {
+			if priorValueElementsMatched[priorIdx] || !priorValueElement.Equal(proposedNewValueElement) {
+				continue
+			}
 
-		resp.Diagnostics.Append(elementResp.Diagnostics...)
+			priorValueElementsMatched[priorIdx] = true
+			proposedNewValueElementsMatched[idx] = true
 
-		if resp.Diagnostics.HasError() {
-			return
+			break
 		}
+	}
+
+	// Short circuit flag
+	updatedElements := false
 
-		if elementResp.NewValue.Equal(elementReq.ProposedNewValue) {
+	// Sets are unordered, so each remaining proposed element is compared
+	// against each remaining prior element by delegating to the recursive
+	// semantic equality logic. A prior element which is semantically equal in
+	// its entirety is preferred, otherwise the first prior element which
+	// caused an update of a nested value, such as for set of objects, is used.
+	for idx, proposedNewValueElement := range proposedNewValueElements {
+		if proposedNewValueElementsMatched[idx] {
+			continue
+		}
+
+		matchedPriorIdx := -1
+		var matchedNewValue attr.Value
+
+		for priorIdx, priorValueElement := range priorValueElements {
+			if priorValueElementsMatched[priorIdx] {
+				continue
+			}
+
+			elementReq := ValueSemanticEqualityRequest{
+				Path:             req.Path.AtSetValue(proposedNewValueElement),
+				PriorValue:       priorValueElement,
+				ProposedNewValue: proposedNewValueElement,
+			}
+			elementResp := &ValueSemanticEqualityResponse{
+				NewValue: elementReq.ProposedNewValue,
+			}
+
+			ValueSemanticEquality(ctx, elementReq, elementResp)
+
+			resp.Diagnostics.Append(elementResp.Diagnostics...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if elementResp.NewValue.Equal(elementReq.ProposedNewValue) {
+				continue
+			}
+
+			if elementResp.NewValue.Equal(priorValueElement) {
+				matchedPriorIdx = priorIdx
+				matchedNewValue = elementResp.NewValue
+
+				break
+			}
+
+			if matchedPriorIdx == -1 {
+				matchedPriorIdx = priorIdx
+				matchedNewValue = elementResp.NewValue
+			}
+		}
+
+		if matchedPriorIdx == -1 {
 			continue
 		}
 
 		updatedElements = true
-		newValueElements[idx] = elementResp.NewValue
+		priorValueElementsMatched[matchedPriorIdx] = true
+		newValueElements[idx] = matchedNewValue
 	}
 
 	// No changes required if the elements were not updated.
@@ -208,3 +297,16 @@ func ValueSemanticEqualitySetElements(ctx context.Context, req ValueSemanticEqua
 
 	resp.NewValue = newValuable
 }
+
+// setElementKey returns a string key for the set element, based on its
+// Terraform value, for finding equal elements. Elements which cannot be
+// converted return false.
+func setElementKey(ctx context.Context, element attr.Value) (string, bool) {
+	tfValue, err := element.ToTerraformValue(ctx)
+
+	if err != nil {
+		return "", false
+	}
+
+	return tfValue.String(), true
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func BenchmarkValueSemanticEqualitySetElements1000(b *testing.B) {
+	benchmarkValueSemanticEqualitySetElements(b, 1000, 0)
+}
+
+func BenchmarkValueSemanticEqualitySetElements1000Changed10(b *testing.B) {
+	benchmarkValueSemanticEqualitySetElements(b, 1000, 10)
+}
+
+func benchmarkValueSemanticEqualitySetElements(b *testing.B, elements int, changed int) {
+	ctx := context.Background()
+
+	elementType := testtypes.StringTypeWithSemanticEquals{
+		SemanticEquals: true,
+	}
+
+	priorElements := make([]attr.Value, elements)
+	proposedNewElements := make([]attr.Value, elements)
+
+	// Proposed new elements are in reverse order of prior elements, with the
+	// first changed elements having a differing value.
+	for i := 0; i < elements; i++ {
+		priorElements[i] = testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringValue("test-value" + strconv.Itoa(i)),
+			SemanticEquals: true,
+		}
+
+		proposedNewValue := "test-value" + strconv.Itoa(elements-i-1)
+
+		if i < changed {
+			proposedNewValue = "test-changed-value" + strconv.Itoa(i)
+		}
+
+		proposedNewElements[i] = testtypes.StringValueWithSemanticEquals{
+			StringValue:    types.StringValue(proposedNewValue),
+			SemanticEquals: true,
+		}
+	}
+
+	req := fwschemadata.ValueSemanticEqualityRequest{
+		Path:             path.Root("test"),
+		PriorValue:       types.SetValueMust(elementType, priorElements),
+		ProposedNewValue: types.SetValueMust(elementType, proposedNewElements),
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		resp := &fwschemadata.ValueSemanticEqualityResponse{
+			NewValue: req.ProposedNewValue,
+		}
+
+		fwschemadata.ValueSemanticEqualitySetElements(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() {
+			b.Fatalf("unexpected error diagnostics: %s", resp.Diagnostics)
+		}
+	}
+}
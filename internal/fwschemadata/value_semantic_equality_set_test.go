@@ -91,6 +91,58 @@ func TestValueSemanticEqualitySet(t *testing.T) {
 				),
 			},
 		},
+		"SetValue-StringValuableWithSemanticEquals-true-unordered": {
+			request: fwschemadata.ValueSemanticEqualityRequest{
+				Path: path.Root("test"),
+				PriorValue: types.SetValueMust(
+					testtypes.StringTypeWithSemanticEquals{
+						SemanticEquals: true,
+					},
+					[]attr.Value{
+						testtypes.StringValueWithSemanticEquals{
+							StringValue:    types.StringValue("prior1"),
+							SemanticEquals: true,
+						},
+						testtypes.StringValueWithSemanticEquals{
+							StringValue:    types.StringValue("prior2"),
+							SemanticEquals: true,
+						},
+					},
+				),
+				ProposedNewValue: types.SetValueMust(
+					testtypes.StringTypeWithSemanticEquals{
+						SemanticEquals: true,
+					},
+					[]attr.Value{
+						testtypes.StringValueWithSemanticEquals{
+							StringValue:    types.StringValue("prior2"),
+							SemanticEquals: true,
+						},
+						testtypes.StringValueWithSemanticEquals{
+							StringValue:    types.StringValue("new"),
+							SemanticEquals: true,
+						},
+					},
+				),
+			},
+			expected: &fwschemadata.ValueSemanticEqualityResponse{
+				NewValue: types.SetValueMust(
+					testtypes.StringTypeWithSemanticEquals{
+						SemanticEquals: true,
+					},
+					[]attr.Value{
+						testtypes.StringValueWithSemanticEquals{
+							StringValue:    types.StringValue("prior2"),
+							SemanticEquals: true,
+						},
+						testtypes.StringValueWithSemanticEquals{
+							StringValue:    types.StringValue("prior1"),
+							SemanticEquals: true,
+						},
+					},
+				),
+			},
+		},
 		"SetValue-StringValuableWithSemanticEquals-false": {
 			request: fwschemadata.ValueSemanticEqualityRequest{
 				Path: path.Root("test"),
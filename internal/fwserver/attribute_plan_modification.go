@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fwlog"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -84,6 +85,7 @@ type ModifyAttributePlanResponse struct {
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
 	ctx = logging.FrameworkWithAttributePath(ctx, req.AttributePath.String())
+	ctx = fwlog.WithPath(ctx, req.AttributePath)
 
 	if req.Private != nil {
 		resp.Private = req.Private
@@ -92,8 +94,12 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 	switch attributeWithPlanModifiers := a.(type) {
 	case fwxschema.AttributeWithBoolPlanModifiers:
 		AttributePlanModifyBool(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithFloat32PlanModifiers:
+		AttributePlanModifyFloat32(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithFloat64PlanModifiers:
 		AttributePlanModifyFloat64(ctx, attributeWithPlanModifiers, req, resp)
+	case fwxschema.AttributeWithInt32PlanModifiers:
+		AttributePlanModifyInt32(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithInt64PlanModifiers:
 		AttributePlanModifyInt64(ctx, attributeWithPlanModifiers, req, resp)
 	case fwxschema.AttributeWithListPlanModifiers:
@@ -785,6 +791,18 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	}
 
 	for _, planModifier := range attribute.BoolPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Bool as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.BoolResponse{
@@ -841,6 +859,178 @@ func AttributePlanModifyBool(ctx context.Context, attribute fwxschema.AttributeW
 	}
 }
 
+// AttributePlanModifyFloat32 performs all types.Float32 plan modification.
+func AttributePlanModifyFloat32(ctx context.Context, attribute fwxschema.AttributeWithFloat32PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.Float32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute plan modification. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceFloat32Typable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.Float32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.Float32PlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Float32 as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.Float32Response{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined planmodifier.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		planModifier.PlanModifyFloat32(ctx, planModifyReq, planModifyResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined planmodifier.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromFloat32(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
 // AttributePlanModifyFloat64 performs all types.Float64 plan modification.
 func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.AttributeWithFloat64PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
 	// Use basetypes.Float64Valuable until custom types cannot re-implement
@@ -945,6 +1135,18 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	}
 
 	for _, planModifier := range attribute.Float64PlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Float64 as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Float64Response{
@@ -1001,6 +1203,178 @@ func AttributePlanModifyFloat64(ctx context.Context, attribute fwxschema.Attribu
 	}
 }
 
+// AttributePlanModifyInt32 performs all types.Int32 plan modification.
+func AttributePlanModifyInt32(ctx context.Context, attribute fwxschema.AttributeWithInt32PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	// Use basetypes.Int32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planValuable, ok := req.AttributePlan.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributePlan),
+		)
+
+		return
+	}
+
+	planValue, diags := planValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	stateValuable, ok := req.AttributeState.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Plan Modifier Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute plan modification. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeState),
+		)
+
+		return
+	}
+
+	stateValue, diags := stateValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	typable, diags := coerceInt32Typable(ctx, req.AttributePath, planValuable)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	planModifyReq := planmodifier.Int32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+		Plan:           req.Plan,
+		PlanValue:      planValue,
+		Private:        req.Private,
+		State:          req.State,
+		StateValue:     stateValue,
+	}
+
+	for _, planModifier := range attribute.Int32PlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Int32 as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
+		// Instantiate a new response for each request to prevent plan modifiers
+		// from modifying or removing diagnostics.
+		planModifyResp := &planmodifier.Int32Response{
+			PlanValue: planModifyReq.PlanValue,
+			Private:   resp.Private,
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined planmodifier.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		planModifier.PlanModifyInt32(ctx, planModifyReq, planModifyResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined planmodifier.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: planModifier.Description(ctx),
+			},
+		)
+
+		// Prepare next request with base type.
+		planModifyReq.PlanValue = planModifyResp.PlanValue
+
+		resp.Diagnostics.Append(planModifyResp.Diagnostics...)
+		resp.Private = planModifyResp.Private
+
+		if planModifyResp.RequiresReplace {
+			resp.RequiresReplace.Append(req.AttributePath)
+		}
+
+		// Only on new errors.
+		if planModifyResp.Diagnostics.HasError() {
+			return
+		}
+
+		// A custom value type must be returned in the final response to prevent
+		// later correctness errors.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/754
+		valuable, valueFromDiags := typable.ValueFromInt32(ctx, planModifyResp.PlanValue)
+
+		resp.Diagnostics.Append(valueFromDiags...)
+
+		// Only on new errors.
+		if valueFromDiags.HasError() {
+			return
+		}
+
+		resp.AttributePlan = valuable
+	}
+}
+
 // AttributePlanModifyInt64 performs all types.Int64 plan modification.
 func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.AttributeWithInt64PlanModifiers, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
 	// Use basetypes.Int64Valuable until custom types cannot re-implement
@@ -1105,6 +1479,18 @@ func AttributePlanModifyInt64(ctx context.Context, attribute fwxschema.Attribute
 	}
 
 	for _, planModifier := range attribute.Int64PlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Int64 as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.Int64Response{
@@ -1265,6 +1651,18 @@ func AttributePlanModifyList(ctx context.Context, attribute fwxschema.AttributeW
 	}
 
 	for _, planModifier := range attribute.ListPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.List as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ListResponse{
@@ -1425,6 +1823,18 @@ func AttributePlanModifyMap(ctx context.Context, attribute fwxschema.AttributeWi
 	}
 
 	for _, planModifier := range attribute.MapPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Map as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.MapResponse{
@@ -1585,6 +1995,18 @@ func AttributePlanModifyNumber(ctx context.Context, attribute fwxschema.Attribut
 	}
 
 	for _, planModifier := range attribute.NumberPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Number as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.NumberResponse{
@@ -1745,6 +2167,18 @@ func AttributePlanModifyObject(ctx context.Context, attribute fwxschema.Attribut
 	}
 
 	for _, planModifier := range attribute.ObjectPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Object as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.ObjectResponse{
@@ -1905,6 +2339,18 @@ func AttributePlanModifySet(ctx context.Context, attribute fwxschema.AttributeWi
 	}
 
 	for _, planModifier := range attribute.SetPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Set as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.SetResponse{
@@ -2065,6 +2511,18 @@ func AttributePlanModifyString(ctx context.Context, attribute fwxschema.Attribut
 	}
 
 	for _, planModifier := range attribute.StringPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.String as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.StringResponse{
@@ -2225,6 +2683,18 @@ func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.Attribu
 	}
 
 	for _, planModifier := range attribute.DynamicPlanModifiers() {
+		if planModifierSkippable(ctx, planModifier, configValue, planModifyReq.PlanValue, stateValue) {
+			logging.FrameworkTrace(
+				ctx,
+				"Skipping provider defined planmodifier.Dynamic as the configuration, plan, and state values are known and equal",
+				map[string]interface{}{
+					logging.KeyDescription: planModifier.Description(ctx),
+				},
+			)
+
+			continue
+		}
+
 		// Instantiate a new response for each request to prevent plan modifiers
 		// from modifying or removing diagnostics.
 		planModifyResp := &planmodifier.DynamicResponse{
@@ -2281,6 +2751,30 @@ func AttributePlanModifyDynamic(ctx context.Context, attribute fwxschema.Attribu
 	}
 }
 
+// planModifierSkippable returns true if calling the attribute plan modifier
+// can be skipped, which is when the plan modifier implements the
+// planmodifier.SkipWhenUnchanged interface and returns true, and the
+// configuration, plan, and prior state values are all known and equal.
+func planModifierSkippable(ctx context.Context, planModifier any, configValue, planValue, stateValue attr.Value) bool {
+	skipWhenUnchanged, ok := planModifier.(planmodifier.SkipWhenUnchanged)
+
+	if !ok || !skipWhenUnchanged.SkipWhenUnchanged(ctx) {
+		return false
+	}
+
+	if !planValue.Equal(stateValue) || !configValue.Equal(stateValue) {
+		return false
+	}
+
+	tfValue, err := stateValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		return false
+	}
+
+	return tfValue.IsFullyKnown()
+}
+
 func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttributeObject, req planmodifier.ObjectRequest, resp *ModifyAttributePlanResponse) {
 	if objectWithPlanModifiers, ok := o.(fwxschema.NestedAttributeObjectWithPlanModifiers); ok {
 		for _, objectPlanModifier := range objectWithPlanModifiers.ObjectPlanModifiers() {
@@ -2377,9 +2871,30 @@ func NestedAttributeObjectPlanModify(ctx context.Context, o fwschema.NestedAttri
 		resp.RequiresReplace.Append(nestedAttrResp.RequiresReplace...)
 	}
 
+	// Preserve an unknown object, such as an element of a collection created
+	// with a known number of unknown elements, unless a nested attribute plan
+	// modifier set a known value.
+	if req.PlanValue.IsUnknown() && allAttributeValuesUnknown(newPlanValueAttributes) {
+		resp.AttributePlan = req.PlanValue
+
+		return
+	}
+
 	newPlanValue, diags := types.ObjectValue(req.PlanValue.AttributeTypes(ctx), newPlanValueAttributes)
 
 	resp.Diagnostics.Append(diags...)
 
 	resp.AttributePlan = newPlanValue
 }
+
+// allAttributeValuesUnknown returns true if every given attribute value is
+// unknown.
+func allAttributeValuesUnknown(attributes map[string]attr.Value) bool {
+	for _, value := range attributes {
+		if !value.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
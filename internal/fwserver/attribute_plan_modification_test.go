@@ -3943,26 +3943,26 @@ func TestAttributePlanModifyBool(t *testing.T) {
 	}
 }
 
-func TestAttributePlanModifyFloat64(t *testing.T) {
+func TestAttributePlanModifyFloat32(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		attribute fwxschema.AttributeWithFloat64PlanModifiers
+		attribute fwxschema.AttributeWithFloat32PlanModifiers
 		request   ModifyAttributePlanRequest
 		response  *ModifyAttributePlanResponse
 		expected  *ModifyAttributePlanResponse
 	}{
 		"request-path": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
 							got := req.Path
 							expected := path.Root("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Path",
+									"Unexpected Float32Request.Path",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -3972,28 +3972,28 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 		},
 		"request-pathexpression": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
 							got := req.PathExpression
 							expected := path.MatchRoot("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.PathExpression",
+									"Unexpected Float32Request.PathExpression",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -4004,22 +4004,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			request: ModifyAttributePlanRequest{
 				AttributePath:           path.Root("test"),
 				AttributePathExpression: path.MatchRoot("test"),
-				AttributeConfig:         types.Float64Value(1.2),
-				AttributePlan:           types.Float64Value(1.2),
-				AttributeState:          types.Float64Value(1.2),
+				AttributeConfig:         types.Float32Value(1.2),
+				AttributePlan:           types.Float32Value(1.2),
+				AttributeState:          types.Float32Value(1.2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 		},
 		"request-config": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
 							got := req.Config
 							expected := tfsdk.Config{
 								Raw: tftypes.NewValue(
@@ -4036,7 +4036,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Config",
+									"Unexpected Float32Request.Config",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -4046,9 +4046,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -4063,23 +4063,23 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 		},
 		"request-configvalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
 							got := req.ConfigValue
-							expected := types.Float64Value(1.2)
+							expected := types.Float32Value(1.2)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.ConfigValue",
+									"Unexpected Float32Request.ConfigValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -4089,22 +4089,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Null(),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Null(),
+				AttributeState:  types.Float32Null(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Float32Null(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Float32Null(),
 			},
 		},
 		"request-plan": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
 							got := req.Plan
 							expected := tfsdk.Plan{
 								Raw: tftypes.NewValue(
@@ -4121,7 +4121,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Plan",
+									"Unexpected Float32Request.Plan",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -4131,9 +4131,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
 				Plan: tfsdk.Plan{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -4148,23 +4148,23 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 		},
 		"request-planvalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
 							got := req.PlanValue
-							expected := types.Float64Value(1.2)
+							expected := types.Float32Value(1.2)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.PlanValue",
+									"Unexpected Float32Request.PlanValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -4174,22 +4174,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Null(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 			},
 		},
 		"request-private": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
 							got, diags := req.Private.GetKey(ctx, "testkey")
 							expected := []byte(`{"testproperty":true}`)
 
@@ -4197,7 +4197,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if diff := cmp.Diff(got, expected); diff != "" {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Private",
+									"Unexpected Float32Request.Private",
 									diff,
 								)
 							}
@@ -4207,9 +4207,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Null(),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -4218,7 +4218,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -4227,7 +4227,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Float32Value(1.2),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -4237,10 +4237,1282 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 		},
 		"request-state": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.State
+							expected := tfsdk.State{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.State",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"request-statevalue": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							got := req.StateValue
+							expected := types.Float32Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.StateValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Null(),
+				AttributeState:  types.Float32Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Null(),
+			},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"response-planvalue": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.PlanValue = types.Float32Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Unknown(),
+				AttributeState:  types.Float32Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Unknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+		},
+		"response-planvalue-custom-type": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.PlanValue = types.Float32Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Null(),
+				},
+				AttributePlan: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Unknown(),
+				},
+				AttributeState: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Null(),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Unknown(),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float32ValueWithSemanticEquals{
+					Float32Value: types.Float32Value(1.2),
+				},
+			},
+		},
+		"response-private": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.Diagnostics.Append(
+								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
+							)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Null(),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"newtestproperty":true}`),
+					}),
+				),
+			},
+		},
+		"response-requiresreplace-add": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
+				},
+			},
+		},
+		"response-requiresreplace-false": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.RequiresReplace = false // same as not being set
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains as it should not be removed
+				},
+			},
+		},
+		"response-requiresreplace-update": {
+			attribute: testschema.AttributeWithFloat32PlanModifiers{
+				PlanModifiers: []planmodifier.Float32{
+					testplanmodifier.Float32{
+						PlanModifyFloat32Method: func(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				AttributePlan:   types.Float32Value(1.2),
+				AttributeState:  types.Float32Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float32Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains deduplicated
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributePlanModifyFloat32(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithFloat64PlanModifiers
+		request   ModifyAttributePlanRequest
+		response  *ModifyAttributePlanResponse
+		expected  *ModifyAttributePlanResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.Float64Value(1.2),
+				AttributePlan:           types.Float64Value(1.2),
+				AttributeState:          types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.ConfigValue
+							expected := types.Float64Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Null(),
+				AttributeState:  types.Float64Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+		},
+		"request-plan": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.Plan
+							expected := tfsdk.Plan{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Plan",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-planvalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.PlanValue
+							expected := types.Float64Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.PlanValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-private": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got, diags := req.Private.GetKey(ctx, "testkey")
+							expected := []byte(`{"testproperty":true}`)
+
+							resp.Diagnostics.Append(diags...)
+
+							if diff := cmp.Diff(got, expected); diff != "" {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.Private",
+									diff,
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+		},
+		"request-state": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.State
+							expected := tfsdk.State{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.State",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"request-statevalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							got := req.StateValue
+							expected := types.Float64Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float64Request.StateValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Null(),
+				AttributeState:  types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Null(),
+			},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(1.2),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"response-planvalue": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.PlanValue = types.Float64Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Unknown(),
+				AttributeState:  types.Float64Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Unknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+		},
+		"response-planvalue-custom-type": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.PlanValue = types.Float64Value(1.2)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Null(),
+				},
+				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Unknown(),
+				},
+				AttributeState: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Null(),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Unknown(),
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
+					Float64Value: types.Float64Value(1.2),
+				},
+			},
+		},
+		"response-private": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.Diagnostics.Append(
+								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
+							)
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Null(),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"newtestproperty":true}`),
+					}),
+				),
+			},
+		},
+		"response-requiresreplace-add": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
+				},
+			},
+		},
+		"response-requiresreplace-false": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.RequiresReplace = false // same as not being set
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains as it should not be removed
+				},
+			},
+		},
+		"response-requiresreplace-update": {
+			attribute: testschema.AttributeWithFloat64PlanModifiers{
+				PlanModifiers: []planmodifier.Float64{
+					testplanmodifier.Float64{
+						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+							resp.RequiresReplace = true
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float64Value(1.2),
+				AttributePlan:   types.Float64Value(1.2),
+				AttributeState:  types.Float64Value(2.4),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Set by prior plan modifier
+				},
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Float64Value(1.2),
+				RequiresReplace: path.Paths{
+					path.Root("test"), // Remains deduplicated
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributePlanModifyFloat64(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributePlanModifyInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithInt32PlanModifiers
+		request   ModifyAttributePlanRequest
+		response  *ModifyAttributePlanResponse
+		expected  *ModifyAttributePlanResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Int32Request.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Int32Request.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.Int32Value(1),
+				AttributePlan:           types.Int32Value(1),
+				AttributeState:          types.Int32Value(1),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Int32Request.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							got := req.ConfigValue
+							expected := types.Int32Value(1)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Int32Request.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Null(),
+				AttributeState:  types.Int32Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Null(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Null(),
+			},
+		},
+		"request-plan": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							got := req.Plan
+							expected := tfsdk.Plan{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Int32Request.Plan",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+		},
+		"request-planvalue": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							got := req.PlanValue
+							expected := types.Int32Value(1)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Int32Request.PlanValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Null(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+			},
+		},
+		"request-private": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							got, diags := req.Private.GetKey(ctx, "testkey")
+							expected := []byte(`{"testproperty":true}`)
+
+							resp.Diagnostics.Append(diags...)
+
+							if diff := cmp.Diff(got, expected); diff != "" {
+								resp.Diagnostics.AddError(
+									"Unexpected Int32Request.Private",
+									diff,
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Null(),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`), // copied from request
+					}),
+				),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.Int32Value(1),
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"testkey": []byte(`{"testproperty":true}`),
+					}),
+				),
+			},
+		},
+		"request-state": {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.State
 							expected := tfsdk.State{
 								Raw: tftypes.NewValue(
@@ -4257,7 +5529,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.State",
+									"Unexpected Int32Request.State",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -4267,9 +5539,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -4284,23 +5556,23 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"request-statevalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							got := req.StateValue
-							expected := types.Float64Value(1.2)
+							expected := types.Int32Value(1)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.StateValue",
+									"Unexpected Int32Request.StateValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -4310,22 +5582,22 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Null(),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Null(),
+				AttributeState:  types.Int32Value(1),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Int32Null(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Null(),
+				AttributePlan: types.Int32Null(),
 			},
 		},
 		"response-diagnostics": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
 							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
 						},
@@ -4334,12 +5606,12 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(1),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -4354,7 +5626,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
 						path.Root("other"),
@@ -4380,66 +5652,66 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 		},
 		"response-planvalue": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
-							resp.PlanValue = types.Float64Value(1.2)
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							resp.PlanValue = types.Int32Value(1)
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Unknown(),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Unknown(),
+				AttributeState:  types.Int32Null(),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Unknown(),
+				AttributePlan: types.Int32Unknown(),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 		},
 		"response-planvalue-custom-type": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
-							resp.PlanValue = types.Float64Value(1.2)
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+							resp.PlanValue = types.Int32Value(1)
 						},
 					},
 				},
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath: path.Root("test"),
-				AttributeConfig: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Null(),
+				AttributeConfig: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Null(),
 				},
-				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Unknown(),
+				AttributePlan: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Unknown(),
 				},
-				AttributeState: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Null(),
+				AttributeState: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Null(),
 				},
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Unknown(),
+				AttributePlan: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Unknown(),
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: testtypes.Float64ValueWithSemanticEquals{
-					Float64Value: types.Float64Value(1.2),
+				AttributePlan: testtypes.Int32ValueWithSemanticEquals{
+					Int32Value: types.Int32Value(1),
 				},
 			},
 		},
 		"response-private": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.Diagnostics.Append(
 								resp.Private.SetKey(ctx, "testkey", []byte(`{"newtestproperty":true}`))...,
 							)
@@ -4449,9 +5721,9 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Null(),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Null(),
+				AttributeConfig: types.Int32Null(),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Null(),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -4460,7 +5732,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -4469,7 +5741,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 				),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				Private: privatestate.MustProviderData(
 					context.Background(),
 					privatestate.MustMarshalToJson(map[string][]byte{
@@ -4479,10 +5751,10 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 		},
 		"response-requiresreplace-add": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.RequiresReplace = true
 						},
 					},
@@ -4490,25 +5762,25 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(2.4),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"),
 				},
 			},
 		},
 		"response-requiresreplace-false": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.RequiresReplace = false // same as not being set
 						},
 					},
@@ -4516,28 +5788,28 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(2.4),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains as it should not be removed
 				},
 			},
 		},
 		"response-requiresreplace-update": {
-			attribute: testschema.AttributeWithFloat64PlanModifiers{
-				PlanModifiers: []planmodifier.Float64{
-					testplanmodifier.Float64{
-						PlanModifyFloat64Method: func(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+			attribute: testschema.AttributeWithInt32PlanModifiers{
+				PlanModifiers: []planmodifier.Int32{
+					testplanmodifier.Int32{
+						PlanModifyInt32Method: func(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
 							resp.RequiresReplace = true
 						},
 					},
@@ -4545,18 +5817,18 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 			},
 			request: ModifyAttributePlanRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
-				AttributePlan:   types.Float64Value(1.2),
-				AttributeState:  types.Float64Value(2.4),
+				AttributeConfig: types.Int32Value(1),
+				AttributePlan:   types.Int32Value(1),
+				AttributeState:  types.Int32Value(2),
 			},
 			response: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Set by prior plan modifier
 				},
 			},
 			expected: &ModifyAttributePlanResponse{
-				AttributePlan: types.Float64Value(1.2),
+				AttributePlan: types.Int32Value(1),
 				RequiresReplace: path.Paths{
 					path.Root("test"), // Remains deduplicated
 				},
@@ -4570,7 +5842,7 @@ func TestAttributePlanModifyFloat64(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributePlanModifyFloat64(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributePlanModifyInt32(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -5224,6 +6496,110 @@ func TestAttributePlanModifyList(t *testing.T) {
 		response  *ModifyAttributePlanResponse
 		expected  *ModifyAttributePlanResponse
 	}{
+		"unchanged-skipped": {
+			attribute: testschema.AttributeWithListPlanModifiers{
+				PlanModifiers: []planmodifier.List{
+					testplanmodifier.List{
+						SkipWhenUnchangedMethod: func(_ context.Context) bool { return true },
+						PlanModifyListMethod: func(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+		},
+		"unchanged-called": {
+			attribute: testschema.AttributeWithListPlanModifiers{
+				PlanModifiers: []planmodifier.List{
+					testplanmodifier.List{
+						PlanModifyListMethod: func(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributeState:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("called", "called"),
+				},
+			},
+		},
+		"changed-called": {
+			attribute: testschema.AttributeWithListPlanModifiers{
+				PlanModifiers: []planmodifier.List{
+					testplanmodifier.List{
+						SkipWhenUnchangedMethod: func(_ context.Context) bool { return true },
+						PlanModifyListMethod: func(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+				AttributePlan:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("newvalue")}),
+				AttributeState:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("testvalue")}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("newvalue")}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("newvalue")}),
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("called", "called"),
+				},
+			},
+		},
+		"unknown-element-called": {
+			attribute: testschema.AttributeWithListPlanModifiers{
+				PlanModifiers: []planmodifier.List{
+					testplanmodifier.List{
+						SkipWhenUnchangedMethod: func(_ context.Context) bool { return true },
+						PlanModifyListMethod: func(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+				AttributePlan:   types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+				AttributeState:  types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}),
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("called", "called"),
+				},
+			},
+		},
 		"request-path": {
 			attribute: testschema.AttributeWithListPlanModifiers{
 				PlanModifiers: []planmodifier.List{
@@ -9348,6 +10724,110 @@ func TestAttributePlanModifyString(t *testing.T) {
 		response  *ModifyAttributePlanResponse
 		expected  *ModifyAttributePlanResponse
 	}{
+		"unchanged-skipped": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						SkipWhenUnchangedMethod: func(_ context.Context) bool { return true },
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+		},
+		"unchanged-called": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("testvalue"),
+				AttributeState:  types.StringValue("testvalue"),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("testvalue"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("called", "called"),
+				},
+			},
+		},
+		"changed-called": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						SkipWhenUnchangedMethod: func(_ context.Context) bool { return true },
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("testvalue"),
+				AttributePlan:   types.StringValue("newvalue"),
+				AttributeState:  types.StringValue("testvalue"),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("newvalue"),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringValue("newvalue"),
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("called", "called"),
+				},
+			},
+		},
+		"unknown-called": {
+			attribute: testschema.AttributeWithStringPlanModifiers{
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						SkipWhenUnchangedMethod: func(_ context.Context) bool { return true },
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							resp.Diagnostics.AddWarning("called", "called")
+						},
+					},
+				},
+			},
+			request: ModifyAttributePlanRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringUnknown(),
+				AttributePlan:   types.StringUnknown(),
+				AttributeState:  types.StringUnknown(),
+			},
+			response: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+			},
+			expected: &ModifyAttributePlanResponse{
+				AttributePlan: types.StringUnknown(),
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("called", "called"),
+				},
+			},
+		},
 		"request-path": {
 			attribute: testschema.AttributeWithStringPlanModifiers{
 				PlanModifiers: []planmodifier.String{
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/fwlog"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -54,6 +55,7 @@ type ValidateAttributeResponse struct {
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	ctx = logging.FrameworkWithAttributePath(ctx, req.AttributePath.String())
+	ctx = fwlog.WithPath(ctx, req.AttributePath)
 
 	if !a.IsRequired() && !a.IsOptional() && !a.IsComputed() {
 		resp.Diagnostics.AddAttributeError(
@@ -111,8 +113,12 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	switch attributeWithValidators := a.(type) {
 	case fwxschema.AttributeWithBoolValidators:
 		AttributeValidateBool(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithFloat32Validators:
+		AttributeValidateFloat32(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithFloat64Validators:
 		AttributeValidateFloat64(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithInt32Validators:
+		AttributeValidateInt32(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithInt64Validators:
 		AttributeValidateInt64(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithListValidators:
@@ -129,12 +135,21 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 		AttributeValidateString(ctx, attributeWithValidators, req, resp)
 	case fwxschema.AttributeWithDynamicValidators:
 		AttributeValidateDynamic(ctx, attributeWithValidators, req, resp)
+	case fwxschema.AttributeWithTupleValidators:
+		AttributeValidateTuple(ctx, attributeWithValidators, req, resp)
+	}
+
+	if attributeWithElementValidators, ok := a.(fwxschema.AttributeWithElementValidators); ok {
+		AttributeValidateElements(ctx, attributeWithElementValidators, req, resp)
 	}
 
 	AttributeValidateNestedAttributes(ctx, a, req, resp)
 
-	// Show deprecation warnings only for known values.
-	if a.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
+	// Show deprecation warnings only for known values, unless the schema
+	// enables warnings on unknown values.
+	warnOnUnknown := deprecationWarningsOnUnknown(req.Config.Schema)
+
+	if a.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && (!attributeConfig.IsUnknown() || warnOnUnknown) {
 		// Dynamic values need to perform more logic to check the config value for null/unknown-ness
 		dynamicValuable, ok := attributeConfig.(basetypes.DynamicValuable)
 		if !ok {
@@ -154,7 +169,7 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 
 		// For dynamic values, it's possible to be known when only the type is known.
 		// The underlying value can still be null or unknown, so check for that here
-		if !dynamicConfigVal.IsUnderlyingValueNull() && !dynamicConfigVal.IsUnderlyingValueUnknown() {
+		if !dynamicConfigVal.IsUnderlyingValueNull() && (!dynamicConfigVal.IsUnderlyingValueUnknown() || warnOnUnknown) {
 			resp.Diagnostics.AddAttributeWarning(
 				req.AttributePath,
 				"Attribute Deprecated",
@@ -164,6 +179,18 @@ func AttributeValidate(ctx context.Context, a fwschema.Attribute, req ValidateAt
 	}
 }
 
+// deprecationWarningsOnUnknown returns true if the schema enables deprecation
+// warnings for unknown configuration values.
+func deprecationWarningsOnUnknown(s fwschema.Schema) bool {
+	schemaWithDeprecationWarningsOnUnknown, ok := s.(fwschema.SchemaWithDeprecationWarningsOnUnknown)
+
+	if !ok {
+		return false
+	}
+
+	return schemaWithDeprecationWarningsOnUnknown.GetDeprecationWarningsOnUnknown()
+}
+
 // AttributeValidateBool performs all types.Bool validation.
 func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWithBoolValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.BoolValuable until custom types cannot re-implement
@@ -229,6 +256,71 @@ func AttributeValidateBool(ctx context.Context, attribute fwxschema.AttributeWit
 	}
 }
 
+// AttributeValidateFloat32 performs all types.Float32 validation.
+func AttributeValidateFloat32(ctx context.Context, attribute fwxschema.AttributeWithFloat32Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.Float32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Float32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Float32 Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Float32 attribute validation. "+
+				"The value type must implement the basetypes.Float32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToFloat32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.Float32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.Float32Validators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.Float32Response{}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined validator.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		attributeValidator.ValidateFloat32(ctx, validateReq, validateResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined validator.Float32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
 // AttributeValidateFloat64 performs all types.Float64 validation.
 func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.AttributeWithFloat64Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.Float64Valuable until custom types cannot re-implement
@@ -294,6 +386,71 @@ func AttributeValidateFloat64(ctx context.Context, attribute fwxschema.Attribute
 	}
 }
 
+// AttributeValidateInt32 performs all types.Int32 validation.
+func AttributeValidateInt32(ctx context.Context, attribute fwxschema.AttributeWithInt32Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Use basetypes.Int32Valuable until custom types cannot re-implement
+	// ValueFromTerraform. Until then, custom types are not technically
+	// required to implement this interface. This opts to enforce the
+	// requirement before compatibility promises would interfere.
+	configValuable, ok := req.AttributeConfig.(basetypes.Int32Valuable)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Int32 Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Int32 attribute validation. "+
+				"The value type must implement the basetypes.Int32Valuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	configValue, diags := configValuable.ToInt32Value(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
+	// Only return early on new errors as the resp.Diagnostics may have errors
+	// from other attributes.
+	if diags.HasError() {
+		return
+	}
+
+	validateReq := validator.Int32Request{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.Int32Validators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.Int32Response{}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined validator.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		attributeValidator.ValidateInt32(ctx, validateReq, validateResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined validator.Int32",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
 // AttributeValidateInt64 performs all types.Int64 validation.
 func AttributeValidateInt64(ctx context.Context, attribute fwxschema.AttributeWithInt64Validators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
 	// Use basetypes.Int64Valuable until custom types cannot re-implement
@@ -747,6 +904,24 @@ func AttributeValidateString(ctx context.Context, attribute fwxschema.AttributeW
 
 		resp.Diagnostics.Append(validateResp.Diagnostics...)
 	}
+
+	attributeWithNormalizeFunc, ok := attribute.(fwschema.AttributeWithStringNormalizeFunc)
+
+	if !ok || attributeWithNormalizeFunc.StringNormalizeFunc() == nil {
+		return
+	}
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined NormalizeFunc")
+	_, normalizeDiags := attributeWithNormalizeFunc.StringNormalizeFunc()(ctx, configValue.ValueString())
+	logging.FrameworkTrace(ctx, "Called provider defined NormalizeFunc")
+
+	for _, d := range normalizeDiags {
+		resp.Diagnostics.Append(diag.WithPath(req.AttributePath, d))
+	}
 }
 
 // AttributeValidateDynamic performs all types.Dynamic validation.
@@ -814,6 +989,497 @@ func AttributeValidateDynamic(ctx context.Context, attribute fwxschema.Attribute
 	}
 }
 
+// AttributeValidateTuple performs all types.Tuple validation.
+func AttributeValidateTuple(ctx context.Context, attribute fwxschema.AttributeWithTupleValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	// Tuple attributes do not support custom types, so the value is always
+	// a basetypes.TupleValue.
+	configValue, ok := req.AttributeConfig.(basetypes.TupleValue)
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Tuple Attribute Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform Tuple attribute validation. "+
+				"The value type must be basetypes.TupleValue. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	validateReq := validator.TupleRequest{
+		Config:         req.Config,
+		ConfigValue:    configValue,
+		Path:           req.AttributePath,
+		PathExpression: req.AttributePathExpression,
+	}
+
+	for _, attributeValidator := range attribute.TupleValidators() {
+		// Instantiate a new response for each request to prevent validators
+		// from modifying or removing diagnostics.
+		validateResp := &validator.TupleResponse{}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Calling provider defined validator.Tuple",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		attributeValidator.ValidateTuple(ctx, validateReq, validateResp)
+
+		logging.FrameworkTrace(
+			ctx,
+			"Called provider defined validator.Tuple",
+			map[string]interface{}{
+				logging.KeyDescription: attributeValidator.Description(ctx),
+			},
+		)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+	}
+}
+
+// AttributeValidateElements performs all List and Set element validation.
+// Unknown and null elements are skipped.
+func AttributeValidateElements(ctx context.Context, attribute fwxschema.AttributeWithElementValidators, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	elementValidators := attribute.GetElementValidators()
+
+	if len(elementValidators) == 0 || req.AttributeConfig.IsNull() || req.AttributeConfig.IsUnknown() {
+		return
+	}
+
+	var elementReqs []ValidateAttributeRequest
+
+	switch configValuable := req.AttributeConfig.(type) {
+	case basetypes.ListValuable:
+		configValue, diags := configValuable.ToListValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		for idx, element := range configValue.Elements() {
+			elementReqs = append(elementReqs, ValidateAttributeRequest{
+				AttributePath:           req.AttributePath.AtListIndex(idx),
+				AttributePathExpression: req.AttributePathExpression.AtListIndex(idx),
+				AttributeConfig:         element,
+				Config:                  req.Config,
+			})
+		}
+	case basetypes.SetValuable:
+		configValue, diags := configValuable.ToSetValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		for _, element := range configValue.Elements() {
+			elementReqs = append(elementReqs, ValidateAttributeRequest{
+				AttributePath:           req.AttributePath.AtSetValue(element),
+				AttributePathExpression: req.AttributePathExpression.AtSetValue(element),
+				AttributeConfig:         element,
+				Config:                  req.Config,
+			})
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Element Validator Value Type",
+			"An unexpected value type was encountered while attempting to perform element validation. "+
+				"The value type must implement the basetypes.ListValuable or basetypes.SetValuable interface. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Incoming Value Type: %T", req.AttributeConfig),
+		)
+
+		return
+	}
+
+	for _, elementReq := range elementReqs {
+		if elementReq.AttributeConfig.IsNull() || elementReq.AttributeConfig.IsUnknown() {
+			continue
+		}
+
+		for _, elementValidator := range elementValidators {
+			logging.FrameworkTrace(
+				ctx,
+				"Calling provider defined element validator",
+				map[string]interface{}{
+					logging.KeyDescription: elementValidator.Description(ctx),
+				},
+			)
+
+			AttributeValidateElement(ctx, elementValidator, elementReq, resp)
+
+			logging.FrameworkTrace(
+				ctx,
+				"Called provider defined element validator",
+				map[string]interface{}{
+					logging.KeyDescription: elementValidator.Description(ctx),
+				},
+			)
+		}
+	}
+}
+
+// AttributeValidateElement calls the element validator using the validator
+// interface matching the element value type.
+func AttributeValidateElement(ctx context.Context, elementValidator validator.Describer, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	switch elementValuable := req.AttributeConfig.(type) {
+	case basetypes.BoolValuable:
+		elementValidator, ok := elementValidator.(validator.Bool)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToBoolValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.BoolRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.BoolResponse{}
+
+		elementValidator.ValidateBool(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.Float32Valuable:
+		elementValidator, ok := elementValidator.(validator.Float32)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToFloat32Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.Float32Request{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.Float32Response{}
+
+		elementValidator.ValidateFloat32(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.Float64Valuable:
+		elementValidator, ok := elementValidator.(validator.Float64)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToFloat64Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.Float64Request{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.Float64Response{}
+
+		elementValidator.ValidateFloat64(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.Int32Valuable:
+		elementValidator, ok := elementValidator.(validator.Int32)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToInt32Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.Int32Request{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.Int32Response{}
+
+		elementValidator.ValidateInt32(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.Int64Valuable:
+		elementValidator, ok := elementValidator.(validator.Int64)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToInt64Value(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.Int64Request{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.Int64Response{}
+
+		elementValidator.ValidateInt64(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.NumberValuable:
+		elementValidator, ok := elementValidator.(validator.Number)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToNumberValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.NumberRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.NumberResponse{}
+
+		elementValidator.ValidateNumber(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.StringValuable:
+		elementValidator, ok := elementValidator.(validator.String)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToStringValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.StringRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.StringResponse{}
+
+		elementValidator.ValidateString(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.ListValuable:
+		elementValidator, ok := elementValidator.(validator.List)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToListValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.ListRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.ListResponse{}
+
+		elementValidator.ValidateList(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.MapValuable:
+		elementValidator, ok := elementValidator.(validator.Map)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToMapValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.MapRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.MapResponse{}
+
+		elementValidator.ValidateMap(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.ObjectValuable:
+		elementValidator, ok := elementValidator.(validator.Object)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToObjectValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.ObjectRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.ObjectResponse{}
+
+		elementValidator.ValidateObject(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.SetValuable:
+		elementValidator, ok := elementValidator.(validator.Set)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToSetValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.SetRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.SetResponse{}
+
+		elementValidator.ValidateSet(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	case basetypes.DynamicValuable:
+		elementValidator, ok := elementValidator.(validator.Dynamic)
+
+		if !ok {
+			break
+		}
+
+		configValue, diags := elementValuable.ToDynamicValue(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		validateReq := validator.DynamicRequest{
+			Config:         req.Config,
+			ConfigValue:    configValue,
+			Path:           req.AttributePath,
+			PathExpression: req.AttributePathExpression,
+		}
+		validateResp := &validator.DynamicResponse{}
+
+		elementValidator.ValidateDynamic(ctx, validateReq, validateResp)
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.AttributePath,
+		"Invalid Element Validator",
+		"An element validator does not implement the validator interface for the element value type. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Validator Type: %T\n", elementValidator)+
+			fmt.Sprintf("Element Value Type: %T", req.AttributeConfig),
+	)
+}
+
 // AttributeValidateNestedAttributes performs all nested Attributes validation.
 //
 // TODO: Clean up this abstraction back into an internal Attribute type method.
@@ -4,6 +4,7 @@
 package fwserver
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -11,6 +12,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -94,6 +98,39 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"config-time-invalid": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "2006-01-02"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Required: true,
+								Type:     types.TimeType,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid RFC 3339 String Value",
+						"A string value was provided that is not valid RFC 3339 string format, such as 2006-01-02T15:04:05Z.\n\n"+
+							"Given Value: 2006-01-02\n"+
+							"Error: parsing time \"2006-01-02\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"\" as \"T\"",
+					),
+				},
+			},
+		},
 		"config-computed-null": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -514,6 +551,155 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{},
 		},
+		"deprecation-message-null-DeprecationWarningsOnUnknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testschema.Schema{
+						DeprecationWarningsOnUnknown: true,
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.StringType,
+								Optional:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"deprecation-message-unknown-DeprecationWarningsOnUnknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testschema.Schema{
+						DeprecationWarningsOnUnknown: true,
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.StringType,
+								Optional:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
+		"deprecation-message-dynamic-underlying-value-null-DeprecationWarningsOnUnknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.DynamicPseudoType,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, nil), // underlying type is String
+					}),
+					Schema: testschema.Schema{
+						DeprecationWarningsOnUnknown: true,
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.DynamicType,
+								Optional:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"deprecation-message-dynamic-underlying-value-unknown-DeprecationWarningsOnUnknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.DynamicPseudoType,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue), // underlying type is String
+					}),
+					Schema: testschema.Schema{
+						DeprecationWarningsOnUnknown: true,
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.DynamicType,
+								Optional:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
+		"deprecation-message-known-DeprecationWarningsOnUnknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+					Schema: testschema.Schema{
+						DeprecationWarningsOnUnknown: true,
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:               types.StringType,
+								Optional:           true,
+								DeprecationMessage: "Use something else instead.",
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Attribute Deprecated",
+						"Use something else instead.",
+					),
+				},
+			},
+		},
 		"warnings": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -646,6 +832,33 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"type-with-valuefromterraformwithvalidation-error": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "invalid"),
+					}),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type:     testtypes.StringTypeWithValueFromTerraformWithValidation{},
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					testtypes.TestErrorDiagnostic(path.Root("test")),
+				},
+			},
+		},
 		"nested-attr-list-no-validation": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
@@ -1527,7 +1740,7 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{},
 		},
-		"nested-custom-attr-single-no-validation": {
+		"nested-attr-single-optional-null-required-child": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
@@ -1548,9 +1761,7 @@ func TestAttributeValidate(t *testing.T) {
 										"nested_attr": tftypes.String,
 									},
 								},
-								map[string]tftypes.Value{
-									"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
-								},
+								nil,
 							),
 						},
 					),
@@ -1566,22 +1777,17 @@ func TestAttributeValidate(t *testing.T) {
 									},
 								},
 								NestingMode: fwschema.NestingModeSingle,
-								Required:    true,
-								Type: testtypes.SingleNestedAttributesCustomTypeType{
-									ObjectType: types.ObjectType{
-										AttrTypes: map[string]attr.Type{
-											"nested_attr": types.StringType,
-										},
-									},
-								},
+								Optional:    true,
 							},
 						},
 					},
 				},
 			},
+			// Required nested attributes are only required when the parent
+			// object is configured.
 			resp: ValidateAttributeResponse{},
 		},
-		"nested-attr-single-validation": {
+		"nested-attr-single-optional-unknown-required-child": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
@@ -1594,16 +1800,15 @@ func TestAttributeValidate(t *testing.T) {
 									},
 								},
 							},
-						}, map[string]tftypes.Value{
+						},
+						map[string]tftypes.Value{
 							"test": tftypes.NewValue(
 								tftypes.Object{
 									AttributeTypes: map[string]tftypes.Type{
 										"nested_attr": tftypes.String,
 									},
 								},
-								map[string]tftypes.Value{
-									"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
-								},
+								tftypes.UnknownValue,
 							),
 						},
 					),
@@ -1612,32 +1817,22 @@ func TestAttributeValidate(t *testing.T) {
 							"test": testschema.NestedAttribute{
 								NestedObject: testschema.NestedAttributeObject{
 									Attributes: map[string]fwschema.Attribute{
-										"nested_attr": testschema.AttributeWithStringValidators{
+										"nested_attr": testschema.Attribute{
+											Type:     types.StringType,
 											Required: true,
-											Validators: []validator.String{
-												testvalidator.String{
-													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-														resp.Diagnostics.Append(testErrorDiagnostic1)
-													},
-												},
-											},
 										},
 									},
 								},
 								NestingMode: fwschema.NestingModeSingle,
-								Required:    true,
+								Optional:    true,
 							},
 						},
 					},
 				},
 			},
-			resp: ValidateAttributeResponse{
-				Diagnostics: diag.Diagnostics{
-					testErrorDiagnostic1,
-				},
-			},
+			resp: ValidateAttributeResponse{},
 		},
-		"nested-custom-attr-single-validation": {
+		"nested-attr-single-optional-required-child-missing": {
 			req: ValidateAttributeRequest{
 				AttributePath: path.Root("test"),
 				Config: tfsdk.Config{
@@ -1650,7 +1845,8 @@ func TestAttributeValidate(t *testing.T) {
 									},
 								},
 							},
-						}, map[string]tftypes.Value{
+						},
+						map[string]tftypes.Value{
 							"test": tftypes.NewValue(
 								tftypes.Object{
 									AttributeTypes: map[string]tftypes.Type{
@@ -1658,7 +1854,7 @@ func TestAttributeValidate(t *testing.T) {
 									},
 								},
 								map[string]tftypes.Value{
-									"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+									"nested_attr": tftypes.NewValue(tftypes.String, nil),
 								},
 							),
 						},
@@ -1668,27 +1864,14 @@ func TestAttributeValidate(t *testing.T) {
 							"test": testschema.NestedAttribute{
 								NestedObject: testschema.NestedAttributeObject{
 									Attributes: map[string]fwschema.Attribute{
-										"nested_attr": testschema.AttributeWithStringValidators{
+										"nested_attr": testschema.Attribute{
+											Type:     types.StringType,
 											Required: true,
-											Validators: []validator.String{
-												testvalidator.String{
-													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
-														resp.Diagnostics.Append(testErrorDiagnostic1)
-													},
-												},
-											},
 										},
 									},
 								},
 								NestingMode: fwschema.NestingModeSingle,
-								Required:    true,
-								Type: testtypes.SingleNestedAttributesCustomTypeType{
-									ObjectType: types.ObjectType{
-										AttrTypes: map[string]attr.Type{
-											"nested_attr": types.StringType,
-										},
-									},
-								},
+								Optional:    true,
 							},
 						},
 					},
@@ -1696,13 +1879,191 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
-					testErrorDiagnostic1,
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtName("nested_attr"),
+						"Missing Configuration for Required Attribute",
+						"Must set a configuration value for the test.nested_attr attribute as the provider has marked it as required.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+					),
 				},
 			},
 		},
-	}
-
-	for name, tc := range testCases {
+		"nested-custom-attr-single-no-validation": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.Attribute{
+											Type:     types.StringType,
+											Required: true,
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeSingle,
+								Required:    true,
+								Type: testtypes.SingleNestedAttributesCustomTypeType{
+									ObjectType: types.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"nested_attr": types.StringType,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
+		"nested-attr-single-validation": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+							},
+						}, map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.AttributeWithStringValidators{
+											Required: true,
+											Validators: []validator.String{
+												testvalidator.String{
+													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+														resp.Diagnostics.Append(testErrorDiagnostic1)
+													},
+												},
+											},
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeSingle,
+								Required:    true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					testErrorDiagnostic1,
+				},
+			},
+		},
+		"nested-custom-attr-single-validation": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+							},
+						}, map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+								},
+							),
+						},
+					),
+					Schema: testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.NestedAttribute{
+								NestedObject: testschema.NestedAttributeObject{
+									Attributes: map[string]fwschema.Attribute{
+										"nested_attr": testschema.AttributeWithStringValidators{
+											Required: true,
+											Validators: []validator.String{
+												testvalidator.String{
+													ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+														resp.Diagnostics.Append(testErrorDiagnostic1)
+													},
+												},
+											},
+										},
+									},
+								},
+								NestingMode: fwschema.NestingModeSingle,
+								Required:    true,
+								Type: testtypes.SingleNestedAttributesCustomTypeType{
+									ObjectType: types.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"nested_attr": types.StringType,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					testErrorDiagnostic1,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
 		name, tc := name, tc
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
@@ -1713,39 +2074,447 @@ func TestAttributeValidate(t *testing.T) {
 
 			attribute, diags := tc.req.Config.Schema.AttributeAtPath(ctx, tc.req.AttributePath)
 
-			if diags.HasError() {
-				t.Fatalf("Unexpected diagnostics: %s", diags)
-			}
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %s", diags)
+			}
+
+			AttributeValidate(ctx, attribute, tc.req, &got)
+
+			if diff := cmp.Diff(got, tc.resp); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeValidateBool(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithBoolValidators
+		request   ValidateAttributeRequest
+		response  *ValidateAttributeResponse
+		expected  *ValidateAttributeResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithBoolValidators{
+				Validators: []validator.Bool{
+					testvalidator.Bool{
+						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected BoolRequest.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.BoolValue(true),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithBoolValidators{
+				Validators: []validator.Bool{
+					testvalidator.Bool{
+						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected BoolRequest.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.BoolValue(true),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithBoolValidators{
+				Validators: []validator.Bool{
+					testvalidator.Bool{
+						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Bool,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Bool, true),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected BoolRequest.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.BoolValue(true),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Bool,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Bool, true),
+						},
+					),
+				},
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithBoolValidators{
+				Validators: []validator.Bool{
+					testvalidator.Bool{
+						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+							got := req.ConfigValue
+							expected := types.BoolValue(true)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected BoolRequest.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.BoolValue(true),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithBoolValidators{
+				Validators: []validator.Bool{
+					testvalidator.Bool{
+						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.BoolValue(true),
+			},
+			response: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributeValidateBool(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeValidateFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithFloat32Validators
+		request   ValidateAttributeRequest
+		response  *ValidateAttributeResponse
+		expected  *ValidateAttributeResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithFloat32Validators{
+				Validators: []validator.Float32{
+					testvalidator.Float32{
+						ValidateFloat32Method: func(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithFloat32Validators{
+				Validators: []validator.Float32{
+					testvalidator.Float32{
+						ValidateFloat32Method: func(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.Float32Value(1.2),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithFloat32Validators{
+				Validators: []validator.Float32{
+					testvalidator.Float32{
+						ValidateFloat32Method: func(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Number,
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Number,
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
+						},
+					),
+				},
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithFloat32Validators{
+				Validators: []validator.Float32{
+					testvalidator.Float32{
+						ValidateFloat32Method: func(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+							got := req.ConfigValue
+							expected := types.Float32Value(1.2)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected Float32Request.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithFloat32Validators{
+				Validators: []validator.Float32{
+					testvalidator.Float32{
+						ValidateFloat32Method: func(ctx context.Context, req validator.Float32Request, resp *validator.Float32Response) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.Float32Value(1.2),
+			},
+			response: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
 
-			AttributeValidate(ctx, attribute, tc.req, &got)
+			AttributeValidateFloat32(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
-			if diff := cmp.Diff(got, tc.resp); diff != "" {
-				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
 	}
 }
 
-func TestAttributeValidateBool(t *testing.T) {
+func TestAttributeValidateFloat64(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		attribute fwxschema.AttributeWithBoolValidators
+		attribute fwxschema.AttributeWithFloat64Validators
 		request   ValidateAttributeRequest
 		response  *ValidateAttributeResponse
 		expected  *ValidateAttributeResponse
 	}{
 		"request-path": {
-			attribute: testschema.AttributeWithBoolValidators{
-				Validators: []validator.Bool{
-					testvalidator.Bool{
-						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			attribute: testschema.AttributeWithFloat64Validators{
+				Validators: []validator.Float64{
+					testvalidator.Float64{
+						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
 							got := req.Path
 							expected := path.Root("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected BoolRequest.Path",
+									"Unexpected Float64Request.Path",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -1755,22 +2524,22 @@ func TestAttributeValidateBool(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.BoolValue(true),
+				AttributeConfig: types.Float64Value(1.2),
 			},
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
 		"request-pathexpression": {
-			attribute: testschema.AttributeWithBoolValidators{
-				Validators: []validator.Bool{
-					testvalidator.Bool{
-						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			attribute: testschema.AttributeWithFloat64Validators{
+				Validators: []validator.Float64{
+					testvalidator.Float64{
+						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
 							got := req.PathExpression
 							expected := path.MatchRoot("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected BoolRequest.PathExpression",
+									"Unexpected Float64Request.PathExpression",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -1781,33 +2550,33 @@ func TestAttributeValidateBool(t *testing.T) {
 			request: ValidateAttributeRequest{
 				AttributePath:           path.Root("test"),
 				AttributePathExpression: path.MatchRoot("test"),
-				AttributeConfig:         types.BoolValue(true),
+				AttributeConfig:         types.Float64Value(1.2),
 			},
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
 		"request-config": {
-			attribute: testschema.AttributeWithBoolValidators{
-				Validators: []validator.Bool{
-					testvalidator.Bool{
-						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			attribute: testschema.AttributeWithFloat64Validators{
+				Validators: []validator.Float64{
+					testvalidator.Float64{
+						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
 							got := req.Config
 							expected := tfsdk.Config{
 								Raw: tftypes.NewValue(
 									tftypes.Object{
 										AttributeTypes: map[string]tftypes.Type{
-											"test": tftypes.Bool,
+											"test": tftypes.Number,
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(tftypes.Bool, true),
+										"test": tftypes.NewValue(tftypes.Number, 1.2),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected BoolRequest.Config",
+									"Unexpected Float64Request.Config",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -1817,16 +2586,16 @@ func TestAttributeValidateBool(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.BoolValue(true),
+				AttributeConfig: types.Float64Value(1.2),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
 							AttributeTypes: map[string]tftypes.Type{
-								"test": tftypes.Bool,
+								"test": tftypes.Number,
 							},
 						},
 						map[string]tftypes.Value{
-							"test": tftypes.NewValue(tftypes.Bool, true),
+							"test": tftypes.NewValue(tftypes.Number, 1.2),
 						},
 					),
 				},
@@ -1835,16 +2604,16 @@ func TestAttributeValidateBool(t *testing.T) {
 			expected: &ValidateAttributeResponse{},
 		},
 		"request-configvalue": {
-			attribute: testschema.AttributeWithBoolValidators{
-				Validators: []validator.Bool{
-					testvalidator.Bool{
-						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			attribute: testschema.AttributeWithFloat64Validators{
+				Validators: []validator.Float64{
+					testvalidator.Float64{
+						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
 							got := req.ConfigValue
-							expected := types.BoolValue(true)
+							expected := types.Float64Value(1.2)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected BoolRequest.ConfigValue",
+									"Unexpected Float64Request.ConfigValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -1854,16 +2623,16 @@ func TestAttributeValidateBool(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.BoolValue(true),
+				AttributeConfig: types.Float64Value(1.2),
 			},
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
 		"response-diagnostics": {
-			attribute: testschema.AttributeWithBoolValidators{
-				Validators: []validator.Bool{
-					testvalidator.Bool{
-						ValidateBoolMethod: func(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+			attribute: testschema.AttributeWithFloat64Validators{
+				Validators: []validator.Float64{
+					testvalidator.Float64{
+						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
 							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
 							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
 						},
@@ -1872,7 +2641,7 @@ func TestAttributeValidateBool(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.BoolValue(true),
+				AttributeConfig: types.Float64Value(1.2),
 			},
 			response: &ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
@@ -1921,7 +2690,7 @@ func TestAttributeValidateBool(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributeValidateBool(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributeValidateFloat64(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -1930,26 +2699,26 @@ func TestAttributeValidateBool(t *testing.T) {
 	}
 }
 
-func TestAttributeValidateFloat64(t *testing.T) {
+func TestAttributeValidateInt32(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		attribute fwxschema.AttributeWithFloat64Validators
+		attribute fwxschema.AttributeWithInt32Validators
 		request   ValidateAttributeRequest
 		response  *ValidateAttributeResponse
 		expected  *ValidateAttributeResponse
 	}{
 		"request-path": {
-			attribute: testschema.AttributeWithFloat64Validators{
-				Validators: []validator.Float64{
-					testvalidator.Float64{
-						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			attribute: testschema.AttributeWithInt32Validators{
+				Validators: []validator.Int32{
+					testvalidator.Int32{
+						ValidateInt32Method: func(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
 							got := req.Path
 							expected := path.Root("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Path",
+									"Unexpected Int32Request.Path",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -1959,22 +2728,22 @@ func TestAttributeValidateFloat64(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(123),
 			},
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
 		"request-pathexpression": {
-			attribute: testschema.AttributeWithFloat64Validators{
-				Validators: []validator.Float64{
-					testvalidator.Float64{
-						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			attribute: testschema.AttributeWithInt32Validators{
+				Validators: []validator.Int32{
+					testvalidator.Int32{
+						ValidateInt32Method: func(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
 							got := req.PathExpression
 							expected := path.MatchRoot("test")
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.PathExpression",
+									"Unexpected Int32Request.PathExpression",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -1985,16 +2754,16 @@ func TestAttributeValidateFloat64(t *testing.T) {
 			request: ValidateAttributeRequest{
 				AttributePath:           path.Root("test"),
 				AttributePathExpression: path.MatchRoot("test"),
-				AttributeConfig:         types.Float64Value(1.2),
+				AttributeConfig:         types.Int32Value(123),
 			},
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
 		"request-config": {
-			attribute: testschema.AttributeWithFloat64Validators{
-				Validators: []validator.Float64{
-					testvalidator.Float64{
-						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			attribute: testschema.AttributeWithInt32Validators{
+				Validators: []validator.Int32{
+					testvalidator.Int32{
+						ValidateInt32Method: func(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
 							got := req.Config
 							expected := tfsdk.Config{
 								Raw: tftypes.NewValue(
@@ -2004,14 +2773,14 @@ func TestAttributeValidateFloat64(t *testing.T) {
 										},
 									},
 									map[string]tftypes.Value{
-										"test": tftypes.NewValue(tftypes.Number, 1.2),
+										"test": tftypes.NewValue(tftypes.Number, 123),
 									},
 								),
 							}
 
 							if !got.Raw.Equal(expected.Raw) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.Config",
+									"Unexpected Int32Request.Config",
 									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
 								)
 							}
@@ -2021,7 +2790,7 @@ func TestAttributeValidateFloat64(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(123),
 				Config: tfsdk.Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2030,7 +2799,7 @@ func TestAttributeValidateFloat64(t *testing.T) {
 							},
 						},
 						map[string]tftypes.Value{
-							"test": tftypes.NewValue(tftypes.Number, 1.2),
+							"test": tftypes.NewValue(tftypes.Number, 123),
 						},
 					),
 				},
@@ -2039,16 +2808,16 @@ func TestAttributeValidateFloat64(t *testing.T) {
 			expected: &ValidateAttributeResponse{},
 		},
 		"request-configvalue": {
-			attribute: testschema.AttributeWithFloat64Validators{
-				Validators: []validator.Float64{
-					testvalidator.Float64{
-						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			attribute: testschema.AttributeWithInt32Validators{
+				Validators: []validator.Int32{
+					testvalidator.Int32{
+						ValidateInt32Method: func(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
 							got := req.ConfigValue
-							expected := types.Float64Value(1.2)
+							expected := types.Int32Value(123)
 
 							if !got.Equal(expected) {
 								resp.Diagnostics.AddError(
-									"Unexpected Float64Request.ConfigValue",
+									"Unexpected Int32Request.ConfigValue",
 									fmt.Sprintf("expected %s, got: %s", expected, got),
 								)
 							}
@@ -2058,16 +2827,16 @@ func TestAttributeValidateFloat64(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(123),
 			},
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
 		"response-diagnostics": {
-			attribute: testschema.AttributeWithFloat64Validators{
-				Validators: []validator.Float64{
-					testvalidator.Float64{
-						ValidateFloat64Method: func(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+			attribute: testschema.AttributeWithInt32Validators{
+				Validators: []validator.Int32{
+					testvalidator.Int32{
+						ValidateInt32Method: func(ctx context.Context, req validator.Int32Request, resp *validator.Int32Response) {
 							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
 							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
 						},
@@ -2076,7 +2845,7 @@ func TestAttributeValidateFloat64(t *testing.T) {
 			},
 			request: ValidateAttributeRequest{
 				AttributePath:   path.Root("test"),
-				AttributeConfig: types.Float64Value(1.2),
+				AttributeConfig: types.Int32Value(123),
 			},
 			response: &ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
@@ -2125,7 +2894,7 @@ func TestAttributeValidateFloat64(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributeValidateFloat64(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributeValidateInt32(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -2533,13 +3302,209 @@ func TestAttributeValidateList(t *testing.T) {
 						"New Warning Details",
 					),
 					diag.NewAttributeErrorDiagnostic(
-						path.Root("test"),
-						"New Error Summary",
-						"New Error Details",
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributeValidateList(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeValidateElements(t *testing.T) {
+	t.Parallel()
+
+	testStringElementValidator := testvalidator.String{
+		ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.ValueString() != "valid" {
+				resp.Diagnostics.AddAttributeError(req.Path, "error summary", "error detail")
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithElementValidators
+		request   ValidateAttributeRequest
+		response  *ValidateAttributeResponse
+		expected  *ValidateAttributeResponse
+	}{
+		"list-request-path": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							got := req.Path
+							expected := path.Root("test").AtListIndex(0)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"list-request-pathexpression": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testvalidator.String{
+						ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test").AtListIndex(0)
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected StringRequest.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"list-response-diagnostics": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testStringElementValidator,
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("invalid"),
+						types.StringValue("valid"),
+						types.StringValue("invalid"),
+					},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "error summary", "error detail"),
+					diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(2), "error summary", "error detail"),
+				},
+			},
+		},
+		"list-null-unknown-elements": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testStringElementValidator,
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.ListValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringNull(),
+						types.StringUnknown(),
+					},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"list-unknown": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testStringElementValidator,
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListUnknown(types.StringType),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"list-validator-type-mismatch": {
+			attribute: testschema.AttributeWithListValidators{
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testvalidator.Int64{},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtListIndex(0),
+						"Invalid Element Validator",
+						"An element validator does not implement the validator interface for the element value type. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"Validator Type: testvalidator.Int64\n"+
+							"Element Value Type: basetypes.StringValue",
 					),
 				},
 			},
 		},
+		"set-response-diagnostics": {
+			attribute: testschema.AttributeWithSetValidators{
+				ElementType: types.StringType,
+				ElementValidators: []validator.Describer{
+					testStringElementValidator,
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				AttributeConfig: types.SetValueMust(
+					types.StringType,
+					[]attr.Value{
+						types.StringValue("invalid"),
+						types.StringValue("valid"),
+					},
+				),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("test").AtSetValue(types.StringValue("invalid")), "error summary", "error detail"),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -2548,7 +3513,7 @@ func TestAttributeValidateList(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			AttributeValidateList(context.Background(), testCase.attribute, testCase.request, testCase.response)
+			AttributeValidateElements(context.Background(), testCase.attribute, testCase.request, testCase.response)
 
 			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -3597,6 +4562,46 @@ func TestAttributeValidateString(t *testing.T) {
 			response: &ValidateAttributeResponse{},
 			expected: &ValidateAttributeResponse{},
 		},
+		"normalizefunc-diagnostics": {
+			attribute: resourceschema.StringAttribute{
+				NormalizeFunc: func(_ context.Context, value string) (string, diag.Diagnostics) {
+					var diags diag.Diagnostics
+
+					diags.AddError("Invalid JSON", "Unable to parse "+value)
+
+					return "", diags
+				},
+				Optional: true,
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("test"),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(path.Root("test"), "Invalid JSON", "Unable to parse test"),
+				},
+			},
+		},
+		"normalizefunc-null": {
+			attribute: resourceschema.StringAttribute{
+				NormalizeFunc: func(_ context.Context, value string) (string, diag.Diagnostics) {
+					var diags diag.Diagnostics
+
+					diags.AddError("Unexpected NormalizeFunc Call", "NormalizeFunc should not be called for null values")
+
+					return "", diags
+				},
+				Optional: true,
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringNull(),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
 		"response-diagnostics": {
 			attribute: testschema.AttributeWithStringValidators{
 				Validators: []validator.String{
@@ -3871,6 +4876,245 @@ func TestAttributeValidateDynamic(t *testing.T) {
 		})
 	}
 }
+
+func TestAttributeValidateTuple(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute fwxschema.AttributeWithTupleValidators
+		request   ValidateAttributeRequest
+		response  *ValidateAttributeResponse
+		expected  *ValidateAttributeResponse
+	}{
+		"request-path": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{types.StringType},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.Path
+							expected := path.Root("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.Path",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-pathexpression": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{types.StringType},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.PathExpression
+							expected := path.MatchRoot("test")
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.PathExpression",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:           path.Root("test"),
+				AttributePathExpression: path.MatchRoot("test"),
+				AttributeConfig:         types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-config": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{types.StringType},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.Config
+							expected := tfsdk.Config{
+								Raw: tftypes.NewValue(
+									tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"test": tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+										},
+									},
+									map[string]tftypes.Value{
+										"test": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{tftypes.NewValue(tftypes.String, "test")}),
+									},
+								),
+							}
+
+							if !got.Raw.Equal(expected.Raw) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.Config",
+									fmt.Sprintf("expected %s, got: %s", expected.Raw, got.Raw),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}}, []tftypes.Value{tftypes.NewValue(tftypes.String, "test")}),
+						},
+					),
+				},
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"request-configvalue": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{types.StringType},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							got := req.ConfigValue
+							expected := types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")})
+
+							if !got.Equal(expected) {
+								resp.Diagnostics.AddError(
+									"Unexpected TupleRequest.ConfigValue",
+									fmt.Sprintf("expected %s, got: %s", expected, got),
+								)
+							}
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{},
+		},
+		"response-diagnostics": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{types.StringType},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							resp.Diagnostics.AddAttributeWarning(req.Path, "New Warning Summary", "New Warning Details")
+							resp.Diagnostics.AddAttributeError(req.Path, "New Error Summary", "New Error Details")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("test")}),
+			},
+			response: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+				},
+			},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("other"),
+						"Existing Warning Summary",
+						"Existing Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("other"),
+						"Existing Error Summary",
+						"Existing Error Details",
+					),
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"New Warning Summary",
+						"New Warning Details",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"New Error Summary",
+						"New Error Details",
+					),
+				},
+			},
+		},
+		"request-configvalue-invalid-type": {
+			attribute: testschema.AttributeWithTupleValidators{
+				ElementTypes: []attr.Type{types.StringType},
+				Validators: []validator.Tuple{
+					testvalidator.Tuple{
+						ValidateTupleMethod: func(ctx context.Context, req validator.TupleRequest, resp *validator.TupleResponse) {
+							resp.Diagnostics.AddError("Unexpected Validator Call", "validator should not be called")
+						},
+					},
+				},
+			},
+			request: ValidateAttributeRequest{
+				AttributePath:   path.Root("test"),
+				AttributeConfig: types.StringValue("test"),
+			},
+			response: &ValidateAttributeResponse{},
+			expected: &ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Tuple Attribute Validator Value Type",
+						"An unexpected value type was encountered while attempting to perform Tuple attribute validation. "+
+							"The value type must be basetypes.TupleValue. "+
+							"Please report this to the provider developers.\n\n"+
+							"Incoming Value Type: basetypes.StringValue",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			AttributeValidateTuple(context.Background(), testCase.attribute, testCase.request, testCase.response)
+
+			if diff := cmp.Diff(testCase.response, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNestedAttributeObjectValidateObject(t *testing.T) {
 	t.Parallel()
 
@@ -4144,3 +5388,61 @@ var (
 		"This is a warning.",
 	)
 )
+
+func TestAttributeValidate_ProviderLogAttributePath(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	attribute := testschema.AttributeWithStringValidators{
+		Required: true,
+		Validators: []validator.String{
+			testvalidator.String{
+				ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+					tflog.Debug(ctx, "test message")
+				},
+			},
+		},
+	}
+
+	req := ValidateAttributeRequest{
+		AttributePath: path.Root("test"),
+		Config: tfsdk.Config{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			Schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": attribute,
+				},
+			},
+		},
+	}
+
+	AttributeValidate(ctx, attribute, req, &ValidateAttributeResponse{})
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	expectedEntries := []map[string]interface{}{
+		{
+			"@level":            "debug",
+			"@message":          "test message",
+			"@module":           "provider",
+			"tf_attribute_path": "test",
+		},
+	}
+
+	if diff := cmp.Diff(entries, expectedEntries); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/fwlog"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -22,6 +23,9 @@ import (
 // package from the tfsdk package and not wanting to export the method.
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	ctx = logging.FrameworkWithAttributePath(ctx, req.AttributePath.String())
+	ctx = fwlog.WithPath(ctx, req.AttributePath)
+
 	configData := &fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionConfiguration,
 		Schema:         req.Config.Schema,
@@ -161,8 +165,9 @@ func BlockValidate(ctx context.Context, b fwschema.Block, req ValidateAttributeR
 		return
 	}
 
-	// Show deprecation warning only on known values.
-	if b.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && !attributeConfig.IsUnknown() {
+	// Show deprecation warning only on known values, unless the schema
+	// enables warnings on unknown values.
+	if b.GetDeprecationMessage() != "" && !attributeConfig.IsNull() && (!attributeConfig.IsUnknown() || deprecationWarningsOnUnknown(req.Config.Schema)) {
 		resp.Diagnostics.AddAttributeWarning(
 			req.AttributePath,
 			"Block Deprecated",
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ResourceConditionalAttributes verifies the configuration against the
+// conditional attribute declarations of the resource, if it implements the
+// resource.ResourceWithConditionalAttributes interface.
+func ResourceConditionalAttributes(ctx context.Context, r resource.Resource, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceWithConditionalAttributes, ok := r.(resource.ResourceWithConditionalAttributes)
+
+	if !ok {
+		return diags
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithConditionalAttributes")
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource ConditionalAttributes")
+	conditionalAttributes := resourceWithConditionalAttributes.ConditionalAttributes(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource ConditionalAttributes")
+
+	for _, conditionalAttribute := range conditionalAttributes {
+		requiredIf, requiredIfDiags := conditionalAttributeConfigured(ctx, config, conditionalAttribute.RequiredIf)
+
+		diags.Append(requiredIfDiags...)
+
+		computedIf, computedIfDiags := conditionalAttributeConfigured(ctx, config, conditionalAttribute.ComputedIf)
+
+		diags.Append(computedIfDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		attributePaths, attributePathsDiags := config.PathMatches(ctx, conditionalAttribute.Attribute)
+
+		diags.Append(attributePathsDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		attributeSteps := len(conditionalAttribute.Attribute.Resolve().Steps())
+
+		for _, attributePath := range attributePaths {
+			// PathMatches returns parent paths with null or unknown values,
+			// which are not the conditional attribute.
+			if len(attributePath.Steps()) < attributeSteps {
+				continue
+			}
+
+			attribute, attributeDiags := config.Schema.AttributeAtPath(ctx, attributePath)
+
+			diags.Append(attributeDiags...)
+
+			if attributeDiags.HasError() {
+				continue
+			}
+
+			if !attribute.IsOptional() || !attribute.IsComputed() {
+				diags.AddAttributeError(
+					attributePath,
+					"Invalid Conditional Attribute",
+					"An unexpected error was encountered while verifying conditional attributes. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						fmt.Sprintf("The conditional attribute %s must be Optional and Computed in the schema.", attributePath),
+				)
+
+				continue
+			}
+
+			var value attr.Value
+
+			diags.Append(config.GetAttribute(ctx, attributePath, &value)...)
+
+			if value == nil || value.IsUnknown() {
+				continue
+			}
+
+			if requiredIf && value.IsNull() {
+				diags.AddAttributeError(
+					attributePath,
+					"Missing Configuration for Required Attribute",
+					fmt.Sprintf("Must set a configuration value for the %s attribute as the provider has marked it as required when any of these attributes are configured: %s\n\n", attributePath, conditionalAttribute.RequiredIf)+
+						"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+				)
+			}
+
+			if computedIf && !value.IsNull() {
+				diags.AddAttributeError(
+					attributePath,
+					"Invalid Configuration for Read-Only Attribute",
+					fmt.Sprintf("Cannot set value for this attribute as the provider has marked it as read-only when any of these attributes are configured: %s "+
+						"Remove the configuration line setting the value.\n\n", conditionalAttribute.ComputedIf)+
+						"Refer to the provider documentation or contact the provider developers for additional information about configurable and read-only attributes that are supported.",
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// conditionalAttributeConfigured returns true if any attribute matching the
+// given expressions is configured with a known, non-null value.
+func conditionalAttributeConfigured(ctx context.Context, config tfsdk.Config, expressions path.Expressions) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, expression := range expressions {
+		paths, pathsDiags := config.PathMatches(ctx, expression)
+
+		diags.Append(pathsDiags...)
+
+		if diags.HasError() {
+			return false, diags
+		}
+
+		for _, p := range paths {
+			var value attr.Value
+
+			diags.Append(config.GetAttribute(ctx, p, &value)...)
+
+			if diags.HasError() {
+				return false, diags
+			}
+
+			if value != nil && !value.IsNull() && !value.IsUnknown() {
+				return true, diags
+			}
+		}
+	}
+
+	return false, diags
+}
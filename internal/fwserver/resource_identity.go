@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// copyResourceIdentity returns a copy of the given resource identity data, so
+// provider defined logic cannot modify request data via response data. A nil
+// input, such as when the resource does not implement identity, returns nil.
+func copyResourceIdentity(in *tfsdk.ResourceIdentity) *tfsdk.ResourceIdentity {
+	if in == nil {
+		return nil
+	}
+
+	return &tfsdk.ResourceIdentity{
+		Schema: in.Schema,
+		Raw:    in.Raw.Copy(),
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ResourceProviderMeta returns the provider meta configuration data for the
+// given resource type name. Terraform always encodes provider_meta data with
+// the provider meta schema, so the given data must be decoded with that
+// schema. If the Resource implements the ResourceWithMetaSchema interface,
+// the data is projected onto the resource meta schema, otherwise it is
+// returned unmodified.
+func (s *Server) ResourceProviderMeta(ctx context.Context, typeName string, providerMeta *tfsdk.Config) (*tfsdk.Config, diag.Diagnostics) {
+	r, diags := s.Resource(ctx, typeName)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if _, ok := r.(resource.ResourceWithMetaSchema); !ok {
+		return providerMeta, diags
+	}
+
+	resourceMetaSchema, resourceMetaSchemaDiags := s.ResourceMetaSchema(ctx, typeName)
+
+	diags.Append(resourceMetaSchemaDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	resourceMetaType := resourceMetaSchema.Type().TerraformType(ctx)
+
+	resourceMeta := &tfsdk.Config{
+		Raw:    tftypes.NewValue(resourceMetaType, nil),
+		Schema: resourceMetaSchema,
+	}
+
+	if providerMeta == nil || providerMeta.Raw.IsNull() {
+		return resourceMeta, diags
+	}
+
+	var providerMetaValues map[string]tftypes.Value
+
+	if err := providerMeta.Raw.As(&providerMetaValues); err != nil {
+		diags.AddError(
+			"Unable to Convert Provider Meta Configuration",
+			"An unexpected error was encountered when converting the provider meta configuration to the resource meta schema. "+
+				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+				"Please report this to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	resourceMetaObjectType, ok := resourceMetaType.(tftypes.Object)
+
+	if !ok {
+		return resourceMeta, diags
+	}
+
+	resourceMetaValues := make(map[string]tftypes.Value, len(resourceMetaObjectType.AttributeTypes))
+
+	for name, attributeType := range resourceMetaObjectType.AttributeTypes {
+		value, ok := providerMetaValues[name]
+
+		if !ok || !value.Type().Equal(attributeType) {
+			diags.AddError(
+				"Unable to Convert Provider Meta Configuration",
+				"An unexpected error was encountered when converting the provider meta configuration to the resource meta schema. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Resource %q meta schema attribute %q is not compatible with the provider meta schema.", typeName, name),
+			)
+
+			return nil, diags
+		}
+
+		resourceMetaValues[name] = value
+	}
+
+	resourceMeta.Raw = tftypes.NewValue(resourceMetaObjectType, resourceMetaValues)
+
+	return resourceMeta, diags
+}
+
+// resourceMetaSchemaCompatible verifies that every resource meta schema
+// attribute is defined in the provider meta schema with the same Terraform
+// type, since Terraform encodes provider_meta data with the provider meta
+// schema.
+func resourceMetaSchemaCompatible(ctx context.Context, typeName string, resourceMetaSchema fwschema.Schema, providerMetaSchema fwschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var providerMetaAttributes map[string]fwschema.Attribute
+
+	if providerMetaSchema != nil {
+		providerMetaAttributes = providerMetaSchema.GetAttributes()
+	}
+
+	for name, attribute := range resourceMetaSchema.GetAttributes() {
+		providerMetaAttribute, ok := providerMetaAttributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Invalid Resource Meta Schema",
+				"When validating the schema, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Resource %q meta schema attribute %q is not defined in the provider meta schema. ", typeName, name)+
+					"Terraform sends provider_meta data based on the provider meta schema, so resource meta schemas can only contain provider meta schema attributes.",
+			)
+
+			continue
+		}
+
+		if !attribute.GetType().TerraformType(ctx).Equal(providerMetaAttribute.GetType().TerraformType(ctx)) {
+			diags.AddError(
+				"Invalid Resource Meta Schema",
+				"When validating the schema, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Resource %q meta schema attribute %q type does not match the provider meta schema attribute type. ", typeName, name)+
+					"Terraform sends provider_meta data based on the provider meta schema, so resource meta schema attributes must have the same underlying type.",
+			)
+		}
+	}
+
+	return diags
+}
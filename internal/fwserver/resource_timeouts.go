@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resource operation names, which match the timeouts attribute names.
+const (
+	resourceOperationCreate = "create"
+	resourceOperationRead   = "read"
+	resourceOperationUpdate = "update"
+	resourceOperationDelete = "delete"
+)
+
+// ResourceTimeout returns the timeout for the given resource operation if the
+// resource implements the ResourceWithTimeouts interface. The configured
+// timeouts.<operation> value in the data takes precedence over the resource
+// defined default.
+func ResourceTimeout(ctx context.Context, r resource.Resource, operation string, data fwschemadata.Data) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resourceWithTimeouts, ok := r.(resource.ResourceWithTimeouts)
+
+	if !ok {
+		return 0, diags
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithTimeouts")
+
+	timeoutsReq := resource.TimeoutsRequest{}
+	timeoutsResp := resource.TimeoutsResponse{}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource Timeouts")
+	resourceWithTimeouts.Timeouts(ctx, timeoutsReq, &timeoutsResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource Timeouts")
+
+	diags.Append(timeoutsResp.Diagnostics...)
+
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	var timeout time.Duration
+
+	switch operation {
+	case resourceOperationCreate:
+		timeout = timeoutsResp.Create
+	case resourceOperationRead:
+		timeout = timeoutsResp.Read
+	case resourceOperationUpdate:
+		timeout = timeoutsResp.Update
+	case resourceOperationDelete:
+		timeout = timeoutsResp.Delete
+	}
+
+	if data.Schema == nil || data.TerraformValue.IsNull() {
+		return timeout, diags
+	}
+
+	timeoutPath := path.Root("timeouts").AtName(operation)
+
+	// Operations without a timeouts attribute always use the default.
+	if _, attributeDiags := data.Schema.AttributeAtPath(ctx, timeoutPath); attributeDiags.HasError() {
+		return timeout, diags
+	}
+
+	var configuredTimeout types.String
+
+	diags.Append(data.GetAtPath(ctx, timeoutPath, &configuredTimeout)...)
+
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	if configuredTimeout.IsNull() || configuredTimeout.IsUnknown() {
+		return timeout, diags
+	}
+
+	timeout, err := time.ParseDuration(configuredTimeout.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			timeoutPath,
+			"Invalid Timeout Duration",
+			fmt.Sprintf("The %s timeout must be a duration string, such as \"30s\" or \"2h45m\". ", operation)+
+				"Valid time units are \"s\", \"m\", and \"h\".\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return timeout, diags
+}
@@ -0,0 +1,677 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// SchemaDefaultValidation verifies that every static default value, such as
+// stringdefault.StaticString, passes the validators of the attribute it is
+// declared on. A default value which fails validation would be saved to
+// state, yet practitioners could not fix it via configuration.
+//
+// Defaults which do not implement the defaults.Static interface are not
+// verified, since their value may depend on the request or external state.
+// Validators which implement the validator.ExpressionProvider interface are
+// also skipped, since they depend on the values of other attributes.
+func SchemaDefaultValidation(ctx context.Context, s fwschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	config := defaultValidationConfig(ctx, s)
+
+	for name, attribute := range s.GetAttributes() {
+		diags.Append(attributeDefaultValidation(ctx, config, attribute, path.MatchRoot(name))...)
+	}
+
+	for name, block := range s.GetBlocks() {
+		diags.Append(blockDefaultValidation(ctx, config, block, path.MatchRoot(name))...)
+	}
+
+	return diags
+}
+
+// attributeDefaultValidation verifies the static default value of the
+// attribute and any nested attributes.
+func attributeDefaultValidation(ctx context.Context, config tfsdk.Config, attribute fwschema.Attribute, expression path.Expression) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(staticDefaultValidation(ctx, config, attribute, expression)...)
+
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return diags
+	}
+
+	nestedObject := nestedAttribute.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	nestedObjectExpression := expression
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList:
+		nestedObjectExpression = expression.AtAnyListIndex()
+	case fwschema.NestingModeMap:
+		nestedObjectExpression = expression.AtAnyMapKey()
+	case fwschema.NestingModeSet:
+		nestedObjectExpression = expression.AtAnySetValue()
+	}
+
+	for name, nestedAttribute := range nestedObject.GetAttributes() {
+		diags.Append(attributeDefaultValidation(ctx, config, nestedAttribute, nestedObjectExpression.AtName(name))...)
+	}
+
+	return diags
+}
+
+// blockDefaultValidation verifies the static default values of any nested
+// attributes and blocks.
+func blockDefaultValidation(ctx context.Context, config tfsdk.Config, block fwschema.Block, expression path.Expression) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nestedObject := block.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	nestedObjectExpression := expression
+
+	switch block.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		nestedObjectExpression = expression.AtAnyListIndex()
+	case fwschema.BlockNestingModeSet:
+		nestedObjectExpression = expression.AtAnySetValue()
+	}
+
+	for name, nestedAttribute := range nestedObject.GetAttributes() {
+		diags.Append(attributeDefaultValidation(ctx, config, nestedAttribute, nestedObjectExpression.AtName(name))...)
+	}
+
+	for name, nestedBlock := range nestedObject.GetBlocks() {
+		diags.Append(blockDefaultValidation(ctx, config, nestedBlock, nestedObjectExpression.AtName(name))...)
+	}
+
+	return diags
+}
+
+// staticDefaultValidation runs the validators of the attribute against its
+// static default value, if any. The request paths are left empty, since the
+// default value is not associated with any configuration data, and the
+// request configuration has every attribute and block set to null.
+func staticDefaultValidation(ctx context.Context, config tfsdk.Config, attribute fwschema.Attribute, expression path.Expression) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch a := attribute.(type) {
+	case fwschema.AttributeWithBoolDefaultValue:
+		d := a.BoolDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.BoolResponse{}
+
+		d.DefaultBool(ctx, defaults.BoolRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithBoolValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.BoolValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.BoolRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.BoolResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateBool(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithDynamicDefaultValue:
+		d := a.DynamicDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.DynamicResponse{}
+
+		d.DefaultDynamic(ctx, defaults.DynamicRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithDynamicValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.DynamicValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.DynamicRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.DynamicResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateDynamic(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithFloat32DefaultValue:
+		d := a.Float32DefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.Float32Response{}
+
+		d.DefaultFloat32(ctx, defaults.Float32Request{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithFloat32Validators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.Float32Validators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.Float32Request{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.Float32Response{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateFloat32(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithFloat64DefaultValue:
+		d := a.Float64DefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.Float64Response{}
+
+		d.DefaultFloat64(ctx, defaults.Float64Request{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithFloat64Validators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.Float64Validators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.Float64Request{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.Float64Response{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateFloat64(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithInt32DefaultValue:
+		d := a.Int32DefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.Int32Response{}
+
+		d.DefaultInt32(ctx, defaults.Int32Request{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithInt32Validators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.Int32Validators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.Int32Request{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.Int32Response{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateInt32(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithInt64DefaultValue:
+		d := a.Int64DefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.Int64Response{}
+
+		d.DefaultInt64(ctx, defaults.Int64Request{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithInt64Validators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.Int64Validators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.Int64Request{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.Int64Response{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateInt64(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithListDefaultValue:
+		d := a.ListDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.ListResponse{}
+
+		d.DefaultList(ctx, defaults.ListRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithListValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.ListValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.ListRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.ListResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateList(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithMapDefaultValue:
+		d := a.MapDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.MapResponse{}
+
+		d.DefaultMap(ctx, defaults.MapRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithMapValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.MapValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.MapRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.MapResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateMap(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithNumberDefaultValue:
+		d := a.NumberDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.NumberResponse{}
+
+		d.DefaultNumber(ctx, defaults.NumberRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithNumberValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.NumberValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.NumberRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.NumberResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateNumber(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithObjectDefaultValue:
+		d := a.ObjectDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.ObjectResponse{}
+
+		d.DefaultObject(ctx, defaults.ObjectRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithObjectValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.ObjectValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.ObjectRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.ObjectResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateObject(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithSetDefaultValue:
+		d := a.SetDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.SetResponse{}
+
+		d.DefaultSet(ctx, defaults.SetRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithSetValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.SetValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.SetRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.SetResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateSet(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	case fwschema.AttributeWithStringDefaultValue:
+		d := a.StringDefaultValue()
+
+		if !isStaticDefault(d) {
+			return diags
+		}
+
+		defaultResp := &defaults.StringResponse{}
+
+		d.DefaultString(ctx, defaults.StringRequest{}, defaultResp)
+
+		if defaultResp.Diagnostics.HasError() {
+			return diags
+		}
+
+		attributeWithValidators, ok := attribute.(fwxschema.AttributeWithStringValidators)
+
+		if !ok {
+			return diags
+		}
+
+		for _, v := range attributeWithValidators.StringValidators() {
+			if _, ok := v.(validator.ExpressionProvider); ok {
+				continue
+			}
+
+			validateReq := validator.StringRequest{
+				PathExpression: expression,
+				Config:         config,
+				ConfigValue:    defaultResp.PlanValue,
+			}
+			validateResp := &validator.StringResponse{}
+
+			if !callDefaultValidator(ctx, expression, func() { v.ValidateString(ctx, validateReq, validateResp) }) {
+				continue
+			}
+
+			diags.Append(defaultValidationDiags(expression, defaultResp.PlanValue, validateResp.Diagnostics)...)
+		}
+	}
+
+	return diags
+}
+
+// defaultValidationConfig returns the configuration passed to validators
+// when verifying static default values. The schema is included, so
+// validators reading other attributes via the configuration receive null
+// values rather than errors.
+func defaultValidationConfig(ctx context.Context, s fwschema.Schema) tfsdk.Config {
+	schemaType := s.Type().TerraformType(ctx)
+	objectType, ok := schemaType.(tftypes.Object)
+
+	if !ok {
+		return tfsdk.Config{
+			Raw:    tftypes.NewValue(schemaType, nil),
+			Schema: s,
+		}
+	}
+
+	attributeValues := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attributeType := range objectType.AttributeTypes {
+		attributeValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	return tfsdk.Config{
+		Raw:    tftypes.NewValue(objectType, attributeValues),
+		Schema: s,
+	}
+}
+
+// callDefaultValidator calls the validator function, returning false if it
+// panicked. Validators were not originally written to expect this
+// configuration, so any validator which cannot handle it is skipped rather
+// than crashing the provider.
+func callDefaultValidator(ctx context.Context, expression path.Expression, validate func()) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			logging.FrameworkWarn(
+				ctx,
+				"Skipping static default value validation due to validator panic",
+				map[string]interface{}{
+					logging.KeyAttributePath: expression.String(),
+					logging.KeyError:         fmt.Sprintf("%v", r),
+				},
+			)
+
+			ok = false
+		}
+	}()
+
+	validate()
+
+	return true
+}
+
+// isStaticDefault returns true if the default implements defaults.Static
+// and reports itself as static.
+func isStaticDefault(d any) bool {
+	static, ok := d.(defaults.Static)
+
+	return ok && static.IsStatic()
+}
+
+// defaultValidationDiags converts any validator error diagnostics into an
+// implementation issue diagnostic. Warnings are not returned, since they are
+// intended for practitioners who may not have configured the attribute.
+func defaultValidationDiags(expression path.Expression, value fmt.Stringer, validateDiags diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, validateDiag := range validateDiags.Errors() {
+		// The diagnostic path is intentionally omitted as it is invalid in
+		// this context. Diagnostic paths are intended to be mapped to
+		// actual data, while this path information must be synthesized.
+		diags.AddError(
+			"Invalid Attribute Default Value",
+			"When validating the schema, an implementation issue was found. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("%q has a static default value which does not pass the attribute validators.\n\n", expression)+
+				fmt.Sprintf("Default Value: %s\n", value)+
+				fmt.Sprintf("Validator Error: %s: %s", validateDiag.Summary(), validateDiag.Detail()),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,360 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testdefaults"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaDefaultValidation(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator := testvalidator.String{
+		ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if req.ConfigValue.ValueString() != "valid" {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid String", "must be valid")
+			}
+		},
+	}
+
+	testInt64Validator := testvalidator.Int64{
+		ValidateInt64Method: func(_ context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+			if req.ConfigValue.ValueInt64() > 10 {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid Int64", "must be at most 10")
+			}
+		},
+	}
+
+	testListValidator := testvalidator.List{
+		ValidateListMethod: func(_ context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+			if len(req.ConfigValue.Elements()) > 1 {
+				resp.Diagnostics.AddAttributeError(req.Path, "Invalid List", "must have at most 1 element")
+			}
+		},
+	}
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected diag.Diagnostics
+	}{
+		"no-default": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional:   true,
+						Validators: []validator.String{testStringValidator},
+					},
+				},
+			},
+		},
+		"no-validators": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+						Default:  stringdefault.StaticString("invalid"),
+					},
+				},
+			},
+		},
+		"static-default-valid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed:   true,
+						Optional:   true,
+						Default:    stringdefault.StaticString("valid"),
+						Validators: []validator.String{testStringValidator},
+					},
+				},
+			},
+		},
+		"static-default-invalid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed:   true,
+						Optional:   true,
+						Default:    stringdefault.StaticString("invalid"),
+						Validators: []validator.String{testStringValidator},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Default Value",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a static default value which does not pass the attribute validators.\n\n"+
+						"Default Value: \"invalid\"\n"+
+						"Validator Error: Invalid String: must be valid",
+				),
+			},
+		},
+		"static-default-invalid-int64": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.Int64Attribute{
+						Computed:   true,
+						Optional:   true,
+						Default:    int64default.StaticInt64(11),
+						Validators: []validator.Int64{testInt64Validator},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Default Value",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a static default value which does not pass the attribute validators.\n\n"+
+						"Default Value: 11\n"+
+						"Validator Error: Invalid Int64: must be at most 10",
+				),
+			},
+		},
+		"static-default-invalid-list": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListAttribute{
+						Computed:    true,
+						Optional:    true,
+						ElementType: types.StringType,
+						Default: listdefault.StaticValue(
+							types.ListValueMust(
+								types.StringType,
+								[]attr.Value{
+									types.StringValue("one"),
+									types.StringValue("two"),
+								},
+							),
+						),
+						Validators: []validator.List{testListValidator},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Default Value",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a static default value which does not pass the attribute validators.\n\n"+
+						"Default Value: [\"one\",\"two\"]\n"+
+						"Validator Error: Invalid List: must have at most 1 element",
+				),
+			},
+		},
+		"static-default-validator-warning": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+						Default:  stringdefault.StaticString("invalid"),
+						Validators: []validator.String{
+							testvalidator.String{
+								ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+									resp.Diagnostics.AddAttributeWarning(req.Path, "Warning Summary", "Warning Detail")
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"static-default-validator-expressions": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+						Default:  stringdefault.StaticString("invalid"),
+						Validators: []validator.String{
+							testvalidator.StringWithExpressions{
+								String: testStringValidator,
+								ExpressionsMethod: func(_ context.Context) path.Expressions {
+									return path.Expressions{path.MatchRoot("test_other")}
+								},
+							},
+						},
+					},
+					"test_other": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"static-default-validator-reads-config": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+						Default:  stringdefault.StaticString("valid"),
+						Validators: []validator.String{
+							testvalidator.String{
+								ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+									var other types.String
+
+									resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("test_other"), &other)...)
+
+									if !other.IsNull() {
+										resp.Diagnostics.AddError("Unexpected Value", "expected null test_other, got: "+other.String())
+									}
+								},
+							},
+						},
+					},
+					"test_other": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"static-default-validator-panic": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+						Default:  stringdefault.StaticString("invalid"),
+						Validators: []validator.String{
+							testvalidator.String{
+								ValidateStringMethod: func(_ context.Context, _ validator.StringRequest, _ *validator.StringResponse) {
+									panic("unexpected")
+								},
+							},
+							testStringValidator,
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Default Value",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a static default value which does not pass the attribute validators.\n\n"+
+						"Default Value: \"invalid\"\n"+
+						"Validator Error: Invalid String: must be valid",
+				),
+			},
+		},
+		"non-static-default": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+						Optional: true,
+						Default: testdefaults.String{
+							DefaultStringMethod: func(_ context.Context, _ defaults.StringRequest, resp *defaults.StringResponse) {
+								resp.PlanValue = types.StringValue("invalid")
+							},
+						},
+						Validators: []validator.String{testStringValidator},
+					},
+				},
+			},
+		},
+		"static-string-from-env-default": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed:   true,
+						Optional:   true,
+						Default:    stringdefault.StaticStringFromEnv("TF_TEST_SCHEMA_DEFAULT_VALIDATION_UNSET", "invalid"),
+						Validators: []validator.String{testStringValidator},
+					},
+				},
+			},
+		},
+		"nested-attribute-static-default-invalid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"test_nested": schema.StringAttribute{
+									Computed:   true,
+									Optional:   true,
+									Default:    stringdefault.StaticString("invalid"),
+									Validators: []validator.String{testStringValidator},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Default Value",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr[*].test_nested\" has a static default value which does not pass the attribute validators.\n\n"+
+						"Default Value: \"invalid\"\n"+
+						"Validator Error: Invalid String: must be valid",
+				),
+			},
+		},
+		"block-attribute-static-default-invalid": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"test_nested": schema.StringAttribute{
+								Computed:   true,
+								Optional:   true,
+								Default:    stringdefault.StaticString("invalid"),
+								Validators: []validator.String{testStringValidator},
+							},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Default Value",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_block.test_nested\" has a static default value which does not pass the attribute validators.\n\n"+
+						"Default Value: \"invalid\"\n"+
+						"Validator Error: Invalid String: must be valid",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.SchemaDefaultValidation(context.Background(), testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
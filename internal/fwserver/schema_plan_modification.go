@@ -31,6 +31,20 @@ type ModifySchemaPlanRequest struct {
 
 	// Private is provider private state data.
 	Private *privatestate.ProviderData
+
+	// ConcurrentAttributes are the top level attribute and block names whose
+	// plan modifiers are independent and can be run concurrently.
+	ConcurrentAttributes map[string]bool
+
+	// MaxConcurrency is the maximum number of ConcurrentAttributes whose
+	// plan modifiers are run at the same time. If zero or less, the value of
+	// runtime.GOMAXPROCS is used.
+	MaxConcurrency int
+
+	// CollectAllDiagnostics continues running the plan modifiers of other
+	// attributes and blocks after one returns an error diagnostic. The plan
+	// value of the attribute or block with the error is left unchanged.
+	CollectAllDiagnostics bool
 }
 
 // ModifySchemaPlanResponse represents a response to a ModifySchemaPlanRequest.
@@ -54,7 +68,8 @@ type ModifySchemaPlanResponse struct {
 }
 
 // SchemaModifyPlan runs all AttributePlanModifiers in all schema attributes
-// and blocks.
+// and blocks. Attributes and blocks in the request ConcurrentAttributes are
+// run concurrently after all others.
 //
 // TODO: Clean up this abstraction back into an internal Schema type method.
 // The extra Schema parameter is a carry-over of creating the proto6server
@@ -82,6 +97,10 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 	}
 
 	for name, attribute := range s.GetAttributes() {
+		if req.ConcurrentAttributes[name] {
+			continue
+		}
+
 		attrReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
 			Config:        req.Config,
@@ -124,13 +143,19 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 
 		resp.Diagnostics.Append(attrResp.Diagnostics...)
 
-		if resp.Diagnostics.HasError() {
+		if attrResp.Diagnostics.HasError() {
+			if req.CollectAllDiagnostics {
+				continue
+			}
+
 			return
 		}
 
-		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrReq.AttributePath, attrResp.AttributePlan)...)
+		diags = resp.Plan.SetAttribute(ctx, attrReq.AttributePath, attrResp.AttributePlan)
+
+		resp.Diagnostics.Append(diags...)
 
-		if resp.Diagnostics.HasError() {
+		if diags.HasError() {
 			return
 		}
 
@@ -139,6 +164,10 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 	}
 
 	for name, block := range s.GetBlocks() {
+		if req.ConcurrentAttributes[name] {
+			continue
+		}
+
 		blockReq := ModifyAttributePlanRequest{
 			AttributePath: path.Root(name),
 			Config:        req.Config,
@@ -181,17 +210,27 @@ func SchemaModifyPlan(ctx context.Context, s fwschema.Schema, req ModifySchemaPl
 
 		resp.Diagnostics.Append(blockResp.Diagnostics...)
 
-		if resp.Diagnostics.HasError() {
+		if blockResp.Diagnostics.HasError() {
+			if req.CollectAllDiagnostics {
+				continue
+			}
+
 			return
 		}
 
-		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, blockReq.AttributePath, blockResp.AttributePlan)...)
+		diags = resp.Plan.SetAttribute(ctx, blockReq.AttributePath, blockResp.AttributePlan)
+
+		resp.Diagnostics.Append(diags...)
 
-		if resp.Diagnostics.HasError() {
+		if diags.HasError() {
 			return
 		}
 
 		resp.RequiresReplace = append(resp.RequiresReplace, blockResp.RequiresReplace...)
 		resp.Private = blockResp.Private
 	}
+
+	if len(req.ConcurrentAttributes) > 0 {
+		schemaModifyPlanConcurrent(ctx, s, req, resp, configData, planData, stateData)
+	}
 }
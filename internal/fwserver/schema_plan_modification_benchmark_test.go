@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func BenchmarkSchemaModifyPlanUnchanged100(b *testing.B) {
+	benchmarkSchemaModifyPlanUnchanged(b, 100)
+}
+
+func BenchmarkSchemaModifyPlanUnchanged1000(b *testing.B) {
+	benchmarkSchemaModifyPlanUnchanged(b, 1000)
+}
+
+// benchmarkSchemaModifyPlanUnchanged compares plan modification of a wide
+// schema with unchanged values when plan modifiers implement
+// planmodifier.SkipWhenUnchanged versus when they are always called.
+func benchmarkSchemaModifyPlanUnchanged(b *testing.B, attributes int) {
+	for name, skipWhenUnchanged := range map[string]bool{"skipped": true, "called": false} {
+		b.Run(name, func(b *testing.B) {
+			ctx := context.Background()
+
+			// Plan modifiers commonly read other data, which is the dominant
+			// cost of calling them.
+			planModifier := testplanmodifier.String{
+				SkipWhenUnchangedMethod: func(_ context.Context) bool {
+					return skipWhenUnchanged
+				},
+				PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+					var other types.String
+
+					resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, req.Path, &other)...)
+				},
+			}
+
+			schemaAttributes := make(map[string]schema.Attribute, attributes)
+			attributeTypes := make(map[string]tftypes.Type, attributes)
+			attributeValues := make(map[string]tftypes.Value, attributes)
+
+			for i := 0; i < attributes; i++ {
+				name := "test_attribute_" + strconv.Itoa(i)
+
+				schemaAttributes[name] = schema.StringAttribute{
+					Optional:      true,
+					PlanModifiers: []planmodifier.String{planModifier},
+				}
+				attributeTypes[name] = tftypes.String
+				attributeValues[name] = tftypes.NewValue(tftypes.String, "test-value-"+strconv.Itoa(i))
+			}
+
+			testSchema := schema.Schema{
+				Attributes: schemaAttributes,
+			}
+			testValue := tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, attributeValues)
+
+			req := fwserver.ModifySchemaPlanRequest{
+				Config: tfsdk.Config{
+					Raw:    testValue,
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testValue,
+					Schema: testSchema,
+				},
+				State: tfsdk.State{
+					Raw:    testValue,
+					Schema: testSchema,
+				},
+			}
+
+			b.ResetTimer()
+
+			for n := 0; n < b.N; n++ {
+				resp := &fwserver.ModifySchemaPlanResponse{
+					Plan:    req.Plan,
+					Private: privatestate.EmptyProviderData(ctx),
+				}
+
+				fwserver.SchemaModifyPlan(ctx, testSchema, req, resp)
+
+				if resp.Diagnostics.HasError() {
+					b.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+				}
+			}
+		})
+	}
+}
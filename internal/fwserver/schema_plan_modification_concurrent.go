@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// resourceConcurrentPlanModifiers returns the top level attribute and block
+// names whose plan modifiers can be run concurrently and the maximum
+// concurrency, if the resource implements the
+// ResourceWithConcurrentPlanModifiers interface.
+func resourceConcurrentPlanModifiers(ctx context.Context, r resource.Resource, s fwschema.Schema) (map[string]bool, int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resourceWithConcurrentPlanModifiers, ok := r.(resource.ResourceWithConcurrentPlanModifiers)
+
+	if !ok {
+		return nil, 0, diags
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithConcurrentPlanModifiers")
+
+	concurrentReq := resource.ConcurrentPlanModifiersRequest{}
+	concurrentResp := resource.ConcurrentPlanModifiersResponse{}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource ConcurrentPlanModifiers")
+	resourceWithConcurrentPlanModifiers.ConcurrentPlanModifiers(ctx, concurrentReq, &concurrentResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource ConcurrentPlanModifiers")
+
+	diags.Append(concurrentResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, 0, diags
+	}
+
+	attributes := s.GetAttributes()
+	blocks := s.GetBlocks()
+	concurrentAttributes := make(map[string]bool, len(concurrentResp.Paths))
+
+	for _, p := range concurrentResp.Paths {
+		steps := p.Steps()
+
+		if len(steps) == 1 {
+			if name, ok := steps[0].(path.PathStepAttributeName); ok {
+				_, isAttribute := attributes[string(name)]
+				_, isBlock := blocks[string(name)]
+
+				if isAttribute || isBlock {
+					concurrentAttributes[string(name)] = true
+
+					continue
+				}
+			}
+		}
+
+		diags.AddError(
+			"Invalid Concurrent Plan Modifiers Path",
+			"The resource declared a concurrent plan modifiers path which is not a top level attribute or block in the resource schema. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Path: "+p.String(),
+		)
+	}
+
+	if diags.HasError() {
+		return nil, 0, diags
+	}
+
+	return concurrentAttributes, concurrentResp.MaxConcurrency, diags
+}
+
+// schemaModifyPlanConcurrent runs the AttributePlanModifiers of the request
+// ConcurrentAttributes using a bounded number of goroutines. Each attribute
+// receives its own copy of the private state data. Responses are merged in
+// attribute name order, so the resulting plan, private state data, and
+// diagnostics do not depend on goroutine scheduling.
+func schemaModifyPlanConcurrent(ctx context.Context, s fwschema.Schema, req ModifySchemaPlanRequest, resp *ModifySchemaPlanResponse, configData, planData, stateData *fwschemadata.Data) {
+	var diags diag.Diagnostics
+
+	attributes := s.GetAttributes()
+	blocks := s.GetBlocks()
+	names := make([]string, 0, len(req.ConcurrentAttributes))
+
+	for name, concurrent := range req.ConcurrentAttributes {
+		if !concurrent {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	basePrivate := resp.Private.Copy()
+	attrReqs := make([]ModifyAttributePlanRequest, len(names))
+	attrResps := make([]ModifyAttributePlanResponse, len(names))
+
+	for i, name := range names {
+		attrReq := ModifyAttributePlanRequest{
+			AttributePath: path.Root(name),
+			Config:        req.Config,
+			State:         req.State,
+			Plan:          req.Plan,
+			ProviderMeta:  req.ProviderMeta,
+			Private:       basePrivate.Copy(),
+		}
+
+		attrReq.AttributeConfig, diags = configData.ValueAtPath(ctx, attrReq.AttributePath)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		attrReq.AttributePlan, diags = planData.ValueAtPath(ctx, attrReq.AttributePath)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		attrReq.AttributeState, diags = stateData.ValueAtPath(ctx, attrReq.AttributePath)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		attrReqs[i] = attrReq
+		attrResps[i] = ModifyAttributePlanResponse{
+			AttributePlan: attrReq.AttributePlan,
+			Private:       attrReq.Private,
+		}
+	}
+
+	maxConcurrency := req.MaxConcurrency
+
+	if maxConcurrency <= 0 {
+		maxConcurrency = runtime.GOMAXPROCS(0)
+	}
+
+	logging.FrameworkTrace(
+		ctx,
+		"Running concurrent attribute plan modifiers",
+		map[string]interface{}{
+			"attributes":      len(names),
+			"max_concurrency": maxConcurrency,
+		},
+	)
+
+	semaphore := make(chan struct{}, maxConcurrency)
+
+	var wg sync.WaitGroup
+
+	for i, name := range names {
+		wg.Add(1)
+
+		semaphore <- struct{}{}
+
+		go func(i int, name string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if attribute, ok := attributes[name]; ok {
+				AttributeModifyPlan(ctx, attribute, attrReqs[i], &attrResps[i])
+
+				return
+			}
+
+			if block, ok := blocks[name]; ok {
+				BlockModifyPlan(ctx, block, attrReqs[i], &attrResps[i])
+			}
+		}(i, name)
+	}
+
+	wg.Wait()
+
+	for i := range names {
+		resp.Diagnostics.Append(attrResps[i].Diagnostics...)
+
+		if attrResps[i].Diagnostics.HasError() {
+			if req.CollectAllDiagnostics {
+				continue
+			}
+
+			return
+		}
+
+		diags = resp.Plan.SetAttribute(ctx, attrReqs[i].AttributePath, attrResps[i].AttributePlan)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		resp.RequiresReplace = append(resp.RequiresReplace, attrResps[i].RequiresReplace...)
+		resp.Private.MergeChanges(basePrivate, attrResps[i].Private)
+	}
+}
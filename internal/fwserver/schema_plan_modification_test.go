@@ -32,10 +32,126 @@ func TestSchemaModifyPlan(t *testing.T) {
 
 	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
 
+	testConcurrentType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_a":          tftypes.String,
+			"test_b":          tftypes.String,
+			"test_c":          tftypes.String,
+			"test_sequential": tftypes.String,
+		},
+	}
+
+	testConcurrentPlanModifier := func(value string, privateKey string) planmodifier.String {
+		return testplanmodifier.String{
+			PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+				resp.PlanValue = types.StringValue(value)
+				resp.Diagnostics.AddAttributeWarning(req.Path, "Warning diag", value)
+
+				if privateKey != "" {
+					resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKey, []byte(`{"`+value+`":true}`))...)
+				}
+			},
+		}
+	}
+
+	testConcurrentSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_a": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testConcurrentPlanModifier("a", ""),
+				},
+			},
+			"test_b": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testConcurrentPlanModifier("b", "keyB"),
+				},
+			},
+			"test_c": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testConcurrentPlanModifier("c", "keyC"),
+				},
+			},
+			"test_sequential": testschema.AttributeWithStringPlanModifiers{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testConcurrentPlanModifier("sequential", ""),
+				},
+			},
+		},
+	}
+
+	testConcurrentUnknownValue := tftypes.NewValue(testConcurrentType, map[string]tftypes.Value{
+		"test_a":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"test_b":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"test_c":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"test_sequential": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
 	testCases := map[string]struct {
 		req          ModifySchemaPlanRequest
 		expectedResp ModifySchemaPlanResponse
 	}{
+		"concurrent-attributes": {
+			req: ModifySchemaPlanRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(testConcurrentType, map[string]tftypes.Value{
+						"test_a":          tftypes.NewValue(tftypes.String, nil),
+						"test_b":          tftypes.NewValue(tftypes.String, nil),
+						"test_c":          tftypes.NewValue(tftypes.String, nil),
+						"test_sequential": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testConcurrentSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testConcurrentUnknownValue,
+					Schema: testConcurrentSchema,
+				},
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(testConcurrentType, nil),
+					Schema: testConcurrentSchema,
+				},
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"keyExisting": []byte(`{"existing":true}`),
+					}),
+				),
+				ConcurrentAttributes: map[string]bool{
+					"test_a": true,
+					"test_b": true,
+					"test_c": true,
+				},
+				MaxConcurrency: 2,
+			},
+			expectedResp: ModifySchemaPlanResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(path.Root("test_sequential"), "Warning diag", "sequential"),
+					diag.NewAttributeWarningDiagnostic(path.Root("test_a"), "Warning diag", "a"),
+					diag.NewAttributeWarningDiagnostic(path.Root("test_b"), "Warning diag", "b"),
+					diag.NewAttributeWarningDiagnostic(path.Root("test_c"), "Warning diag", "c"),
+				},
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(testConcurrentType, map[string]tftypes.Value{
+						"test_a":          tftypes.NewValue(tftypes.String, "a"),
+						"test_b":          tftypes.NewValue(tftypes.String, "b"),
+						"test_c":          tftypes.NewValue(tftypes.String, "c"),
+						"test_sequential": tftypes.NewValue(tftypes.String, "sequential"),
+					}),
+					Schema: testConcurrentSchema,
+				},
+				Private: privatestate.MustProviderData(
+					context.Background(),
+					privatestate.MustMarshalToJson(map[string][]byte{
+						"keyB":        []byte(`{"b":true}`),
+						"keyC":        []byte(`{"c":true}`),
+						"keyExisting": []byte(`{"existing":true}`),
+					}),
+				),
+			},
+		},
 		"config-error": {
 			req: ModifySchemaPlanRequest{
 				Config: tfsdk.Config{
@@ -6,6 +6,8 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -37,10 +39,11 @@ type SchemaSemanticEqualityResponse struct {
 // SchemaSemanticEquality runs semantic equality logic for all schema attributes
 // and blocks.
 //
-// MAINTAINER NOTE: Since semantic equality is purely value based, where
-// attributes and blocks cannot currently introduce semantic equality logic
-// based on those schema concepts, this logic immediately delegates to value
-// based handling. On the off chance that the framework is enhanced with
+// MAINTAINER NOTE: Semantic equality is value based, where attributes and
+// blocks cannot currently introduce semantic equality logic based on those
+// schema concepts, so this logic immediately delegates to value based
+// handling. The only exception is the string attribute NormalizeFunc, which
+// is applied afterwards with a separate schema walk. On the off chance that the framework is enhanced with
 // attribute and block level semantic equality support (not recommended since
 // value types should really be the correct provider developer abstraction,
 // rather than potentially causing confusing or duplicated provider logic), this
@@ -143,4 +146,26 @@ func SchemaSemanticEquality(ctx context.Context, req SchemaSemanticEqualityReque
 			return
 		}
 	}
+
+	// String attributes can also declare a NormalizeFunc, which is handled
+	// separately from the value based logic above by walking the schema.
+	newValue, err := tftypes.Transform(
+		resp.NewData.TerraformValue,
+		NormalizeStringAttributes(ctx, req.ProposedNewData.Schema, req.PriorData.TerraformValue, &resp.Diagnostics),
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Normalizing Values",
+			"There was an unexpected error normalizing string attribute values. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.NewData.TerraformValue = newValue
 }
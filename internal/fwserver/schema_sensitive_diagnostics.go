@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// sensitiveDiagnosticDetail is the detail of diagnostics which were redacted
+// by SchemaRedactSensitiveDiagnostics.
+const sensitiveDiagnosticDetail = "The diagnostic detail was redacted because the attribute is marked as sensitive in the schema."
+
+var _ diag.DiagnosticWithPath = sensitiveDiagnostic{}
+
+// sensitiveDiagnostic wraps a diagnostic with a sensitive attribute path to
+// redact its detail. The original diagnostic is preserved, including any
+// custom implementation, and is available via the Unwrap method.
+type sensitiveDiagnostic struct {
+	diag.DiagnosticWithPath
+}
+
+// Detail returns the redacted diagnostic detail.
+func (d sensitiveDiagnostic) Detail() string {
+	return sensitiveDiagnosticDetail
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d sensitiveDiagnostic) Equal(other diag.Diagnostic) bool {
+	o, ok := other.(sensitiveDiagnostic)
+
+	if !ok {
+		return false
+	}
+
+	if d.DiagnosticWithPath == nil {
+		return o.DiagnosticWithPath == nil
+	}
+
+	return d.DiagnosticWithPath.Equal(o.DiagnosticWithPath)
+}
+
+// Unwrap returns the original diagnostic.
+func (d sensitiveDiagnostic) Unwrap() diag.Diagnostic {
+	return d.DiagnosticWithPath
+}
+
+// SchemaRedactSensitiveDiagnostics returns the given diagnostics with the
+// detail of any diagnostic redacted if the diagnostic path is an attribute,
+// or is underneath an attribute, which is marked as sensitive in the schema
+// and the detail contains a known value of that attribute in the given data.
+// This prevents provider logic, such as validators, plan modifiers, and
+// resource CRUD methods, from leaking sensitive values into the Terraform UI
+// or logs, while diagnostics which do not include the value are returned
+// unchanged. It is called by the protocol servers before converting the
+// response diagnostics to the protocol.
+//
+// The data can be any of *tfsdk.Config, *tfsdk.Plan, *tfsdk.State,
+// *tfsdk.EphemeralResultData, or their non-pointer equivalents, such as the
+// request configuration and the response state of an RPC. Other types and nil
+// pointers are ignored.
+//
+// Redacted diagnostics wrap the original diagnostic, which is returned by
+// their Unwrap method.
+func SchemaRedactSensitiveDiagnostics(ctx context.Context, s fwschema.Schema, diags diag.Diagnostics, data ...any) diag.Diagnostics {
+	if len(diags) == 0 || s == nil {
+		return diags
+	}
+
+	values := sensitiveDiagnosticValues(data)
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		diagWithPath, ok := d.(diag.DiagnosticWithPath)
+
+		if !ok || d.Detail() == "" {
+			result = append(result, d)
+
+			continue
+		}
+
+		if _, ok := d.(sensitiveDiagnostic); ok {
+			result = append(result, d)
+
+			continue
+		}
+
+		sensitivePath, ok := schemaSensitivePath(ctx, s, diagWithPath.Path())
+
+		if !ok || !detailContainsValue(ctx, d.Detail(), sensitivePath, values) {
+			result = append(result, d)
+
+			continue
+		}
+
+		logging.FrameworkDebug(ctx, "Redacting diagnostic detail for sensitive attribute", map[string]any{
+			logging.KeyAttributePath: diagWithPath.Path().String(),
+		})
+
+		result = append(result, sensitiveDiagnostic{
+			DiagnosticWithPath: diagWithPath,
+		})
+	}
+
+	return result
+}
+
+// schemaSensitivePath returns the path of the attribute at the given path, or
+// its nearest parent attribute, which is marked as sensitive in the schema.
+// Paths which do not represent an attribute, such as blocks, are not
+// sensitive.
+func schemaSensitivePath(ctx context.Context, s fwschema.Schema, p path.Path) (path.Path, bool) {
+	for ; len(p.Steps()) > 0; p = p.ParentPath() {
+		attribute, diags := fwschema.SchemaAttributeAtPath(ctx, s, p)
+
+		if diags.HasError() {
+			continue
+		}
+
+		if attribute.IsSensitive() {
+			return p, true
+		}
+	}
+
+	return path.Empty(), false
+}
+
+// sensitiveDiagnosticValues returns the raw Terraform values of the given
+// data, ignoring unsupported types and nil pointers.
+func sensitiveDiagnosticValues(data []any) []tftypes.Value {
+	var values []tftypes.Value
+
+	for _, d := range data {
+		switch d := d.(type) {
+		case tfsdk.Config:
+			values = append(values, d.Raw)
+		case *tfsdk.Config:
+			if d != nil {
+				values = append(values, d.Raw)
+			}
+		case tfsdk.Plan:
+			values = append(values, d.Raw)
+		case *tfsdk.Plan:
+			if d != nil {
+				values = append(values, d.Raw)
+			}
+		case tfsdk.State:
+			values = append(values, d.Raw)
+		case *tfsdk.State:
+			if d != nil {
+				values = append(values, d.Raw)
+			}
+		case tfsdk.EphemeralResultData:
+			values = append(values, d.Raw)
+		case *tfsdk.EphemeralResultData:
+			if d != nil {
+				values = append(values, d.Raw)
+			}
+		}
+	}
+
+	return values
+}
+
+// detailContainsValue returns true if the diagnostic detail contains any
+// known, non-empty primitive value at or underneath the given path in the
+// given values. Numbers are compared in their decimal form and strings are
+// also compared in their quoted form, as used by the %q formatting verb.
+func detailContainsValue(ctx context.Context, detail string, p path.Path, values []tftypes.Value) bool {
+	tfTypePath, diags := totftypes.AttributePath(ctx, p)
+
+	if diags.HasError() {
+		return false
+	}
+
+	for _, value := range values {
+		if value.Type() == nil {
+			continue
+		}
+
+		valueAtPath, _, err := tftypes.WalkAttributePath(value, tfTypePath)
+
+		if err != nil {
+			continue
+		}
+
+		tfValue, ok := valueAtPath.(tftypes.Value)
+
+		if !ok {
+			continue
+		}
+
+		var found bool
+
+		_ = tftypes.Walk(tfValue, func(_ *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+			if found {
+				return false, nil
+			}
+
+			for _, s := range primitiveValueStrings(v) {
+				if s != "" && strings.Contains(detail, s) {
+					found = true
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// primitiveValueStrings returns the string representations of the given
+// value if it is a known, non-null primitive value.
+func primitiveValueStrings(v tftypes.Value) []string {
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+
+		if err := v.As(&s); err != nil {
+			return nil
+		}
+
+		return []string{s, strconv.Quote(s)}
+	case v.Type().Is(tftypes.Number):
+		var n big.Float
+
+		if err := v.As(&n); err != nil {
+			return nil
+		}
+
+		return []string{n.Text('f', -1)}
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+
+		if err := v.As(&b); err != nil {
+			return nil
+		}
+
+		return []string{strconv.FormatBool(b)}
+	}
+
+	return nil
+}
@@ -0,0 +1,283 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestSchemaRedactSensitiveDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_attr": schema.StringAttribute{
+				Optional: true,
+			},
+			"test_sensitive_attr": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"test_sensitive_nested_attr": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nested_attr": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"test_block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"nested_sensitive_attr": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_attr":           tftypes.NewValue(tftypes.String, "test-value"),
+			"test_sensitive_attr": tftypes.NewValue(tftypes.String, "test-sensitive-value"),
+			"test_sensitive_nested_attr": tftypes.NewValue(
+				testSchema.Attributes["test_sensitive_nested_attr"].GetType().TerraformType(context.Background()),
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"nested_attr": tftypes.String}}, map[string]tftypes.Value{
+						"nested_attr": tftypes.NewValue(tftypes.String, "test-nested-sensitive-value"),
+					}),
+				},
+			),
+			"test_block": tftypes.NewValue(
+				testSchema.Blocks["test_block"].Type().TerraformType(context.Background()),
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"nested_sensitive_attr": tftypes.String}}, map[string]tftypes.Value{
+						"nested_sensitive_attr": tftypes.NewValue(tftypes.String, "test-block-sensitive-value"),
+					}),
+				},
+			),
+		}),
+		Schema: testSchema,
+	}
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		data     []any
+		expected diag.Diagnostics
+	}{
+		"nil": {
+			diags:    nil,
+			data:     []any{testConfig},
+			expected: nil,
+		},
+		"no-path": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "got: test-sensitive-value"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "got: test-sensitive-value"),
+			},
+		},
+		"non-sensitive-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_attr"), "test summary", "got: test-value"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_attr"), "test summary", "got: test-value"),
+			},
+		},
+		"sensitive-path-error": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value")},
+			},
+		},
+		"sensitive-path-warning": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeWarningDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value")},
+			},
+		},
+		"sensitive-path-quoted-value": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", `got: "test-sensitive-value"`),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", `got: "test-sensitive-value"`)},
+			},
+		},
+		"sensitive-path-empty-detail": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", ""),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", ""),
+			},
+		},
+		"sensitive-path-value-not-in-detail": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "Attribute test_sensitive_attr string length must be at most 10"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "Attribute test_sensitive_attr string length must be at most 10"),
+			},
+		},
+		"sensitive-path-no-data": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value"),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value"),
+			},
+		},
+		"sensitive-parent-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_nested_attr").AtListIndex(0).AtName("nested_attr"), "test summary", "got: test-nested-sensitive-value"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_nested_attr").AtListIndex(0).AtName("nested_attr"), "test summary", "got: test-nested-sensitive-value")},
+			},
+		},
+		"sensitive-parent-path-value-not-in-detail": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_nested_attr").AtListIndex(0).AtName("nested_attr"), "test summary", "test detail"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_nested_attr").AtListIndex(0).AtName("nested_attr"), "test summary", "test detail"),
+			},
+		},
+		"sensitive-path-already-redacted": {
+			diags: diag.Diagnostics{
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value")},
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value")},
+			},
+		},
+		"block-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_block"), "test summary", "got: test-block-sensitive-value"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_block"), "test summary", "got: test-block-sensitive-value"),
+			},
+		},
+		"block-sensitive-attribute-path": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_block").AtListIndex(0).AtName("nested_sensitive_attr"), "test summary", "got: test-block-sensitive-value"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(path.Root("test_block").AtListIndex(0).AtName("nested_sensitive_attr"), "test summary", "got: test-block-sensitive-value")},
+			},
+		},
+		"mixed": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_attr"), "test summary", "got: test-value"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "Attribute test_sensitive_attr string length must be at most 10"),
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+			data: []any{testConfig},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test_attr"), "test summary", "got: test-value"),
+				sensitiveDiagnostic{DiagnosticWithPath: diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value")},
+				diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "Attribute test_sensitive_attr string length must be at most 10"),
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SchemaRedactSensitiveDiagnostics(context.Background(), testSchema, testCase.diags, testCase.data...)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSensitiveDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	original := diag.NewAttributeErrorDiagnostic(path.Root("test_sensitive_attr"), "test summary", "got: test-sensitive-value")
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_sensitive_attr": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test_sensitive_attr": tftypes.String}}, map[string]tftypes.Value{
+			"test_sensitive_attr": tftypes.NewValue(tftypes.String, "test-sensitive-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	got := SchemaRedactSensitiveDiagnostics(context.Background(), testSchema, diag.Diagnostics{original}, testConfig)
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 diagnostic, got: %d", len(got))
+	}
+
+	if diff := cmp.Diff(got[0].Detail(), sensitiveDiagnosticDetail); diff != "" {
+		t.Errorf("unexpected detail difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(got[0].Summary(), original.Summary()); diff != "" {
+		t.Errorf("unexpected summary difference: %s", diff)
+	}
+
+	unwrapper, ok := got[0].(interface{ Unwrap() diag.Diagnostic })
+
+	if !ok {
+		t.Fatalf("expected diagnostic with Unwrap method, got: %T", got[0])
+	}
+
+	if !unwrapper.Unwrap().Equal(original) {
+		t.Errorf("expected original diagnostic, got: %#v", unwrapper.Unwrap())
+	}
+}
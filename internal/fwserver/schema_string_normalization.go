@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// NormalizeStringAttributes returns a tftypes.Transform function which
+// replaces string attribute values with the value at the same path in prior,
+// if the attribute implements fwschema.AttributeWithStringNormalizeFunc and
+// the normalized forms of both values are equal. Any diagnostics returned by
+// the provider defined normalization are appended to diags.
+func NormalizeStringAttributes(ctx context.Context, s fwschema.Schema, prior tftypes.Value, diags *diag.Diagnostics) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(tfPath *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		// we are only modifying attributes, not the entire resource
+		if len(tfPath.Steps()) < 1 {
+			return val, nil
+		}
+
+		if !val.Type().Is(tftypes.String) || !val.IsKnown() || val.IsNull() {
+			return val, nil
+		}
+
+		ctx := logging.FrameworkWithAttributePath(ctx, tfPath.String())
+
+		attribute, err := s.AttributeAtTerraformPath(ctx, tfPath)
+
+		if err != nil {
+			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) {
+				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
+				return val, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+				// ignore attributes/elements inside schema.DynamicAttribute, they have no schema of their own
+				return val, nil
+			}
+
+			logging.FrameworkError(ctx, "couldn't find attribute in resource schema")
+
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+		}
+
+		attributeWithNormalizeFunc, ok := attribute.(fwschema.AttributeWithStringNormalizeFunc)
+
+		if !ok || attributeWithNormalizeFunc.StringNormalizeFunc() == nil {
+			return val, nil
+		}
+
+		// Paths without a prior value, such as new set elements, are left as-is.
+		priorRaw, _, err := tftypes.WalkAttributePath(prior, tfPath)
+
+		if err != nil {
+			return val, nil
+		}
+
+		priorVal, ok := priorRaw.(tftypes.Value)
+
+		if !ok || !priorVal.Type().Is(tftypes.String) || !priorVal.IsKnown() || priorVal.IsNull() {
+			return val, nil
+		}
+
+		var priorString, proposedNewString string
+
+		if err := priorVal.As(&priorString); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		if err := val.As(&proposedNewString); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		if priorString == proposedNewString {
+			return val, nil
+		}
+
+		fwPath, fwPathDiags := fromtftypes.AttributePath(ctx, tfPath, s)
+
+		diags.Append(fwPathDiags...)
+
+		if fwPathDiags.HasError() {
+			return val, nil
+		}
+
+		normalize := attributeWithNormalizeFunc.StringNormalizeFunc()
+
+		logging.FrameworkTrace(ctx, "Calling provider defined NormalizeFunc")
+		normalizedPrior, priorDiags := normalize(ctx, priorString)
+		normalizedProposedNew, proposedNewDiags := normalize(ctx, proposedNewString)
+		logging.FrameworkTrace(ctx, "Called provider defined NormalizeFunc")
+
+		for _, d := range append(priorDiags, proposedNewDiags...) {
+			diags.Append(diag.WithPath(fwPath, d))
+		}
+
+		if priorDiags.HasError() || proposedNewDiags.HasError() {
+			return val, nil
+		}
+
+		if normalizedPrior != normalizedProposedNew {
+			return val, nil
+		}
+
+		logging.FrameworkDebug(ctx, "Value switched to prior value due to NormalizeFunc")
+
+		return priorVal, nil
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestNormalizeStringAttributes(t *testing.T) {
+	t.Parallel()
+
+	testNormalizeFunc := func(_ context.Context, value string) (string, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		if value == "invalid" {
+			diags.AddError("Invalid Value", "The value cannot be normalized.")
+
+			return "", diags
+		}
+
+		return strings.ToLower(strings.TrimSpace(value)), diags
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_normalized": schema.StringAttribute{
+				Optional:      true,
+				NormalizeFunc: testNormalizeFunc,
+			},
+			"test_other": schema.StringAttribute{
+				Optional: true,
+			},
+			"test_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_normalized": schema.StringAttribute{
+						Optional:      true,
+						NormalizeFunc: testNormalizeFunc,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_normalized": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_normalized": tftypes.String,
+			"test_other":      tftypes.String,
+			"test_nested":     testNestedType,
+		},
+	}
+
+	testValue := func(normalized, other, nestedNormalized any) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_normalized": tftypes.NewValue(tftypes.String, normalized),
+			"test_other":      tftypes.NewValue(tftypes.String, other),
+			"test_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+				"test_normalized": tftypes.NewValue(tftypes.String, nestedNormalized),
+			}),
+		})
+	}
+
+	testCases := map[string]struct {
+		prior         tftypes.Value
+		proposedNew   tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"equal": {
+			prior:       testValue("value", "value", "value"),
+			proposedNew: testValue("value", "value", "value"),
+			expected:    testValue("value", "value", "value"),
+		},
+		"normalized-equal": {
+			prior:       testValue("Value", "Value", " Value "),
+			proposedNew: testValue("value", "value", "value"),
+			expected:    testValue("Value", "value", " Value "),
+		},
+		"normalized-not-equal": {
+			prior:       testValue("Value", "Value", "Value"),
+			proposedNew: testValue("other", "other", "other"),
+			expected:    testValue("other", "other", "other"),
+		},
+		"prior-null": {
+			prior:       tftypes.NewValue(testType, nil),
+			proposedNew: testValue("value", "value", "value"),
+			expected:    testValue("value", "value", "value"),
+		},
+		"prior-unknown": {
+			prior:       testValue(tftypes.UnknownValue, tftypes.UnknownValue, tftypes.UnknownValue),
+			proposedNew: testValue("value", "value", "value"),
+			expected:    testValue("value", "value", "value"),
+		},
+		"diagnostics": {
+			prior:       testValue("invalid", "value", "value"),
+			proposedNew: testValue("value", "value", "value"),
+			expected:    testValue("value", "value", "value"),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(
+					path.Root("test_normalized"),
+					diag.NewErrorDiagnostic("Invalid Value", "The value cannot be normalized."),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			got, err := tftypes.Transform(
+				testCase.proposedNew,
+				fwserver.NormalizeStringAttributes(context.Background(), testSchema, testCase.prior, &diags),
+			)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// SchemaValidatorExpressions verifies that every path expression returned by
+// attribute, block, and nested object validators implementing the
+// validator.ExpressionProvider interface resolves to an attribute or block
+// in the schema. Expressions which traverse into a dynamic type are not
+// verified, since there is no schema information underneath them.
+//
+// Validators implementing the validator.ImplementationErrorProvider interface
+// are also verified to have no implementation error, such as invalid
+// arguments given to the validator constructor.
+func SchemaValidatorExpressions(ctx context.Context, s fwschema.Schema) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, attribute := range s.GetAttributes() {
+		diags.Append(attributeValidatorExpressions(ctx, s, attribute, path.MatchRoot(name))...)
+	}
+
+	for name, block := range s.GetBlocks() {
+		diags.Append(blockValidatorExpressions(ctx, s, block, path.MatchRoot(name))...)
+	}
+
+	return diags
+}
+
+// attributeValidatorExpressions verifies the validator expressions of the
+// attribute and any nested attributes.
+func attributeValidatorExpressions(ctx context.Context, s fwschema.Schema, attribute fwschema.Attribute, expression path.Expression) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validatorExpressions(ctx, s, attributeValidators(attribute), expression)...)
+
+	if attributeWithElementValidators, ok := attribute.(fwxschema.AttributeWithElementValidators); ok {
+		elementExpression := expression.AtAnyListIndex()
+
+		switch attribute.GetType().(type) {
+		case basetypes.MapTypable:
+			elementExpression = expression.AtAnyMapKey()
+		case basetypes.SetTypable:
+			elementExpression = expression.AtAnySetValue()
+		}
+
+		var elementValidators []any
+
+		for _, elementValidator := range attributeWithElementValidators.GetElementValidators() {
+			elementValidators = append(elementValidators, elementValidator)
+		}
+
+		diags.Append(validatorExpressions(ctx, s, elementValidators, elementExpression)...)
+	}
+
+	nestedAttribute, ok := attribute.(fwschema.NestedAttribute)
+
+	if !ok {
+		return diags
+	}
+
+	nestedObject := nestedAttribute.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	nestedObjectExpression := expression
+
+	switch nestedAttribute.GetNestingMode() {
+	case fwschema.NestingModeList:
+		nestedObjectExpression = expression.AtAnyListIndex()
+	case fwschema.NestingModeMap:
+		nestedObjectExpression = expression.AtAnyMapKey()
+	case fwschema.NestingModeSet:
+		nestedObjectExpression = expression.AtAnySetValue()
+	}
+
+	if nestedObjectWithValidators, ok := nestedObject.(fwxschema.NestedAttributeObjectWithValidators); ok {
+		diags.Append(validatorExpressions(ctx, s, objectValidators(nestedObjectWithValidators.ObjectValidators()), nestedObjectExpression)...)
+	}
+
+	for name, nestedAttribute := range nestedObject.GetAttributes() {
+		diags.Append(attributeValidatorExpressions(ctx, s, nestedAttribute, nestedObjectExpression.AtName(name))...)
+	}
+
+	return diags
+}
+
+// blockValidatorExpressions verifies the validator expressions of the block
+// and any nested attributes and blocks.
+func blockValidatorExpressions(ctx context.Context, s fwschema.Schema, block fwschema.Block, expression path.Expression) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch blockWithValidators := block.(type) {
+	case fwxschema.BlockWithListValidators:
+		var validators []any
+
+		for _, listValidator := range blockWithValidators.ListValidators() {
+			validators = append(validators, listValidator)
+		}
+
+		diags.Append(validatorExpressions(ctx, s, validators, expression)...)
+	case fwxschema.BlockWithObjectValidators:
+		diags.Append(validatorExpressions(ctx, s, objectValidators(blockWithValidators.ObjectValidators()), expression)...)
+	case fwxschema.BlockWithSetValidators:
+		var validators []any
+
+		for _, setValidator := range blockWithValidators.SetValidators() {
+			validators = append(validators, setValidator)
+		}
+
+		diags.Append(validatorExpressions(ctx, s, validators, expression)...)
+	}
+
+	nestedObject := block.GetNestedObject()
+
+	if nestedObject == nil {
+		return diags
+	}
+
+	nestedObjectExpression := expression
+
+	switch block.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		nestedObjectExpression = expression.AtAnyListIndex()
+	case fwschema.BlockNestingModeSet:
+		nestedObjectExpression = expression.AtAnySetValue()
+	}
+
+	if nestedObjectWithValidators, ok := nestedObject.(fwxschema.NestedBlockObjectWithValidators); ok {
+		diags.Append(validatorExpressions(ctx, s, objectValidators(nestedObjectWithValidators.ObjectValidators()), nestedObjectExpression)...)
+	}
+
+	for name, nestedAttribute := range nestedObject.GetAttributes() {
+		diags.Append(attributeValidatorExpressions(ctx, s, nestedAttribute, nestedObjectExpression.AtName(name))...)
+	}
+
+	for name, nestedBlock := range nestedObject.GetBlocks() {
+		diags.Append(blockValidatorExpressions(ctx, s, nestedBlock, nestedObjectExpression.AtName(name))...)
+	}
+
+	return diags
+}
+
+// validatorExpressions verifies the expressions of any validators which
+// implement validator.ExpressionProvider, merged with the expression of the
+// attribute or block the validators are declared on, and the implementation
+// of any validators which implement validator.ImplementationErrorProvider.
+func validatorExpressions(ctx context.Context, s fwschema.Schema, validators []any, expression path.Expression) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range validators {
+		if implementationErrorProvider, ok := v.(validator.ImplementationErrorProvider); ok {
+			if err := implementationErrorProvider.ImplementationError(ctx); err != nil {
+				diags.AddError(
+					"Invalid Validator Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						fmt.Sprintf("%q has a validator which was created with invalid arguments.\n\n", expression)+
+						fmt.Sprintf("Error: %s", err),
+				)
+			}
+		}
+
+		expressionProvider, ok := v.(validator.ExpressionProvider)
+
+		if !ok {
+			continue
+		}
+
+		for _, validatorExpression := range expressionProvider.Expressions(ctx) {
+			mergedExpression := expression.Merge(validatorExpression)
+
+			err := schemaExpressionResolves(s, mergedExpression)
+
+			if err == nil {
+				continue
+			}
+
+			// The diagnostic path is intentionally omitted as it is invalid in
+			// this context. Diagnostic paths are intended to be mapped to
+			// actual data, while this path information must be synthesized.
+			diags.AddError(
+				"Invalid Validator Path Expression",
+				"When validating the schema, an implementation issue was found. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("%q has a validator with a path expression which does not resolve to an attribute or block in the schema.\n\n", expression)+
+					fmt.Sprintf("Path Expression: %s\n", mergedExpression)+
+					fmt.Sprintf("Error: %s", err),
+			)
+		}
+	}
+
+	return diags
+}
+
+// schemaExpressionResolves returns an error if the expression steps cannot
+// be applied to the schema. Element steps are applied with arbitrary keys,
+// since only the schema types are verified.
+func schemaExpressionResolves(s fwschema.Schema, expression path.Expression) error {
+	var current any = s
+
+	// Previously stepped into schema locations, which are restored by
+	// ExpressionStepParent.
+	var parents []any
+
+	for _, expressionStep := range expression.Steps() {
+		if _, ok := expressionStep.(path.ExpressionStepParent); ok {
+			if len(parents) == 0 {
+				return errors.New("parent step goes beyond the schema root")
+			}
+
+			current = parents[len(parents)-1]
+			parents = parents[:len(parents)-1]
+
+			continue
+		}
+
+		if schemaLocationIsDynamic(current) {
+			return nil
+		}
+
+		stepper, ok := current.(tftypes.AttributePathStepper)
+
+		if !ok {
+			return fmt.Errorf("cannot apply step %s to %T", expressionStep, current)
+		}
+
+		var next any
+		var err error
+
+		for _, step := range schemaExpressionStepCandidates(expressionStep) {
+			next, err = stepper.ApplyTerraform5AttributePathStep(step)
+
+			if err == nil {
+				break
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("cannot apply step %s: %w", expressionStep, err)
+		}
+
+		parents = append(parents, current)
+		current = next
+	}
+
+	return nil
+}
+
+// schemaExpressionStepCandidates returns the tftypes.AttributePathStep which
+// could represent the expression step in the schema. Element steps which do
+// not declare the type of element, such as ExpressionStepElementMatching,
+// return a step for each possible element type.
+func schemaExpressionStepCandidates(expressionStep path.ExpressionStep) []tftypes.AttributePathStep {
+	switch step := expressionStep.(type) {
+	case path.ExpressionStepAttributeNameExact:
+		return []tftypes.AttributePathStep{tftypes.AttributeName(step)}
+	case path.ExpressionStepElementKeyIntAny:
+		return []tftypes.AttributePathStep{tftypes.ElementKeyInt(0)}
+	case path.ExpressionStepElementKeyIntExact:
+		return []tftypes.AttributePathStep{tftypes.ElementKeyInt(step)}
+	case path.ExpressionStepElementKeyStringAny:
+		return []tftypes.AttributePathStep{tftypes.ElementKeyString("")}
+	case path.ExpressionStepElementKeyStringExact:
+		return []tftypes.AttributePathStep{tftypes.ElementKeyString(step)}
+	case path.ExpressionStepElementKeyValueAny, path.ExpressionStepElementKeyValueExact:
+		return []tftypes.AttributePathStep{tftypes.ElementKeyValue{}}
+	case path.ExpressionStepElementMatching:
+		return []tftypes.AttributePathStep{
+			tftypes.ElementKeyInt(0),
+			tftypes.ElementKeyString(""),
+			tftypes.ElementKeyValue{},
+		}
+	default:
+		return nil
+	}
+}
+
+// schemaLocationIsDynamic returns true if the schema location is a dynamic
+// type or an attribute with a dynamic type.
+func schemaLocationIsDynamic(location any) bool {
+	if _, ok := location.(basetypes.DynamicTypable); ok {
+		return true
+	}
+
+	attribute, ok := location.(fwschema.Attribute)
+
+	if !ok {
+		return false
+	}
+
+	_, ok = attribute.GetType().(basetypes.DynamicTypable)
+
+	return ok
+}
+
+// attributeValidators returns the validators declared on the attribute.
+func attributeValidators(attribute fwschema.Attribute) []any {
+	var validators []any
+
+	switch attributeWithValidators := attribute.(type) {
+	case fwxschema.AttributeWithBoolValidators:
+		for _, v := range attributeWithValidators.BoolValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithDynamicValidators:
+		for _, v := range attributeWithValidators.DynamicValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithFloat32Validators:
+		for _, v := range attributeWithValidators.Float32Validators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithFloat64Validators:
+		for _, v := range attributeWithValidators.Float64Validators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithInt32Validators:
+		for _, v := range attributeWithValidators.Int32Validators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithInt64Validators:
+		for _, v := range attributeWithValidators.Int64Validators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithListValidators:
+		for _, v := range attributeWithValidators.ListValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithMapValidators:
+		for _, v := range attributeWithValidators.MapValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithNumberValidators:
+		for _, v := range attributeWithValidators.NumberValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithObjectValidators:
+		for _, v := range attributeWithValidators.ObjectValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithSetValidators:
+		for _, v := range attributeWithValidators.SetValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithStringValidators:
+		for _, v := range attributeWithValidators.StringValidators() {
+			validators = append(validators, v)
+		}
+	case fwxschema.AttributeWithTupleValidators:
+		for _, v := range attributeWithValidators.TupleValidators() {
+			validators = append(validators, v)
+		}
+	}
+
+	return validators
+}
+
+// objectValidators returns the object validators as a slice of any.
+func objectValidators(objectValidators []validator.Object) []any {
+	validators := make([]any, 0, len(objectValidators))
+
+	for _, v := range objectValidators {
+		validators = append(validators, v)
+	}
+
+	return validators
+}
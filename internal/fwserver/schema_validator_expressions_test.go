@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaValidatorExpressions(t *testing.T) {
+	t.Parallel()
+
+	testStringValidator := func(expressions ...path.Expression) validator.String {
+		return testvalidator.StringWithExpressions{
+			ExpressionsMethod: func(_ context.Context) path.Expressions {
+				return expressions
+			},
+		}
+	}
+
+	testObjectValidator := func(expressions ...path.Expression) validator.Object {
+		return testvalidator.ObjectWithExpressions{
+			ExpressionsMethod: func(_ context.Context) path.Expressions {
+				return expressions
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected diag.Diagnostics
+	}{
+		"no-validators": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"validator-without-expressions": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testvalidator.String{},
+						},
+					},
+				},
+			},
+		},
+		"root-expression": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testStringValidator(path.MatchRoot("test_other")),
+						},
+					},
+					"test_other": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"root-expression-invalid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testStringValidator(path.MatchRoot("test_othr")),
+						},
+					},
+					"test_other": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Path Expression",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a validator with a path expression which does not resolve to an attribute or block in the schema.\n\n"+
+						"Path Expression: test_othr\n"+
+						"Error: cannot apply step test_othr: could not find attribute or block \"test_othr\" in schema",
+				),
+			},
+		},
+		"implementation-error": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testvalidator.StringWithImplementationError{
+								ImplementationErrorMethod: func(_ context.Context) error {
+									return errors.New("test implementation error")
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a validator which was created with invalid arguments.\n\n"+
+						"Error: test implementation error",
+				),
+			},
+		},
+		"relative-expression-nested-attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										testStringValidator(path.MatchRelative().AtParent().AtName("nested_other")),
+									},
+								},
+								"nested_other": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+		"relative-expression-nested-attribute-invalid": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+									Validators: []validator.String{
+										testStringValidator(path.MatchRelative().AtParent().AtName("nested_othr")),
+									},
+								},
+								"nested_other": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Path Expression",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr[*].nested_attr\" has a validator with a path expression which does not resolve to an attribute or block in the schema.\n\n"+
+						"Path Expression: test_attr[*].nested_attr.<.nested_othr\n"+
+						"Error: cannot apply step nested_othr: no attribute \"nested_othr\" on NestedAttributeObject",
+				),
+			},
+		},
+		"relative-expression-beyond-root": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testStringValidator(path.MatchRelative().AtParent().AtParent().AtName("test_other")),
+						},
+					},
+					"test_other": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Path Expression",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a validator with a path expression which does not resolve to an attribute or block in the schema.\n\n"+
+						"Path Expression: test_attr.<.<.test_other\n"+
+						"Error: parent step goes beyond the schema root",
+				),
+			},
+		},
+		"expression-inside-dynamic": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testStringValidator(path.MatchRoot("test_dynamic").AtName("anything")),
+						},
+					},
+					"test_dynamic": schema.DynamicAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"expression-inside-primitive": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testStringValidator(path.MatchRoot("test_other").AtName("nested")),
+						},
+					},
+					"test_other": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Path Expression",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_attr\" has a validator with a path expression which does not resolve to an attribute or block in the schema.\n\n"+
+						"Path Expression: test_other.nested\n"+
+						"Error: cannot apply step nested: cannot apply AttributePathStep tftypes.AttributeName to basetypes.StringType",
+				),
+			},
+		},
+		"element-expression": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							testStringValidator(
+								path.MatchRoot("test_list").AtAnyListIndex(),
+								path.MatchRoot("test_map").AtMapKey("key"),
+								path.MatchRoot("test_set").AtAnySetValue(),
+							),
+						},
+					},
+					"test_list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"test_map": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"test_set": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"block-nested-object-validator": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+							Validators: []validator.Object{
+								testObjectValidator(
+									path.MatchRelative().AtName("nested_attr"),
+									path.MatchRelative().AtName("nested_missing"),
+								),
+							},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Validator Path Expression",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test_block[*]\" has a validator with a path expression which does not resolve to an attribute or block in the schema.\n\n"+
+						"Path Expression: test_block[*].nested_missing\n"+
+						"Error: cannot apply step nested_missing: no attribute or block \"nested_missing\" on NestedBlockObject",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwserver.SchemaValidatorExpressions(context.Background(), testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
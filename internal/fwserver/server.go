@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -28,11 +29,36 @@ type Server struct {
 	// to [datasource.ConfigureRequest.ProviderData].
 	DataSourceConfigureData any
 
+	// EphemeralResourceConfigureData is the
+	// [provider.ConfigureResponse.EphemeralResourceData] field value which is
+	// passed to [ephemeral.ConfigureRequest.ProviderData].
+	EphemeralResourceConfigureData any
+
+	// FunctionConfigureData is the
+	// [provider.ConfigureResponse.FunctionData] field value which is passed
+	// to [function.ConfigureRequest.ProviderData].
+	FunctionConfigureData any
+
 	// ResourceConfigureData is the
 	// [provider.ConfigureResponse.ResourceData] field value which is passed
 	// to [resource.ConfigureRequest.ProviderData].
 	ResourceConfigureData any
 
+	// CollectAllDiagnostics enables best-effort validation and planning,
+	// which continues past recoverable error diagnostics to return as many
+	// diagnostics as possible in one request. This is intended for tooling,
+	// such as acceptance testing in CI, and should not be enabled for normal
+	// provider usage.
+	//
+	// Recoverable errors are those scoped to individual attributes or
+	// provider-defined validation logic, such as resource
+	// ValidateConfiguredConfig, conditional attribute, attribute plan
+	// modifier, and resource config validator errors when the resource
+	// implements ResourceWithConfigValidatorsStopOnError. Fatal errors, such as failing to decode the whole
+	// configuration, plan, or state, or failing to configure the resource,
+	// always stop the request.
+	CollectAllDiagnostics bool
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
@@ -56,6 +82,30 @@ type Server struct {
 	// access from race conditions.
 	dataSourceTypesMutex sync.Mutex
 
+	// ephemeralResourceSchemas is the cached EphemeralResource Schemas for
+	// RPCs that need to convert configuration data from the protocol. If not
+	// found, it will be fetched from the EphemeralResource.Schema() method.
+	ephemeralResourceSchemas map[string]fwschema.Schema
+
+	// ephemeralResourceSchemasMutex is a mutex to protect concurrent
+	// ephemeralResourceSchemas access from race conditions.
+	ephemeralResourceSchemasMutex sync.RWMutex
+
+	// ephemeralResourceFuncs is the cached EphemeralResource functions for
+	// RPCs that need to access ephemeral resources. If not found, it will be
+	// fetched from the Provider.EphemeralResources() method.
+	ephemeralResourceFuncs map[string]func() ephemeral.EphemeralResource
+
+	// ephemeralResourceTypesDiags is the cached Diagnostics obtained while
+	// populating ephemeralResourceFuncs. This is to ensure any warnings or
+	// errors are also returned appropriately when fetching
+	// ephemeralResourceFuncs.
+	ephemeralResourceTypesDiags diag.Diagnostics
+
+	// ephemeralResourceTypesMutex is a mutex to protect concurrent
+	// ephemeralResourceFuncs access from race conditions.
+	ephemeralResourceTypesMutex sync.Mutex
+
 	// functionDefinitions is the cached Function Definitions for RPCs that need to
 	// convert data from the protocol. If not found, it will be fetched from the
 	// Function.Definition() method.
@@ -65,6 +115,21 @@ type Server struct {
 	// access from race conditions.
 	functionDefinitionsMutex sync.RWMutex
 
+	// functionDefinitionsAll is the cached validated Function Definitions
+	// of all functions for the GetFunctions and GetProviderSchema RPCs, if
+	// the provider implements ProviderWithFunctionsCacheKey.
+	functionDefinitionsAll map[string]function.Definition
+
+	// functionDefinitionsAllDiags is the cached Diagnostics obtained while
+	// populating functionDefinitionsAll.
+	functionDefinitionsAllDiags diag.Diagnostics
+
+	// functionDefinitionsGeneration is incremented each time the function
+	// caches are invalidated, which prevents caching definitions which were
+	// populated concurrently with an invalidation. It is protected by
+	// functionDefinitionsMutex.
+	functionDefinitionsGeneration uint64
+
 	// functionFuncs is the cached Function functions for RPCs that need to
 	// access functions. If not found, it will be fetched from the
 	// Provider.Functions() method.
@@ -75,10 +140,22 @@ type Server struct {
 	// returned appropriately when fetching functionFuncs.
 	functionFuncsDiags diag.Diagnostics
 
+	// functionFuncsCacheKey is the key returned by the provider
+	// FunctionsCacheKey method when functionFuncs was populated, if the
+	// provider implements ProviderWithFunctionsCacheKey.
+	functionFuncsCacheKey string
+
 	// functionFuncsMutex is a mutex to protect concurrent functionFuncs
 	// access from race conditions.
 	functionFuncsMutex sync.Mutex
 
+	// instanceID is the generated identifier for this provider instance,
+	// which is passed to [provider.ConfigureRequest.InstanceID].
+	instanceID string
+
+	// instanceIDOnce ensures instanceID is only generated once.
+	instanceIDOnce sync.Once
+
 	// providerSchema is the cached Provider Schema for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the Provider.GetSchema() method.
@@ -107,14 +184,36 @@ type Server struct {
 	// access from race conditions.
 	providerMetaSchemaMutex sync.Mutex
 
+	// providerPreferredProtocolVersion is the cached preferred protocol
+	// version of the provider, if the provider implemented the Metadata
+	// method. Access this field with the ProviderPreferredProtocolVersion()
+	// method.
+	providerPreferredProtocolVersion int
+
 	// providerTypeName is the cached type name of the provider, if the provider
 	// implemented the Metadata method. Access this field with the Provider.ProviderTypeName() method.
 	providerTypeName string
 
 	// providerTypeNameMutex is a mutex to protect concurrent providerTypeName
-	// access from race conditions.
+	// and providerPreferredProtocolVersion access from race conditions.
 	providerTypeNameMutex sync.Mutex
 
+	// resourceIdentitySchemas is the cached Resource Identity Schemas for
+	// resources which implement the ResourceWithIdentity interface.
+	resourceIdentitySchemas map[string]fwschema.Schema
+
+	// resourceIdentitySchemasMutex is a mutex to protect concurrent
+	// resourceIdentitySchemas access from race conditions.
+	resourceIdentitySchemasMutex sync.RWMutex
+
+	// resourceMetaSchemas is the cached Resource Meta Schemas for resources
+	// which implement the ResourceWithMetaSchema interface.
+	resourceMetaSchemas map[string]fwschema.Schema
+
+	// resourceMetaSchemasMutex is a mutex to protect concurrent
+	// resourceMetaSchemas access from race conditions.
+	resourceMetaSchemasMutex sync.RWMutex
+
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the ResourceType.GetSchema() method.
@@ -302,6 +401,8 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 
 		validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
 
+		validateDiags.Append(SchemaValidatorExpressions(ctx, schemaResp.Schema)...)
+
 		diags.Append(validateDiags...)
 
 		if validateDiags.HasError() {
@@ -314,14 +415,210 @@ func (s *Server) DataSourceSchemas(ctx context.Context) (map[string]fwschema.Sch
 	return dataSourceSchemas, diags
 }
 
+// EphemeralResource returns the EphemeralResource for a given type name.
+func (s *Server) EphemeralResource(ctx context.Context, typeName string) (ephemeral.EphemeralResource, diag.Diagnostics) {
+	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
+
+	ephemeralResourceFunc, ok := ephemeralResourceFuncs[typeName]
+
+	if !ok {
+		diags.AddError(
+			"Ephemeral Resource Type Not Found",
+			fmt.Sprintf("No ephemeral resource type named %q was found in the provider.", typeName),
+		)
+
+		return nil, diags
+	}
+
+	return ephemeralResourceFunc(), diags
+}
+
+// EphemeralResourceFuncs returns a map of EphemeralResource functions. The
+// results are cached on first use.
+func (s *Server) EphemeralResourceFuncs(ctx context.Context) (map[string]func() ephemeral.EphemeralResource, diag.Diagnostics) {
+	logging.FrameworkTrace(ctx, "Checking EphemeralResourceTypes lock")
+	s.ephemeralResourceTypesMutex.Lock()
+	defer s.ephemeralResourceTypesMutex.Unlock()
+
+	if s.ephemeralResourceFuncs != nil {
+		return s.ephemeralResourceFuncs, s.ephemeralResourceTypesDiags
+	}
+
+	s.ephemeralResourceFuncs = make(map[string]func() ephemeral.EphemeralResource)
+
+	provider, ok := s.Provider.(provider.ProviderWithEphemeralResources)
+
+	if !ok {
+		// Only ephemeral resource specific RPCs should return diagnostics
+		// about the provider not implementing ephemeral resources or missing
+		// ephemeral resources.
+		return s.ephemeralResourceFuncs, s.ephemeralResourceTypesDiags
+	}
+
+	providerTypeName := s.ProviderTypeName(ctx)
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider EphemeralResources")
+	ephemeralResourceFuncsSlice := provider.EphemeralResources(ctx)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider EphemeralResources")
+
+	for _, ephemeralResourceFunc := range ephemeralResourceFuncsSlice {
+		ephemeralResource := ephemeralResourceFunc()
+
+		ephemeralResourceTypeNameReq := ephemeral.MetadataRequest{
+			ProviderTypeName: providerTypeName,
+		}
+		ephemeralResourceTypeNameResp := ephemeral.MetadataResponse{}
+
+		ephemeralResource.Metadata(ctx, ephemeralResourceTypeNameReq, &ephemeralResourceTypeNameResp)
+
+		if ephemeralResourceTypeNameResp.TypeName == "" {
+			s.ephemeralResourceTypesDiags.AddError(
+				"Ephemeral Resource Type Name Missing",
+				fmt.Sprintf("The %T EphemeralResource returned an empty string from the Metadata method. ", ephemeralResource)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		logging.FrameworkTrace(ctx, "Found ephemeral resource type", map[string]interface{}{logging.KeyEphemeralResourceType: ephemeralResourceTypeNameResp.TypeName})
+
+		if _, ok := s.ephemeralResourceFuncs[ephemeralResourceTypeNameResp.TypeName]; ok {
+			s.ephemeralResourceTypesDiags.AddError(
+				"Duplicate Ephemeral Resource Type Defined",
+				fmt.Sprintf("The %s ephemeral resource type name was returned for multiple ephemeral resources. ", ephemeralResourceTypeNameResp.TypeName)+
+					"Ephemeral resource type names must be unique. "+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+			continue
+		}
+
+		s.ephemeralResourceFuncs[ephemeralResourceTypeNameResp.TypeName] = ephemeralResourceFunc
+	}
+
+	return s.ephemeralResourceFuncs, s.ephemeralResourceTypesDiags
+}
+
+// EphemeralResourceMetadatas returns a slice of EphemeralResourceMetadata for
+// the GetMetadata RPC.
+func (s *Server) EphemeralResourceMetadatas(ctx context.Context) ([]EphemeralResourceMetadata, diag.Diagnostics) {
+	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
+
+	ephemeralResourceMetadatas := make([]EphemeralResourceMetadata, 0, len(ephemeralResourceFuncs))
+
+	for typeName := range ephemeralResourceFuncs {
+		ephemeralResourceMetadatas = append(ephemeralResourceMetadatas, EphemeralResourceMetadata{
+			TypeName: typeName,
+		})
+	}
+
+	return ephemeralResourceMetadatas, diags
+}
+
+// EphemeralResourceSchema returns the EphemeralResource Schema for the given
+// type name and caches the result for later EphemeralResource operations.
+func (s *Server) EphemeralResourceSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
+	s.ephemeralResourceSchemasMutex.RLock()
+	ephemeralResourceSchema, ok := s.ephemeralResourceSchemas[typeName]
+	s.ephemeralResourceSchemasMutex.RUnlock()
+
+	if ok {
+		return ephemeralResourceSchema, nil
+	}
+
+	var diags diag.Diagnostics
+
+	ephemeralResource, ephemeralResourceDiags := s.EphemeralResource(ctx, typeName)
+
+	diags.Append(ephemeralResourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schemaReq := ephemeral.SchemaRequest{}
+	schemaResp := ephemeral.SchemaResponse{}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined EphemeralResource Schema method", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+	ephemeralResource.Schema(ctx, schemaReq, &schemaResp)
+	logging.FrameworkTrace(ctx, "Called provider defined EphemeralResource Schema method", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return schemaResp.Schema, diags
+	}
+
+	s.ephemeralResourceSchemasMutex.Lock()
+
+	if s.ephemeralResourceSchemas == nil {
+		s.ephemeralResourceSchemas = make(map[string]fwschema.Schema)
+	}
+
+	s.ephemeralResourceSchemas[typeName] = schemaResp.Schema
+
+	s.ephemeralResourceSchemasMutex.Unlock()
+
+	return schemaResp.Schema, diags
+}
+
+// EphemeralResourceSchemas returns a map of EphemeralResource Schemas for the
+// GetProviderSchema RPC without caching since not all schemas are guaranteed
+// to be necessary for later provider operations. The schema implementations
+// are also validated.
+func (s *Server) EphemeralResourceSchemas(ctx context.Context) (map[string]fwschema.Schema, diag.Diagnostics) {
+	ephemeralResourceSchemas := make(map[string]fwschema.Schema)
+
+	ephemeralResourceFuncs, diags := s.EphemeralResourceFuncs(ctx)
+
+	for typeName, ephemeralResourceFunc := range ephemeralResourceFuncs {
+		ephemeralResource := ephemeralResourceFunc()
+
+		schemaReq := ephemeral.SchemaRequest{}
+		schemaResp := ephemeral.SchemaResponse{}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined EphemeralResource Schema", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+		ephemeralResource.Schema(ctx, schemaReq, &schemaResp)
+		logging.FrameworkTrace(ctx, "Called provider defined EphemeralResource Schema", map[string]interface{}{logging.KeyEphemeralResourceType: typeName})
+
+		diags.Append(schemaResp.Diagnostics...)
+
+		if schemaResp.Diagnostics.HasError() {
+			continue
+		}
+
+		validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
+
+		validateDiags.Append(SchemaValidatorExpressions(ctx, schemaResp.Schema)...)
+
+		diags.Append(validateDiags...)
+
+		if validateDiags.HasError() {
+			continue
+		}
+
+		ephemeralResourceSchemas[typeName] = schemaResp.Schema
+	}
+
+	return ephemeralResourceSchemas, diags
+}
+
 // ProviderTypeName returns the TypeName associated with the Provider. The TypeName is cached on first use.
 func (s *Server) ProviderTypeName(ctx context.Context) string {
 	logging.FrameworkTrace(ctx, "Checking ProviderTypeName lock")
 	s.providerTypeNameMutex.Lock()
 	defer s.providerTypeNameMutex.Unlock()
 
+	s.providerMetadata(ctx)
+
+	return s.providerTypeName
+}
+
+// providerMetadata calls the provider Metadata method and caches the
+// response, if a provider type name was not already cached. Callers must hold
+// the providerTypeNameMutex lock.
+func (s *Server) providerMetadata(ctx context.Context) {
 	if s.providerTypeName != "" {
-		return s.providerTypeName
+		return
 	}
 
 	metadataReq := provider.MetadataRequest{}
@@ -331,9 +628,8 @@ func (s *Server) ProviderTypeName(ctx context.Context) string {
 	s.Provider.Metadata(ctx, metadataReq, &metadataResp)
 	logging.FrameworkTrace(ctx, "Called provider defined Provider Metadata")
 
+	s.providerPreferredProtocolVersion = metadataResp.PreferredProtocolVersion
 	s.providerTypeName = metadataResp.TypeName
-
-	return s.providerTypeName
 }
 
 // ProviderSchema returns the Schema associated with the Provider. The Schema
@@ -358,6 +654,7 @@ func (s *Server) ProviderSchema(ctx context.Context) (fwschema.Schema, diag.Diag
 	s.providerSchemaDiags = schemaResp.Diagnostics
 
 	s.providerSchemaDiags.Append(schemaResp.Schema.ValidateImplementation(ctx)...)
+	s.providerSchemaDiags.Append(SchemaValidatorExpressions(ctx, schemaResp.Schema)...)
 
 	return s.providerSchema, s.providerSchemaDiags
 }
@@ -485,6 +782,180 @@ func (s *Server) ResourceMetadatas(ctx context.Context) ([]ResourceMetadata, dia
 	return resourceMetadatas, diags
 }
 
+// ResourceMetaSchema returns the Meta Schema for the given resource type name.
+// If the Resource implements the ResourceWithMetaSchema interface, that Schema
+// takes precedence, otherwise the Provider Meta Schema is returned. Resource
+// Meta Schemas are verified against the Provider Meta Schema and cached on
+// first use. Protocol data must still be decoded with the Provider Meta
+// Schema, then converted via ResourceProviderMeta.
+func (s *Server) ResourceMetaSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
+	s.resourceMetaSchemasMutex.RLock()
+	resourceMetaSchema, ok := s.resourceMetaSchemas[typeName]
+	s.resourceMetaSchemasMutex.RUnlock()
+
+	if ok {
+		return resourceMetaSchema, nil
+	}
+
+	var diags diag.Diagnostics
+
+	r, resourceDiags := s.Resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	resourceWithMetaSchema, ok := r.(resource.ResourceWithMetaSchema)
+
+	if !ok {
+		providerMetaSchema, providerMetaSchemaDiags := s.ProviderMetaSchema(ctx)
+
+		diags.Append(providerMetaSchemaDiags...)
+
+		return providerMetaSchema, diags
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithMetaSchema", map[string]interface{}{logging.KeyResourceType: typeName})
+
+	metaSchemaReq := resource.MetaSchemaRequest{}
+	metaSchemaResp := resource.MetaSchemaResponse{}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource MetaSchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+	resourceWithMetaSchema.MetaSchema(ctx, metaSchemaReq, &metaSchemaResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource MetaSchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+
+	diags.Append(metaSchemaResp.Diagnostics...)
+	diags.Append(metaSchemaResp.Schema.ValidateImplementation(ctx)...)
+
+	if diags.HasError() {
+		return metaSchemaResp.Schema, diags
+	}
+
+	providerMetaSchema, providerMetaSchemaDiags := s.ProviderMetaSchema(ctx)
+
+	diags.Append(providerMetaSchemaDiags...)
+	diags.Append(resourceMetaSchemaCompatible(ctx, typeName, metaSchemaResp.Schema, providerMetaSchema)...)
+
+	if diags.HasError() {
+		return metaSchemaResp.Schema, diags
+	}
+
+	s.resourceMetaSchemasMutex.Lock()
+
+	if s.resourceMetaSchemas == nil {
+		s.resourceMetaSchemas = make(map[string]fwschema.Schema)
+	}
+
+	s.resourceMetaSchemas[typeName] = metaSchemaResp.Schema
+
+	s.resourceMetaSchemasMutex.Unlock()
+
+	return metaSchemaResp.Schema, diags
+}
+
+// ResourceIdentitySchema returns the Resource Identity Schema for the given
+// type name and caches the result for later Resource operations. A nil schema
+// is returned if the resource does not implement the ResourceWithIdentity
+// interface.
+func (s *Server) ResourceIdentitySchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
+	s.resourceIdentitySchemasMutex.RLock()
+	identitySchema, ok := s.resourceIdentitySchemas[typeName]
+	s.resourceIdentitySchemasMutex.RUnlock()
+
+	if ok {
+		return identitySchema, nil
+	}
+
+	var diags diag.Diagnostics
+
+	r, resourceDiags := s.Resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	identitySchema, identitySchemaDiags := resourceIdentitySchema(ctx, typeName, r)
+
+	diags.Append(identitySchemaDiags...)
+
+	if diags.HasError() {
+		return identitySchema, diags
+	}
+
+	s.resourceIdentitySchemasMutex.Lock()
+
+	if s.resourceIdentitySchemas == nil {
+		s.resourceIdentitySchemas = make(map[string]fwschema.Schema)
+	}
+
+	s.resourceIdentitySchemas[typeName] = identitySchema
+
+	s.resourceIdentitySchemasMutex.Unlock()
+
+	return identitySchema, diags
+}
+
+// ResourceIdentitySchemas returns a map of Resource Identity Schemas for the
+// GetResourceIdentitySchemas RPC without caching since not all schemas are
+// guaranteed to be necessary for later provider operations. Only resources
+// which implement the ResourceWithIdentity interface are included. The schema
+// implementations are also validated.
+func (s *Server) ResourceIdentitySchemas(ctx context.Context) (map[string]fwschema.Schema, diag.Diagnostics) {
+	identitySchemas := make(map[string]fwschema.Schema)
+
+	resourceFuncs, diags := s.ResourceFuncs(ctx)
+
+	for typeName, resourceFunc := range resourceFuncs {
+		identitySchema, identitySchemaDiags := resourceIdentitySchema(ctx, typeName, resourceFunc())
+
+		diags.Append(identitySchemaDiags...)
+
+		if identitySchemaDiags.HasError() || identitySchema == nil {
+			continue
+		}
+
+		identitySchemas[typeName] = identitySchema
+	}
+
+	return identitySchemas, diags
+}
+
+// resourceIdentitySchema returns the validated Resource Identity Schema of
+// the given resource or nil if the resource does not implement the
+// ResourceWithIdentity interface.
+func resourceIdentitySchema(ctx context.Context, typeName string, r resource.Resource) (fwschema.Schema, diag.Diagnostics) {
+	resourceWithIdentity, ok := r.(resource.ResourceWithIdentity)
+
+	if !ok {
+		return nil, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithIdentity", map[string]interface{}{logging.KeyResourceType: typeName})
+
+	var diags diag.Diagnostics
+
+	identitySchemaReq := resource.IdentitySchemaRequest{}
+	identitySchemaResp := resource.IdentitySchemaResponse{}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource IdentitySchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+	resourceWithIdentity.IdentitySchema(ctx, identitySchemaReq, &identitySchemaResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource IdentitySchema method", map[string]interface{}{logging.KeyResourceType: typeName})
+
+	diags.Append(identitySchemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return identitySchemaResp.IdentitySchema, diags
+	}
+
+	diags.Append(identitySchemaResp.IdentitySchema.ValidateImplementation(ctx)...)
+
+	return identitySchemaResp.IdentitySchema, diags
+}
+
 // ResourceSchema returns the Resource Schema for the given type name and
 // caches the result for later Resource operations.
 func (s *Server) ResourceSchema(ctx context.Context, typeName string) (fwschema.Schema, diag.Diagnostics) {
@@ -559,6 +1030,9 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 
 		validateDiags := schemaResp.Schema.ValidateImplementation(ctx)
 
+		validateDiags.Append(SchemaValidatorExpressions(ctx, schemaResp.Schema)...)
+		validateDiags.Append(SchemaDefaultValidation(ctx, schemaResp.Schema)...)
+
 		diags.Append(validateDiags...)
 
 		if validateDiags.HasError() {
@@ -570,3 +1044,20 @@ func (s *Server) ResourceSchemas(ctx context.Context) (map[string]fwschema.Schem
 
 	return resourceSchemas, diags
 }
+
+// stopOnRecoverableError returns true if the given diagnostics contain an
+// error and the server should stop processing the request. If
+// CollectAllDiagnostics is enabled, recoverable errors do not stop the request.
+func (s *Server) stopOnRecoverableError(ctx context.Context, diags diag.Diagnostics) bool {
+	if !diags.HasError() {
+		return false
+	}
+
+	if !s.CollectAllDiagnostics {
+		return true
+	}
+
+	logging.FrameworkDebug(ctx, "Continuing past recoverable error diagnostics as CollectAllDiagnostics is enabled")
+
+	return false
+}
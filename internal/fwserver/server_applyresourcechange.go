@@ -17,19 +17,21 @@ import (
 // ApplyResourceChangeRequest is the framework server request for the
 // ApplyResourceChange RPC.
 type ApplyResourceChangeRequest struct {
-	Config         *tfsdk.Config
-	PlannedPrivate *privatestate.Data
-	PlannedState   *tfsdk.Plan
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	Config          *tfsdk.Config
+	PlannedIdentity *tfsdk.ResourceIdentity
+	PlannedPrivate  *privatestate.Data
+	PlannedState    *tfsdk.Plan
+	PriorState      *tfsdk.State
+	ProviderMeta    *tfsdk.Config
+	ResourceSchema  fwschema.Schema
+	Resource        resource.Resource
 }
 
 // ApplyResourceChangeResponse is the framework server response for the
 // ApplyResourceChange RPC.
 type ApplyResourceChangeResponse struct {
 	Diagnostics diag.Diagnostics
+	NewIdentity *tfsdk.ResourceIdentity
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
 }
@@ -45,18 +47,20 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PriorState, running CreateResource")
 
 		createReq := &CreateResourceRequest{
-			Config:         req.Config,
-			PlannedPrivate: req.PlannedPrivate,
-			PlannedState:   req.PlannedState,
-			ProviderMeta:   req.ProviderMeta,
-			ResourceSchema: req.ResourceSchema,
-			Resource:       req.Resource,
+			Config:          req.Config,
+			PlannedIdentity: req.PlannedIdentity,
+			PlannedPrivate:  req.PlannedPrivate,
+			PlannedState:    req.PlannedState,
+			ProviderMeta:    req.ProviderMeta,
+			ResourceSchema:  req.ResourceSchema,
+			Resource:        req.Resource,
 		}
 		createResp := &CreateResourceResponse{}
 
 		s.CreateResource(ctx, createReq, createResp)
 
 		resp.Diagnostics = createResp.Diagnostics
+		resp.NewIdentity = createResp.NewIdentity
 		resp.NewState = createResp.NewState
 		resp.Private = createResp.Private
 
@@ -68,11 +72,12 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 		logging.FrameworkTrace(ctx, "ApplyResourceChange received no PlannedState, running DeleteResource")
 
 		deleteReq := &DeleteResourceRequest{
-			PlannedPrivate: req.PlannedPrivate,
-			PriorState:     req.PriorState,
-			ProviderMeta:   req.ProviderMeta,
-			ResourceSchema: req.ResourceSchema,
-			Resource:       req.Resource,
+			PlannedIdentity: req.PlannedIdentity,
+			PlannedPrivate:  req.PlannedPrivate,
+			PriorState:      req.PriorState,
+			ProviderMeta:    req.ProviderMeta,
+			ResourceSchema:  req.ResourceSchema,
+			Resource:        req.Resource,
 		}
 		deleteResp := &DeleteResourceResponse{}
 
@@ -89,19 +94,21 @@ func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChan
 	logging.FrameworkTrace(ctx, "ApplyResourceChange running UpdateResource")
 
 	updateReq := &UpdateResourceRequest{
-		Config:         req.Config,
-		PlannedPrivate: req.PlannedPrivate,
-		PlannedState:   req.PlannedState,
-		PriorState:     req.PriorState,
-		ProviderMeta:   req.ProviderMeta,
-		ResourceSchema: req.ResourceSchema,
-		Resource:       req.Resource,
+		Config:          req.Config,
+		PlannedIdentity: req.PlannedIdentity,
+		PlannedPrivate:  req.PlannedPrivate,
+		PlannedState:    req.PlannedState,
+		PriorState:      req.PriorState,
+		ProviderMeta:    req.ProviderMeta,
+		ResourceSchema:  req.ResourceSchema,
+		Resource:        req.Resource,
 	}
 	updateResp := &UpdateResourceResponse{}
 
 	s.UpdateResource(ctx, updateReq, updateResp)
 
 	resp.Diagnostics = updateResp.Diagnostics
+	resp.NewIdentity = updateResp.NewIdentity
 	resp.NewState = updateResp.NewState
 	resp.Private = updateResp.Private
 }
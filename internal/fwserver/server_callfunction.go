@@ -31,6 +31,25 @@ func (s *Server) CallFunction(ctx context.Context, req *CallFunctionRequest, res
 		return
 	}
 
+	if functionWithConfigure, ok := req.Function.(function.FunctionWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "Function implements FunctionWithConfigure")
+
+		configureReq := function.ConfigureRequest{
+			ProviderData: s.FunctionConfigureData,
+		}
+		configureResp := function.ConfigureResponse{}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined Function Configure")
+		functionWithConfigure.Configure(ctx, configureReq, &configureResp)
+		logging.FrameworkTrace(ctx, "Called provider defined Function Configure")
+
+		resp.Error = function.ConcatFuncErrors(resp.Error, configureResp.Error)
+
+		if resp.Error != nil {
+			return
+		}
+	}
+
 	resultData, err := req.FunctionDefinition.Return.NewResultData(ctx)
 
 	resp.Error = function.ConcatFuncErrors(resp.Error, err)
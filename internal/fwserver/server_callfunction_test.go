@@ -337,6 +337,115 @@ func TestServerCallFunction(t *testing.T) {
 				Result: function.NewResultData(basetypes.NewStringUnknown()),
 			},
 		},
+		"request-configure-providerdata": {
+			server: &fwserver.Server{
+				Provider:              &testprovider.ProviderWithFunctions{},
+				FunctionConfigureData: "test-provider-data",
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				Function: func() function.Function {
+					var providerData string
+
+					return &testprovider.FunctionWithConfigure{
+						ConfigureMethod: func(ctx context.Context, req function.ConfigureRequest, resp *function.ConfigureResponse) {
+							providerData, _ = req.ProviderData.(string)
+						},
+						Function: &testprovider.Function{
+							RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+								resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, providerData))
+							},
+						},
+					}
+				}(),
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Error:  nil,
+				Result: function.NewResultData(basetypes.NewStringValue("test-provider-data")),
+			},
+		},
+		"request-configure-providerdata-nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFunctions{},
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				Function: &testprovider.FunctionWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req function.ConfigureRequest, resp *function.ConfigureResponse) {
+						if req.ProviderData != nil {
+							resp.Error = function.NewFuncError(fmt.Sprintf("unexpected provider data: %v", req.ProviderData))
+						}
+					},
+					Function: &testprovider.Function{
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, "result"))
+						},
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Error:  nil,
+				Result: function.NewResultData(basetypes.NewStringValue("result")),
+			},
+		},
+		"request-configure-providerdata-resource-data": {
+			server: &fwserver.Server{
+				Provider:              &testprovider.ProviderWithFunctions{},
+				ResourceConfigureData: "test-resource-data",
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				Function: &testprovider.FunctionWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req function.ConfigureRequest, resp *function.ConfigureResponse) {
+						if req.ProviderData != nil {
+							resp.Error = function.NewFuncError(fmt.Sprintf("unexpected provider data: %v", req.ProviderData))
+						}
+					},
+					Function: &testprovider.Function{
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, "result"))
+						},
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Error:  nil,
+				Result: function.NewResultData(basetypes.NewStringValue("result")),
+			},
+		},
+		"response-configure-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithFunctions{},
+			},
+			request: &fwserver.CallFunctionRequest{
+				Arguments: function.NewArgumentsData(nil),
+				Function: &testprovider.FunctionWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req function.ConfigureRequest, resp *function.ConfigureResponse) {
+						resp.Error = function.NewFuncError("error summary: error detail")
+					},
+					Function: &testprovider.Function{
+						RunMethod: func(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+							resp.Error = function.NewFuncError("Run should not be called")
+						},
+					},
+				},
+				FunctionDefinition: function.Definition{
+					Return: function.StringReturn{},
+				},
+			},
+			expectedResponse: &fwserver.CallFunctionResponse{
+				Error: function.NewFuncError("error summary: error detail"),
+			},
+		},
 		"response-result": {
 			server: &fwserver.Server{
 				Provider: &testprovider.ProviderWithFunctions{},
@@ -5,23 +5,81 @@ package fwserver
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 )
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
+//
+// Each call fully replaces the provider data from any prior call, so data
+// sources, ephemeral resources, resources, and functions always receive the
+// data from the latest provider configuration. Provider data is cleared
+// before any validation or provider logic runs, so it is not carried over
+// from a prior call when this call returns error diagnostics.
 func (s *Server) ConfigureProvider(ctx context.Context, req *provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-	logging.FrameworkTrace(ctx, "Calling provider defined Provider Configure")
+	s.DataSourceConfigureData = nil
+	s.EphemeralResourceConfigureData = nil
+	s.FunctionConfigureData = nil
+	s.ResourceConfigureData = nil
+
+	// Provider defined config validation is run again before Configure, so
+	// error diagnostics always prevent Configure from being called, such as
+	// when the ValidateProviderConfig RPC was skipped or the configuration
+	// has since become known. Only errors are returned, since any warnings
+	// were already returned by the ValidateProviderConfig RPC.
+	if req != nil && req.Config.Schema != nil {
+		validateDiags := s.validateProviderConfig(ctx, req.Config)
+
+		if validateDiags.HasError() {
+			resp.Diagnostics.Append(validateDiags.Errors()...)
+
+			return
+		}
+	}
+
+	configureReq := provider.ConfigureRequest{}
 
 	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
-	} else {
-		s.Provider.Configure(ctx, provider.ConfigureRequest{}, resp)
+		configureReq = *req
 	}
 
+	configureReq.InstanceID = s.InstanceID(ctx)
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider Configure")
+
+	s.Provider.Configure(ctx, configureReq, resp)
+
 	logging.FrameworkTrace(ctx, "Called provider defined Provider Configure")
 
 	s.DataSourceConfigureData = resp.DataSourceData
+	s.EphemeralResourceConfigureData = resp.EphemeralResourceData
+	s.FunctionConfigureData = resp.FunctionData
 	s.ResourceConfigureData = resp.ResourceData
 }
+
+// InstanceID returns the generated identifier for this provider server,
+// which is passed to [provider.ConfigureRequest.InstanceID]. The identifier
+// is generated on first use and is the same for every later call.
+func (s *Server) InstanceID(ctx context.Context) string {
+	s.instanceIDOnce.Do(func() {
+		b := make([]byte, 16)
+
+		if _, err := rand.Read(b); err != nil {
+			logging.FrameworkError(ctx,
+				"Unable to generate provider instance identifier",
+				map[string]any{
+					logging.KeyError: err.Error(),
+				},
+			)
+
+			return
+		}
+
+		s.instanceID = hex.EncodeToString(b)
+	})
+
+	return s.instanceID
+}
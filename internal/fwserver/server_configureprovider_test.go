@@ -8,12 +8,16 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -82,6 +86,124 @@ func TestServerConfigureProvider(t *testing.T) {
 			},
 			expectedResponse: &provider.ConfigureResponse{},
 		},
+		"request-configvalidators-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithConfigValidators{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.Diagnostics.AddError("Unexpected Configure Call", "Configure should not be called after validation errors")
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []provider.ConfigValidator {
+						return []provider.ConfigValidator{
+							&testprovider.ProviderConfigValidator{
+								ValidateProviderMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+									resp.Diagnostics.AddWarning("warning summary", "warning detail")
+									resp.Diagnostics.AddError("error summary", "error detail")
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+			},
+		},
+		"request-configvalidators-warning": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithConfigValidators{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.ResourceData = "test-provider-configure-value"
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []provider.ConfigValidator {
+						return []provider.ConfigValidator{
+							&testprovider.ProviderConfigValidator{
+								ValidateProviderMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+									// Warnings are returned by the ValidateProviderConfig RPC.
+									resp.Diagnostics.AddWarning("warning summary", "warning detail")
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				ResourceData: "test-provider-configure-value",
+			},
+		},
+		"request-validateconfig-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithValidateConfig{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.Diagnostics.AddError("Unexpected Configure Call", "Configure should not be called after validation errors")
+							resp.DataSourceData = "test-provider-configure-value"
+						},
+					},
+					ValidateConfigMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+			},
+		},
+		"request-validateconfig-error-prior-data": {
+			server: &fwserver.Server{
+				DataSourceConfigureData:        "test-prior-configure-value",
+				EphemeralResourceConfigureData: "test-prior-configure-value",
+				FunctionConfigureData:          "test-prior-configure-value",
+				ResourceConfigureData:          "test-prior-configure-value",
+				Provider: &testprovider.ProviderWithValidateConfig{
+					Provider: &testprovider.Provider{
+						SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+						ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+							resp.Diagnostics.AddError("Unexpected Configure Call", "Configure should not be called after validation errors")
+						},
+					},
+					ValidateConfigMethod: func(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{
+				Config: testConfig,
+			},
+			expectedResponse: &provider.ConfigureResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+			},
+		},
 		"request-terraformversion": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -136,6 +258,20 @@ func TestServerConfigureProvider(t *testing.T) {
 				},
 			},
 		},
+		"response-functiondata": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {},
+					ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+						resp.FunctionData = "test-provider-configure-value"
+					},
+				},
+			},
+			request: &provider.ConfigureRequest{},
+			expectedResponse: &provider.ConfigureResponse{
+				FunctionData: "test-provider-configure-value",
+			},
+		},
 		"response-resourcedata": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -169,9 +305,189 @@ func TestServerConfigureProvider(t *testing.T) {
 				t.Errorf("unexpected server.DataSourceConfigureData difference: %s", diff)
 			}
 
+			if diff := cmp.Diff(testCase.server.EphemeralResourceConfigureData, testCase.expectedResponse.EphemeralResourceData); diff != "" {
+				t.Errorf("unexpected server.EphemeralResourceConfigureData difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.server.FunctionConfigureData, testCase.expectedResponse.FunctionData); diff != "" {
+				t.Errorf("unexpected server.FunctionConfigureData difference: %s", diff)
+			}
+
 			if diff := cmp.Diff(testCase.server.ResourceConfigureData, testCase.expectedResponse.ResourceData); diff != "" {
 				t.Errorf("unexpected server.ResourceConfigureData difference: %s", diff)
 			}
 		})
 	}
 }
+
+func TestServerConfigureProvider_Reconfigure(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := func(value string) *provider.ConfigureRequest {
+		return &provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.String, value),
+				}),
+				Schema: testSchema,
+			},
+		}
+	}
+
+	testResourceSchema := resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			"test": resourceschema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testDataSourceSchema := datasourceschema.Schema{
+		Attributes: map[string]datasourceschema.Attribute{
+			"test": datasourceschema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testDataSourceConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testDataSourceSchema,
+	}
+
+	testResourceState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-state-value"),
+		}),
+		Schema: testResourceSchema,
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{
+			SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+				resp.Schema = testSchema
+			},
+			ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+				var config struct {
+					Test types.String `tfsdk:"test"`
+				}
+
+				resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+				resp.DataSourceData = config.Test.ValueString()
+				resp.EphemeralResourceData = config.Test.ValueString()
+				resp.FunctionData = config.Test.ValueString()
+				resp.ResourceData = config.Test.ValueString()
+			},
+		},
+	}
+
+	for _, expected := range []string{"test-first-value", "test-second-value"} {
+		configureResp := &provider.ConfigureResponse{}
+		server.ConfigureProvider(context.Background(), testConfig(expected), configureResp)
+
+		if diff := cmp.Diff(configureResp.Diagnostics, diag.Diagnostics(nil)); diff != "" {
+			t.Fatalf("unexpected ConfigureProvider diagnostics difference: %s", diff)
+		}
+
+		var dataSourceProviderData, resourceProviderData any
+
+		readDataSourceResp := &fwserver.ReadDataSourceResponse{}
+		server.ReadDataSource(context.Background(), &fwserver.ReadDataSourceRequest{
+			DataSource: &testprovider.DataSourceWithConfigure{
+				ConfigureMethod: func(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+					dataSourceProviderData = req.ProviderData
+				},
+				DataSource: &testprovider.DataSource{},
+			},
+			Config:           testDataSourceConfig,
+			DataSourceSchema: testDataSourceSchema,
+		}, readDataSourceResp)
+
+		if diff := cmp.Diff(dataSourceProviderData, any(expected)); diff != "" {
+			t.Errorf("unexpected data source ProviderData difference: %s", diff)
+		}
+
+		readResourceResp := &fwserver.ReadResourceResponse{}
+		server.ReadResource(context.Background(), &fwserver.ReadResourceRequest{
+			CurrentState: testResourceState,
+			Resource: &testprovider.ResourceWithConfigure{
+				ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+					resourceProviderData = req.ProviderData
+				},
+				Resource: &testprovider.Resource{},
+			},
+		}, readResourceResp)
+
+		if diff := cmp.Diff(resourceProviderData, any(expected)); diff != "" {
+			t.Errorf("unexpected resource ProviderData difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(server.EphemeralResourceConfigureData, any(expected)); diff != "" {
+			t.Errorf("unexpected server.EphemeralResourceConfigureData difference: %s", diff)
+		}
+
+		if diff := cmp.Diff(server.FunctionConfigureData, any(expected)); diff != "" {
+			t.Errorf("unexpected server.FunctionConfigureData difference: %s", diff)
+		}
+	}
+}
+
+func TestServerConfigureProvider_InstanceID(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(instanceIDs *[]string) *fwserver.Server {
+		return &fwserver.Server{
+			Provider: &testprovider.Provider{
+				ConfigureMethod: func(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+					*instanceIDs = append(*instanceIDs, req.InstanceID)
+				},
+			},
+		}
+	}
+
+	var firstInstanceIDs, secondInstanceIDs []string
+
+	firstServer := newServer(&firstInstanceIDs)
+	secondServer := newServer(&secondInstanceIDs)
+
+	firstServer.ConfigureProvider(context.Background(), nil, &provider.ConfigureResponse{})
+	firstServer.ConfigureProvider(context.Background(), &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+	secondServer.ConfigureProvider(context.Background(), &provider.ConfigureRequest{}, &provider.ConfigureResponse{})
+
+	if len(firstInstanceIDs) != 2 || len(secondInstanceIDs) != 1 {
+		t.Fatalf("unexpected Configure calls: %v, %v", firstInstanceIDs, secondInstanceIDs)
+	}
+
+	if firstInstanceIDs[0] == "" {
+		t.Fatal("expected non-empty InstanceID")
+	}
+
+	if diff := cmp.Diff(firstInstanceIDs[1], firstInstanceIDs[0]); diff != "" {
+		t.Errorf("unexpected InstanceID difference between Configure calls: %s", diff)
+	}
+
+	if diff := cmp.Diff(firstServer.InstanceID(context.Background()), firstInstanceIDs[0]); diff != "" {
+		t.Errorf("unexpected server InstanceID difference: %s", diff)
+	}
+
+	if secondInstanceIDs[0] == firstInstanceIDs[0] {
+		t.Errorf("expected different InstanceID between servers, got %s", firstInstanceIDs[0])
+	}
+}
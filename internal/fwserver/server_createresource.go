@@ -20,18 +20,20 @@ import (
 // CreateResourceRequest is the framework server request for a create request
 // with the ApplyResourceChange RPC.
 type CreateResourceRequest struct {
-	Config         *tfsdk.Config
-	PlannedPrivate *privatestate.Data
-	PlannedState   *tfsdk.Plan
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	Config          *tfsdk.Config
+	PlannedIdentity *tfsdk.ResourceIdentity
+	PlannedPrivate  *privatestate.Data
+	PlannedState    *tfsdk.Plan
+	ProviderMeta    *tfsdk.Config
+	ResourceSchema  fwschema.Schema
+	Resource        resource.Resource
 }
 
 // CreateResourceResponse is the framework server response for a create request
 // with the ApplyResourceChange RPC.
 type CreateResourceResponse struct {
 	Diagnostics diag.Diagnostics
+	NewIdentity *tfsdk.ResourceIdentity
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
 }
@@ -93,15 +95,42 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		createReq.Plan = *req.PlannedState
 	}
 
+	if req.PlannedIdentity != nil {
+		createResp.Identity = copyResourceIdentity(req.PlannedIdentity)
+	}
+
 	if req.ProviderMeta != nil {
 		createReq.ProviderMeta = *req.ProviderMeta
 	}
 
+	timeout, timeoutDiags := ResourceTimeout(ctx, req.Resource, resourceOperationCreate, fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         createReq.Config.Schema,
+		TerraformValue: createReq.Config.Raw,
+	})
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq.Timeout = timeout
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+
+		defer cancel()
+	}
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Create")
 	req.Resource.Create(ctx, createReq, &createResp)
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Create")
 
-	resp.Diagnostics = createResp.Diagnostics
+	resp.Diagnostics.Append(createResp.Diagnostics...)
+	resp.NewIdentity = createResp.Identity
 	resp.NewState = &createResp.State
 
 	if !resp.Diagnostics.HasError() && createResp.State.Raw.Equal(nullSchemaData) {
@@ -128,6 +157,23 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		resp.Private.Provider = createResp.Private
 	}
 
+	// Ensure write-only attribute values are never stored in the state. This
+	// is applied before checking for errors, since any state set by the
+	// provider is always returned to Terraform alongside error diagnostics,
+	// so partially created resources remain tracked.
+	newState, err := tftypes.Transform(resp.NewState.Raw, NullifyWriteOnlyAttributes(ctx, req.ResourceSchema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying State After Create",
+			"There was an unexpected error updating the resource state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewState.Raw = newState
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
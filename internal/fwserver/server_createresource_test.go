@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -16,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -44,6 +47,74 @@ func TestServerCreateResource(t *testing.T) {
 		},
 	}
 
+	testSchemaWithWriteOnly := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+
+	testSchemaTypeWithTimeouts := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_required": tftypes.String,
+			"timeouts": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"create": tftypes.String,
+				},
+			},
+		},
+	}
+
+	testSchemaWithTimeouts := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+			"timeouts": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testTimeoutsValue := func(create any) tftypes.Value {
+		createValue := tftypes.NewValue(tftypes.String, create)
+
+		return tftypes.NewValue(testSchemaTypeWithTimeouts, map[string]tftypes.Value{
+			"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+			"timeouts": tftypes.NewValue(testSchemaTypeWithTimeouts.AttributeTypes["timeouts"], map[string]tftypes.Value{
+				"create": createValue,
+			}),
+		})
+	}
+
+	testTimeoutsCreateMethod := func(expectedTimeout time.Duration) func(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+		return func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+			if req.Timeout != expectedTimeout {
+				resp.Diagnostics.AddError("Unexpected req.Timeout Value", "Got: "+req.Timeout.String())
+			}
+
+			if _, ok := ctx.Deadline(); !ok {
+				resp.Diagnostics.AddError("Missing Context Deadline", "Expected context deadline to be set")
+			}
+
+			resp.State.Raw = req.Plan.Raw
+		}
+	}
+
+	testTimeoutsMethod := func(_ context.Context, _ resource.TimeoutsRequest, resp *resource.TimeoutsResponse) {
+		resp.Create = 20 * time.Minute
+	}
+
 	testSchemaWithSemanticEquals := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed": schema.StringAttribute{
@@ -126,6 +197,16 @@ func TestServerCreateResource(t *testing.T) {
 
 	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
 
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testIdentityType := testIdentitySchema.Type().TerraformType(context.Background())
+
 	testEmptyPrivate := &privatestate.Data{
 		Provider: testEmptyProviderData,
 	}
@@ -263,6 +344,99 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"request-timeout-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTimeoutsValue("5m"),
+					Schema: testSchemaWithTimeouts,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTimeoutsValue("5m"),
+					Schema: testSchemaWithTimeouts,
+				},
+				ResourceSchema: testSchemaWithTimeouts,
+				Resource: &testprovider.ResourceWithTimeouts{
+					Resource: &testprovider.Resource{
+						CreateMethod: testTimeoutsCreateMethod(5 * time.Minute),
+					},
+					TimeoutsMethod: testTimeoutsMethod,
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testTimeoutsValue("5m"),
+					Schema: testSchemaWithTimeouts,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-timeout-default": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTimeoutsValue(nil),
+					Schema: testSchemaWithTimeouts,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTimeoutsValue(nil),
+					Schema: testSchemaWithTimeouts,
+				},
+				ResourceSchema: testSchemaWithTimeouts,
+				Resource: &testprovider.ResourceWithTimeouts{
+					Resource: &testprovider.Resource{
+						CreateMethod: testTimeoutsCreateMethod(20 * time.Minute),
+					},
+					TimeoutsMethod: testTimeoutsMethod,
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw:    testTimeoutsValue(nil),
+					Schema: testSchemaWithTimeouts,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-timeout-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testTimeoutsValue("invalid"),
+					Schema: testSchemaWithTimeouts,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw:    testTimeoutsValue("invalid"),
+					Schema: testSchemaWithTimeouts,
+				},
+				ResourceSchema: testSchemaWithTimeouts,
+				Resource: &testprovider.ResourceWithTimeouts{
+					Resource: &testprovider.Resource{
+						CreateMethod: func(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+							resp.Diagnostics.AddError("Unexpected Create Call", "Create should not be called with an invalid timeout")
+						},
+					},
+					TimeoutsMethod: testTimeoutsMethod,
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("timeouts").AtName("create"),
+						"Invalid Timeout Duration",
+						"The create timeout must be a duration string, such as \"30s\" or \"2h45m\". "+
+							"Valid time units are \"s\", \"m\", and \"h\".\n\n"+
+							"Error: time: invalid duration \"invalid\"",
+					),
+				},
+			},
+		},
 		"resource-configure-data": {
 			server: &fwserver.Server{
 				Provider:              &testprovider.Provider{},
@@ -433,6 +607,111 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-setfromplan": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.Append(resp.State.SetFromPlan(ctx, req.Plan)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-setfromplan-setattribute": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						resp.Diagnostics.Append(resp.State.SetFromPlan(ctx, req.Plan)...)
+						resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-computed-value")...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newidentity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				PlannedIdentity: &tfsdk.ResourceIdentity{
+					Raw:    tftypes.NewValue(testIdentityType, nil),
+					Schema: testIdentitySchema,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("test_id"), "test-newidentity-value")...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+						"test_id": tftypes.NewValue(tftypes.String, "test-newidentity-value"),
+					}),
+					Schema: testIdentitySchema,
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-newstate-null": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -506,6 +785,103 @@ func TestServerCreateResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-newstate-write-only": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				ResourceSchema: testSchemaWithWriteOnly,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						if data.TestRequired.ValueString() != "test-config-value" {
+							resp.Diagnostics.AddError("unexpected req.Config value: %s", data.TestRequired.ValueString())
+						}
+
+						data.TestComputed = types.StringValue("test-computed-value")
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-newstate-write-only-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				PlannedState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				ResourceSchema: testSchemaWithWriteOnly,
+				Resource: &testprovider.Resource{
+					CreateMethod: func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						data.TestComputed = types.StringValue("test-computed-value")
+
+						resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-computed-value"),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,11 +20,12 @@ import (
 // DeleteResourceRequest is the framework server request for a delete request
 // with the ApplyResourceChange RPC.
 type DeleteResourceRequest struct {
-	PlannedPrivate *privatestate.Data
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	PlannedIdentity *tfsdk.ResourceIdentity
+	PlannedPrivate  *privatestate.Data
+	PriorState      *tfsdk.State
+	ProviderMeta    *tfsdk.Config
+	ResourceSchema  fwschema.Schema
+	Resource        resource.Resource
 }
 
 // DeleteResourceResponse is the framework server response for a delete request
@@ -78,6 +80,10 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		deleteResp.State = *req.PriorState
 	}
 
+	if req.PlannedIdentity != nil {
+		deleteReq.Identity = copyResourceIdentity(req.PlannedIdentity)
+	}
+
 	if req.ProviderMeta != nil {
 		deleteReq.ProviderMeta = *req.ProviderMeta
 	}
@@ -96,6 +102,28 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		resp.Private = req.PlannedPrivate
 	}
 
+	timeout, timeoutDiags := ResourceTimeout(ctx, req.Resource, resourceOperationDelete, fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         deleteReq.State.Schema,
+		TerraformValue: deleteReq.State.Raw,
+	})
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteReq.Timeout = timeout
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+
+		defer cancel()
+	}
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Delete")
 	req.Resource.Delete(ctx, deleteReq, &deleteResp)
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Delete")
@@ -110,7 +138,7 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		resp.Private = nil
 	}
 
-	resp.Diagnostics = deleteResp.Diagnostics
+	resp.Diagnostics.Append(deleteResp.Diagnostics...)
 	resp.NewState = &deleteResp.State
 
 	if deleteResp.Private != nil {
@@ -35,6 +35,7 @@ func (s *Server) Function(ctx context.Context, name string) (function.Function,
 func (s *Server) FunctionDefinition(ctx context.Context, name string) (function.Definition, *function.FuncError) {
 	s.functionDefinitionsMutex.RLock()
 	functionDefinition, ok := s.functionDefinitions[name]
+	generation := s.functionDefinitionsGeneration
 	s.functionDefinitionsMutex.RUnlock()
 
 	if ok {
@@ -62,11 +63,14 @@ func (s *Server) FunctionDefinition(ctx context.Context, name string) (function.
 
 	s.functionDefinitionsMutex.Lock()
 
-	if s.functionDefinitions == nil {
-		s.functionDefinitions = make(map[string]function.Definition)
-	}
+	// Only cache if the caches were not invalidated in the meantime.
+	if generation == s.functionDefinitionsGeneration {
+		if s.functionDefinitions == nil {
+			s.functionDefinitions = make(map[string]function.Definition)
+		}
 
-	s.functionDefinitions[name] = definitionResp.Definition
+		s.functionDefinitions[name] = definitionResp.Definition
+	}
 
 	s.functionDefinitionsMutex.Unlock()
 
@@ -74,14 +78,57 @@ func (s *Server) FunctionDefinition(ctx context.Context, name string) (function.
 }
 
 // FunctionDefinitions returns a map of Function Definitions for the
-// GetProviderSchema RPC without caching since not all definitions are
-// guaranteed to be necessary for later provider operations. The definition
-// implementations are also validated.
+// GetFunctions and GetProviderSchema RPCs. The definition implementations are
+// also validated. The results are only cached if the provider implements
+// ProviderWithFunctionsCacheKey, since not all definitions are otherwise
+// guaranteed to be necessary for later provider operations.
 func (s *Server) FunctionDefinitions(ctx context.Context) (map[string]function.Definition, diag.Diagnostics) {
-	functionDefinitions := make(map[string]function.Definition)
-
 	functionFuncs, diags := s.FunctionFuncs(ctx)
 
+	_, cacheable := s.Provider.(provider.ProviderWithFunctionsCacheKey)
+
+	if !cacheable {
+		functionDefinitions, definitionsDiags := s.functionDefinitionsFromFuncs(ctx, functionFuncs)
+
+		diags.Append(definitionsDiags...)
+
+		return functionDefinitions, diags
+	}
+
+	s.functionDefinitionsMutex.RLock()
+	functionDefinitions := s.functionDefinitionsAll
+	functionDefinitionsDiags := s.functionDefinitionsAllDiags
+	generation := s.functionDefinitionsGeneration
+	s.functionDefinitionsMutex.RUnlock()
+
+	if functionDefinitions == nil {
+		logging.FrameworkTrace(ctx, "Populating cached Function Definitions")
+
+		functionDefinitions, functionDefinitionsDiags = s.functionDefinitionsFromFuncs(ctx, functionFuncs)
+
+		s.functionDefinitionsMutex.Lock()
+
+		// Only cache if the caches were not invalidated in the meantime.
+		if generation == s.functionDefinitionsGeneration {
+			s.functionDefinitionsAll = functionDefinitions
+			s.functionDefinitionsAllDiags = functionDefinitionsDiags
+		}
+
+		s.functionDefinitionsMutex.Unlock()
+	}
+
+	diags.Append(functionDefinitionsDiags...)
+
+	return functionDefinitions, diags
+}
+
+// functionDefinitionsFromFuncs returns the validated Function Definitions of
+// the given Function functions.
+func (s *Server) functionDefinitionsFromFuncs(ctx context.Context, functionFuncs map[string]func() function.Function) (map[string]function.Definition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	functionDefinitions := make(map[string]function.Definition, len(functionFuncs))
+
 	for name, functionFunc := range functionFuncs {
 		functionImpl := functionFunc()
 
@@ -119,17 +166,42 @@ func (s *Server) FunctionDefinitions(ctx context.Context) (map[string]function.D
 }
 
 // FunctionFuncs returns a map of Function functions. The results are cached
-// on first use.
+// on first use. If the provider implements ProviderWithFunctionsCacheKey, all
+// cached function data is invalidated when the cache key changes.
 func (s *Server) FunctionFuncs(ctx context.Context) (map[string]func() function.Function, diag.Diagnostics) {
 	logging.FrameworkTrace(ctx, "Checking FunctionTypes lock")
 	s.functionFuncsMutex.Lock()
 	defer s.functionFuncsMutex.Unlock()
 
+	var cacheKey string
+
+	providerWithFunctionsCacheKey, cacheable := s.Provider.(provider.ProviderWithFunctionsCacheKey)
+
+	if cacheable {
+		logging.FrameworkTrace(ctx, "Calling provider defined Provider FunctionsCacheKey")
+		cacheKey = providerWithFunctionsCacheKey.FunctionsCacheKey(ctx)
+		logging.FrameworkTrace(ctx, "Called provider defined Provider FunctionsCacheKey")
+	}
+
 	if s.functionFuncs != nil {
-		return s.functionFuncs, s.functionFuncsDiags
+		if !cacheable || cacheKey == s.functionFuncsCacheKey {
+			return s.functionFuncs, s.functionFuncsDiags
+		}
+
+		logging.FrameworkDebug(ctx, "Invalidating cached Function data due to FunctionsCacheKey change")
+
+		s.functionFuncsDiags = nil
+
+		s.functionDefinitionsMutex.Lock()
+		s.functionDefinitions = nil
+		s.functionDefinitionsAll = nil
+		s.functionDefinitionsAllDiags = nil
+		s.functionDefinitionsGeneration++
+		s.functionDefinitionsMutex.Unlock()
 	}
 
 	s.functionFuncs = make(map[string]func() function.Function)
+	s.functionFuncsCacheKey = cacheKey
 
 	provider, ok := s.Provider.(provider.ProviderWithFunctions)
 
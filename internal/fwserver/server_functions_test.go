@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServerFunctionDefinitionsCache(t *testing.T) {
+	t.Parallel()
+
+	testFunctions := func(functionsCalls, definitionCalls *atomic.Int64) *testprovider.ProviderWithFunctions {
+		return &testprovider.ProviderWithFunctions{
+			FunctionsMethod: func(_ context.Context) []func() function.Function {
+				functionsCalls.Add(1)
+
+				return []func() function.Function{
+					func() function.Function {
+						return &testprovider.Function{
+							DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+								definitionCalls.Add(1)
+
+								resp.Definition = function.Definition{
+									Return: function.StringReturn{},
+								}
+							},
+							MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+								resp.Name = "function1"
+							},
+						}
+					},
+				}
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		provider                func(functionsCalls, definitionCalls *atomic.Int64, cacheKey *atomic.Value) provider.Provider
+		changeKeyAfterCall      int
+		expectedFunctionsCalls  int64
+		expectedDefinitionCalls int64
+	}{
+		"no-cache-key": {
+			provider: func(functionsCalls, definitionCalls *atomic.Int64, _ *atomic.Value) provider.Provider {
+				return testFunctions(functionsCalls, definitionCalls)
+			},
+			expectedFunctionsCalls:  1,
+			expectedDefinitionCalls: 3,
+		},
+		"cache-key": {
+			provider: func(functionsCalls, definitionCalls *atomic.Int64, cacheKey *atomic.Value) provider.Provider {
+				return &testprovider.ProviderWithFunctionsCacheKey{
+					ProviderWithFunctions: testFunctions(functionsCalls, definitionCalls),
+					FunctionsCacheKeyMethod: func(_ context.Context) string {
+						return cacheKey.Load().(string) //nolint:forcetypeassert // always string
+					},
+				}
+			},
+			expectedFunctionsCalls:  1,
+			expectedDefinitionCalls: 1,
+		},
+		"cache-key-changed": {
+			provider: func(functionsCalls, definitionCalls *atomic.Int64, cacheKey *atomic.Value) provider.Provider {
+				return &testprovider.ProviderWithFunctionsCacheKey{
+					ProviderWithFunctions: testFunctions(functionsCalls, definitionCalls),
+					FunctionsCacheKeyMethod: func(_ context.Context) string {
+						return cacheKey.Load().(string) //nolint:forcetypeassert // always string
+					},
+				}
+			},
+			changeKeyAfterCall:      1,
+			expectedFunctionsCalls:  2,
+			expectedDefinitionCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var functionsCalls, definitionCalls atomic.Int64
+			var cacheKey atomic.Value
+
+			cacheKey.Store("key1")
+
+			server := &fwserver.Server{
+				Provider: testCase.provider(&functionsCalls, &definitionCalls, &cacheKey),
+			}
+
+			for call := 1; call <= 3; call++ {
+				resp := &fwserver.GetFunctionsResponse{}
+
+				server.GetFunctions(context.Background(), &fwserver.GetFunctionsRequest{}, resp)
+
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error diagnostics: %v", resp.Diagnostics)
+				}
+
+				if len(resp.FunctionDefinitions) != 1 {
+					t.Fatalf("expected 1 function definition, got: %d", len(resp.FunctionDefinitions))
+				}
+
+				if call == testCase.changeKeyAfterCall {
+					cacheKey.Store("key2")
+				}
+			}
+
+			if got := functionsCalls.Load(); got != testCase.expectedFunctionsCalls {
+				t.Errorf("expected %d Functions calls, got: %d", testCase.expectedFunctionsCalls, got)
+			}
+
+			if got := definitionCalls.Load(); got != testCase.expectedDefinitionCalls {
+				t.Errorf("expected %d Definition calls, got: %d", testCase.expectedDefinitionCalls, got)
+			}
+		})
+	}
+}
+
+func TestServerFunctionDefinitionsCacheConcurrent(t *testing.T) {
+	t.Parallel()
+
+	var cacheKey atomic.Int64
+
+	server := &fwserver.Server{
+		Provider: &testprovider.ProviderWithFunctionsCacheKey{
+			ProviderWithFunctions: &testprovider.ProviderWithFunctions{
+				FunctionsMethod: func(_ context.Context) []func() function.Function {
+					return []func() function.Function{
+						func() function.Function {
+							return &testprovider.Function{
+								DefinitionMethod: func(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+									resp.Definition = function.Definition{
+										Return: function.StringReturn{},
+									}
+								},
+								MetadataMethod: func(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+									resp.Name = "function1"
+								},
+							}
+						},
+					}
+				},
+			},
+			FunctionsCacheKeyMethod: func(_ context.Context) string {
+				if cacheKey.Add(1)%10 == 0 {
+					return "key2"
+				}
+
+				return "key1"
+			},
+		},
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+
+			resp := &fwserver.GetFunctionsResponse{}
+			server.GetFunctions(context.Background(), &fwserver.GetFunctionsRequest{}, resp)
+
+			if len(resp.FunctionDefinitions) != 1 {
+				t.Errorf("expected 1 function definition, got: %d", len(resp.FunctionDefinitions))
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			resp := &fwserver.GetMetadataResponse{}
+			server.GetMetadata(context.Background(), &fwserver.GetMetadataRequest{}, resp)
+
+			if len(resp.Functions) != 1 {
+				t.Errorf("expected 1 function, got: %d", len(resp.Functions))
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			if _, funcErr := server.FunctionDefinition(context.Background(), "function1"); funcErr != nil {
+				t.Errorf("unexpected error: %s", funcErr)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -18,6 +18,7 @@ type GetMetadataRequest struct{}
 type GetMetadataResponse struct {
 	DataSources        []DataSourceMetadata
 	Diagnostics        diag.Diagnostics
+	EphemeralResources []EphemeralResourceMetadata
 	Functions          []FunctionMetadata
 	Resources          []ResourceMetadata
 	ServerCapabilities *ServerCapabilities
@@ -30,6 +31,14 @@ type DataSourceMetadata struct {
 	TypeName string
 }
 
+// EphemeralResourceMetadata is the framework server equivalent of the
+// tfprotov5.EphemeralResourceMetadata and tfprotov6.EphemeralResourceMetadata
+// types.
+type EphemeralResourceMetadata struct {
+	// TypeName is the name of the ephemeral resource.
+	TypeName string
+}
+
 // FunctionMetadata is the framework server equivalent of the
 // tfprotov5.FunctionMetadata and tfprotov6.FunctionMetadata types.
 type FunctionMetadata struct {
@@ -47,6 +56,7 @@ type ResourceMetadata struct {
 // GetMetadata implements the framework server GetMetadata RPC.
 func (s *Server) GetMetadata(ctx context.Context, req *GetMetadataRequest, resp *GetMetadataResponse) {
 	resp.DataSources = []DataSourceMetadata{}
+	resp.EphemeralResources = []EphemeralResourceMetadata{}
 	resp.Functions = []FunctionMetadata{}
 	resp.Resources = []ResourceMetadata{}
 	resp.ServerCapabilities = s.ServerCapabilities()
@@ -55,6 +65,10 @@ func (s *Server) GetMetadata(ctx context.Context, req *GetMetadataRequest, resp
 
 	resp.Diagnostics.Append(diags...)
 
+	ephemeralResourceMetadatas, diags := s.EphemeralResourceMetadatas(ctx)
+
+	resp.Diagnostics.Append(diags...)
+
 	functionMetadatas, diags := s.FunctionMetadatas(ctx)
 
 	resp.Diagnostics.Append(diags...)
@@ -68,6 +82,7 @@ func (s *Server) GetMetadata(ctx context.Context, req *GetMetadataRequest, resp
 	}
 
 	resp.DataSources = datasourceMetadatas
+	resp.EphemeralResources = ephemeralResourceMetadatas
 	resp.Functions = functionMetadatas
 	resp.Resources = resourceMetadatas
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
@@ -32,9 +33,10 @@ func TestServerGetMetadata(t *testing.T) {
 				Provider: &testprovider.Provider{},
 			},
 			expectedResponse: &fwserver.GetMetadataResponse{
-				DataSources: []fwserver.DataSourceMetadata{},
-				Functions:   []fwserver.FunctionMetadata{},
-				Resources:   []fwserver.ResourceMetadata{},
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -75,8 +77,9 @@ func TestServerGetMetadata(t *testing.T) {
 						TypeName: "test_data_source2",
 					},
 				},
-				Functions: []fwserver.FunctionMetadata{},
-				Resources: []fwserver.ResourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -118,8 +121,9 @@ func TestServerGetMetadata(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				Functions: []fwserver.FunctionMetadata{},
-				Resources: []fwserver.ResourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -153,8 +157,9 @@ func TestServerGetMetadata(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				Functions: []fwserver.FunctionMetadata{},
-				Resources: []fwserver.ResourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -188,6 +193,50 @@ func TestServerGetMetadata(t *testing.T) {
 						TypeName: "testprovidertype_data_source",
 					},
 				},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"ephemeralresources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithEphemeralResources{
+					EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+						return []func() ephemeral.EphemeralResource{
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource1"
+									},
+								}
+							},
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource2"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources: []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{
+					{
+						TypeName: "test_ephemeral_resource1",
+					},
+					{
+						TypeName: "test_ephemeral_resource2",
+					},
+				},
 				Functions: []fwserver.FunctionMetadata{},
 				Resources: []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
@@ -197,6 +246,50 @@ func TestServerGetMetadata(t *testing.T) {
 				},
 			},
 		},
+		"ephemeralresources-duplicate-type-name": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithEphemeralResources{
+					EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+						return []func() ephemeral.EphemeralResource{
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource"
+									},
+								}
+							},
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetMetadataRequest{},
+			expectedResponse: &fwserver.GetMetadataResponse{
+				DataSources: []fwserver.DataSourceMetadata{},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Ephemeral Resource Type Defined",
+						"The test_ephemeral_resource ephemeral resource type name was returned for multiple ephemeral resources. "+
+							"Ephemeral resource type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
 		"functions": {
 			server: &fwserver.Server{
 				Provider: &testprovider.ProviderWithFunctions{
@@ -222,7 +315,8 @@ func TestServerGetMetadata(t *testing.T) {
 			},
 			request: &fwserver.GetMetadataRequest{},
 			expectedResponse: &fwserver.GetMetadataResponse{
-				DataSources: []fwserver.DataSourceMetadata{},
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
 				Functions: []fwserver.FunctionMetadata{
 					{
 						Name: "function1",
@@ -273,8 +367,9 @@ func TestServerGetMetadata(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				Functions: []fwserver.FunctionMetadata{},
-				Resources: []fwserver.ResourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -308,8 +403,9 @@ func TestServerGetMetadata(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				Functions: []fwserver.FunctionMetadata{},
-				Resources: []fwserver.ResourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -342,8 +438,9 @@ func TestServerGetMetadata(t *testing.T) {
 			},
 			request: &fwserver.GetMetadataRequest{},
 			expectedResponse: &fwserver.GetMetadataResponse{
-				DataSources: []fwserver.DataSourceMetadata{},
-				Functions:   []fwserver.FunctionMetadata{},
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
 				Resources: []fwserver.ResourceMetadata{
 					{
 						TypeName: "test_resource1",
@@ -393,8 +490,9 @@ func TestServerGetMetadata(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				Functions: []fwserver.FunctionMetadata{},
-				Resources: []fwserver.ResourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -428,8 +526,9 @@ func TestServerGetMetadata(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				Functions: []fwserver.FunctionMetadata{},
-				Resources: []fwserver.ResourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
+				Resources:          []fwserver.ResourceMetadata{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -458,8 +557,9 @@ func TestServerGetMetadata(t *testing.T) {
 			},
 			request: &fwserver.GetMetadataRequest{},
 			expectedResponse: &fwserver.GetMetadataResponse{
-				DataSources: []fwserver.DataSourceMetadata{},
-				Functions:   []fwserver.FunctionMetadata{},
+				DataSources:        []fwserver.DataSourceMetadata{},
+				EphemeralResources: []fwserver.EphemeralResourceMetadata{},
+				Functions:          []fwserver.FunctionMetadata{},
 				Resources: []fwserver.ResourceMetadata{
 					{
 						TypeName: "testprovidertype_resource",
@@ -488,6 +588,10 @@ func TestServerGetMetadata(t *testing.T) {
 				return response.DataSources[i].TypeName < response.DataSources[j].TypeName
 			})
 
+			sort.Slice(response.EphemeralResources, func(i int, j int) bool {
+				return response.EphemeralResources[i].TypeName < response.EphemeralResources[j].TypeName
+			})
+
 			sort.Slice(response.Functions, func(i int, j int) bool {
 				return response.Functions[i].Name < response.Functions[j].Name
 			})
@@ -12,15 +12,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func TestServerGetProviderSchema(t *testing.T) {
@@ -36,10 +42,11 @@ func TestServerGetProviderSchema(t *testing.T) {
 				Provider: &testprovider.Provider{},
 			},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas:   map[string]fwschema.Schema{},
-				FunctionDefinitions: map[string]function.Definition{},
-				Provider:            providerschema.Schema{},
-				ResourceSchemas:     map[string]fwschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -106,9 +113,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 						},
 					},
 				},
-				FunctionDefinitions: map[string]function.Definition{},
-				Provider:            providerschema.Schema{},
-				ResourceSchemas:     map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -312,6 +320,77 @@ func TestServerGetProviderSchema(t *testing.T) {
 						},
 					},
 				},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+			},
+		},
+		"ephemeralresourceschemas": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithEphemeralResources{
+					EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+						return []func() ephemeral.EphemeralResource{
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+										resp.Schema = ephemeralschema.Schema{
+											Attributes: map[string]ephemeralschema.Attribute{
+												"test1": ephemeralschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource1"
+									},
+								}
+							},
+							func() ephemeral.EphemeralResource {
+								return &testprovider.EphemeralResource{
+									SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+										resp.Schema = ephemeralschema.Schema{
+											Attributes: map[string]ephemeralschema.Attribute{
+												"test2": ephemeralschema.StringAttribute{
+													Required: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+										resp.TypeName = "test_ephemeral_resource2"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{
+					"test_ephemeral_resource1": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test1": ephemeralschema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+					"test_ephemeral_resource2": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test2": ephemeralschema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
 				FunctionDefinitions: map[string]function.Definition{},
 				Provider:            providerschema.Schema{},
 				ResourceSchemas:     map[string]fwschema.Schema{},
@@ -357,7 +436,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]fwschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
 				FunctionDefinitions: map[string]function.Definition{
 					"function1": {
 						Return: function.StringReturn{},
@@ -419,9 +499,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"Function \"function1\" - Definition Return field is undefined",
 					),
 				},
-				FunctionDefinitions: nil,
-				Provider:            providerschema.Schema{},
-				ResourceSchemas:     map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      nil,
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -473,9 +554,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				FunctionDefinitions: nil,
-				Provider:            providerschema.Schema{},
-				ResourceSchemas:     map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      nil,
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -509,9 +591,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					),
 				},
-				FunctionDefinitions: nil,
-				Provider:            providerschema.Schema{},
-				ResourceSchemas:     map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      nil,
+				Provider:                 providerschema.Schema{},
+				ResourceSchemas:          map[string]fwschema.Schema{},
 				ServerCapabilities: &fwserver.ServerCapabilities{
 					GetProviderSchemaOptional: true,
 					MoveResourceState:         true,
@@ -535,8 +618,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas:   map[string]fwschema.Schema{},
-				FunctionDefinitions: map[string]function.Definition{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
 				Provider: providerschema.Schema{
 					Attributes: map[string]providerschema.Attribute{
 						"test": providerschema.StringAttribute{
@@ -601,9 +685,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas:   map[string]fwschema.Schema{},
-				FunctionDefinitions: map[string]function.Definition{},
-				Provider:            providerschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
 				ProviderMeta: metaschema.Schema{
 					Attributes: map[string]metaschema.Attribute{
 						"test": metaschema.StringAttribute{
@@ -696,9 +781,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas:   map[string]fwschema.Schema{},
-				FunctionDefinitions: map[string]function.Definition{},
-				Provider:            providerschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{
 					"test_resource1": resourceschema.Schema{
 						Attributes: map[string]resourceschema.Attribute{
@@ -782,6 +868,117 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"resourceschemas-invalid-validator-expression": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{
+											Attributes: map[string]resourceschema.Attribute{
+												"test1": resourceschema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														testvalidator.StringWithExpressions{
+															ExpressionsMethod: func(_ context.Context) path.Expressions {
+																return path.Expressions{
+																	path.MatchRoot("test3"),
+																}
+															},
+														},
+													},
+												},
+												"test2": resourceschema.StringAttribute{
+													Optional: true,
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Provider: providerschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Validator Path Expression",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test1\" has a validator with a path expression which does not resolve to an attribute or block in the schema.\n\n"+
+							"Path Expression: test3\n"+
+							"Error: cannot apply step test3: could not find attribute or block \"test3\" in schema",
+					),
+				},
+			},
+		},
+		"resourceschemas-invalid-static-default": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.Resource{
+									SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+										resp.Schema = resourceschema.Schema{
+											Attributes: map[string]resourceschema.Attribute{
+												"test1": resourceschema.StringAttribute{
+													Computed: true,
+													Optional: true,
+													Default:  stringdefault.StaticString("invalid"),
+													Validators: []validator.String{
+														testvalidator.String{
+															ValidateStringMethod: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+																resp.Diagnostics.AddAttributeError(req.Path, "Invalid String", "must be valid")
+															},
+														},
+													},
+												},
+											},
+										}
+									},
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Provider: providerschema.Schema{},
+				ServerCapabilities: &fwserver.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					MoveResourceState:         true,
+					PlanDestroy:               true,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Default Value",
+						"When validating the schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test1\" has a static default value which does not pass the attribute validators.\n\n"+
+							"Default Value: \"invalid\"\n"+
+							"Validator Error: Invalid String: must be valid",
+					),
+				},
+			},
+		},
 		"resourceschemas-duplicate-type-name": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -908,9 +1105,10 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &fwserver.GetProviderSchemaRequest{},
 			expectedResponse: &fwserver.GetProviderSchemaResponse{
-				DataSourceSchemas:   map[string]fwschema.Schema{},
-				FunctionDefinitions: map[string]function.Definition{},
-				Provider:            providerschema.Schema{},
+				DataSourceSchemas:        map[string]fwschema.Schema{},
+				EphemeralResourceSchemas: map[string]fwschema.Schema{},
+				FunctionDefinitions:      map[string]function.Definition{},
+				Provider:                 providerschema.Schema{},
 				ResourceSchemas: map[string]fwschema.Schema{
 					"testprovidertype_resource": resourceschema.Schema{
 						Attributes: map[string]resourceschema.Attribute{
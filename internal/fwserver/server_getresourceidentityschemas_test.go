@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+)
+
+func TestServerGetResourceIdentitySchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.GetResourceIdentitySchemasRequest
+		expectedResponse *fwserver.GetResourceIdentitySchemasResponse
+	}{
+		"empty-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]fwschema.Schema{},
+			},
+		},
+		"identityschemas": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource1"
+										},
+									},
+									IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+										resp.IdentitySchema = identityschema.Schema{
+											Attributes: map[string]identityschema.Attribute{
+												"test1": identityschema.StringAttribute{
+													RequiredForImport: true,
+												},
+											},
+										}
+									},
+								}
+							},
+							func() resource.Resource {
+								// Resources without identity are omitted.
+								return &testprovider.Resource{
+									MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+										resp.TypeName = "test_resource2"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetResourceIdentitySchemasRequest{},
+			expectedResponse: &fwserver.GetResourceIdentitySchemasResponse{
+				IdentitySchemas: map[string]fwschema.Schema{
+					"test_resource1": identityschema.Schema{
+						Attributes: map[string]identityschema.Attribute{
+							"test1": identityschema.StringAttribute{
+								RequiredForImport: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"identityschemas-invalid-attribute-implementation": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+						return []func() resource.Resource{
+							func() resource.Resource {
+								return &testprovider.ResourceWithIdentity{
+									Resource: &testprovider.Resource{
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									},
+									IdentitySchemaMethod: func(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+										resp.IdentitySchema = identityschema.Schema{
+											Attributes: map[string]identityschema.Attribute{
+												"test": identityschema.StringAttribute{
+													OptionalForImport: true,
+													RequiredForImport: true,
+												},
+											},
+										}
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetResourceIdentitySchemasRequest{},
+			expectedResponse: &fwserver.GetResourceIdentitySchemasResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Attribute Implementation",
+						"When validating the resource identity schema, an implementation issue was found. "+
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+							"\"test\" has both RequiredForImport and OptionalForImport set to true. "+
+							"At most one of these fields can be enabled.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.GetResourceIdentitySchemasResponse{}
+			testCase.server.GetResourceIdentitySchemas(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
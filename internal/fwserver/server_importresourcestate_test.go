@@ -124,6 +124,87 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"request-id-parts": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id,test-required",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughWithIDParts(ctx, ",", path.Paths{path.Root("id"), path.Root("required")}, req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: []fwserver.ImportedResource{
+					{
+						State: tfsdk.State{
+							Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+								"id":       tftypes.NewValue(tftypes.String, "test-id"),
+								"optional": tftypes.NewValue(tftypes.String, nil),
+								"required": tftypes.NewValue(tftypes.String, "test-required"),
+							}),
+							Schema: testSchema,
+						},
+						TypeName: "test_resource",
+						Private:  testEmptyPrivate,
+					},
+				},
+			},
+		},
+		"request-id-parts-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id,",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughWithIDParts(ctx, ",", path.Paths{path.Root("id"), path.Root("required")}, req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unexpected Import Identifier",
+						`Expected import identifier in the format id,required. Got: "test-id,"`,
+					),
+				},
+			},
+		},
+		"request-id-parts-missing-separator": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ImportResourceStateRequest{
+				EmptyState: *testEmptyState,
+				ID:         "test-id",
+				Resource: &testprovider.ResourceWithImportState{
+					Resource: &testprovider.Resource{},
+					ImportStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+						resource.ImportStatePassthroughWithIDParts(ctx, "", path.Paths{path.Root("id")}, req, resp)
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Resource Import Passthrough Missing Separator or Attribute Paths",
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Resource ImportState method call to ImportStatePassthroughWithIDParts must set a separator and at least one valid attribute path that can accept a string value.",
+					),
+				},
+			},
+		},
 		"request-resourcetype-importstate-not-implemented": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
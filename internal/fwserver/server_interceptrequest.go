@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// InterceptRequest calls the provider defined request interceptor, if
+// implemented, returning the context to use for the RPC and a function which
+// must be called with the error diagnostics, if any, once the RPC is done.
+// The returned function is never nil.
+func (s *Server) InterceptRequest(ctx context.Context, rpc string) (context.Context, func(err error)) {
+	providerWithRequestInterceptor, ok := s.Provider.(provider.ProviderWithRequestInterceptor)
+
+	if !ok {
+		return ctx, func(error) {}
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithRequestInterceptor")
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider InterceptRequest")
+	interceptCtx, done := providerWithRequestInterceptor.InterceptRequest(ctx, rpc)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider InterceptRequest")
+
+	if interceptCtx == nil {
+		interceptCtx = ctx
+	}
+
+	if done == nil {
+		done = func(error) {}
+	}
+
+	return interceptCtx, done
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+type testInterceptRequestContextKey struct{}
+
+func TestServerInterceptRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server               *fwserver.Server
+		expectedContextValue any
+	}{
+		"no-interceptor": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+		},
+		"interceptor-nil-returns": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithRequestInterceptor{
+					Provider: &testprovider.Provider{},
+					InterceptRequestMethod: func(_ context.Context, _ string) (context.Context, func(error)) {
+						return nil, nil //nolint:staticcheck // testing nil context handling
+					},
+				},
+			},
+		},
+		"interceptor": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithRequestInterceptor{
+					Provider: &testprovider.Provider{},
+					InterceptRequestMethod: func(ctx context.Context, _ string) (context.Context, func(error)) {
+						return context.WithValue(ctx, testInterceptRequestContextKey{}, "test-value"), nil
+					},
+				},
+			},
+			expectedContextValue: "test-value",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, done := testCase.server.InterceptRequest(context.Background(), "TestRPC")
+
+			if ctx == nil {
+				t.Fatal("expected non-nil context")
+			}
+
+			if done == nil {
+				t.Fatal("expected non-nil done function")
+			}
+
+			done(nil)
+
+			if got := ctx.Value(testInterceptRequestContextKey{}); got != testCase.expectedContextValue {
+				t.Errorf("expected context value %v, got %v", testCase.expectedContextValue, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// ModifyProtocolResponse calls the provider defined protocol response
+// modifier, if implemented, returning the value to use as the protocol
+// response. The given response is returned if the provider does not
+// implement the modifier.
+func (s *Server) ModifyProtocolResponse(ctx context.Context, rpc string, resp any) any {
+	providerWithModifyProtocolResponse, ok := s.Provider.(provider.ProviderWithModifyProtocolResponse)
+
+	if !ok {
+		return resp
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithModifyProtocolResponse")
+	logging.FrameworkTrace(ctx, "Calling provider defined Provider ModifyProtocolResponse")
+	modifiedResp := providerWithModifyProtocolResponse.ModifyProtocolResponse(ctx, rpc, resp)
+	logging.FrameworkTrace(ctx, "Called provider defined Provider ModifyProtocolResponse")
+
+	return modifiedResp
+}
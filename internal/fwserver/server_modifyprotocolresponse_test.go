@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+func TestServerModifyProtocolResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server   *fwserver.Server
+		expected any
+	}{
+		"no-modifier": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expected: "test-response",
+		},
+		"modifier": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithModifyProtocolResponse{
+					Provider: &testprovider.Provider{},
+					ModifyProtocolResponseMethod: func(_ context.Context, rpc string, resp any) any {
+						return rpc + ": modified " + resp.(string) //nolint:forcetypeassert // test response is always a string
+					},
+				},
+			},
+			expected: "TestRPC: modified test-response",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.server.ModifyProtocolResponse(context.Background(), "TestRPC", "test-response")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
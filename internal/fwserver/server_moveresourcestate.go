@@ -5,7 +5,9 @@ package fwserver
 
 import (
 	"context"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -123,7 +125,27 @@ func (s *Server) MoveResourceState(ctx context.Context, req *MoveResourceStateRe
 		resp.TargetPrivate = privatestate.EmptyData(ctx)
 	}
 
+	var supportedSourceTypeNames []string
+
 	for _, resourceStateMover := range resourceStateMovers {
+		if resourceStateMover.SourceTypeName != "" {
+			if !slices.Contains(supportedSourceTypeNames, resourceStateMover.SourceTypeName) {
+				supportedSourceTypeNames = append(supportedSourceTypeNames, resourceStateMover.SourceTypeName)
+			}
+
+			if resourceStateMover.SourceTypeName != req.SourceTypeName {
+				logging.FrameworkTrace(ctx, "Skipping provider defined Resource StateMover due to SourceTypeName mismatch")
+
+				continue
+			}
+		}
+
+		if resourceStateMover.SourceSchemaVersion != nil && *resourceStateMover.SourceSchemaVersion != req.SourceSchemaVersion {
+			logging.FrameworkTrace(ctx, "Skipping provider defined Resource StateMover due to SourceSchemaVersion mismatch")
+
+			continue
+		}
+
 		moveStateReq := resource.MoveStateRequest{
 			SourcePrivate:         sourcePrivate,
 			SourceProviderAddress: req.SourceProviderAddress,
@@ -218,13 +240,19 @@ func (s *Server) MoveResourceState(ctx context.Context, req *MoveResourceStateRe
 		}
 	}
 
+	detail := "The target resource implementation does not include support for the given source resource. " +
+		"The resource implementation can be updated by the provider developers to include this support by returning the moved state when the request matches this source.\n\n" +
+		"Source Provider Address: " + req.SourceProviderAddress + "\n" +
+		"Source Resource Type: " + req.SourceTypeName + "\n" +
+		"Source Resource Schema Version: " + strconv.FormatInt(req.SourceSchemaVersion, 10) + "\n" +
+		"Target Resource Type: " + req.TargetTypeName
+
+	if len(supportedSourceTypeNames) > 0 {
+		detail += "\nSupported Source Resource Types: " + strings.Join(supportedSourceTypeNames, ", ")
+	}
+
 	resp.Diagnostics.AddError(
 		"Unable to Move Resource State",
-		"The target resource implementation does not include support for the given source resource. "+
-			"The resource implementation can be updated by the provider developers to include this support by returning the moved state when the request matches this source.\n\n"+
-			"Source Provider Address: "+req.SourceProviderAddress+"\n"+
-			"Source Resource Type: "+req.SourceTypeName+"\n"+
-			"Source Resource Schema Version: "+strconv.FormatInt(req.SourceSchemaVersion, 10)+"\n"+
-			"Target Resource Type: "+req.TargetTypeName,
+		detail,
 	)
 }
@@ -39,6 +39,8 @@ func TestServerMoveResourceState(t *testing.T) {
 		},
 	}
 	schemaType := testSchema.Type().TerraformType(ctx)
+	testSchemaVersionZero := int64(0)
+	testSchemaVersionOne := int64(1)
 
 	testCases := map[string]struct {
 		server           *fwserver.Server
@@ -541,6 +543,107 @@ func TestServerMoveResourceState(t *testing.T) {
 				TargetPrivate: privatestate.EmptyData(ctx),
 			},
 		},
+		"request-StateMover-SourceTypeName-no-match": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceProviderAddress: "example.com/namespace/type",
+				SourceRawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": true,
+				}),
+				SourceTypeName: "test_source_resource",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					MoveStateMethod: func(ctx context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								SourceTypeName: "test_legacy_resource_one",
+								StateMover: func(_ context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddError("Unexpected StateMover Call", "This StateMover should be skipped.")
+								},
+							},
+							{
+								SourceTypeName: "test_legacy_resource_two",
+								StateMover: func(_ context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddError("Unexpected StateMover Call", "This StateMover should be skipped.")
+								},
+							},
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_resource",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Move Resource State",
+						"The target resource implementation does not include support for the given source resource. "+
+							"The resource implementation can be updated by the provider developers to include this support by returning the moved state when the request matches this source.\n\n"+
+							"Source Provider Address: example.com/namespace/type\n"+
+							"Source Resource Type: test_source_resource\n"+
+							"Source Resource Schema Version: 0\n"+
+							"Target Resource Type: test_resource\n"+
+							"Supported Source Resource Types: test_legacy_resource_one, test_legacy_resource_two",
+					),
+				},
+				TargetPrivate: privatestate.EmptyData(ctx),
+			},
+		},
+		"request-StateMover-SourceTypeName-SourceSchemaVersion-match": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.MoveResourceStateRequest{
+				SourceRawState: testNewRawState(t, map[string]interface{}{
+					"id":                 "test-id-value",
+					"required_attribute": true,
+				}),
+				SourceSchemaVersion: 1,
+				SourceTypeName:      "test_legacy_resource_two",
+				TargetResource: &testprovider.ResourceWithMoveState{
+					MoveStateMethod: func(ctx context.Context) []resource.StateMover {
+						return []resource.StateMover{
+							{
+								SourceTypeName: "test_legacy_resource_one",
+								StateMover: func(_ context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddError("Unexpected StateMover Call", "This StateMover should be skipped.")
+								},
+							},
+							{
+								SourceSchemaVersion: &testSchemaVersionZero,
+								SourceTypeName:      "test_legacy_resource_two",
+								StateMover: func(_ context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.AddError("Unexpected StateMover Call", "This StateMover should be skipped.")
+								},
+							},
+							{
+								SourceSchemaVersion: &testSchemaVersionOne,
+								SourceTypeName:      "test_legacy_resource_two",
+								StateMover: func(_ context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+									resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), "test-id-value")...)
+									resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("required_attribute"), "true")...)
+								},
+							},
+						}
+					},
+				},
+				TargetResourceSchema: testSchema,
+				TargetTypeName:       "test_resource",
+			},
+			expectedResponse: &fwserver.MoveResourceStateResponse{
+				TargetPrivate: privatestate.EmptyData(ctx),
+				TargetState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"response-Diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
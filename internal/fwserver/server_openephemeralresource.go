@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// OpenEphemeralResourceRequest is the framework server request for the
+// OpenEphemeralResource RPC.
+type OpenEphemeralResourceRequest struct {
+	Config                  *tfsdk.Config
+	EphemeralResourceSchema fwschema.Schema
+	EphemeralResource       ephemeral.EphemeralResource
+}
+
+// OpenEphemeralResourceResponse is the framework server response for the
+// OpenEphemeralResource RPC.
+type OpenEphemeralResourceResponse struct {
+	Result      *tfsdk.EphemeralResultData
+	Private     *privatestate.Data
+	Diagnostics diag.Diagnostics
+	RenewAt     time.Time
+}
+
+// OpenEphemeralResource implements the framework server
+// OpenEphemeralResource RPC.
+func (s *Server) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralResourceRequest, resp *OpenEphemeralResourceResponse) {
+	if req == nil {
+		return
+	}
+
+	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
+
+		configureReq := ephemeral.ConfigureRequest{
+			ProviderData: s.EphemeralResourceConfigureData,
+		}
+		configureResp := ephemeral.ConfigureResponse{}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined EphemeralResource Configure")
+		ephemeralResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		logging.FrameworkTrace(ctx, "Called provider defined EphemeralResource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	openReq := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Schema: req.EphemeralResourceSchema,
+		},
+	}
+	openResp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: req.EphemeralResourceSchema,
+		},
+		Private: privatestate.EmptyProviderData(ctx),
+	}
+
+	if req.Config != nil {
+		openReq.Config = *req.Config
+		openResp.Result.Raw = req.Config.Raw.Copy()
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined EphemeralResource Open")
+	req.EphemeralResource.Open(ctx, openReq, &openResp)
+	logging.FrameworkTrace(ctx, "Called provider defined EphemeralResource Open")
+
+	resp.Diagnostics = openResp.Diagnostics
+	resp.Result = &openResp.Result
+	resp.RenewAt = openResp.RenewAt
+	resp.Private = &privatestate.Data{
+		Provider: openResp.Private,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Semantic equality compares against the configuration, so there is
+	// nothing to compare without it.
+	if req.Config == nil {
+		return
+	}
+
+	semanticEqualityReq := SchemaSemanticEqualityRequest{
+		PriorData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionConfiguration,
+			Schema:         req.Config.Schema,
+			TerraformValue: req.Config.Raw.Copy(),
+		},
+		ProposedNewData: fwschemadata.Data{
+			Description:    fwschemadata.DataDescriptionEphemeralResultData,
+			Schema:         resp.Result.Schema,
+			TerraformValue: resp.Result.Raw.Copy(),
+		},
+	}
+	semanticEqualityResp := &SchemaSemanticEqualityResponse{
+		NewData: semanticEqualityReq.ProposedNewData,
+	}
+
+	SchemaSemanticEquality(ctx, semanticEqualityReq, semanticEqualityResp)
+
+	resp.Diagnostics.Append(semanticEqualityResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if semanticEqualityResp.NewData.TerraformValue.Equal(resp.Result.Raw) {
+		return
+	}
+
+	logging.FrameworkDebug(ctx, "Ephemeral result data updated due to semantic equality")
+
+	resp.Result.Raw = semanticEqualityResp.NewData.TerraformValue
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerOpenEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testConfigValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_computed": tftypes.NewValue(tftypes.String, nil),
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	testResultValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test_computed": tftypes.NewValue(tftypes.String, "test-result-value"),
+		"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testSchemaWithSemanticEquals := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				CustomType: testtypes.StringTypeWithSemanticEquals{
+					SemanticEquals: true,
+				},
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw:    testConfigValue,
+		Schema: testSchema,
+	}
+
+	testResultUnchanged := &tfsdk.EphemeralResultData{
+		Raw:    testConfigValue,
+		Schema: testSchema,
+	}
+
+	testResult := &tfsdk.EphemeralResultData{
+		Raw:    testResultValue,
+		Schema: testSchema,
+	}
+
+	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
+		"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
+	})
+
+	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testRenewAt := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.OpenEphemeralResourceRequest
+		expectedResponse *fwserver.OpenEphemeralResourceResponse
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{},
+		},
+		"request-config": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						var config struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+						if config.TestRequired.ValueString() != "test-config-value" {
+							resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result:  testResultUnchanged,
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-config-nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result: &tfsdk.EphemeralResultData{
+					Schema: testSchema,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"ephemeral-resource-configure-data": {
+			server: &fwserver.Server{
+				EphemeralResourceConfigureData: "test-provider-configure-value",
+				Provider:                       &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResourceWithConfigure{
+					ConfigureMethod: func(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+						providerData, ok := req.ProviderData.(string)
+
+						if !ok {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected string, got: %T", req.ProviderData),
+							)
+							return
+						}
+
+						if providerData != "test-provider-configure-value" {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected test-provider-configure-value, got: %q", providerData),
+							)
+						}
+					},
+					EphemeralResource: &testprovider.EphemeralResource{
+						OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+							// In practice, the Configure method would save the
+							// provider data to the EphemeralResource
+							// implementation and use it here. The fact that
+							// Configure is able to read the data proves this
+							// can work.
+						},
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result:  testResultUnchanged,
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				Result:  testResultUnchanged,
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.Diagnostics.Append(resp.Private.SetKey(ctx, "providerKeyOne", []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`))...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result: testResultUnchanged,
+				Private: &privatestate.Data{
+					Provider: testProviderData,
+				},
+			},
+		},
+		"response-renew-at": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						resp.RenewAt = testRenewAt
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result:  testResultUnchanged,
+				Private: testEmptyPrivate,
+				RenewAt: testRenewAt,
+			},
+		},
+		"response-result": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config:                  testConfig,
+				EphemeralResourceSchema: testSchema,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						var data struct {
+							TestComputed types.String `tfsdk:"test_computed"`
+							TestRequired types.String `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						data.TestComputed = types.StringValue("test-result-value")
+
+						resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result:  testResult,
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-result-semantic-equality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.OpenEphemeralResourceRequest{
+				Config: &tfsdk.Config{
+					Raw:    testConfigValue,
+					Schema: testSchemaWithSemanticEquals,
+				},
+				EphemeralResourceSchema: testSchemaWithSemanticEquals,
+				EphemeralResource: &testprovider.EphemeralResource{
+					OpenMethod: func(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+						var data struct {
+							TestComputed types.String                            `tfsdk:"test_computed"`
+							TestRequired testtypes.StringValueWithSemanticEquals `tfsdk:"test_required"`
+						}
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						// This value should be overwritten back to the config value.
+						data.TestRequired = testtypes.StringValueWithSemanticEquals{
+							SemanticEquals: true,
+							StringValue:    types.StringValue("test-semantic-equal-value"),
+						}
+
+						resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.OpenEphemeralResourceResponse{
+				Result: &tfsdk.EphemeralResultData{
+					Raw:    testConfigValue,
+					Schema: testSchemaWithSemanticEquals,
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.OpenEphemeralResourceResponse{}
+			testCase.server.OpenEphemeralResource(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
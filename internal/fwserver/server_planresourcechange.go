@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -26,19 +27,23 @@ import (
 // PlanResourceChangeRequest is the framework server request for the
 // PlanResourceChange RPC.
 type PlanResourceChangeRequest struct {
-	Config           *tfsdk.Config
-	PriorPrivate     *privatestate.Data
-	PriorState       *tfsdk.State
-	ProposedNewState *tfsdk.Plan
-	ProviderMeta     *tfsdk.Config
-	ResourceSchema   fwschema.Schema
-	Resource         resource.Resource
+	ClientCapabilities resource.ModifyPlanClientCapabilities
+	Config             *tfsdk.Config
+	PriorIdentity      *tfsdk.ResourceIdentity
+	PriorPrivate       *privatestate.Data
+	PriorState         *tfsdk.State
+	ProposedNewState   *tfsdk.Plan
+	ProviderMeta       *tfsdk.Config
+	ResourceSchema     fwschema.Schema
+	Resource           resource.Resource
 }
 
 // PlanResourceChangeResponse is the framework server response for the
 // PlanResourceChange RPC.
 type PlanResourceChangeResponse struct {
+	Deferred        *resource.Deferred
 	Diagnostics     diag.Diagnostics
+	PlannedIdentity *tfsdk.ResourceIdentity
 	PlannedPrivate  *privatestate.Data
 	PlannedState    *tfsdk.State
 	RequiresReplace path.Paths
@@ -94,6 +99,44 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 	}
 
+	// Snapshot the proposed new state before any framework or provider
+	// modifications for the resource-level ModifyPlan method.
+	proposedNewState := *req.ProposedNewState
+
+	// Execute any resource-level validation which requires provider-level
+	// data, now that the resource has been configured.
+	//
+	// We only do this if there's a plan; otherwise, it represents a resource
+	// being deleted and there's no point.
+	if resourceWithConfigureAndValidate, ok := req.Resource.(resource.ResourceWithConfigureAndValidate); ok && !req.ProposedNewState.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigureAndValidate")
+
+		validateReq := resource.ValidateConfiguredConfigRequest{
+			Config: *req.Config,
+		}
+		validateResp := resource.ValidateConfiguredConfigResponse{}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined Resource ValidateConfiguredConfig")
+		resourceWithConfigureAndValidate.ValidateConfiguredConfig(ctx, validateReq, &validateResp)
+		logging.FrameworkTrace(ctx, "Called provider defined Resource ValidateConfiguredConfig")
+
+		resp.Diagnostics.Append(validateResp.Diagnostics...)
+
+		if s.stopOnRecoverableError(ctx, resp.Diagnostics) {
+			return
+		}
+	}
+
+	// Verify conditional attributes again, as configuration values which were
+	// unknown during validation may now be known.
+	if !req.ProposedNewState.Raw.IsNull() {
+		resp.Diagnostics.Append(ResourceConditionalAttributes(ctx, req.Resource, *req.Config)...)
+
+		if s.stopOnRecoverableError(ctx, resp.Diagnostics) {
+			return
+		}
+	}
+
 	// Ensure that resp.PlannedPrivate is never nil.
 	resp.PlannedPrivate = privatestate.EmptyData(ctx)
 
@@ -110,6 +153,11 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 	resp.PlannedState = planToState(*req.ProposedNewState)
 
+	// Resource identity data is expected to remain stable, so the prior
+	// identity is used as the planned identity unless the resource
+	// modifies it during ModifyPlan.
+	resp.PlannedIdentity = copyResourceIdentity(req.PriorIdentity)
+
 	// Set Defaults.
 	//
 	// If the planned state is not null (i.e., not a destroy operation) we traverse the schema,
@@ -127,7 +175,22 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 		resp.Diagnostics.Append(diags...)
 
-		if resp.Diagnostics.HasError() {
+		if diags.HasError() {
+			return
+		}
+
+		// Defaults which depend on other planned values are applied after
+		// all other defaults, so those values are available.
+		defaultsPlan := tfsdk.Plan{
+			Schema: resp.PlannedState.Schema,
+			Raw:    data.TerraformValue,
+		}
+
+		diags = data.TransformDefaultsWithPlan(ctx, req.Config.Raw, defaultsPlan)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
 			return
 		}
 
@@ -218,32 +281,14 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	//
 	// We only do this if there's a plan to modify; otherwise, it
 	// represents a resource being deleted and there's no point.
-	if !resp.PlannedState.Raw.IsNull() {
-		modifySchemaPlanReq := ModifySchemaPlanRequest{
-			Config:  *req.Config,
-			Plan:    stateToPlan(*resp.PlannedState),
-			State:   *req.PriorState,
-			Private: resp.PlannedPrivate.Provider,
-		}
-
-		if req.ProviderMeta != nil {
-			modifySchemaPlanReq.ProviderMeta = *req.ProviderMeta
-		}
-
-		modifySchemaPlanResp := ModifySchemaPlanResponse{
-			Diagnostics: resp.Diagnostics,
-			Plan:        modifySchemaPlanReq.Plan,
-			Private:     modifySchemaPlanReq.Private,
-		}
-
-		SchemaModifyPlan(ctx, req.ResourceSchema, modifySchemaPlanReq, &modifySchemaPlanResp)
-
-		resp.Diagnostics = modifySchemaPlanResp.Diagnostics
-		resp.PlannedState = planToState(modifySchemaPlanResp.Plan)
-		resp.RequiresReplace = append(resp.RequiresReplace, modifySchemaPlanResp.RequiresReplace...)
-		resp.PlannedPrivate.Provider = modifySchemaPlanResp.Private
+	//
+	// The diagnostics and RequiresReplace paths prior to plan modification
+	// are saved so any plan modifier fixpoint iterations can recompute them.
+	preModifyPlanDiagnostics := slices.Clone(resp.Diagnostics)
+	preModifyPlanRequiresReplace := slices.Clone(resp.RequiresReplace)
 
-		if resp.Diagnostics.HasError() {
+	if !resp.PlannedState.Raw.IsNull() {
+		if s.planResourceChangeSchemaModifyPlan(ctx, req, resp) {
 			return
 		}
 	}
@@ -259,37 +304,38 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	if resourceWithModifyPlan, ok := req.Resource.(resource.ResourceWithModifyPlan); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithModifyPlan")
 
-		modifyPlanReq := resource.ModifyPlanRequest{
-			Config:  *req.Config,
-			Plan:    stateToPlan(*resp.PlannedState),
-			State:   *req.PriorState,
-			Private: resp.PlannedPrivate.Provider,
-		}
+		schemaPlan := resp.PlannedState.Raw
 
-		if req.ProviderMeta != nil {
-			modifyPlanReq.ProviderMeta = *req.ProviderMeta
+		if s.planResourceChangeModifyPlan(ctx, req, resp, resourceWithModifyPlan, proposedNewState) {
+			return
 		}
 
-		modifyPlanResp := resource.ModifyPlanResponse{
-			Diagnostics:     resp.Diagnostics,
-			Plan:            modifyPlanReq.Plan,
-			RequiresReplace: path.Paths{},
-			Private:         modifyPlanReq.Private,
+		if resourceWithFixpoint, ok := req.Resource.(resource.ResourceWithPlanModifierFixpoint); ok {
+			if s.planResourceChangeFixpoint(ctx, req, resp, resourceWithFixpoint, proposedNewState, schemaPlan, preModifyPlanDiagnostics, preModifyPlanRequiresReplace) {
+				return
+			}
 		}
-
-		logging.FrameworkTrace(ctx, "Calling provider defined Resource ModifyPlan")
-		resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
-		logging.FrameworkTrace(ctx, "Called provider defined Resource ModifyPlan")
-
-		resp.Diagnostics = modifyPlanResp.Diagnostics
-		resp.PlannedState = planToState(modifyPlanResp.Plan)
-		resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
-		resp.PlannedPrivate.Provider = modifyPlanResp.Private
 	}
 
 	// Ensure deterministic RequiresReplace by sorting and deduplicating
 	resp.RequiresReplace = NormaliseRequiresReplace(ctx, resp.RequiresReplace)
 
+	// Ensure write-only attribute values are never stored in the plan.
+	if !resp.PlannedState.Raw.IsNull() {
+		modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, NullifyWriteOnlyAttributes(ctx, req.ResourceSchema))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error modifying plan",
+				"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+
+			return
+		}
+
+		resp.PlannedState.Raw = modifiedPlan
+	}
+
 	// If this was a destroy resource plan, ensure the plan remained null.
 	if req.ProposedNewState.Raw.IsNull() && !resp.PlannedState.Raw.IsNull() {
 		resp.Diagnostics.AddError(
@@ -369,10 +415,18 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			if a.BoolDefaultValue() != nil {
 				return val, nil
 			}
+		case fwschema.AttributeWithFloat32DefaultValue:
+			if a.Float32DefaultValue() != nil {
+				return val, nil
+			}
 		case fwschema.AttributeWithFloat64DefaultValue:
 			if a.Float64DefaultValue() != nil {
 				return val, nil
 			}
+		case fwschema.AttributeWithInt32DefaultValue:
+			if a.Int32DefaultValue() != nil {
+				return val, nil
+			}
 		case fwschema.AttributeWithInt64DefaultValue:
 			if a.Int64DefaultValue() != nil {
 				return val, nil
@@ -407,6 +461,15 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			}
 		}
 
+		// Single nested attributes created from child attribute defaults are
+		// left as-is, with any of their computed child attributes without
+		// defaults already marked as unknown.
+		if fwschema.MaterializesFromChildDefaults(attribute) {
+			logging.FrameworkTrace(ctx, "attribute is created from child attribute defaults, not marking unknown")
+
+			return val, nil
+		}
+
 		// Value type from planned state to create unknown with
 		newValueType := val.Type()
 
@@ -468,3 +531,172 @@ func stateToPlan(state tfsdk.State) tfsdk.Plan {
 		Schema: state.Schema,
 	}
 }
+
+// planResourceChangeSchemaModifyPlan executes the schema-based plan modifiers
+// against the response planned state. It returns true if the request should
+// stop processing.
+func (s *Server) planResourceChangeSchemaModifyPlan(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) bool {
+	modifySchemaPlanReq := ModifySchemaPlanRequest{
+		Config:  *req.Config,
+		Plan:    stateToPlan(*resp.PlannedState),
+		State:   *req.PriorState,
+		Private: resp.PlannedPrivate.Provider,
+	}
+
+	if req.ProviderMeta != nil {
+		modifySchemaPlanReq.ProviderMeta = *req.ProviderMeta
+	}
+
+	concurrentAttributes, maxConcurrency, diags := resourceConcurrentPlanModifiers(ctx, req.Resource, req.ResourceSchema)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return true
+	}
+
+	modifySchemaPlanReq.ConcurrentAttributes = concurrentAttributes
+	modifySchemaPlanReq.MaxConcurrency = maxConcurrency
+	modifySchemaPlanReq.CollectAllDiagnostics = s.CollectAllDiagnostics
+
+	modifySchemaPlanResp := ModifySchemaPlanResponse{
+		Plan:    modifySchemaPlanReq.Plan,
+		Private: modifySchemaPlanReq.Private,
+	}
+
+	SchemaModifyPlan(ctx, req.ResourceSchema, modifySchemaPlanReq, &modifySchemaPlanResp)
+
+	resp.Diagnostics.Append(modifySchemaPlanResp.Diagnostics...)
+	resp.PlannedState = planToState(modifySchemaPlanResp.Plan)
+	resp.RequiresReplace = append(resp.RequiresReplace, modifySchemaPlanResp.RequiresReplace...)
+	resp.PlannedPrivate.Provider = modifySchemaPlanResp.Private
+
+	return s.stopOnRecoverableError(ctx, resp.Diagnostics)
+}
+
+// planResourceChangeModifyPlan executes the resource-level ModifyPlan method
+// against the response planned state. It returns true if the request should
+// stop processing.
+func (s *Server) planResourceChangeModifyPlan(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse, resourceWithModifyPlan resource.ResourceWithModifyPlan, proposedNewState tfsdk.Plan) bool {
+	modifyPlanReq := resource.ModifyPlanRequest{
+		ClientCapabilities: req.ClientCapabilities,
+		Config:             *req.Config,
+		Plan:               stateToPlan(*resp.PlannedState),
+		ProposedNewState:   proposedNewState,
+		State:              *req.PriorState,
+		Private:            resp.PlannedPrivate.Provider,
+		Identity:           copyResourceIdentity(resp.PlannedIdentity),
+	}
+
+	if req.ProviderMeta != nil {
+		modifyPlanReq.ProviderMeta = *req.ProviderMeta
+	}
+
+	modifyPlanResp := resource.ModifyPlanResponse{
+		Diagnostics:     resp.Diagnostics,
+		Plan:            modifyPlanReq.Plan,
+		RequiresReplace: path.Paths{},
+		Private:         modifyPlanReq.Private,
+		Identity:        copyResourceIdentity(resp.PlannedIdentity),
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource ModifyPlan")
+	resourceWithModifyPlan.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource ModifyPlan")
+
+	resp.Diagnostics = modifyPlanResp.Diagnostics
+	resp.PlannedState = planToState(modifyPlanResp.Plan)
+	resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
+	resp.PlannedPrivate.Provider = modifyPlanResp.Private
+	resp.PlannedIdentity = modifyPlanResp.Identity
+	resp.Deferred = modifyPlanResp.Deferred
+
+	if modifyPlanResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+		resp.Diagnostics.AddError(
+			"Invalid Deferred Resource Response",
+			"Resource configured a deferred response while the Terraform client did not indicate support for deferred actions. "+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+
+		return true
+	}
+
+	// Expand any RequiresReplace path expressions against the planned
+	// state. Destroy plans are skipped as there is nothing to replace.
+	// PathMatches returns the nearest parent path when a parent is null or
+	// unknown, which is dropped as it would force replacement on a path the
+	// provider did not request.
+	if len(modifyPlanResp.RequiresReplaceExpressions) > 0 && !modifyPlanResp.Plan.Raw.IsNull() {
+		for _, expression := range modifyPlanResp.RequiresReplaceExpressions {
+			matchedPaths, diags := modifyPlanResp.Plan.PathMatches(ctx, expression)
+
+			resp.Diagnostics.Append(diags...)
+
+			expressionSteps := len(expression.Resolve().Steps())
+
+			for _, matchedPath := range matchedPaths {
+				if len(matchedPath.Steps()) < expressionSteps {
+					continue
+				}
+
+				resp.RequiresReplace = append(resp.RequiresReplace, matchedPath)
+			}
+		}
+	}
+
+	// Terraform only shows that an attribute forces replacement, so any
+	// explanations are returned as warning diagnostics at each path. Terraform
+	// ignores paths without a value change, so those are not explained.
+	if !modifyPlanResp.Plan.Raw.IsNull() {
+		for _, requiresReplaceReason := range modifyPlanResp.RequiresReplaceReasons {
+			resp.RequiresReplace = append(resp.RequiresReplace, requiresReplaceReason.Path)
+
+			if !planResourceChangePathChanged(ctx, modifyPlanResp.Plan, modifyPlanReq.State, requiresReplaceReason.Path) {
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeWarning(
+				requiresReplaceReason.Path,
+				"Resource Replacement Required",
+				fmt.Sprintf("A change to %s requires the resource to be replaced. %s", requiresReplaceReason.Path, requiresReplaceReason.Reason),
+			)
+		}
+	}
+
+	return false
+}
+
+// planResourceChangePathChanged returns true if the planned value at the
+// given path differs from the prior state value. Paths which cannot be read
+// from either value are considered changed.
+func planResourceChangePathChanged(ctx context.Context, plan tfsdk.Plan, priorState tfsdk.State, p path.Path) bool {
+	if priorState.Raw.IsNull() {
+		return false
+	}
+
+	planData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionPlan,
+		Schema:         plan.Schema,
+		TerraformValue: plan.Raw,
+	}
+
+	stateData := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         priorState.Schema,
+		TerraformValue: priorState.Raw,
+	}
+
+	planValue, diags := planData.ValueAtPath(ctx, p)
+
+	if diags.HasError() {
+		return true
+	}
+
+	stateValue, diags := stateData.ValueAtPath(ctx, p)
+
+	if diags.HasError() {
+		return true
+	}
+
+	return !planValue.Equal(stateValue)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// planResourceChangeFixpoint repeatedly executes the schema-based plan
+// modifiers and the resource-level ModifyPlan method until the ModifyPlan
+// method no longer changes the plan. The schemaPlan is the planned state
+// before the first ModifyPlan call. The preModifyPlanDiagnostics and
+// preModifyPlanRequiresReplace are the response values before any plan
+// modification, which are restored before each iteration so the diagnostics,
+// RequiresReplace paths, and RequiresReplaceReasons warnings reflect only the
+// final iteration. It returns true if the request should stop processing.
+func (s *Server) planResourceChangeFixpoint(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse, r resource.ResourceWithPlanModifierFixpoint, proposedNewState tfsdk.Plan, schemaPlan tftypes.Value, preModifyPlanDiagnostics diag.Diagnostics, preModifyPlanRequiresReplace path.Paths) bool {
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithPlanModifierFixpoint")
+
+	fixpointReq := resource.PlanModifierFixpointRequest{}
+	fixpointResp := resource.PlanModifierFixpointResponse{}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined Resource PlanModifierFixpoint")
+	r.PlanModifierFixpoint(ctx, fixpointReq, &fixpointResp)
+	logging.FrameworkTrace(ctx, "Called provider defined Resource PlanModifierFixpoint")
+
+	resp.Diagnostics.Append(fixpointResp.Diagnostics...)
+
+	if fixpointResp.Diagnostics.HasError() {
+		return true
+	}
+
+	maxIterations := fixpointResp.MaxIterations
+
+	if maxIterations <= 0 {
+		maxIterations = resource.DefaultPlanModifierFixpointMaxIterations
+	}
+
+	// Plans returned by the ModifyPlan method, which are used to detect
+	// cycles where the ModifyPlan method and attribute plan modifiers
+	// alternate between values.
+	var modifyPlanResults []tftypes.Value
+
+	for iteration := 1; ; iteration++ {
+		// Destroy plans, deferrals, and errors end plan modification.
+		if resp.PlannedState.Raw.IsNull() || resp.Deferred != nil || resp.Diagnostics.HasError() {
+			return false
+		}
+
+		if resp.PlannedState.Raw.Equal(schemaPlan) {
+			logging.FrameworkDebug(ctx, "Resource ModifyPlan did not change the plan, ending plan modifier fixpoint iteration")
+
+			return false
+		}
+
+		for _, modifyPlanResult := range modifyPlanResults {
+			if !resp.PlannedState.Raw.Equal(modifyPlanResult) {
+				continue
+			}
+
+			resp.Diagnostics.AddError(
+				"Plan Modifier Fixpoint Cycle Detected",
+				"The resource ModifyPlan method returned a previously returned plan after attribute plan modifiers were run again, "+
+					"which would cause plan modification to repeat indefinitely. "+
+					"This is always a problem with the provider and should be reported to the provider developer.",
+			)
+
+			return true
+		}
+
+		if iteration > maxIterations {
+			resp.Diagnostics.AddError(
+				"Plan Modifier Fixpoint Iteration Limit Reached",
+				"The resource ModifyPlan method was still changing the plan after the maximum number of attribute plan modifier passes. "+
+					"This is always a problem with the provider and should be reported to the provider developer.\n\n"+
+					fmt.Sprintf("Maximum Iterations: %d", maxIterations),
+			)
+
+			return true
+		}
+
+		modifyPlanResults = append(modifyPlanResults, resp.PlannedState.Raw)
+
+		logging.FrameworkDebug(ctx, "Resource ModifyPlan changed the plan, running attribute plan modifiers again")
+
+		// Plan modifiers may no longer raise the same diagnostics or require
+		// replacement of the same paths against the changed plan.
+		resp.Diagnostics = slices.Clone(preModifyPlanDiagnostics)
+		resp.Diagnostics.Append(fixpointResp.Diagnostics...)
+		resp.RequiresReplace = slices.Clone(preModifyPlanRequiresReplace)
+
+		if s.planResourceChangeSchemaModifyPlan(ctx, req, resp) {
+			return true
+		}
+
+		schemaPlan = resp.PlannedState.Raw
+
+		if s.planResourceChangeModifyPlan(ctx, req, resp, r, proposedNewState) {
+			return true
+		}
+	}
+}
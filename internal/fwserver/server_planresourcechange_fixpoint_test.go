@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerPlanResourceChange_PlanModifierFixpoint(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed":       tftypes.String,
+			"test_other_computed": tftypes.String,
+			"test_required":       tftypes.String,
+		},
+	}
+
+	// The test_computed plan modifier derives its value from the
+	// test_other_computed value, which is set by ModifyPlan.
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					testplanmodifier.String{
+						PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+							var other types.String
+
+							resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_other_computed"), &other)...)
+
+							if other.IsUnknown() {
+								return
+							}
+
+							resp.PlanValue = types.StringValue(other.ValueString() + "-derived")
+						},
+					},
+				},
+			},
+			"test_other_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed":       tftypes.NewValue(tftypes.String, nil),
+			"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+			"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testProposedNewState := &tfsdk.Plan{
+		Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed":       tftypes.NewValue(tftypes.String, nil),
+			"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+			"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testPriorState := &tfsdk.State{
+		Raw:    tftypes.NewValue(testSchemaType, nil),
+		Schema: testSchema,
+	}
+
+	testPlannedState := func(computed, otherComputed any) *tfsdk.State {
+		return &tfsdk.State{
+			Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_computed":       tftypes.NewValue(tftypes.String, computed),
+				"test_other_computed": tftypes.NewValue(tftypes.String, otherComputed),
+				"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testCases := map[string]struct {
+		resource         resource.Resource
+		expectedResponse *fwserver.PlanResourceChangeResponse
+	}{
+		"modifyplan-without-fixpoint": {
+			resource: &testprovider.ResourceWithModifyPlan{
+				ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), "test-modifyplan-value")...)
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState:   testPlannedState(tftypes.UnknownValue, "test-modifyplan-value"),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"converged": {
+			resource: &testprovider.ResourceWithPlanModifierFixpoint{
+				ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), "test-modifyplan-value")...)
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState:   testPlannedState("test-modifyplan-value-derived", "test-modifyplan-value"),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"requiresreplace-recomputed": {
+			resource: &testprovider.ResourceWithPlanModifierFixpoint{
+				ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+					var computed types.String
+
+					resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_computed"), &computed)...)
+
+					// Only the first iteration, before the test_computed plan
+					// modifier has derived its value, requires replacement.
+					if computed.IsUnknown() {
+						resp.Diagnostics.AddWarning("test first iteration summary", "test first iteration details")
+						resp.RequiresReplace = path.Paths{path.Root("test_required")}
+					}
+
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), "test-modifyplan-value")...)
+				},
+				PlanModifierFixpointMethod: func(ctx context.Context, req resource.PlanModifierFixpointRequest, resp *resource.PlanModifierFixpointResponse) {
+					resp.Diagnostics.AddWarning("test warning summary", "test warning details")
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning details"),
+				},
+				PlannedState:   testPlannedState("test-modifyplan-value-derived", "test-modifyplan-value"),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"unchanged": {
+			resource: &testprovider.ResourceWithPlanModifierFixpoint{
+				ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {},
+				PlanModifierFixpointMethod: func(ctx context.Context, req resource.PlanModifierFixpointRequest, resp *resource.PlanModifierFixpointResponse) {
+					resp.Diagnostics.AddWarning("test warning summary", "test warning details")
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning details"),
+				},
+				PlannedState:   testPlannedState(tftypes.UnknownValue, tftypes.UnknownValue),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"cycle": {
+			resource: &testprovider.ResourceWithPlanModifierFixpoint{
+				ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+					var other types.String
+
+					resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_other_computed"), &other)...)
+
+					next := "a"
+
+					if other.ValueString() == "a" {
+						next = "b"
+					}
+
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), next)...)
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Plan Modifier Fixpoint Cycle Detected",
+						"The resource ModifyPlan method returned a previously returned plan after attribute plan modifiers were run again, "+
+							"which would cause plan modification to repeat indefinitely. "+
+							"This is always a problem with the provider and should be reported to the provider developer.",
+					),
+				},
+				PlannedState:   testPlannedState("a-derived", "b"),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"iteration-limit": {
+			resource: &testprovider.ResourceWithPlanModifierFixpoint{
+				ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+					var other types.String
+
+					resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_other_computed"), &other)...)
+
+					iteration, _ := strconv.Atoi(other.ValueString())
+
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), strconv.Itoa(iteration+1))...)
+				},
+				PlanModifierFixpointMethod: func(ctx context.Context, req resource.PlanModifierFixpointRequest, resp *resource.PlanModifierFixpointResponse) {
+					resp.MaxIterations = 2
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Plan Modifier Fixpoint Iteration Limit Reached",
+						"The resource ModifyPlan method was still changing the plan after the maximum number of attribute plan modifier passes. "+
+							"This is always a problem with the provider and should be reported to the provider developer.\n\n"+
+							"Maximum Iterations: 2",
+					),
+				},
+				PlannedState:   testPlannedState("2-derived", "3"),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"fixpoint-diagnostics-error": {
+			resource: &testprovider.ResourceWithPlanModifierFixpoint{
+				ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_other_computed"), "test-modifyplan-value")...)
+				},
+				PlanModifierFixpointMethod: func(ctx context.Context, req resource.PlanModifierFixpointRequest, resp *resource.PlanModifierFixpointResponse) {
+					resp.Diagnostics.AddError("test error summary", "test error details")
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary", "test error details"),
+				},
+				PlannedState:   testPlannedState(tftypes.UnknownValue, "test-modifyplan-value"),
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			request := &fwserver.PlanResourceChangeRequest{
+				Config:           testConfig,
+				ProposedNewState: testProposedNewState,
+				PriorState:       testPriorState,
+				ResourceSchema:   testSchema,
+				Resource:         testCase.resource,
+			}
+			response := &fwserver.PlanResourceChangeResponse{}
+
+			server.PlanResourceChange(context.Background(), request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicdefault"
@@ -427,6 +428,45 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaTypeSingleNested := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_single_nested": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_computed": tftypes.String,
+					"test_default":  tftypes.String,
+					"test_optional": tftypes.String,
+				},
+			},
+		},
+	}
+
+	testSchemaSingleNested := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_single_nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_computed": schema.StringAttribute{
+						Computed: true,
+					},
+					"test_default": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("test-default-value"),
+					},
+					"test_optional": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	testEmptyStateSingleNested := &tfsdk.State{
+		Raw:    tftypes.NewValue(testSchemaTypeSingleNested, nil),
+		Schema: testSchemaSingleNested,
+	}
+
 	testSchemaTypeDefault := tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
 			"test_computed_bool":                    tftypes.Bool,
@@ -552,6 +592,30 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaOptionalComputed := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testSchemaWithWriteOnly := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+
 	testSchemaDefault := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_computed_bool": schema.BoolAttribute{
@@ -1178,6 +1242,46 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaTypeSetNested := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_set": tftypes.Set{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+						"size": tftypes.Number,
+					},
+				},
+			},
+		},
+	}
+
+	testSchemaSetNested := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_set": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"size": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+
+	testSetNestedElementType := testSchemaTypeSetNested.AttributeTypes["test_set"].(tftypes.Set).ElementType
+
+	testSetNestedElement := func(name string, size int64) tftypes.Value {
+		return tftypes.NewValue(testSetNestedElementType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+			"size": tftypes.NewValue(tftypes.Number, size),
+		})
+	}
+
 	testProviderMetaType := tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
 			"test_provider_meta_attribute": tftypes.String,
@@ -1226,6 +1330,16 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
 
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testIdentityType := testIdentitySchema.Type().TerraformType(context.Background())
+
 	testEmptyPrivate := &privatestate.Data{
 		Provider: testEmptyProviderData,
 	}
@@ -1296,6 +1410,207 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"resource-configure-and-validate": {
+			server: &fwserver.Server{
+				Provider:              &testprovider.Provider{},
+				ResourceConfigureData: "test-provider-configure-value",
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: func() resource.Resource {
+					var providerData any
+
+					return &testprovider.ResourceWithConfigureAndValidate{
+						ConfigureMethod: func(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+							providerData = req.ProviderData
+						},
+						ValidateConfiguredConfigMethod: func(ctx context.Context, req resource.ValidateConfiguredConfigRequest, resp *resource.ValidateConfiguredConfigResponse) {
+							if providerData != "test-provider-configure-value" {
+								resp.Diagnostics.AddError(
+									"Unexpected ConfigureRequest.ProviderData",
+									fmt.Sprintf("Expected test-provider-configure-value, got: %v", providerData),
+								)
+							}
+
+							var data testSchemaData
+
+							resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+							if data.TestRequired.ValueString() != "test-config-value" {
+								resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
+							}
+						},
+						Resource: &testprovider.Resource{},
+					}
+				}(),
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"resource-configure-and-validate-diagnostics": {
+			server: &fwserver.Server{
+				Provider:              &testprovider.Provider{},
+				ResourceConfigureData: "test-provider-configure-value",
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithConfigureAndValidate{
+					ValidateConfiguredConfigMethod: func(ctx context.Context, req resource.ValidateConfiguredConfigRequest, resp *resource.ValidateConfiguredConfigResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+					Resource: &testprovider.Resource{},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"resource-configure-and-validate-diagnostics-collect-all-diagnostics": {
+			server: &fwserver.Server{
+				CollectAllDiagnostics: true,
+				Provider:              &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierDiagnosticsError,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierDiagnosticsError,
+				},
+				PriorState: &tfsdk.State{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchemaAttributePlanModifierDiagnosticsError,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierDiagnosticsError,
+				Resource: &testprovider.ResourceWithConfigureAndValidate{
+					ValidateConfiguredConfigMethod: func(ctx context.Context, req resource.ValidateConfiguredConfigRequest, resp *resource.ValidateConfiguredConfigResponse) {
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+					Resource: &testprovider.Resource{},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_required"),
+						"error summary",
+						"error detail",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierDiagnosticsError,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"resource-conditional-attributes-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaOptionalComputed,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchemaOptionalComputed,
+				Resource: &testprovider.ResourceWithConditionalAttributes{
+					ConditionalAttributesMethod: func(_ context.Context) []resource.ConditionalAttribute {
+						return []resource.ConditionalAttribute{
+							{
+								Attribute:  path.MatchRoot("test_computed"),
+								RequiredIf: path.Expressions{path.MatchRoot("test_required")},
+							},
+						}
+					},
+					Resource: &testprovider.Resource{},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_computed"),
+						"Missing Configuration for Required Attribute",
+						"Must set a configuration value for the test_computed attribute as the provider has marked it as required when any of these attributes are configured: [test_required]\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+					),
+				},
+			},
+		},
 		"create-mark-computed-config-nils-as-unknown": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -1330,31 +1645,103 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"create-set-default-values": {
+		"create-single-nested-attribute-child-defaults": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
 			request: &fwserver.PlanResourceChangeRequest{
 				Config: &tfsdk.Config{
-					Raw: tftypes.NewValue(testSchemaTypeDefault, map[string]tftypes.Value{
-						"test_computed_bool":               tftypes.NewValue(tftypes.Bool, nil),
-						"test_computed_float64":            tftypes.NewValue(tftypes.Number, nil),
-						"test_computed_int64":              tftypes.NewValue(tftypes.Number, nil),
-						"test_computed_list":               tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
-						"test_computed_map":                tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-						"test_computed_number":             tftypes.NewValue(tftypes.Number, nil),
-						"test_computed_object":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.String}}, nil),
-						"test_computed_set":                tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
-						"test_computed_string":             tftypes.NewValue(tftypes.String, nil),
-						"test_computed_string_custom_type": tftypes.NewValue(tftypes.String, nil),
-						"test_computed_dynamic":            tftypes.NewValue(tftypes.DynamicPseudoType, nil),
-						"test_computed_nested_list": tftypes.NewValue(
-							tftypes.List{
-								ElementType: tftypes.Object{
-									AttributeTypes: map[string]tftypes.Type{
-										"string_attribute": tftypes.String,
-									},
-								},
+					Raw: tftypes.NewValue(testSchemaTypeSingleNested, map[string]tftypes.Value{
+						"test_single_nested": tftypes.NewValue(testSchemaTypeSingleNested.AttributeTypes["test_single_nested"], nil),
+					}),
+					Schema: testSchemaSingleNested,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeSingleNested, map[string]tftypes.Value{
+						"test_single_nested": tftypes.NewValue(testSchemaTypeSingleNested.AttributeTypes["test_single_nested"], nil),
+					}),
+					Schema: testSchemaSingleNested,
+				},
+				PriorState:     testEmptyStateSingleNested,
+				ResourceSchema: testSchemaSingleNested,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeSingleNested, map[string]tftypes.Value{
+						"test_single_nested": tftypes.NewValue(
+							testSchemaTypeSingleNested.AttributeTypes["test_single_nested"],
+							map[string]tftypes.Value{
+								"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+								"test_default":  tftypes.NewValue(tftypes.String, "test-default-value"),
+								"test_optional": tftypes.NewValue(tftypes.String, nil),
+							},
+						),
+					}),
+					Schema: testSchemaSingleNested,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-write-only-nullified": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchemaWithWriteOnly,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testSchemaWithWriteOnly,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-set-default-values": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeDefault, map[string]tftypes.Value{
+						"test_computed_bool":               tftypes.NewValue(tftypes.Bool, nil),
+						"test_computed_float64":            tftypes.NewValue(tftypes.Number, nil),
+						"test_computed_int64":              tftypes.NewValue(tftypes.Number, nil),
+						"test_computed_list":               tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"test_computed_map":                tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"test_computed_number":             tftypes.NewValue(tftypes.Number, nil),
+						"test_computed_object":             tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.String}}, nil),
+						"test_computed_set":                tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+						"test_computed_string":             tftypes.NewValue(tftypes.String, nil),
+						"test_computed_string_custom_type": tftypes.NewValue(tftypes.String, nil),
+						"test_computed_dynamic":            tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"test_computed_nested_list": tftypes.NewValue(
+							tftypes.List{
+								ElementType: tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"string_attribute": tftypes.String,
+									},
+								},
 							},
 							nil,
 						),
@@ -2599,6 +2986,156 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-resourcewithconcurrentplanmodifiers-response-attributeplan": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, nil),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierAttributePlan,
+				Resource: &testprovider.ResourceWithConcurrentPlanModifiers{
+					Resource: &testprovider.Resource{},
+					ConcurrentPlanModifiersMethod: func(_ context.Context, _ resource.ConcurrentPlanModifiersRequest, resp *resource.ConcurrentPlanModifiersResponse) {
+						resp.Paths = path.Paths{
+							path.Root("test_computed"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, "test-attributeplanmodifier-value"),
+						"test_other_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithconcurrentplanmodifiers-invalid-path": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, nil),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierAttributePlan,
+				Resource: &testprovider.ResourceWithConcurrentPlanModifiers{
+					Resource: &testprovider.Resource{},
+					ConcurrentPlanModifiersMethod: func(_ context.Context, _ resource.ConcurrentPlanModifiersRequest, resp *resource.ConcurrentPlanModifiersResponse) {
+						resp.Paths = path.Paths{
+							path.Root("test_missing"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Concurrent Plan Modifiers Path",
+						"The resource declared a concurrent plan modifiers path which is not a top level attribute or block in the resource schema. "+
+							"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+							"Path: test_missing",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_other_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-attributeplanmodifier-response-attributeplan-custom-type": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -2779,11 +3316,14 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"create-resourcewithmodifyplan-request-config": {
+		"create-resourcewithmodifyplan-request-client-capabilities": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
 			request: &fwserver.PlanResourceChangeRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{
+					DeferralAllowed: true,
+				},
 				Config: &tfsdk.Config{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -2802,12 +3342,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						var data testSchemaData
-
-						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-
-						if data.TestRequired.ValueString() != "test-config-value" {
-							resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("Unexpected req.ClientCapabilities", "expected DeferralAllowed true")
 						}
 					},
 				},
@@ -2823,7 +3359,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"create-resourcewithmodifyplan-request-private": {
+		"create-resourcewithmodifyplan-request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -2846,19 +3382,15 @@ func TestServerPlanResourceChange(t *testing.T) {
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
-
-						key := "providerKeyOne"
-						got, diags := req.Private.GetKey(ctx, key)
+						var data testSchemaData
 
-						resp.Diagnostics.Append(diags...)
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-						if string(got) != expected {
-							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+						if data.TestRequired.ValueString() != "test-config-value" {
+							resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 						}
 					},
 				},
-				PriorPrivate: testPrivate,
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
 				PlannedState: &tfsdk.State{
@@ -2868,10 +3400,10 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
-				PlannedPrivate: testPrivate,
+				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"create-resourcewithmodifyplan-request-proposednewstate": {
+		"create-resourcewithmodifyplan-request-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -2894,15 +3426,19 @@ func TestServerPlanResourceChange(t *testing.T) {
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						var data testSchemaData
+						expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
 
-						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+						key := "providerKeyOne"
+						got, diags := req.Private.GetKey(ctx, key)
 
-						if !data.TestComputed.IsUnknown() {
-							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
+						resp.Diagnostics.Append(diags...)
+
+						if string(got) != expected {
+							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
 						}
 					},
 				},
+				PriorPrivate: testPrivate,
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
 				PlannedState: &tfsdk.State{
@@ -2912,10 +3448,10 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
-				PlannedPrivate: testEmptyPrivate,
+				PlannedPrivate: testPrivate,
 			},
 		},
-		"create-resourcewithmodifyplan-request-providermeta": {
+		"create-resourcewithmodifyplan-request-proposednewstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -2935,16 +3471,15 @@ func TestServerPlanResourceChange(t *testing.T) {
 					Schema: testSchema,
 				},
 				PriorState:     testEmptyState,
-				ProviderMeta:   testProviderMetaConfig,
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						var data testProviderMetaData
+						var data testSchemaData
 
-						resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-						if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
-							resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
+						if !data.TestComputed.IsUnknown() {
+							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 						}
 					},
 				},
@@ -2960,20 +3495,245 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"create-resourcewithmodifyplan-response-diagnostics": {
+		"create-resourcewithmodifyplan-request-proposednewstate-unmodified": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
 			request: &fwserver.PlanResourceChangeRequest{
 				Config: &tfsdk.Config{
-					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
-						"test_computed": tftypes.NewValue(tftypes.String, nil),
-						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
-					}),
-					Schema: testSchema,
-				},
-				ProposedNewState: &tfsdk.Plan{
-					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, nil),
+						"test_other_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, nil),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				ResourceSchema: testSchemaAttributePlanModifierAttributePlan,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						var proposedComputed, proposedOtherComputed, planComputed types.String
+
+						resp.Diagnostics.Append(req.ProposedNewState.GetAttribute(ctx, path.Root("test_computed"), &proposedComputed)...)
+						resp.Diagnostics.Append(req.ProposedNewState.GetAttribute(ctx, path.Root("test_other_computed"), &proposedOtherComputed)...)
+						resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("test_computed"), &planComputed)...)
+
+						if !proposedComputed.IsNull() {
+							resp.Diagnostics.AddError("Unexpected req.ProposedNewState Value", "Got: "+proposedComputed.String())
+						}
+
+						if !proposedOtherComputed.IsNull() {
+							resp.Diagnostics.AddError("Unexpected req.ProposedNewState Value", "Got: "+proposedOtherComputed.String())
+						}
+
+						if planComputed.ValueString() != "test-attributeplanmodifier-value" {
+							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+planComputed.String())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed":       tftypes.String,
+							"test_other_computed": tftypes.String,
+							"test_required":       tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"test_computed":       tftypes.NewValue(tftypes.String, "test-attributeplanmodifier-value"),
+						"test_other_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required":       tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchemaAttributePlanModifierAttributePlan,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithmodifyplan-request-providermeta": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ProviderMeta:   testProviderMetaConfig,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						var data testProviderMetaData
+
+						resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
+
+						if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+							resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithmodifyplan-response-deferred": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.Deferred = &resource.Deferred{
+							Reason: resource.DeferredReasonResourceConfigUnknown,
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Deferred: &resource.Deferred{
+					Reason: resource.DeferredReasonResourceConfigUnknown,
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithmodifyplan-response-deferred-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.Deferred = &resource.Deferred{
+							Reason: resource.DeferredReasonResourceConfigUnknown,
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Deferred: &resource.Deferred{
+					Reason: resource.DeferredReasonResourceConfigUnknown,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Resource Response",
+						"Resource configured a deferred response while the Terraform client did not indicate support for deferred actions. "+
+							"This is always a problem with the provider and should be reported to the provider developer.",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"create-resourcewithmodifyplan-response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
 						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
 					}),
@@ -3136,6 +3896,54 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"create-resourcewithmodifyplan-response-requiresreplacereasons": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						// Terraform does not replace resources on creation,
+						// so no explanation warning should be raised.
+						resp.RequiresReplaceReasons = []resource.RequiresReplaceReason{
+							{
+								Path:   path.Root("test_required"),
+								Reason: "The remote system does not support updating this value.",
+							},
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_required"),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"create-resourcewithmodifyplan-attributeplanmodifier-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -3183,6 +3991,36 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testPrivateProvider,
 			},
 		},
+		"delete-resource-configure-and-validate-not-called": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw:    tftypes.NewValue(testSchemaType, nil),
+					Schema: testSchema,
+				},
+				ProposedNewState: testEmptyPlan,
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-state-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithConfigureAndValidate{
+					ValidateConfiguredConfigMethod: func(ctx context.Context, req resource.ValidateConfiguredConfigRequest, resp *resource.ValidateConfiguredConfigResponse) {
+						resp.Diagnostics.AddError("Unexpected ValidateConfiguredConfig Call", "ValidateConfiguredConfig should not be called when planning to destroy.")
+					},
+					Resource: &testprovider.Resource{},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState:   testEmptyState,
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
 		"delete-resourcewithmodifyplan-request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -5583,38 +6421,242 @@ func TestServerPlanResourceChange(t *testing.T) {
 							},
 						}, nil),
 					}),
-					Schema: testSchemaBlock,
+					Schema: testSchemaBlock,
+				},
+				ResourceSchema: testSchemaBlock,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						var data testSchemaDataBlock
+
+						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+						if data.TestRequired.ValueString() != "test-new-value" {
+							resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaBlockType, map[string]tftypes.Value{
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+						"test_optional_block": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test_optional_one": tftypes.String,
+								"test_optional_two": tftypes.String,
+							},
+						}, nil),
+					}),
+					Schema: testSchemaBlock,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-resourcewithmodifyplan-request-proposednewstate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						var data testSchemaData
+
+						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+						if !data.TestComputed.IsUnknown() {
+							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-resourcewithmodifyplan-request-providermeta": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProviderMeta:   testProviderMetaConfig,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						var data testProviderMetaData
+
+						resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
+
+						if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+							resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-resourcewithmodifyplan-request-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchema,
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
+
+						key := "providerKeyOne"
+						got, diags := req.Private.GetKey(ctx, key)
+
+						resp.Diagnostics.Append(diags...)
+
+						if string(got) != expected {
+							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+						}
+					},
+				},
+				PriorPrivate: testPrivate,
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PlannedPrivate: testPrivate,
+			},
+		},
+		"update-resourcewithmodifyplan-response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchema,
 				},
-				ResourceSchema: testSchemaBlock,
+				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						var data testSchemaDataBlock
-
-						resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-
-						if data.TestRequired.ValueString() != "test-new-value" {
-							resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
-						}
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
 					},
 				},
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
 				PlannedState: &tfsdk.State{
-					Raw: tftypes.NewValue(testSchemaBlockType, map[string]tftypes.Value{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
-						"test_optional_block": tftypes.NewValue(tftypes.Object{
-							AttributeTypes: map[string]tftypes.Type{
-								"test_optional_one": tftypes.String,
-								"test_optional_two": tftypes.String,
-							},
-						}, nil),
 					}),
-					Schema: testSchemaBlock,
+					Schema: testSchema,
 				},
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"update-resourcewithmodifyplan-request-proposednewstate": {
+		"update-resourcewithmodifyplan-response-plannedstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -5647,16 +6689,16 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-						if !data.TestComputed.IsUnknown() {
-							resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
-						}
+						data.TestComputed = types.StringValue("test-plannedstate-value")
+
+						resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
 					},
 				},
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
-						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
 						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
 					}),
 					Schema: testSchema,
@@ -5664,7 +6706,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"update-resourcewithmodifyplan-request-providermeta": {
+		"update-resourcewithmodifyplan-request-prioridentity": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -5683,6 +6725,12 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
+				PriorIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+						"test_id": tftypes.NewValue(tftypes.String, "test-prioridentity-value"),
+					}),
+					Schema: testIdentitySchema,
+				},
 				PriorState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -5690,21 +6738,28 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
-				ProviderMeta:   testProviderMetaConfig,
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						var data testProviderMetaData
+						var data struct {
+							TestID types.String `tfsdk:"test_id"`
+						}
 
-						resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
+						resp.Diagnostics.Append(req.Identity.Get(ctx, &data)...)
 
-						if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
-							resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
+						if data.TestID.ValueString() != "test-prioridentity-value" {
+							resp.Diagnostics.AddError("Unexpected req.Identity Value", "Got: "+data.TestID.ValueString())
 						}
 					},
 				},
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+						"test_id": tftypes.NewValue(tftypes.String, "test-prioridentity-value"),
+					}),
+					Schema: testIdentitySchema,
+				},
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
@@ -5715,7 +6770,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"update-resourcewithmodifyplan-request-private": {
+		"update-resourcewithmodifyplan-response-plannedidentity": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -5734,6 +6789,12 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
+				PriorIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+						"test_id": tftypes.NewValue(tftypes.String, "test-prioridentity-value"),
+					}),
+					Schema: testIdentitySchema,
+				},
 				PriorState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
@@ -5744,21 +6805,17 @@ func TestServerPlanResourceChange(t *testing.T) {
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
-
-						key := "providerKeyOne"
-						got, diags := req.Private.GetKey(ctx, key)
-
-						resp.Diagnostics.Append(diags...)
-
-						if string(got) != expected {
-							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
-						}
+						resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("test_id"), "test-plannedidentity-value")...)
 					},
 				},
-				PriorPrivate: testPrivate,
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+						"test_id": tftypes.NewValue(tftypes.String, "test-plannedidentity-value"),
+					}),
+					Schema: testIdentitySchema,
+				},
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
@@ -5766,10 +6823,10 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
-				PlannedPrivate: testPrivate,
+				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"update-resourcewithmodifyplan-response-diagnostics": {
+		"update-resourcewithmodifyplan-response-requiresreplace": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -5798,16 +6855,19 @@ func TestServerPlanResourceChange(t *testing.T) {
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						resp.Diagnostics.AddWarning("warning summary", "warning detail")
-						resp.Diagnostics.AddError("error summary", "error detail")
+						// This is a strange thing to signal on creation,
+						// but the framework does not prevent you from
+						// doing it and it might be overly burdensome on
+						// provider developers to have the framework raise
+						// an error if it is technically valid in the
+						// protocol.
+						resp.RequiresReplace = path.Paths{
+							path.Root("test_required"),
+						}
 					},
 				},
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
-				Diagnostics: diag.Diagnostics{
-					diag.NewWarningDiagnostic("warning summary", "warning detail"),
-					diag.NewErrorDiagnostic("error summary", "error detail"),
-				},
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
@@ -5815,10 +6875,133 @@ func TestServerPlanResourceChange(t *testing.T) {
 					}),
 					Schema: testSchema,
 				},
+				RequiresReplace: path.Paths{
+					path.Root("test_required"),
+				},
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"update-resourcewithmodifyplan-response-plannedstate": {
+		"update-resourcewithmodifyplan-response-requiresreplaceexpressions": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], []tftypes.Value{
+							testSetNestedElement("one", 1),
+							testSetNestedElement("two", 20),
+						}),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], []tftypes.Value{
+							testSetNestedElement("one", 1),
+							testSetNestedElement("two", 20),
+						}),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], []tftypes.Value{
+							testSetNestedElement("one", 1),
+							testSetNestedElement("two", 2),
+						}),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				ResourceSchema: testSchemaSetNested,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.RequiresReplaceExpressions = path.Expressions{
+							path.MatchRoot("test_set").AtAnySetValue().AtName("size"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], []tftypes.Value{
+							testSetNestedElement("one", 1),
+							testSetNestedElement("two", 20),
+						}),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				RequiresReplace: path.Paths{
+					path.Root("test_set").AtSetValue(types.ObjectValueMust(
+						map[string]attr.Type{
+							"name": types.StringType,
+							"size": types.Int64Type,
+						},
+						map[string]attr.Value{
+							"name": types.StringValue("one"),
+							"size": types.Int64Value(1),
+						},
+					)).AtName("size"),
+					path.Root("test_set").AtSetValue(types.ObjectValueMust(
+						map[string]attr.Type{
+							"name": types.StringType,
+							"size": types.Int64Type,
+						},
+						map[string]attr.Value{
+							"name": types.StringValue("two"),
+							"size": types.Int64Value(20),
+						},
+					)).AtName("size"),
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-resourcewithmodifyplan-response-requiresreplaceexpressions-unknown-parent": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], tftypes.UnknownValue),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], tftypes.UnknownValue),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], []tftypes.Value{
+							testSetNestedElement("one", 1),
+						}),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				ResourceSchema: testSchemaSetNested,
+				Resource: &testprovider.ResourceWithModifyPlan{
+					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+						resp.RequiresReplaceExpressions = path.Expressions{
+							path.MatchRoot("test_set").AtAnySetValue().AtName("size"),
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaTypeSetNested, map[string]tftypes.Value{
+						"test_set": tftypes.NewValue(testSchemaTypeSetNested.AttributeTypes["test_set"], tftypes.UnknownValue),
+					}),
+					Schema: testSchemaSetNested,
+				},
+				PlannedPrivate: testEmptyPrivate,
+			},
+		},
+		"update-resourcewithmodifyplan-response-requiresreplacereasons": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -5847,28 +7030,37 @@ func TestServerPlanResourceChange(t *testing.T) {
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						var data testSchemaData
-
-						resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-						data.TestComputed = types.StringValue("test-plannedstate-value")
-
-						resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+						resp.RequiresReplaceReasons = []resource.RequiresReplaceReason{
+							{
+								Path:   path.Root("test_required"),
+								Reason: "The remote system does not support updating this value.",
+							},
+						}
 					},
 				},
 			},
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test_required"),
+						"Resource Replacement Required",
+						"A change to test_required requires the resource to be replaced. The remote system does not support updating this value.",
+					),
+				},
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
-						"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
 					}),
 					Schema: testSchema,
 				},
+				RequiresReplace: path.Paths{
+					path.Root("test_required"),
+				},
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
-		"update-resourcewithmodifyplan-response-requiresreplace": {
+		"update-resourcewithmodifyplan-response-requiresreplacereasons-unchanged": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
@@ -5876,35 +7068,32 @@ func TestServerPlanResourceChange(t *testing.T) {
 				Config: &tfsdk.Config{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
-						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-value"),
 					}),
 					Schema: testSchema,
 				},
 				ProposedNewState: &tfsdk.Plan{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
-						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-value"),
 					}),
 					Schema: testSchema,
 				},
 				PriorState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, nil),
-						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-value"),
 					}),
 					Schema: testSchema,
 				},
 				ResourceSchema: testSchema,
 				Resource: &testprovider.ResourceWithModifyPlan{
 					ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-						// This is a strange thing to signal on creation,
-						// but the framework does not prevent you from
-						// doing it and it might be overly burdensome on
-						// provider developers to have the framework raise
-						// an error if it is technically valid in the
-						// protocol.
-						resp.RequiresReplace = path.Paths{
-							path.Root("test_required"),
+						resp.RequiresReplaceReasons = []resource.RequiresReplaceReason{
+							{
+								Path:   path.Root("test_required"),
+								Reason: "The remote system does not support updating this value.",
+							},
 						}
 					},
 				},
@@ -5912,8 +7101,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 			expectedResponse: &fwserver.PlanResourceChangeResponse{
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
-						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-value"),
 					}),
 					Schema: testSchema,
 				},
@@ -6032,3 +7221,128 @@ func TestServerPlanResourceChange(t *testing.T) {
 		})
 	}
 }
+
+func TestServerPlanResourceChange_UnknownWithLength(t *testing.T) {
+	t.Parallel()
+
+	testNestedObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_nested_computed": tftypes.String,
+		},
+	}
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_list_nested": tftypes.List{ElementType: testNestedObjectType},
+			"test_set":         tftypes.Set{ElementType: tftypes.String},
+			"test_required":    tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_nested_computed": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								testplanmodifier.String{
+									PlanModifyStringMethod: func(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+										if !req.PlanValue.IsUnknown() {
+											resp.Diagnostics.AddAttributeError(req.Path, "Unexpected Plan Value", req.PlanValue.String())
+										}
+									},
+								},
+							},
+						},
+					},
+				},
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					testplanmodifier.List{
+						PlanModifyListMethod: func(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+							planValue, diags := types.ListUnknownWithLength(ctx, req.PlanValue.ElementType(ctx), 2)
+
+							resp.Diagnostics.Append(diags...)
+
+							resp.PlanValue = planValue
+						},
+					},
+				},
+			},
+			"test_set": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					testplanmodifier.Set{
+						PlanModifySetMethod: func(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+							planValue, diags := types.SetUnknownWithLength(ctx, req.PlanValue.ElementType(ctx), 3)
+
+							resp.Diagnostics.Append(diags...)
+
+							resp.PlanValue = planValue
+						},
+					},
+				},
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testConfigValue := tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+		"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, nil),
+		"test_set":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		"test_required":    tftypes.NewValue(tftypes.String, "test-config-value"),
+	})
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+	request := &fwserver.PlanResourceChangeRequest{
+		Config: &tfsdk.Config{
+			Raw:    testConfigValue,
+			Schema: testSchema,
+		},
+		ProposedNewState: &tfsdk.Plan{
+			Raw:    testConfigValue,
+			Schema: testSchema,
+		},
+		PriorState: &tfsdk.State{
+			Raw:    tftypes.NewValue(testSchemaType, nil),
+			Schema: testSchema,
+		},
+		ResourceSchema: testSchema,
+		Resource:       &testprovider.Resource{},
+	}
+	response := &fwserver.PlanResourceChangeResponse{}
+
+	expectedResponse := &fwserver.PlanResourceChangeResponse{
+		PlannedState: &tfsdk.State{
+			Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+				"test_list_nested": tftypes.NewValue(tftypes.List{ElementType: testNestedObjectType}, []tftypes.Value{
+					tftypes.NewValue(testNestedObjectType, tftypes.UnknownValue),
+					tftypes.NewValue(testNestedObjectType, tftypes.UnknownValue),
+				}),
+				"test_set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+			}),
+			Schema: testSchema,
+		},
+		PlannedPrivate: &privatestate.Data{
+			Provider: privatestate.EmptyProviderData(context.Background()),
+		},
+	}
+
+	server.PlanResourceChange(context.Background(), request, response)
+
+	if diff := cmp.Diff(response, expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
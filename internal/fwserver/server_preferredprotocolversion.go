@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// ProviderPreferredProtocolVersion returns the PreferredProtocolVersion from
+// the provider Metadata method. The value is informational only, such as for
+// tooling and testing, and does not affect protocol negotiation, which is
+// determined by the providerserver function used to serve the provider.
+func (s *Server) ProviderPreferredProtocolVersion(ctx context.Context) (int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	logging.FrameworkTrace(ctx, "Checking ProviderTypeName lock")
+	s.providerTypeNameMutex.Lock()
+	defer s.providerTypeNameMutex.Unlock()
+
+	s.providerMetadata(ctx)
+
+	switch s.providerPreferredProtocolVersion {
+	case 0, 5, 6:
+		return s.providerPreferredProtocolVersion, diags
+	}
+
+	diags.AddError(
+		"Invalid Preferred Protocol Version",
+		"The provider Metadata method returned an invalid PreferredProtocolVersion. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("Expected 0, 5, or 6, got: %d", s.providerPreferredProtocolVersion),
+	)
+
+	return 0, diags
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestServerProviderPreferredProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server        *fwserver.Server
+		expected      int
+		expectedDiags diag.Diagnostics
+	}{
+		"unset": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "testprovidertype"
+					},
+				},
+			},
+			expected: 0,
+		},
+		"protocol-version-5": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "testprovidertype"
+						resp.PreferredProtocolVersion = 5
+					},
+				},
+			},
+			expected: 5,
+		},
+		"protocol-version-6": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "testprovidertype"
+						resp.PreferredProtocolVersion = 6
+					},
+				},
+			},
+			expected: 6,
+		},
+		"protocol-version-invalid": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+						resp.TypeName = "testprovidertype"
+						resp.PreferredProtocolVersion = 4
+					},
+				},
+			},
+			expected: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Preferred Protocol Version",
+					"The provider Metadata method returned an invalid PreferredProtocolVersion. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Expected 0, 5, or 6, got: 4",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.server.ProviderPreferredProtocolVersion(context.Background())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got: %d", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -17,15 +17,17 @@ import (
 // ReadDataSourceRequest is the framework server request for the
 // ReadDataSource RPC.
 type ReadDataSourceRequest struct {
-	Config           *tfsdk.Config
-	DataSourceSchema fwschema.Schema
-	DataSource       datasource.DataSource
-	ProviderMeta     *tfsdk.Config
+	ClientCapabilities datasource.ReadClientCapabilities
+	Config             *tfsdk.Config
+	DataSourceSchema   fwschema.Schema
+	DataSource         datasource.DataSource
+	ProviderMeta       *tfsdk.Config
 }
 
 // ReadDataSourceResponse is the framework server response for the
 // ReadDataSource RPC.
 type ReadDataSourceResponse struct {
+	Deferred    *datasource.Deferred
 	Diagnostics diag.Diagnostics
 	State       *tfsdk.State
 }
@@ -56,6 +58,7 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 	}
 
 	readReq := datasource.ReadRequest{
+		ClientCapabilities: req.ClientCapabilities,
 		Config: tfsdk.Config{
 			Schema: req.DataSourceSchema,
 		},
@@ -81,6 +84,17 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.State = &readResp.State
+	resp.Deferred = readResp.Deferred
+
+	if readResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+		resp.Diagnostics.AddError(
+			"Invalid Deferred Data Source Response",
+			"Data source configured a deferred response while the Terraform client did not indicate support for deferred actions. "+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+
+		return
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
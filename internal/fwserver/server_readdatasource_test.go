@@ -109,6 +109,28 @@ func TestServerReadDataSource(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ReadDataSourceResponse{},
 		},
+		"request-client-capabilities": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: datasource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("unexpected req.ClientCapabilities.DeferralAllowed value", "expected true")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testStateUnchanged,
+			},
+		},
 		"request-config": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -203,6 +225,60 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"response-deferred": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				ClientCapabilities: datasource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Deferred = &datasource.Deferred{
+							Reason: datasource.DeferredReasonAbsentPrereq,
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Deferred: &datasource.Deferred{
+					Reason: datasource.DeferredReasonAbsentPrereq,
+				},
+				State: testStateUnchanged,
+			},
+		},
+		"response-deferred-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSource: &testprovider.DataSource{
+					ReadMethod: func(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+						resp.Deferred = &datasource.Deferred{
+							Reason: datasource.DeferredReasonAbsentPrereq,
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Deferred: &datasource.Deferred{
+					Reason: datasource.DeferredReasonAbsentPrereq,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Data Source Response",
+						"Data source configured a deferred response while the Terraform client did not indicate support for deferred actions. "+
+							"This is always a problem with the provider and should be reported to the provider developer.",
+					),
+				},
+				State: testStateUnchanged,
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
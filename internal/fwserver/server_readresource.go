@@ -17,16 +17,20 @@ import (
 // ReadResourceRequest is the framework server request for the
 // ReadResource RPC.
 type ReadResourceRequest struct {
-	CurrentState *tfsdk.State
-	Resource     resource.Resource
-	Private      *privatestate.Data
-	ProviderMeta *tfsdk.Config
+	ClientCapabilities resource.ReadClientCapabilities
+	CurrentIdentity    *tfsdk.ResourceIdentity
+	CurrentState       *tfsdk.State
+	Resource           resource.Resource
+	Private            *privatestate.Data
+	ProviderMeta       *tfsdk.Config
 }
 
 // ReadResourceResponse is the framework server response for the
 // ReadResource RPC.
 type ReadResourceResponse struct {
+	Deferred    *resource.Deferred
 	Diagnostics diag.Diagnostics
+	NewIdentity *tfsdk.ResourceIdentity
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
 }
@@ -67,6 +71,7 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 	}
 
 	readReq := resource.ReadRequest{
+		ClientCapabilities: req.ClientCapabilities,
 		State: tfsdk.State{
 			Schema: req.CurrentState.Schema,
 			Raw:    req.CurrentState.Raw.Copy(),
@@ -79,6 +84,11 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		},
 	}
 
+	if req.CurrentIdentity != nil {
+		readReq.Identity = copyResourceIdentity(req.CurrentIdentity)
+		readResp.Identity = copyResourceIdentity(req.CurrentIdentity)
+	}
+
 	if req.ProviderMeta != nil {
 		readReq.ProviderMeta = *req.ProviderMeta
 	}
@@ -97,12 +107,46 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private = req.Private
 	}
 
+	timeout, timeoutDiags := ResourceTimeout(ctx, req.Resource, resourceOperationRead, fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         readReq.State.Schema,
+		TerraformValue: readReq.State.Raw,
+	})
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readReq.Timeout = timeout
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+
+		defer cancel()
+	}
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Read")
 	req.Resource.Read(ctx, readReq, &readResp)
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Read")
 
-	resp.Diagnostics = readResp.Diagnostics
+	// Private state data is meaningless without a resource, so drop any data
+	// when the resource was removed to prevent stale data, such as a
+	// tombstone key, from surviving.
+	if !readResp.Diagnostics.HasError() && readResp.State.Raw.IsNull() {
+		logging.FrameworkTrace(ctx, "Resource was removed by provider defined Read, ensuring Private is cleared")
+
+		readResp.Private = nil
+		resp.Private = nil
+	}
+
+	resp.Diagnostics.Append(readResp.Diagnostics...)
+	resp.NewIdentity = readResp.Identity
 	resp.NewState = &readResp.State
+	resp.Deferred = readResp.Deferred
 
 	if readResp.Private != nil {
 		if resp.Private == nil {
@@ -112,6 +156,16 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private.Provider = readResp.Private
 	}
 
+	if readResp.Deferred != nil && !req.ClientCapabilities.DeferralAllowed {
+		resp.Diagnostics.AddError(
+			"Invalid Deferred Resource Response",
+			"Resource configured a deferred response while the Terraform client did not indicate support for deferred actions. "+
+				"This is always a problem with the provider and should be reported to the provider developer.",
+		)
+
+		return
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
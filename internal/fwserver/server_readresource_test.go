@@ -17,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -106,6 +108,30 @@ func TestServerReadResource(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"test_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testIdentityType := testIdentitySchema.Type().TerraformType(context.Background())
+
+	testCurrentIdentity := &tfsdk.ResourceIdentity{
+		Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+			"test_id": tftypes.NewValue(tftypes.String, "test-currentidentity-value"),
+		}),
+		Schema: testIdentitySchema,
+	}
+
+	testNewIdentity := &tfsdk.ResourceIdentity{
+		Raw: tftypes.NewValue(testIdentityType, map[string]tftypes.Value{
+			"test_id": tftypes.NewValue(tftypes.String, "test-newidentity-value"),
+		}),
+		Schema: testIdentitySchema,
+	}
+
 	testPrivateFrameworkMap := map[string][]byte{
 		".frameworkKey": []byte(`{"fk": "framework value"}`),
 	}
@@ -161,6 +187,28 @@ func TestServerReadResource(t *testing.T) {
 				},
 			},
 		},
+		"request-client-capabilities": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				ClientCapabilities: resource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						if !req.ClientCapabilities.DeferralAllowed {
+							resp.Diagnostics.AddError("Unexpected req.ClientCapabilities", "expected DeferralAllowed true")
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"request-currentstate": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -187,6 +235,33 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"request-currentidentity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentIdentity: testCurrentIdentity,
+				CurrentState:    testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						var data struct {
+							TestID types.String `tfsdk:"test_id"`
+						}
+
+						resp.Diagnostics.Append(req.Identity.Get(ctx, &data)...)
+
+						if data.TestID.ValueString() != "test-currentidentity-value" {
+							resp.Diagnostics.AddError("unexpected req.Identity value: %s", data.TestID.ValueString())
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewIdentity: testCurrentIdentity,
+				NewState:    testCurrentState,
+				Private:     testEmptyPrivate,
+			},
+		},
 		"request-providermeta": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -308,6 +383,60 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-deferred": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				ClientCapabilities: resource.ReadClientCapabilities{
+					DeferralAllowed: true,
+				},
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Deferred = &resource.Deferred{
+							Reason: resource.DeferredReasonAbsentPrereq,
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Deferred: &resource.Deferred{
+					Reason: resource.DeferredReasonAbsentPrereq,
+				},
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+			},
+		},
+		"response-deferred-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Deferred = &resource.Deferred{
+							Reason: resource.DeferredReasonAbsentPrereq,
+						}
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Deferred: &resource.Deferred{
+					Reason: resource.DeferredReasonAbsentPrereq,
+				},
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Deferred Resource Response",
+						"Resource configured a deferred response while the Terraform client did not indicate support for deferred actions. "+
+							"This is always a problem with the provider and should be reported to the provider developer.",
+					),
+				},
+				NewState: testCurrentState,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -386,6 +515,25 @@ func TestServerReadResource(t *testing.T) {
 				Private: testEmptyPrivate,
 			},
 		},
+		"response-identity": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentIdentity: testCurrentIdentity,
+				CurrentState:    testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("test_id"), "test-newidentity-value")...)
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewIdentity: testNewIdentity,
+				NewState:    testCurrentState,
+				Private:     testEmptyPrivate,
+			},
+		},
 		"response-state": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -426,7 +574,51 @@ func TestServerReadResource(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ReadResourceResponse{
 				NewState: testNewStateRemoved,
-				Private:  testEmptyPrivate,
+			},
+		},
+		"response-state-removeresource-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						tombstone, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+						resp.Diagnostics.Append(diags...)
+
+						if tombstone != nil {
+							resp.State.RemoveResource(ctx)
+						}
+					},
+				},
+				Private: testPrivate,
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNewStateRemoved,
+			},
+		},
+		"response-state-removeresource-diagnostics-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.State.RemoveResource(ctx)
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+				Private: testPrivate,
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+				NewState: testNewStateRemoved,
+				Private:  testPrivate,
 			},
 		},
 		"response-state-semantic-equality": {
@@ -490,6 +682,32 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testPrivateProvider,
 			},
 		},
+		"response-private-cleared": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						diags := resp.Private.Clear(ctx)
+
+						resp.Diagnostics.Append(diags...)
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testPrivateFrameworkMap,
+					Provider:  privatestate.MustProviderData(context.Background(), testProviderKeyValue),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private: &privatestate.Data{
+					Framework: testPrivateFrameworkMap,
+					Provider:  testEmptyProviderData,
+				},
+			},
+		},
 		"response-private-updated": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// RenewEphemeralResourceRequest is the framework server request for the
+// RenewEphemeralResource RPC.
+type RenewEphemeralResourceRequest struct {
+	Private           *privatestate.Data
+	EphemeralResource ephemeral.EphemeralResource
+}
+
+// RenewEphemeralResourceResponse is the framework server response for the
+// RenewEphemeralResource RPC.
+type RenewEphemeralResourceResponse struct {
+	Private     *privatestate.Data
+	Diagnostics diag.Diagnostics
+	RenewAt     time.Time
+}
+
+// RenewEphemeralResource implements the framework server
+// RenewEphemeralResource RPC.
+func (s *Server) RenewEphemeralResource(ctx context.Context, req *RenewEphemeralResourceRequest, resp *RenewEphemeralResourceResponse) {
+	if req == nil {
+		return
+	}
+
+	ephemeralResourceWithRenew, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithRenew)
+
+	if !ok {
+		// Terraform should only call this RPC if Open or a prior Renew
+		// returned a RenewAt time, which is only meaningful if Renew is
+		// implemented.
+		resp.Diagnostics.AddError(
+			"Ephemeral Resource Renew Not Implemented",
+			"An unexpected error was encountered when renewing the ephemeral resource. "+
+				"Terraform called the RenewEphemeralResource RPC, however the ephemeral resource does not implement the Renew method. "+
+				"Either remove the RenewAt response value from the Open method or implement the Renew method. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return
+	}
+
+	if ephemeralResourceWithConfigure, ok := req.EphemeralResource.(ephemeral.EphemeralResourceWithConfigure); ok {
+		logging.FrameworkTrace(ctx, "EphemeralResource implements EphemeralResourceWithConfigure")
+
+		configureReq := ephemeral.ConfigureRequest{
+			ProviderData: s.EphemeralResourceConfigureData,
+		}
+		configureResp := ephemeral.ConfigureResponse{}
+
+		logging.FrameworkTrace(ctx, "Calling provider defined EphemeralResource Configure")
+		ephemeralResourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+		logging.FrameworkTrace(ctx, "Called provider defined EphemeralResource Configure")
+
+		resp.Diagnostics.Append(configureResp.Diagnostics...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	renewReq := ephemeral.RenewRequest{
+		Private: privatestate.EmptyProviderData(ctx),
+	}
+	renewResp := ephemeral.RenewResponse{
+		Private: privatestate.EmptyProviderData(ctx),
+	}
+
+	if req.Private != nil && req.Private.Provider != nil {
+		renewReq.Private = req.Private.Provider
+		renewResp.Private = req.Private.Provider.Copy()
+	}
+
+	logging.FrameworkTrace(ctx, "Calling provider defined EphemeralResource Renew")
+	ephemeralResourceWithRenew.Renew(ctx, renewReq, &renewResp)
+	logging.FrameworkTrace(ctx, "Called provider defined EphemeralResource Renew")
+
+	resp.Diagnostics = renewResp.Diagnostics
+	resp.RenewAt = renewResp.RenewAt
+	resp.Private = &privatestate.Data{
+		Provider: renewResp.Private,
+	}
+
+	if req.Private != nil {
+		resp.Private.Framework = req.Private.Framework
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+func TestServerRenewEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testPrivateFrameworkMap := map[string][]byte{
+		".frameworkKey": []byte(`{"fk": "framework value"}`),
+	}
+
+	testProviderKeyValue := privatestate.MustMarshalToJson(map[string][]byte{
+		"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
+	})
+
+	testProviderData := privatestate.MustProviderData(context.Background(), testProviderKeyValue)
+
+	testPrivate := &privatestate.Data{
+		Framework: testPrivateFrameworkMap,
+		Provider:  testProviderData,
+	}
+
+	testEmptyPrivate := &privatestate.Data{
+		Provider: privatestate.EmptyProviderData(context.Background()),
+	}
+
+	testRenewAt := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.RenewEphemeralResourceRequest
+		expectedResponse *fwserver.RenewEphemeralResourceResponse
+	}{
+		"nil": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{},
+		},
+		"ephemeral-resource-without-renew": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResource{},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Ephemeral Resource Renew Not Implemented",
+						"An unexpected error was encountered when renewing the ephemeral resource. "+
+							"Terraform called the RenewEphemeralResource RPC, however the ephemeral resource does not implement the Renew method. "+
+							"Either remove the RenewAt response value from the Open method or implement the Renew method. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+		"ephemeral-resource-configure-data": {
+			server: &fwserver.Server{
+				EphemeralResourceConfigureData: "test-provider-configure-value",
+				Provider:                       &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithConfigureAndRenew{
+					ConfigureMethod: func(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+						providerData, ok := req.ProviderData.(string)
+
+						if !ok {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected string, got: %T", req.ProviderData),
+							)
+							return
+						}
+
+						if providerData != "test-provider-configure-value" {
+							resp.Diagnostics.AddError(
+								"Unexpected ConfigureRequest.ProviderData",
+								fmt.Sprintf("Expected test-provider-configure-value, got: %q", providerData),
+							)
+						}
+					},
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						// In practice, the Configure method would save the
+						// provider data to the EphemeralResource
+						// implementation and use it here. The fact that
+						// Configure is able to read the data proves this can
+						// work.
+					},
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+			},
+		},
+		"request-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
+
+						got, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+						resp.Diagnostics.Append(diags...)
+
+						if string(got) != expected {
+							resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+						}
+					},
+				},
+				Private: testPrivate,
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Private: testPrivate,
+			},
+		},
+		"response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				Private: testEmptyPrivate,
+			},
+		},
+		"response-private": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						resp.Diagnostics.Append(resp.Private.SetKey(ctx, "providerKeyOne", []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`))...)
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: testPrivateFrameworkMap,
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Private: testPrivate,
+			},
+		},
+		"response-renew-at": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.RenewEphemeralResourceRequest{
+				EphemeralResource: &testprovider.EphemeralResourceWithRenew{
+					RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+						resp.RenewAt = testRenewAt
+					},
+				},
+			},
+			expectedResponse: &fwserver.RenewEphemeralResourceResponse{
+				Private: testEmptyPrivate,
+				RenewAt: testRenewAt,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.RenewEphemeralResourceResponse{}
+			testCase.server.RenewEphemeralResource(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -5,9 +5,11 @@ package fwserver
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -20,19 +22,21 @@ import (
 // UpdateResourceRequest is the framework server request for an update request
 // with the ApplyResourceChange RPC.
 type UpdateResourceRequest struct {
-	Config         *tfsdk.Config
-	PlannedPrivate *privatestate.Data
-	PlannedState   *tfsdk.Plan
-	PriorState     *tfsdk.State
-	ProviderMeta   *tfsdk.Config
-	ResourceSchema fwschema.Schema
-	Resource       resource.Resource
+	Config          *tfsdk.Config
+	PlannedIdentity *tfsdk.ResourceIdentity
+	PlannedPrivate  *privatestate.Data
+	PlannedState    *tfsdk.Plan
+	PriorState      *tfsdk.State
+	ProviderMeta    *tfsdk.Config
+	ResourceSchema  fwschema.Schema
+	Resource        resource.Resource
 }
 
 // UpdateResourceResponse is the framework server response for an update request
 // with the ApplyResourceChange RPC.
 type UpdateResourceResponse struct {
 	Diagnostics diag.Diagnostics
+	NewIdentity *tfsdk.ResourceIdentity
 	NewState    *tfsdk.State
 	Private     *privatestate.Data
 }
@@ -100,6 +104,11 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		updateResp.State = *req.PriorState
 	}
 
+	if req.PlannedIdentity != nil {
+		updateReq.Identity = copyResourceIdentity(req.PlannedIdentity)
+		updateResp.Identity = copyResourceIdentity(req.PlannedIdentity)
+	}
+
 	if req.ProviderMeta != nil {
 		updateReq.ProviderMeta = *req.ProviderMeta
 	}
@@ -118,11 +127,39 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		resp.Private = req.PlannedPrivate
 	}
 
+	timeout, timeoutDiags := ResourceTimeout(ctx, req.Resource, resourceOperationUpdate, fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionConfiguration,
+		Schema:         updateReq.Config.Schema,
+		TerraformValue: updateReq.Config.Raw,
+	})
+
+	resp.Diagnostics.Append(timeoutDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq.Timeout = timeout
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+
+		defer cancel()
+	}
+
 	logging.FrameworkTrace(ctx, "Calling provider defined Resource Update")
 	req.Resource.Update(ctx, updateReq, &updateResp)
 	logging.FrameworkTrace(ctx, "Called provider defined Resource Update")
 
-	resp.Diagnostics = updateResp.Diagnostics
+	resp.Diagnostics.Append(updateResp.Diagnostics...)
+
+	if len(updateResp.AppliedFromPlan) > 0 || len(updateResp.KeptFromPriorState) > 0 {
+		resp.Diagnostics.Append(updateResourcePartialState(ctx, updateReq, &updateResp)...)
+	}
+
+	resp.NewIdentity = updateResp.Identity
 	resp.NewState = &updateResp.State
 
 	if !resp.Diagnostics.HasError() && updateResp.State.Raw.Equal(nullSchemaData) {
@@ -145,6 +182,20 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
+	// Ensure write-only attribute values are never stored in the state.
+	newState, err := tftypes.Transform(resp.NewState.Raw, NullifyWriteOnlyAttributes(ctx, req.ResourceSchema))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying State After Update",
+			"There was an unexpected error updating the resource state. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.NewState.Raw = newState
+
 	semanticEqualityReq := SchemaSemanticEqualityRequest{
 		PriorData: fwschemadata.Data{
 			Description:    fwschemadata.DataDescriptionPlan,
@@ -177,3 +228,73 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 
 	resp.NewState.Raw = semanticEqualityResp.NewData.TerraformValue
 }
+
+// updateResourcePartialState sets the new state values of the paths marked by
+// the provider as applied from the plan or kept from the prior state.
+func updateResourcePartialState(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, p := range resp.AppliedFromPlan {
+		var value attr.Value
+
+		diags.Append(req.Plan.GetAttribute(ctx, p, &value)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddAttributeError(
+				p,
+				"Invalid Applied Path",
+				"An unexpected error was encountered while setting the applied planned value into the resource state. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					err.Error(),
+			)
+
+			return diags
+		}
+
+		if !tfValue.IsFullyKnown() {
+			diags.AddAttributeError(
+				p,
+				"Invalid Applied Path",
+				"An unexpected error was encountered while setting the applied planned value into the resource state. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("The planned value at %s contains unknown values. Set the value in the response state instead.", p),
+			)
+
+			return diags
+		}
+
+		logging.FrameworkTrace(ctx, "Setting resource state value applied from plan", map[string]interface{}{logging.KeyAttributePath: p.String()})
+
+		diags.Append(resp.State.SetAttribute(ctx, p, value)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, p := range resp.KeptFromPriorState {
+		var value attr.Value
+
+		diags.Append(req.State.GetAttribute(ctx, p, &value)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		logging.FrameworkTrace(ctx, "Setting resource state value kept from prior state", map[string]interface{}{logging.KeyAttributePath: p.String()})
+
+		diags.Append(resp.State.SetAttribute(ctx, p, value)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
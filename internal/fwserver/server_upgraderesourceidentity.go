@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// UpgradeResourceIdentityRequest is the framework server request for the
+// UpgradeResourceIdentity RPC.
+type UpgradeResourceIdentityRequest struct {
+	// Using the tfprotov6 type here matches the UpgradeResourceState RPC
+	// handling, since the type is trivial to convert between tfprotov5.
+	RawIdentity *tfprotov6.RawState
+
+	IdentitySchema fwschema.Schema
+	Version        int64
+}
+
+// UpgradeResourceIdentityResponse is the framework server response for the
+// UpgradeResourceIdentity RPC.
+type UpgradeResourceIdentityResponse struct {
+	Diagnostics      diag.Diagnostics
+	UpgradedIdentity *tfsdk.ResourceIdentity
+}
+
+// UpgradeResourceIdentity implements the framework server
+// UpgradeResourceIdentity RPC. The framework does not yet support provider
+// defined identity upgrades, so only identity data matching the current
+// identity schema version is accepted.
+func (s *Server) UpgradeResourceIdentity(ctx context.Context, req *UpgradeResourceIdentityRequest, resp *UpgradeResourceIdentityResponse) {
+	if req == nil {
+		return
+	}
+
+	// No UpgradedIdentity to return, similar to UpgradeResourceState.
+	if req.RawIdentity == nil {
+		return
+	}
+
+	if req.IdentitySchema == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource Identity",
+			"This resource was implemented without an IdentitySchema() method, "+
+				"however Terraform was expecting resource identity data to upgrade.\n\n"+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+
+		return
+	}
+
+	if req.Version != req.IdentitySchema.GetVersion() {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource Identity",
+			fmt.Sprintf("Terraform was expecting an implementation for resource identity version %d upgrade to version %d, ", req.Version, req.IdentitySchema.GetVersion())+
+				"however resource identity upgrades are not supported.\n\n"+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "UpgradeResourceIdentity request version matches current identity schema version, using framework defined passthrough implementation")
+
+	// IgnoreUndefinedAttributes will silently skip over fields in the JSON
+	// that do not have a matching entry in the schema.
+	unmarshalOpts := tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	}
+
+	identitySchemaType := req.IdentitySchema.Type().TerraformType(ctx)
+
+	rawIdentityValue, err := req.RawIdentity.UnmarshalWithOpts(identitySchemaType, unmarshalOpts)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Previously Saved Identity for UpgradeResourceIdentity",
+			"There was an error reading the saved resource identity using the current resource identity schema.\n\n"+
+				"If you manually modified the resource identity, you will need to manually modify it to match the current resource identity schema. "+
+				"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
+		)
+
+		return
+	}
+
+	resp.UpgradedIdentity = &tfsdk.ResourceIdentity{
+		Schema: req.IdentitySchema,
+		Raw:    rawIdentityValue,
+	}
+}
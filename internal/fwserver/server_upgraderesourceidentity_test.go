@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerUpgradeResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testIdentitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+		Version: 1,
+	}
+	identitySchemaType := testIdentitySchema.Type().TerraformType(ctx)
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.UpgradeResourceIdentityRequest
+		expectedResponse *fwserver.UpgradeResourceIdentityResponse
+	}{
+		"nil": {
+			server:           &fwserver.Server{},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{},
+		},
+		"RawIdentity-missing": {
+			server: &fwserver.Server{},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				IdentitySchema: testIdentitySchema,
+				Version:        1,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{},
+		},
+		"IdentitySchema-missing": {
+			server: &fwserver.Server{},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: &tfprotov6.RawState{
+					JSON: []byte(`{"id": "test-id-value"}`),
+				},
+				Version: 1,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource Identity",
+						"This resource was implemented without an IdentitySchema() method, "+
+							"however Terraform was expecting resource identity data to upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"RawIdentity-JSON-passthrough": {
+			server: &fwserver.Server{},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: &tfprotov6.RawState{
+					JSON: []byte(`{"id": "test-id-value"}`),
+				},
+				IdentitySchema: testIdentitySchema,
+				Version:        1,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				UpgradedIdentity: &tfsdk.ResourceIdentity{
+					Raw: tftypes.NewValue(identitySchemaType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "test-id-value"),
+					}),
+					Schema: testIdentitySchema,
+				},
+			},
+		},
+		"RawIdentity-JSON-mismatch": {
+			server: &fwserver.Server{},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: &tfprotov6.RawState{
+					JSON: []byte(`{"id": ["test-id-value"]}`),
+				},
+				IdentitySchema: testIdentitySchema,
+				Version:        1,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Read Previously Saved Identity for UpgradeResourceIdentity",
+						"There was an error reading the saved resource identity using the current resource identity schema.\n\n"+
+							"If you manually modified the resource identity, you will need to manually modify it to match the current resource identity schema. "+
+							"Otherwise, please report this to the provider developer:\n\n"+
+							"AttributeName(\"id\"): unsupported type json.Delim sent as tftypes.String",
+					),
+				},
+			},
+		},
+		"Version-mismatch": {
+			server: &fwserver.Server{},
+			request: &fwserver.UpgradeResourceIdentityRequest{
+				RawIdentity: &tfprotov6.RawState{
+					JSON: []byte(`{"id": "test-id-value"}`),
+				},
+				IdentitySchema: testIdentitySchema,
+				Version:        0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceIdentityResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource Identity",
+						"Terraform was expecting an implementation for resource identity version 0 upgrade to version 1, "+
+							"however resource identity upgrades are not supported.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.UpgradeResourceIdentityResponse{}
+			testCase.server.UpgradeResourceIdentity(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromflatmap"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -82,13 +83,14 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/113
 	//
 	// UnmarshalWithOpts allows optionally ignoring instances in which elements being
-	// do not have a corresponding attribute within the schema.
+	// do not have a corresponding attribute within the schema. Flatmap states,
+	// written by Terraform CLI 0.11 and earlier, are converted using the schema.
 	if req.Version == req.ResourceSchema.GetVersion() {
 		logging.FrameworkTrace(ctx, "UpgradeResourceState request version matches current Schema version, using framework defined passthrough implementation")
 
 		resourceSchemaType := req.ResourceSchema.Type().TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.UnmarshalWithOpts(resourceSchemaType, unmarshalOpts)
+		rawStateValue, err := fromflatmap.RawState(*req.RawState, resourceSchemaType, unmarshalOpts)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -172,7 +174,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		priorSchemaType := resourceStateUpgrader.PriorSchema.Type().TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.UnmarshalWithOpts(priorSchemaType, unmarshalOpts)
+		rawStateValue, err := fromflatmap.RawState(*req.RawState, priorSchemaType, unmarshalOpts)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
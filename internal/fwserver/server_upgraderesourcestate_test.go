@@ -269,6 +269,115 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"RawState-UnmarshalRawState-flatmap-and-DynamicValue": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":                 "test-id-value",
+						"required_attribute": "true",
+					},
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(ctx context.Context) map[int64]resource.StateUpgrader {
+						return map[int64]resource.StateUpgrader{
+							0: {
+								StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+									rawStateValue, err := req.UnmarshalRawState(tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"id":                 tftypes.String,
+											"optional_attribute": tftypes.Bool,
+											"required_attribute": tftypes.Bool,
+										},
+									})
+
+									if err != nil {
+										resp.Diagnostics.AddError(
+											"Unable to Unmarshal Prior State",
+											err.Error(),
+										)
+										return
+									}
+
+									var rawState map[string]tftypes.Value
+
+									if err := rawStateValue.As(&rawState); err != nil {
+										resp.Diagnostics.AddError(
+											"Unable to Convert Prior State",
+											err.Error(),
+										)
+										return
+									}
+
+									var optionalAttributeString *string
+
+									if !rawState["optional_attribute"].IsNull() {
+										var optionalAttribute bool
+
+										if err := rawState["optional_attribute"].As(&optionalAttribute); err != nil {
+											resp.Diagnostics.AddAttributeError(
+												path.Root("optional_attribute"),
+												"Unable to Convert Prior State",
+												err.Error(),
+											)
+											return
+										}
+
+										v := fmt.Sprintf("%t", optionalAttribute)
+										optionalAttributeString = &v
+									}
+
+									var requiredAttribute bool
+
+									if err := rawState["required_attribute"].As(&requiredAttribute); err != nil {
+										resp.Diagnostics.AddAttributeError(
+											path.Root("required_attribute"),
+											"Unable to Convert Prior State",
+											err.Error(),
+										)
+										return
+									}
+
+									dynamicValue, err := tfprotov6.NewDynamicValue(
+										schemaType,
+										tftypes.NewValue(schemaType, map[string]tftypes.Value{
+											"id":                 rawState["id"],
+											"optional_attribute": tftypes.NewValue(tftypes.String, optionalAttributeString),
+											"required_attribute": tftypes.NewValue(tftypes.String, fmt.Sprintf("%t", requiredAttribute)),
+										}),
+									)
+
+									if err != nil {
+										resp.Diagnostics.AddError(
+											"Unable to Convert Upgraded State",
+											err.Error(),
+										)
+										return
+									}
+
+									resp.DynamicValue = &dynamicValue
+								},
+							},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"RawState-JSON-and-DynamicValue": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -517,6 +626,82 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"PriorSchema-and-State-flatmap": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":                 "test-id-value",
+						"required_attribute": "true",
+					},
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(ctx context.Context) map[int64]resource.StateUpgrader {
+						return map[int64]resource.StateUpgrader{
+							0: {
+								PriorSchema: &schema.Schema{
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											Computed: true,
+										},
+										"optional_attribute": schema.BoolAttribute{
+											Optional: true,
+										},
+										"required_attribute": schema.BoolAttribute{
+											Required: true,
+										},
+									},
+								},
+								StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+									var priorStateData struct {
+										Id                string `tfsdk:"id"`
+										OptionalAttribute *bool  `tfsdk:"optional_attribute"`
+										RequiredAttribute bool   `tfsdk:"required_attribute"`
+									}
+
+									resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+									if resp.Diagnostics.HasError() {
+										return
+									}
+
+									upgradedStateData := struct {
+										Id                string  `tfsdk:"id"`
+										OptionalAttribute *string `tfsdk:"optional_attribute"`
+										RequiredAttribute string  `tfsdk:"required_attribute"`
+									}{
+										Id:                priorStateData.Id,
+										RequiredAttribute: fmt.Sprintf("%t", priorStateData.RequiredAttribute),
+									}
+
+									if priorStateData.OptionalAttribute != nil {
+										v := fmt.Sprintf("%t", *priorStateData.OptionalAttribute)
+										upgradedStateData.OptionalAttribute = &v
+									}
+
+									resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+								},
+							},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"PriorSchema-and-State-json-mismatch": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -649,7 +834,8 @@ func TestServerUpgradeResourceState(t *testing.T) {
 			request: &fwserver.UpgradeResourceStateRequest{
 				RawState: &tfprotov6.RawState{
 					Flatmap: map[string]string{
-						"flatmap": "is not supported",
+						"id":                 "test-id-value",
+						"required_attribute": "true",
 					},
 				},
 				ResourceSchema: testSchema,
@@ -657,15 +843,13 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
 			},
 			expectedResponse: &fwserver.UpgradeResourceStateResponse{
-				Diagnostics: diag.Diagnostics{
-					diag.NewErrorDiagnostic(
-						"Unable to Read Previously Saved State for UpgradeResourceState",
-						"There was an error reading the saved resource state using the current resource schema.\n\n"+
-							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
-							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
-							"Otherwise, please report this to the provider developer:\n\n"+
-							"flatmap states cannot be unmarshaled, only states written by Terraform 0.12 and higher can be unmarshaled",
-					),
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
 				},
 			},
 		},
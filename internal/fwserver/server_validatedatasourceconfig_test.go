@@ -64,6 +64,20 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 		},
 	}
 
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DeprecationMessage: "Use examplecloud_other data source instead.",
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaDeprecated,
+	}
+
 	testConfigAttributeValidator := tfsdk.Config{
 		Raw:    testValue,
 		Schema: testSchemaAttributeValidator,
@@ -114,6 +128,27 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{},
 		},
+		"request-config-DeprecationMessage": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateDataSourceConfigRequest{
+				Config: &testConfigDeprecated,
+				DataSource: &testprovider.DataSource{
+					SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateDataSourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deprecated",
+						"Use examplecloud_other data source instead.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -31,9 +31,39 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		return
 	}
 
-	vpcReq := provider.ValidateConfigRequest{
+	resp.Diagnostics.Append(s.validateProviderConfig(ctx, *req.Config)...)
+
+	validateSchemaReq := ValidateSchemaRequest{
 		Config: *req.Config,
 	}
+	// Instantiate a new response for each request to prevent validators
+	// from modifying or removing diagnostics.
+	validateSchemaResp := ValidateSchemaResponse{}
+
+	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
+
+	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
+
+	// This RPC allows a modified configuration to be returned. This was
+	// previously used to allow a "required" provider attribute (as defined
+	// by a schema) to still be "optional" with a default value, typically
+	// through an environment variable. Other tooling based on the provider
+	// schema information could not determine this implementation detail.
+	// To ensure accuracy going forward, this implementation is opinionated
+	// towards accurate provider schema definitions and optional values
+	// can be filled in or return errors during ConfigureProvider().
+	resp.PreparedConfig = req.Config
+}
+
+// validateProviderConfig calls the provider defined ConfigValidators and
+// ValidateConfig logic, if implemented. Schema-based validation is not
+// included.
+func (s *Server) validateProviderConfig(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vpcReq := provider.ValidateConfigRequest{
+		Config: config,
+	}
 
 	if providerWithConfigValidators, ok := s.Provider.(provider.ProviderWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Provider implements ProviderWithConfigValidators")
@@ -59,7 +89,7 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 				},
 			)
 
-			resp.Diagnostics.Append(vpcRes.Diagnostics...)
+			diags.Append(vpcRes.Diagnostics...)
 		}
 	}
 
@@ -74,27 +104,8 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 		providerWithValidateConfig.ValidateConfig(ctx, vpcReq, vpcRes)
 		logging.FrameworkTrace(ctx, "Called provider defined Provider ValidateConfig")
 
-		resp.Diagnostics.Append(vpcRes.Diagnostics...)
-	}
-
-	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		diags.Append(vpcRes.Diagnostics...)
 	}
-	// Instantiate a new response for each request to prevent validators
-	// from modifying or removing diagnostics.
-	validateSchemaResp := ValidateSchemaResponse{}
-
-	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
 
-	resp.Diagnostics.Append(validateSchemaResp.Diagnostics...)
-
-	// This RPC allows a modified configuration to be returned. This was
-	// previously used to allow a "required" provider attribute (as defined
-	// by a schema) to still be "optional" with a default value, typically
-	// through an environment variable. Other tooling based on the provider
-	// schema information could not determine this implementation detail.
-	// To ensure accuracy going forward, this implementation is opinionated
-	// towards accurate provider schema definitions and optional values
-	// can be filled in or return errors during ConfigureProvider().
-	resp.PreparedConfig = req.Config
+	return diags
 }
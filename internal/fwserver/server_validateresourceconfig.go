@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
@@ -15,8 +16,10 @@ import (
 // ValidateResourceConfigRequest is the framework server request for the
 // ValidateResourceConfig RPC.
 type ValidateResourceConfigRequest struct {
-	Config   *tfsdk.Config
-	Resource resource.Resource
+	ClientCapabilities resource.ValidateConfigClientCapabilities
+	Config             *tfsdk.Config
+	Resource           resource.Resource
+	TypeName           string
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -31,6 +34,10 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		return
 	}
 
+	if !req.ClientCapabilities.WriteOnlyAttributesAllowed {
+		resp.Diagnostics.Append(ValidateWriteOnlyAttributesNotConfigured(ctx, *req.Config)...)
+	}
+
 	if resourceWithConfigure, ok := req.Resource.(resource.ResourceWithConfigure); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
 
@@ -50,13 +57,55 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		}
 	}
 
+	if providerWithResourceConfigValidators, ok := s.Provider.(provider.ProviderWithResourceConfigValidators); ok {
+		logging.FrameworkTrace(ctx, "Provider implements ProviderWithResourceConfigValidators")
+
+		vrcReq := provider.ValidateResourceConfigRequest{
+			Config:   *req.Config,
+			TypeName: req.TypeName,
+		}
+
+		for _, configValidator := range providerWithResourceConfigValidators.ResourceConfigValidators(ctx) {
+			// Instantiate a new response for each request to prevent validators
+			// from modifying or removing diagnostics.
+			vrcResp := &provider.ValidateResourceConfigResponse{}
+
+			logging.FrameworkTrace(
+				ctx,
+				"Calling provider defined ProviderResourceConfigValidator",
+				map[string]interface{}{
+					logging.KeyDescription: configValidator.Description(ctx),
+				},
+			)
+			configValidator.ValidateResourceConfig(ctx, vrcReq, vrcResp)
+			logging.FrameworkTrace(
+				ctx,
+				"Called provider defined ProviderResourceConfigValidator",
+				map[string]interface{}{
+					logging.KeyDescription: configValidator.Description(ctx),
+				},
+			)
+
+			resp.Diagnostics.Append(vrcResp.Diagnostics...)
+		}
+	}
+
 	vdscReq := resource.ValidateConfigRequest{
-		Config: *req.Config,
+		ClientCapabilities: req.ClientCapabilities,
+		Config:             *req.Config,
 	}
 
 	if resourceWithConfigValidators, ok := req.Resource.(resource.ResourceWithConfigValidators); ok {
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigValidators")
 
+		var stopOnError bool
+
+		if resourceWithStopOnError, ok := req.Resource.(resource.ResourceWithConfigValidatorsStopOnError); ok {
+			logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigValidatorsStopOnError")
+
+			stopOnError = resourceWithStopOnError.ConfigValidatorsStopOnError(ctx)
+		}
+
 		for _, configValidator := range resourceWithConfigValidators.ConfigValidators(ctx) {
 			// Instantiate a new response for each request to prevent validators
 			// from modifying or removing diagnostics.
@@ -79,6 +128,12 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 			)
 
 			resp.Diagnostics.Append(vdscResp.Diagnostics...)
+
+			if stopOnError && s.stopOnRecoverableError(ctx, vdscResp.Diagnostics) {
+				logging.FrameworkTrace(ctx, "Skipping remaining ResourceConfigValidators due to error diagnostic")
+
+				break
+			}
 		}
 	}
 
@@ -96,6 +151,8 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResour
 		resp.Diagnostics.Append(vdscResp.Diagnostics...)
 	}
 
+	resp.Diagnostics.Append(ResourceConditionalAttributes(ctx, req.Resource, *req.Config)...)
+
 	validateSchemaReq := ValidateSchemaRequest{
 		Config: *req.Config,
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -64,6 +65,81 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		},
 	}
 
+	testSchemaDeprecated := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DeprecationMessage: "Use examplecloud_other resource instead.",
+	}
+
+	testConfigDeprecated := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaDeprecated,
+	}
+
+	testSchemaComputed := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"test_computed": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testConfigComputed := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed": tftypes.String,
+						},
+					},
+				},
+			},
+			map[string]tftypes.Value{
+				"test": tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test_computed": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			},
+		),
+		Schema: testSchemaComputed,
+	}
+
+	testSchemaWriteOnly := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+			},
+		},
+	}
+
+	testConfigWriteOnly := tfsdk.Config{
+		Raw:    testValue,
+		Schema: testSchemaWriteOnly,
+	}
+
+	testConfigWriteOnlyNull := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchemaWriteOnly,
+	}
+
 	testConfigAttributeValidator := tfsdk.Config{
 		Raw:    testValue,
 		Schema: testSchemaAttributeValidator,
@@ -89,6 +165,49 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaConditional := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Computed: true,
+				Optional: true,
+			},
+			"test_other": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testConfigConditional := func(test tftypes.Value, testOther tftypes.Value) *tfsdk.Config {
+		return &tfsdk.Config{
+			Raw: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test":       tftypes.String,
+						"test_other": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"test":       test,
+					"test_other": testOther,
+				},
+			),
+			Schema: testSchemaConditional,
+		}
+	}
+
+	testResourceConditional := func(conditionalAttribute resource.ConditionalAttribute, resourceSchema schema.Schema) resource.Resource {
+		return &testprovider.ResourceWithConditionalAttributes{
+			Resource: &testprovider.Resource{
+				SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+					resp.Schema = resourceSchema
+				},
+			},
+			ConditionalAttributesMethod: func(_ context.Context) []resource.ConditionalAttribute {
+				return []resource.ConditionalAttribute{conditionalAttribute}
+			},
+		}
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -114,6 +233,105 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-write-only-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				ClientCapabilities: resource.ValidateConfigClientCapabilities{
+					WriteOnlyAttributesAllowed: true,
+				},
+				Config: &testConfigWriteOnly,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaWriteOnly
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-write-only-not-allowed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigWriteOnly,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaWriteOnly
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Write-Only Attribute Not Allowed",
+						"The resource contains a non-null value for a write-only attribute, "+
+							"but the Terraform client does not support write-only attributes. "+
+							"Write-only attributes are supported in Terraform 1.11 and later.",
+					),
+				},
+			},
+		},
+		"request-config-write-only-not-allowed-null": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigWriteOnlyNull,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaWriteOnly
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-DeprecationMessage": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigDeprecated,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaDeprecated
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Deprecated",
+						"Use examplecloud_other resource instead.",
+					),
+				},
+			},
+		},
+		"request-config-Computed-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigComputed,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchemaComputed
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test").AtName("test_computed"),
+						"Invalid Configuration for Read-Only Attribute",
+						"Cannot set value for this attribute as the provider has marked it as read-only. Remove the configuration line setting the value.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable and read-only attributes that are supported.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -150,6 +368,112 @@ func TestServerValidateResourceConfig(t *testing.T) {
 				},
 			},
 		},
+		"request-config-ProviderWithResourceConfigValidators": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResourceConfigValidators{
+					Provider: &testprovider.Provider{},
+					ResourceConfigValidatorsMethod: func(ctx context.Context) []provider.ResourceConfigValidator {
+						return []provider.ResourceConfigValidator{
+							&testprovider.ProviderResourceConfigValidator{
+								ValidateResourceConfigMethod: func(ctx context.Context, req provider.ValidateResourceConfigRequest, resp *provider.ValidateResourceConfigResponse) {
+									if req.TypeName != "test_resource" {
+										resp.Diagnostics.AddError("Incorrect req.TypeName", "expected test_resource, got "+req.TypeName)
+									}
+
+									var got types.String
+
+									resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+									if resp.Diagnostics.HasError() {
+										return
+									}
+
+									if got.ValueString() != "test-value" {
+										resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
+									}
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.Resource{
+					SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+						resp.Schema = testSchema
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-ProviderWithResourceConfigValidators-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResourceConfigValidators{
+					Provider: &testprovider.Provider{},
+					ResourceConfigValidatorsMethod: func(ctx context.Context) []provider.ResourceConfigValidator {
+						return []provider.ResourceConfigValidator{
+							&testprovider.ProviderResourceConfigValidator{
+								ValidateResourceConfigMethod: func(ctx context.Context, req provider.ValidateResourceConfigRequest, resp *provider.ValidateResourceConfigResponse) {
+									resp.Diagnostics.AddWarning("provider warning summary", "provider warning detail")
+									resp.Diagnostics.AddError("provider error summary 1", "provider error detail 1")
+								},
+							},
+							&testprovider.ProviderResourceConfigValidator{
+								ValidateResourceConfigMethod: func(ctx context.Context, req provider.ValidateResourceConfigRequest, resp *provider.ValidateResourceConfigResponse) {
+									// Intentionally set diagnostics instead of add/append.
+									// The framework should not overwrite existing diagnostics.
+									resp.Diagnostics = diag.Diagnostics{
+										diag.NewErrorDiagnostic("provider error summary 2", "provider error detail 2"),
+									}
+								},
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigValidators{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []resource.ConfigValidator {
+						return []resource.ConfigValidator{
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("resource error summary", "resource error detail")
+								},
+							},
+						}
+					},
+				},
+				TypeName: "test_resource",
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"provider warning summary",
+						"provider warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"provider error summary 1",
+						"provider error detail 1",
+					),
+					diag.NewErrorDiagnostic(
+						"provider error summary 2",
+						"provider error detail 2",
+					),
+					diag.NewErrorDiagnostic(
+						"resource error summary",
+						"resource error detail",
+					),
+				},
+			},
+		},
 		"request-config-ResourceWithConfigValidators": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -230,6 +554,312 @@ func TestServerValidateResourceConfig(t *testing.T) {
 					),
 				}},
 		},
+		"request-config-ResourceWithConfigValidatorsStopOnError-false": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigValidatorsStopOnError{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []resource.ConfigValidator {
+						return []resource.ConfigValidator{
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("error summary 1", "error detail 1")
+								},
+							},
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("error summary 2", "error detail 2")
+								},
+							},
+						}
+					},
+					ConfigValidatorsStopOnErrorMethod: func(ctx context.Context) bool {
+						return false
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary 1",
+						"error detail 1",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary 2",
+						"error detail 2",
+					),
+				}},
+		},
+		"request-config-ResourceWithConfigValidatorsStopOnError-true": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigValidatorsStopOnError{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []resource.ConfigValidator {
+						return []resource.ConfigValidator{
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddWarning("warning summary", "warning detail")
+								},
+							},
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("error summary 1", "error detail 1")
+								},
+							},
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("error summary 2", "error detail 2")
+								},
+							},
+						}
+					},
+					ConfigValidatorsStopOnErrorMethod: func(ctx context.Context) bool {
+						return true
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary 1",
+						"error detail 1",
+					),
+				}},
+		},
+		"request-config-ResourceWithConfigValidatorsStopOnError-true-CollectAllDiagnostics": {
+			server: &fwserver.Server{
+				CollectAllDiagnostics: true,
+				Provider:              &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfig,
+				Resource: &testprovider.ResourceWithConfigValidatorsStopOnError{
+					Resource: &testprovider.Resource{
+						SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+							resp.Schema = testSchema
+						},
+					},
+					ConfigValidatorsMethod: func(ctx context.Context) []resource.ConfigValidator {
+						return []resource.ConfigValidator{
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddWarning("warning summary", "warning detail")
+								},
+							},
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("error summary 1", "error detail 1")
+								},
+							},
+							&testprovider.ResourceConfigValidator{
+								ValidateResourceMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+									resp.Diagnostics.AddError("error summary 2", "error detail 2")
+								},
+							},
+						}
+					},
+					ConfigValidatorsStopOnErrorMethod: func(ctx context.Context) bool {
+						return true
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"warning summary",
+						"warning detail",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary 1",
+						"error detail 1",
+					),
+					diag.NewErrorDiagnostic(
+						"error summary 2",
+						"error detail 2",
+					),
+				}},
+		},
+		"request-config-ResourceWithConditionalAttributes-RequiredIf-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigConditional(
+					tftypes.NewValue(tftypes.String, "test-value"),
+					tftypes.NewValue(tftypes.String, "test-other-value"),
+				),
+				Resource: testResourceConditional(
+					resource.ConditionalAttribute{
+						Attribute:  path.MatchRoot("test"),
+						RequiredIf: path.Expressions{path.MatchRoot("test_other")},
+					},
+					testSchemaConditional,
+				),
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-ResourceWithConditionalAttributes-RequiredIf-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigConditional(
+					tftypes.NewValue(tftypes.String, nil),
+					tftypes.NewValue(tftypes.String, "test-other-value"),
+				),
+				Resource: testResourceConditional(
+					resource.ConditionalAttribute{
+						Attribute:  path.MatchRoot("test"),
+						RequiredIf: path.Expressions{path.MatchRoot("test_other")},
+					},
+					testSchemaConditional,
+				),
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Missing Configuration for Required Attribute",
+						"Must set a configuration value for the test attribute as the provider has marked it as required when any of these attributes are configured: [test_other]\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+					),
+				},
+			},
+		},
+		"request-config-ResourceWithConditionalAttributes-RequiredIf-unmet": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigConditional(
+					tftypes.NewValue(tftypes.String, nil),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+				Resource: testResourceConditional(
+					resource.ConditionalAttribute{
+						Attribute:  path.MatchRoot("test"),
+						RequiredIf: path.Expressions{path.MatchRoot("test_other")},
+					},
+					testSchemaConditional,
+				),
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-ResourceWithConditionalAttributes-RequiredIf-unknown": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigConditional(
+					tftypes.NewValue(tftypes.String, nil),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				),
+				Resource: testResourceConditional(
+					resource.ConditionalAttribute{
+						Attribute:  path.MatchRoot("test"),
+						RequiredIf: path.Expressions{path.MatchRoot("test_other")},
+					},
+					testSchemaConditional,
+				),
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-ResourceWithConditionalAttributes-ComputedIf-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigConditional(
+					tftypes.NewValue(tftypes.String, "test-value"),
+					tftypes.NewValue(tftypes.String, "test-other-value"),
+				),
+				Resource: testResourceConditional(
+					resource.ConditionalAttribute{
+						Attribute:  path.MatchRoot("test"),
+						ComputedIf: path.Expressions{path.MatchRoot("test_other")},
+					},
+					testSchemaConditional,
+				),
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Configuration for Read-Only Attribute",
+						"Cannot set value for this attribute as the provider has marked it as read-only when any of these attributes are configured: [test_other] "+
+							"Remove the configuration line setting the value.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable and read-only attributes that are supported.",
+					),
+				},
+			},
+		},
+		"request-config-ResourceWithConditionalAttributes-ComputedIf-unmet": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigConditional(
+					tftypes.NewValue(tftypes.String, "test-value"),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+				Resource: testResourceConditional(
+					resource.ConditionalAttribute{
+						Attribute:  path.MatchRoot("test"),
+						ComputedIf: path.Expressions{path.MatchRoot("test_other")},
+					},
+					testSchemaConditional,
+				),
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
+		},
+		"request-config-ResourceWithConditionalAttributes-invalid-schema": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: testConfigConditional(
+					tftypes.NewValue(tftypes.String, "test-value"),
+					tftypes.NewValue(tftypes.String, "test-other-value"),
+				),
+				Resource: testResourceConditional(
+					resource.ConditionalAttribute{
+						Attribute:  path.MatchRoot("test_other"),
+						RequiredIf: path.Expressions{path.MatchRoot("test")},
+					},
+					testSchemaConditional,
+				),
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test_other"),
+						"Invalid Conditional Attribute",
+						"An unexpected error was encountered while verifying conditional attributes. "+
+							"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"The conditional attribute test_other must be Optional and Computed in the schema.",
+					),
+				},
+			},
+		},
 		"request-config-ResourceWithValidateConfig": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
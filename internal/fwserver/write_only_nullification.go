@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// NullifyWriteOnlyAttributes returns a tftypes.Transform function which sets
+// all write-only attribute values to null, so they are never stored in the
+// plan or state.
+func NullifyWriteOnlyAttributes(ctx context.Context, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		ctx = logging.FrameworkWithAttributePath(ctx, path.String())
+
+		// we are only modifying attributes, not the entire resource
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+
+		attribute, err := resourceSchema.AttributeAtTerraformPath(ctx, path)
+
+		if err != nil {
+			if errors.Is(err, fwschema.ErrPathInsideAtomicAttribute) {
+				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
+				return val, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathIsBlock) {
+				// ignore blocks, they do not have a write-only field
+				return val, nil
+			}
+
+			if errors.Is(err, fwschema.ErrPathInsideDynamicAttribute) {
+				// ignore attributes/elements inside schema.DynamicAttribute, they have no schema of their own
+				return val, nil
+			}
+
+			logging.FrameworkError(ctx, "couldn't find attribute in resource schema")
+
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+		}
+
+		attributeWithWriteOnly, ok := attribute.(fwschema.AttributeWithWriteOnly)
+
+		if !ok || !attributeWithWriteOnly.IsWriteOnly() {
+			return val, nil
+		}
+
+		if val.IsNull() {
+			return val, nil
+		}
+
+		logging.FrameworkTrace(ctx, "Setting write-only attribute value to null")
+
+		return tftypes.NewValue(val.Type(), nil), nil
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ValidateWriteOnlyAttributesNotConfigured returns an error diagnostic for
+// each write-only attribute with a non-null configuration value. This is used
+// when the Terraform client does not support write-only attributes, since it
+// would otherwise reject the null planned values of those attributes.
+func ValidateWriteOnlyAttributesNotConfigured(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.Schema == nil {
+		return diags
+	}
+
+	_ = tftypes.Walk(config.Raw, func(tfPath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		// we are only checking attributes, not the entire resource
+		if len(tfPath.Steps()) < 1 {
+			return true, nil
+		}
+
+		attribute, err := config.Schema.AttributeAtTerraformPath(ctx, tfPath)
+
+		if err != nil {
+			// Blocks do not have a write-only field, so continue into them.
+			return errors.Is(err, fwschema.ErrPathIsBlock), nil
+		}
+
+		attributeWithWriteOnly, ok := attribute.(fwschema.AttributeWithWriteOnly)
+
+		if !ok || !attributeWithWriteOnly.IsWriteOnly() {
+			return true, nil
+		}
+
+		if value.IsNull() {
+			return false, nil
+		}
+
+		attributePath, attributePathDiags := fromtftypes.AttributePath(ctx, tfPath, config.Schema)
+
+		diags.Append(attributePathDiags...)
+
+		if attributePathDiags.HasError() {
+			return false, nil
+		}
+
+		diags.AddAttributeError(
+			attributePath,
+			"Write-Only Attribute Not Allowed",
+			"The resource contains a non-null value for a write-only attribute, "+
+				"but the Terraform client does not support write-only attributes. "+
+				"Write-only attributes are supported in Terraform 1.11 and later.",
+		)
+
+		return false, nil
+	})
+
+	return diags
+}
@@ -22,6 +22,9 @@ const (
 	// implement the Description() method, such as validators.
 	KeyDescription = "description"
 
+	// The type of ephemeral resource being operated on, such as "random_password"
+	KeyEphemeralResourceType = "tf_ephemeral_resource_type"
+
 	// Underlying Go error string when logging an error.
 	KeyError = "error"
 
@@ -4,6 +4,7 @@
 package privatestate
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -264,6 +265,62 @@ func (d *ProviderData) Equal(o *ProviderData) bool {
 	return true
 }
 
+// Copy returns a duplicate of the ProviderData, which can be modified
+// without affecting the original.
+func (d *ProviderData) Copy() *ProviderData {
+	if d == nil {
+		return nil
+	}
+
+	copied := &ProviderData{}
+
+	if d.data == nil {
+		return copied
+	}
+
+	copied.data = make(map[string][]byte, len(d.data))
+
+	for key, value := range d.data {
+		copied.data[key] = value
+	}
+
+	return copied
+}
+
+// MergeChanges applies the keys which were added, modified, or removed in
+// changed, relative to base, to the ProviderData. Keys which are unchanged
+// are left as-is, so changes previously merged from other copies of base are
+// preserved.
+func (d *ProviderData) MergeChanges(base, changed *ProviderData) {
+	if d == nil || changed == nil {
+		return
+	}
+
+	var baseData map[string][]byte
+
+	if base != nil {
+		baseData = base.data
+	}
+
+	for key, value := range changed.data {
+		if baseValue, ok := baseData[key]; ok && bytes.Equal(baseValue, value) {
+			continue
+		}
+
+		if d.data == nil {
+			d.data = make(map[string][]byte)
+		}
+
+		d.data[key] = value
+	}
+
+	for key := range baseData {
+		if _, ok := changed.data[key]; !ok {
+			delete(d.data, key)
+		}
+	}
+}
+
 // GetKey returns the private state data associated with the given key.
 //
 // If the key is reserved for framework usage, an error diagnostic
@@ -293,6 +350,32 @@ func (d *ProviderData) GetKey(ctx context.Context, key string) ([]byte, diag.Dia
 	return value, nil
 }
 
+// GetKeyAs fetches the private state data associated with the given key and
+// unmarshals the JSON data into the target, which must be a pointer. If no
+// data is found at the key, false is returned and the target is unmodified.
+//
+// If the key is reserved for framework usage or the data cannot be
+// unmarshalled into the target, an error diagnostic is returned.
+func (d *ProviderData) GetKeyAs(ctx context.Context, key string, target any) (bool, diag.Diagnostics) {
+	value, diags := d.GetKey(ctx, key)
+
+	if diags.HasError() || value == nil {
+		return false, diags
+	}
+
+	if err := json.Unmarshal(value, target); err != nil {
+		diags.AddError(
+			"Error Decoding Private State",
+			fmt.Sprintf("An error was encountered when decoding private state key %q: %s.\n\n"+
+				"This is always a problem with the provider. Please report this to the provider developer.", key, err),
+		)
+
+		return false, diags
+	}
+
+	return true, diags
+}
+
 // SetKey sets the private state data at the given key.
 //
 // If the key is reserved for framework usage, an error diagnostic
@@ -361,6 +444,52 @@ func (d *ProviderData) SetKey(ctx context.Context, key string, value []byte) dia
 	return nil
 }
 
+// Clear removes all provider-defined private state data. This is useful for
+// discarding previously stored data which is no longer compatible with the
+// provider, such as after changing the structure of private state data
+// between provider versions. Framework private state data, such as timeouts,
+// is stored separately and is never removed.
+func (d *ProviderData) Clear(ctx context.Context) diag.Diagnostics {
+	return d.ClearKeysWithPrefix(ctx, "")
+}
+
+// ClearKeysWithPrefix removes all provider-defined private state data with
+// keys beginning with the given prefix. An empty prefix removes all
+// provider-defined private state data.
+//
+// If the prefix is reserved for framework usage, an error diagnostic is
+// returned and no data is removed.
+func (d *ProviderData) ClearKeysWithPrefix(ctx context.Context, prefix string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d == nil {
+		tflog.Error(ctx, "error calling ClearKeysWithPrefix on uninitialized ProviderData")
+
+		diags.AddError("Uninitialized ProviderData",
+			"ProviderData must be initialized before it is used.\n\n"+
+				"Call privatestate.NewProviderData to obtain an initialized instance of ProviderData.",
+		)
+
+		return diags
+	}
+
+	if prefix != "" {
+		diags.Append(ValidateProviderDataKey(ctx, prefix)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for key := range d.data {
+		if strings.HasPrefix(key, prefix) {
+			delete(d.data, key)
+		}
+	}
+
+	return diags
+}
+
 // ValidateProviderDataKey determines whether the key supplied is allowed on the basis of any
 // restrictions that are in place, such as key prefixes that are reserved for use with
 // framework private state data.
@@ -645,6 +645,166 @@ func TestProviderDataEqual(t *testing.T) {
 	}
 }
 
+func TestProviderData_Copy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData *ProviderData
+		expected     *ProviderData
+	}{
+		"nil": {
+			providerData: nil,
+			expected:     nil,
+		},
+		"empty": {
+			providerData: EmptyProviderData(context.Background()),
+			expected:     EmptyProviderData(context.Background()),
+		},
+		"data": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+			expected: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.providerData.Copy()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %#v, got %#v", testCase.expected, got)
+			}
+
+			if got == nil {
+				return
+			}
+
+			// Modifying the copy must not modify the original.
+			got.SetKey(context.Background(), "copy", []byte(`{}`))
+
+			if testCase.providerData.Equal(got) {
+				t.Errorf("expected original to be unmodified")
+			}
+		})
+	}
+}
+
+func TestProviderData_MergeChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData *ProviderData
+		base         *ProviderData
+		changed      *ProviderData
+		expected     *ProviderData
+	}{
+		"nil-changed": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+			base: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+			changed: nil,
+			expected: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+		},
+		"added": {
+			providerData: EmptyProviderData(context.Background()),
+			base:         EmptyProviderData(context.Background()),
+			changed: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+			expected: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+		},
+		"modified": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{"subtest":false}`)}),
+			),
+			base: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{"subtest":false}`)}),
+			),
+			changed: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{"subtest":true}`)}),
+			),
+			expected: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{"subtest":true}`)}),
+			),
+		},
+		"removed": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+			base: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test": []byte(`{}`)}),
+			),
+			changed:  EmptyProviderData(context.Background()),
+			expected: EmptyProviderData(context.Background()),
+		},
+		"unchanged-preserves-previous-merge": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{
+					"test1": []byte(`{"subtest":true}`),
+					"test2": []byte(`{}`),
+				}),
+			),
+			base: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test1": []byte(`{"subtest":false}`)}),
+			),
+			changed: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"test1": []byte(`{"subtest":false}`)}),
+			),
+			expected: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{
+					"test1": []byte(`{"subtest":true}`),
+					"test2": []byte(`{}`),
+				}),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.providerData.MergeChanges(testCase.base, testCase.changed)
+
+			if !testCase.providerData.Equal(testCase.expected) {
+				t.Errorf("expected %#v, got %#v", testCase.expected, testCase.providerData)
+			}
+		})
+	}
+}
+
 func TestProviderData_GetKey(t *testing.T) {
 	t.Parallel()
 
@@ -711,6 +871,93 @@ func TestProviderData_GetKey(t *testing.T) {
 	}
 }
 
+func TestProviderData_GetKeyAs(t *testing.T) {
+	t.Parallel()
+
+	type testTarget struct {
+		Original string `json:"original"`
+	}
+
+	testCases := map[string]struct {
+		providerData  *ProviderData
+		key           string
+		expected      testTarget
+		expectedFound bool
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			providerData: nil,
+			key:          "key",
+		},
+		"key-invalid": {
+			providerData: EmptyProviderData(context.Background()),
+			key:          ".key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						`The key ".key" is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.`,
+				),
+			},
+		},
+		"key-not-found": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"other": []byte(`{"original":"test"}`)}),
+			),
+			key: "key",
+		},
+		"key-found": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"key": []byte(`{"original":"test"}`)}),
+			),
+			key:           "key",
+			expected:      testTarget{Original: "test"},
+			expectedFound: true,
+		},
+		"key-found-decode-error": {
+			providerData: MustProviderData(
+				context.Background(),
+				MustMarshalToJson(map[string][]byte{"key": []byte(`{"original":true}`)}),
+			),
+			key: "key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					"An error was encountered when decoding private state key \"key\": "+
+						"json: cannot unmarshal bool into Go struct field testTarget.original of type string.\n\n"+
+						"This is always a problem with the provider. Please report this to the provider developer.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testTarget
+
+			found, diags := testCase.providerData.GetKeyAs(context.Background(), testCase.key, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if found != testCase.expectedFound {
+				t.Errorf("expected found %t, got %t", testCase.expectedFound, found)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestProviderData_SetKey(t *testing.T) {
 	t.Parallel()
 
@@ -956,6 +1203,158 @@ func TestProviderData_SetKey(t *testing.T) {
 	}
 }
 
+func TestProviderData_Clear(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *ProviderData
+		expected      *ProviderData
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			providerData: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Uninitialized ProviderData",
+					"ProviderData must be initialized before it is used.\n\n"+
+						"Call privatestate.NewProviderData to obtain an initialized instance of ProviderData."),
+			},
+		},
+		"data-uninitialized": {
+			providerData: &ProviderData{},
+			expected:     &ProviderData{},
+		},
+		"data": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"key1":        []byte(`{"key1": "value1"}`),
+					"key2":        []byte(`{"key2": "value2"}`),
+					"legacy_key1": []byte(`{"legacy": true}`),
+				},
+			},
+			expected: &ProviderData{
+				data: map[string][]byte{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := testCase.providerData.Clear(context.Background())
+
+			if diff := cmp.Diff(testCase.expected, testCase.providerData, cmp.AllowUnexported(ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(actual, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProviderData_ClearKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *ProviderData
+		prefix        string
+		expected      *ProviderData
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			providerData: nil,
+			prefix:       "legacy_",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Uninitialized ProviderData",
+					"ProviderData must be initialized before it is used.\n\n"+
+						"Call privatestate.NewProviderData to obtain an initialized instance of ProviderData."),
+			},
+		},
+		"prefix-invalid": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"key1": []byte(`{"key1": "value1"}`),
+				},
+			},
+			prefix: ".",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"key1": []byte(`{"key1": "value1"}`),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						`The key "." is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.`,
+				),
+			},
+		},
+		"prefix-empty": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"key1":        []byte(`{"key1": "value1"}`),
+					"legacy_key1": []byte(`{"legacy": true}`),
+				},
+			},
+			prefix: "",
+			expected: &ProviderData{
+				data: map[string][]byte{},
+			},
+		},
+		"prefix-match": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"key1":        []byte(`{"key1": "value1"}`),
+					"legacy_key1": []byte(`{"legacy": true}`),
+					"legacy_key2": []byte(`{"legacy": true}`),
+				},
+			},
+			prefix: "legacy_",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"key1": []byte(`{"key1": "value1"}`),
+				},
+			},
+		},
+		"prefix-no-match": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"key1": []byte(`{"key1": "value1"}`),
+				},
+			},
+			prefix: "legacy_",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"key1": []byte(`{"key1": "value1"}`),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := testCase.providerData.ClearKeysWithPrefix(context.Background(), testCase.prefix)
+
+			if diff := cmp.Diff(testCase.expected, testCase.providerData, cmp.AllowUnexported(ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(actual, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestValidateProviderDataKey(t *testing.T) {
 	t.Parallel()
 
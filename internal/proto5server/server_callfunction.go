@@ -19,16 +19,27 @@ import (
 func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunctionRequest) (*tfprotov5.CallFunctionResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "CallFunction")
 
 	fwResp := &fwserver.CallFunctionResponse{}
 
+	defer func() {
+		if fwResp.Error != nil {
+			done(fwResp.Error)
+
+			return
+		}
+
+		done(nil)
+	}()
+
 	serverFunction, err := s.FrameworkServer.Function(ctx, protoReq.Name)
 
 	fwResp.Error = err
 
 	if fwResp.Error != nil {
 		//nolint:nilerr // error is assigned to fwResp.Error
-		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "CallFunction", toproto5.CallFunctionResponse(ctx, fwResp)), nil
 	}
 
 	functionDefinition, err := s.FrameworkServer.FunctionDefinition(ctx, protoReq.Name)
@@ -37,7 +48,7 @@ func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunct
 
 	if fwResp.Error != nil {
 		//nolint:nilerr // error is assigned to fwResp.Error
-		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "CallFunction", toproto5.CallFunctionResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.CallFunctionRequest(ctx, protoReq, serverFunction, functionDefinition)
@@ -46,10 +57,10 @@ func (s *Server) CallFunction(ctx context.Context, protoReq *tfprotov5.CallFunct
 
 	if fwResp.Error != nil {
 		//nolint:nilerr // error is assigned to fwResp.Error
-		return toproto5.CallFunctionResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "CallFunction", toproto5.CallFunctionResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.CallFunction(ctx, fwReq, fwResp)
 
-	return toproto5.CallFunctionResponse(ctx, fwResp), nil
+	return modifyProtocolResponse(ctx, s, "CallFunction", toproto5.CallFunctionResponse(ctx, fwResp)), nil
 }
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// ephemeralResourcesUnsupportedDiagnostics returns the error diagnostics for
+// ephemeral resource RPCs, which the framework only supports with protocol
+// version 6. The protocol version 5 provider schema never contains ephemeral
+// resources and GetProviderSchema returns an error diagnostic if the provider
+// defines any, so Terraform should not call these RPCs.
+func ephemeralResourcesUnsupportedDiagnostics(typeName string) []*tfprotov5.Diagnostic {
+	return []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Ephemeral Resources Not Supported",
+			Detail: "Terraform attempted to use the " + typeName + " ephemeral resource type, however ephemeral resources " +
+				"are only supported by terraform-plugin-framework providers served with protocol version 6, such as with providerserver.NewProtocol6. " +
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.",
+		},
+	}
+}
+
+// ValidateEphemeralResourceConfig satisfies the tfprotov5.ProviderServer interface.
+func (s *Server) ValidateEphemeralResourceConfig(_ context.Context, proto5Req *tfprotov5.ValidateEphemeralResourceConfigRequest) (*tfprotov5.ValidateEphemeralResourceConfigResponse, error) {
+	return &tfprotov5.ValidateEphemeralResourceConfigResponse{
+		Diagnostics: ephemeralResourcesUnsupportedDiagnostics(proto5Req.TypeName),
+	}, nil
+}
+
+// OpenEphemeralResource satisfies the tfprotov5.ProviderServer interface.
+func (s *Server) OpenEphemeralResource(_ context.Context, proto5Req *tfprotov5.OpenEphemeralResourceRequest) (*tfprotov5.OpenEphemeralResourceResponse, error) {
+	return &tfprotov5.OpenEphemeralResourceResponse{
+		Diagnostics: ephemeralResourcesUnsupportedDiagnostics(proto5Req.TypeName),
+	}, nil
+}
+
+// RenewEphemeralResource satisfies the tfprotov5.ProviderServer interface.
+func (s *Server) RenewEphemeralResource(_ context.Context, proto5Req *tfprotov5.RenewEphemeralResourceRequest) (*tfprotov5.RenewEphemeralResourceResponse, error) {
+	return &tfprotov5.RenewEphemeralResourceResponse{
+		Diagnostics: ephemeralResourcesUnsupportedDiagnostics(proto5Req.TypeName),
+	}, nil
+}
+
+// CloseEphemeralResource satisfies the tfprotov5.ProviderServer interface.
+func (s *Server) CloseEphemeralResource(_ context.Context, proto5Req *tfprotov5.CloseEphemeralResourceRequest) (*tfprotov5.CloseEphemeralResourceResponse, error) {
+	return &tfprotov5.CloseEphemeralResourceResponse{
+		Diagnostics: ephemeralResourcesUnsupportedDiagnostics(proto5Req.TypeName),
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestServerOpenEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.ProviderWithEphemeralResources{},
+		},
+	}
+
+	expectedResponse := &tfprotov5.OpenEphemeralResourceResponse{
+		Diagnostics: []*tfprotov5.Diagnostic{
+			{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Ephemeral Resources Not Supported",
+				Detail: "Terraform attempted to use the test_ephemeral_resource ephemeral resource type, however ephemeral resources " +
+					"are only supported by terraform-plugin-framework providers served with protocol version 6, such as with providerserver.NewProtocol6. " +
+					"This is always an issue with the Terraform Provider and should be reported to the provider developers.",
+			},
+		},
+	}
+
+	got, err := server.OpenEphemeralResource(context.Background(), &tfprotov5.OpenEphemeralResourceRequest{
+		TypeName: "test_ephemeral_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(expectedResponse, got); diff != "" {
+		t.Errorf("unexpected response difference: %s", diff)
+	}
+}
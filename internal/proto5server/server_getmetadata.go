@@ -17,11 +17,16 @@ import (
 func (s *Server) GetMetadata(ctx context.Context, proto6Req *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "GetMetadata")
 
 	fwReq := fromproto5.GetMetadataRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetMetadataResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	s.FrameworkServer.GetMetadata(ctx, fwReq, fwResp)
 
-	return toproto5.GetMetadataResponse(ctx, fwResp), nil
+	return modifyProtocolResponse(ctx, s, "GetMetadata", toproto5.GetMetadataResponse(ctx, fwResp)), nil
 }
@@ -5,6 +5,8 @@ package proto5server
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -17,11 +19,33 @@ import (
 func (s *Server) GetProviderSchema(ctx context.Context, proto5Req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "GetProviderSchema")
 
 	fwReq := fromproto5.GetProviderSchemaRequest(ctx, proto5Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
-	return toproto5.GetProviderSchemaResponse(ctx, fwResp), nil
+	if len(fwResp.EphemeralResourceSchemas) > 0 {
+		typeNames := make([]string, 0, len(fwResp.EphemeralResourceSchemas))
+
+		for typeName := range fwResp.EphemeralResourceSchemas {
+			typeNames = append(typeNames, typeName)
+		}
+
+		sort.Strings(typeNames)
+
+		fwResp.Diagnostics.AddError(
+			"Ephemeral Resources Not Supported",
+			"The provider defines the "+strings.Join(typeNames, ", ")+" ephemeral resource types, however ephemeral resources "+
+				"are only supported by terraform-plugin-framework providers served with protocol version 6, such as with providerserver.NewProtocol6. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developers.",
+		)
+	}
+
+	return modifyProtocolResponse(ctx, s, "GetProviderSchema", toproto5.GetProviderSchemaResponse(ctx, fwResp)), nil
 }
@@ -11,6 +11,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -380,6 +382,73 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"ephemeralresources": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithEphemeralResources{
+						Provider: &testprovider.Provider{},
+						EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+							return []func() ephemeral.EphemeralResource{
+								func() ephemeral.EphemeralResource {
+									return &testprovider.EphemeralResource{
+										SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+											resp.Schema = ephemeralschema.Schema{
+												Attributes: map[string]ephemeralschema.Attribute{
+													"test1": ephemeralschema.StringAttribute{
+														Required: true,
+													},
+												},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+											resp.TypeName = "test_ephemeral_resource1"
+										},
+									}
+								},
+								func() ephemeral.EphemeralResource {
+									return &testprovider.EphemeralResource{
+										SchemaMethod: func(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+											resp.Schema = ephemeralschema.Schema{
+												Attributes: map[string]ephemeralschema.Attribute{
+													"test2": ephemeralschema.StringAttribute{
+														Required: true,
+													},
+												},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+											resp.TypeName = "test_ephemeral_resource2"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.GetProviderSchemaRequest{},
+			expectedResponse: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Ephemeral Resources Not Supported",
+						Detail: "The provider defines the test_ephemeral_resource1, test_ephemeral_resource2 ephemeral resource types, however ephemeral resources " +
+							"are only supported by terraform-plugin-framework providers served with protocol version 6, such as with providerserver.NewProtocol6. " +
+							"This is always an issue with the Terraform Provider and should be reported to the provider developers.",
+					},
+				},
+				Functions: map[string]*tfprotov5.Function{},
+				Provider: &tfprotov5.Schema{
+					Block: &tfprotov5.SchemaBlock{},
+				},
+				ResourceSchemas: map[string]*tfprotov5.Schema{},
+				ServerCapabilities: &tfprotov5.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					PlanDestroy:               true,
+				},
+			},
+		},
 		"provider": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -769,6 +838,11 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 			"@message": "Called provider defined Provider DataSources",
 			"@module":  "sdk.framework",
 		},
+		{
+			"@level":   "trace",
+			"@message": "Checking EphemeralResourceTypes lock",
+			"@module":  "sdk.framework",
+		},
 		{
 			"@level":   "trace",
 			"@message": "Checking FunctionTypes lock",
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+)
+
+// GetResourceIdentitySchemas satisfies the
+// tfprotov5.ProviderServerWithResourceIdentity interface.
+func (s *Server) GetResourceIdentitySchemas(ctx context.Context, proto5Req *tfprotov5.GetResourceIdentitySchemasRequest) (*tfprotov5.GetResourceIdentitySchemasResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "GetResourceIdentitySchemas")
+
+	fwReq := &fwserver.GetResourceIdentitySchemasRequest{}
+	fwResp := &fwserver.GetResourceIdentitySchemasResponse{}
+
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
+	s.FrameworkServer.GetResourceIdentitySchemas(ctx, fwReq, fwResp)
+
+	return modifyProtocolResponse(ctx, s, "GetResourceIdentitySchemas", toproto5.GetResourceIdentitySchemasResponse(ctx, fwResp)), nil
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+type testInterceptRequestContextKey struct{}
+
+func TestServerInterceptRequest(t *testing.T) {
+	t.Parallel()
+
+	type interceptedRequest struct {
+		ContextValue any
+		Error        string
+		RPC          string
+	}
+
+	testCases := map[string]struct {
+		call     func(context.Context, *Server)
+		expected []interceptedRequest
+	}{
+		"GetMetadata": {
+			call: func(ctx context.Context, s *Server) {
+				_, _ = s.GetMetadata(ctx, &tfprotov5.GetMetadataRequest{})
+			},
+			expected: []interceptedRequest{
+				{
+					ContextValue: "test-value",
+					RPC:          "GetMetadata",
+				},
+			},
+		},
+		"ReadResource-error": {
+			call: func(ctx context.Context, s *Server) {
+				_, _ = s.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+					TypeName: "test_resource",
+				})
+			},
+			expected: []interceptedRequest{
+				{
+					ContextValue: "test-value",
+					Error:        "Resource Type Not Found: No resource type named \"test_resource\" was found in the provider.",
+					RPC:          "ReadResource",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []interceptedRequest
+
+			server := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithRequestInterceptor{
+						Provider: &testprovider.Provider{},
+						InterceptRequestMethod: func(ctx context.Context, rpc string) (context.Context, func(error)) {
+							ctx = context.WithValue(ctx, testInterceptRequestContextKey{}, "test-value")
+
+							return ctx, func(err error) {
+								r := interceptedRequest{
+									ContextValue: ctx.Value(testInterceptRequestContextKey{}),
+									RPC:          rpc,
+								}
+
+								if err != nil {
+									r.Error = err.Error()
+								}
+
+								got = append(got, r)
+							}
+						},
+					},
+				},
+			}
+
+			testCase.call(context.Background(), server)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto5server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+)
+
+// modifyProtocolResponse returns the response after calling the provider
+// defined protocol response modifier, if implemented. The given response is
+// returned if the modifier returns nil or a different type.
+func modifyProtocolResponse[T any](ctx context.Context, s *Server, rpc string, resp *T) *T {
+	modifiedResp := s.FrameworkServer.ModifyProtocolResponse(ctx, rpc, resp)
+
+	// An untyped nil is documented as leaving the response unmodified.
+	if modifiedResp == nil {
+		return resp
+	}
+
+	typedResp, ok := modifiedResp.(*T)
+
+	if !ok {
+		logging.FrameworkError(
+			ctx,
+			"Ignoring provider defined ModifyProtocolResponse return with unexpected type",
+			map[string]interface{}{
+				logging.KeyError: fmt.Sprintf("expected %T, got %T", resp, modifiedResp),
+			},
+		)
+
+		return resp
+	}
+
+	if typedResp == nil {
+		return resp
+	}
+
+	return typedResp
+}
@@ -17,11 +17,16 @@ import (
 func (s *Server) MoveResourceState(ctx context.Context, proto5Req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "MoveResourceState")
 
 	fwResp := &fwserver.MoveResourceStateResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	if proto5Req == nil {
-		return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "MoveResourceState", toproto5.MoveResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TargetTypeName)
@@ -29,7 +34,7 @@ func (s *Server) MoveResourceState(ctx context.Context, proto5Req *tfprotov5.Mov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "MoveResourceState", toproto5.MoveResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TargetTypeName)
@@ -37,7 +42,7 @@ func (s *Server) MoveResourceState(ctx context.Context, proto5Req *tfprotov5.Mov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "MoveResourceState", toproto5.MoveResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.MoveResourceStateRequest(ctx, proto5Req, resource, resourceSchema)
@@ -45,10 +50,12 @@ func (s *Server) MoveResourceState(ctx context.Context, proto5Req *tfprotov5.Mov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "MoveResourceState", toproto5.MoveResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.MoveResourceState(ctx, fwReq, fwResp)
 
-	return toproto5.MoveResourceStateResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwResp.TargetState)
+
+	return modifyProtocolResponse(ctx, s, "MoveResourceState", toproto5.MoveResourceStateResponse(ctx, fwResp)), nil
 }
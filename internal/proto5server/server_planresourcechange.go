@@ -17,15 +17,20 @@ import (
 func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "PlanResourceChange")
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -33,7 +38,15 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
+	}
+
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -41,18 +54,28 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto5Req *tfprotov5.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
-	fwReq, diags := fromproto5.PlanResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
+	fwReq, diags := fromproto5.PlanResourceChangeRequest(ctx, proto5Req, resource, resourceSchema, identitySchema, providerMetaSchema)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
+	}
+
+	fwReq.ProviderMeta, diags = s.FrameworkServer.ResourceProviderMeta(ctx, proto5Req.TypeName, fwReq.ProviderMeta)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
 
-	return toproto5.PlanResourceChangeResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwReq.Config, fwReq.ProposedNewState, fwReq.PriorState, fwResp.PlannedState)
+
+	return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto5.PlanResourceChangeResponse(ctx, fwResp)), nil
 }
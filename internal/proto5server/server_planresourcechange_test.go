@@ -58,6 +58,13 @@ func TestServerPlanResourceChange(t *testing.T) {
 		"test_provider_meta_attribute": tftypes.NewValue(tftypes.String, "test-provider-meta-value"),
 	})
 
+	testProviderMetaTypeFull := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"other_attribute":              tftypes.Bool,
+			"test_provider_meta_attribute": tftypes.String,
+		},
+	}
+
 	testProviderMetaSchema := metaschema.Schema{
 		Attributes: map[string]metaschema.Attribute{
 			"test_provider_meta_attribute": metaschema.StringAttribute{
@@ -235,6 +242,164 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			},
 		},
+		"create-request-providermeta-resourcewithmetaschema": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithMetaSchema{
+						Provider: &testprovider.Provider{
+							ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+								return []func() resource.Resource{
+									func() resource.Resource {
+										return &testprovider.ResourceWithMetaSchemaAndModifyPlan{
+											Resource: &testprovider.Resource{
+												SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+													resp.Schema = testSchema
+												},
+												MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+													resp.TypeName = "test_resource"
+												},
+											},
+											MetaSchemaMethod: func(_ context.Context, _ resource.MetaSchemaRequest, resp *resource.MetaSchemaResponse) {
+												resp.Schema = testProviderMetaSchema
+											},
+											ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+												var data testProviderMetaData
+
+												resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
+
+												if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+													resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
+												}
+											},
+										}
+									},
+								}
+							},
+						},
+						MetaSchemaMethod: func(_ context.Context, _ provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
+							// The resource meta schema is a subset of this schema.
+							resp.Schema = metaschema.Schema{
+								Attributes: map[string]metaschema.Attribute{
+									"other_attribute": metaschema.BoolAttribute{
+										Optional: true,
+									},
+									"test_provider_meta_attribute": metaschema.StringAttribute{
+										Optional: true,
+									},
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.PlanResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				ProposedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PriorState: &testEmptyDynamicValue,
+				ProviderMeta: testNewDynamicValue(t, testProviderMetaTypeFull, map[string]tftypes.Value{
+					"other_attribute":              tftypes.NewValue(tftypes.Bool, true),
+					"test_provider_meta_attribute": tftypes.NewValue(tftypes.String, "test-provider-meta-value"),
+				}),
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov5.PlanResourceChangeResponse{
+				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+			},
+		},
+		"create-request-providermeta-resourcewithmetaschema-incompatible": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithMetaSchema{
+						Provider: &testprovider.Provider{
+							ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+								return []func() resource.Resource{
+									func() resource.Resource {
+										return &testprovider.ResourceWithMetaSchemaAndModifyPlan{
+											Resource: &testprovider.Resource{
+												SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+													resp.Schema = testSchema
+												},
+												MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+													resp.TypeName = "test_resource"
+												},
+											},
+											MetaSchemaMethod: func(_ context.Context, _ resource.MetaSchemaRequest, resp *resource.MetaSchemaResponse) {
+												resp.Schema = metaschema.Schema{
+													Attributes: map[string]metaschema.Attribute{
+														"test_provider_meta_attribute": metaschema.BoolAttribute{
+															Optional: true,
+														},
+													},
+												}
+											},
+											ModifyPlanMethod: func(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+												var data testProviderMetaData
+
+												resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
+
+												if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+													resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
+												}
+											},
+										}
+									},
+								}
+							},
+						},
+						MetaSchemaMethod: func(_ context.Context, _ provider.MetaSchemaRequest, resp *provider.MetaSchemaResponse) {
+							// The resource meta schema is a subset of this schema.
+							resp.Schema = metaschema.Schema{
+								Attributes: map[string]metaschema.Attribute{
+									"other_attribute": metaschema.BoolAttribute{
+										Optional: true,
+									},
+									"test_provider_meta_attribute": metaschema.StringAttribute{
+										Optional: true,
+									},
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.PlanResourceChangeRequest{
+				Config: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				ProposedNewState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, nil),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				PriorState: &testEmptyDynamicValue,
+				ProviderMeta: testNewDynamicValue(t, testProviderMetaTypeFull, map[string]tftypes.Value{
+					"other_attribute":              tftypes.NewValue(tftypes.Bool, true),
+					"test_provider_meta_attribute": tftypes.NewValue(tftypes.String, "test-provider-meta-value"),
+				}),
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov5.PlanResourceChangeResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Invalid Resource Meta Schema",
+						Detail: "When validating the schema, an implementation issue was found. " +
+							"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+							"Resource \"test_resource\" meta schema attribute \"test_provider_meta_attribute\" type does not match the provider meta schema attribute type. " +
+							"Terraform sends provider_meta data based on the provider meta schema, so resource meta schema attributes must have the same underlying type.",
+					},
+				},
+			},
+		},
 		"create-response-diagnostics": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
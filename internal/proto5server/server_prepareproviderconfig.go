@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -17,15 +18,20 @@ import (
 func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "PrepareProviderConfig")
 
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PrepareProviderConfig", toproto5.PrepareProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.PrepareProviderConfigRequest(ctx, proto5Req, providerSchema)
@@ -33,10 +39,19 @@ func (s *Server) PrepareProviderConfig(ctx context.Context, proto5Req *tfprotov5
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PrepareProviderConfig", toproto5.PrepareProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
 
-	return toproto5.PrepareProviderConfigResponse(ctx, fwResp), nil
+	// The request is nil if the protocol request was nil.
+	var config *tfsdk.Config
+
+	if fwReq != nil {
+		config = fwReq.Config
+	}
+
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, providerSchema, fwResp.Diagnostics, config)
+
+	return modifyProtocolResponse(ctx, s, "PrepareProviderConfig", toproto5.PrepareProviderConfigResponse(ctx, fwResp)), nil
 }
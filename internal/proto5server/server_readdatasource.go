@@ -17,15 +17,20 @@ import (
 func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ReadDataSource")
 
 	fwResp := &fwserver.ReadDataSourceResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	dataSourceSchema, diags := s.FrameworkServer.DataSourceSchema(ctx, proto5Req.TypeName)
@@ -33,7 +38,7 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -41,7 +46,7 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ReadDataSourceRequest(ctx, proto5Req, dataSource, dataSourceSchema, providerMetaSchema)
@@ -49,10 +54,12 @@ func (s *Server) ReadDataSource(ctx context.Context, proto5Req *tfprotov5.ReadDa
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ReadDataSource(ctx, fwReq, fwResp)
 
-	return toproto5.ReadDataSourceResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, dataSourceSchema, fwResp.Diagnostics, fwReq.Config, fwResp.State)
+
+	return modifyProtocolResponse(ctx, s, "ReadDataSource", toproto5.ReadDataSourceResponse(ctx, fwResp)), nil
 }
@@ -18,15 +18,20 @@ import (
 func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ReadResource")
 
 	fwResp := &fwserver.ReadResourceResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -34,7 +39,15 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
+	}
+
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto5Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -42,18 +55,28 @@ func (s *Server) ReadResource(ctx context.Context, proto5Req *tfprotov5.ReadReso
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
-	fwReq, diags := fromproto5.ReadResourceRequest(ctx, proto5Req, resource, resourceSchema, providerMetaSchema)
+	fwReq, diags := fromproto5.ReadResourceRequest(ctx, proto5Req, resource, resourceSchema, identitySchema, providerMetaSchema)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ReadResourceResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
+	}
+
+	fwReq.ProviderMeta, diags = s.FrameworkServer.ResourceProviderMeta(ctx, proto5Req.TypeName, fwReq.ProviderMeta)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ReadResource(ctx, fwReq, fwResp)
 
-	return toproto5.ReadResourceResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwReq.CurrentState, fwResp.NewState)
+
+	return modifyProtocolResponse(ctx, s, "ReadResource", toproto5.ReadResourceResponse(ctx, fwResp)), nil
 }
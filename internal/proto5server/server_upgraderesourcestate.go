@@ -17,11 +17,16 @@ import (
 func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "UpgradeResourceState")
 
 	fwResp := &fwserver.UpgradeResourceStateResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	if proto5Req == nil {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
@@ -29,7 +34,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -37,7 +42,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.UpgradeResourceStateRequest(ctx, proto5Req, resource, resourceSchema)
@@ -45,10 +50,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto5Req *tfprotov5.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
 
-	return toproto5.UpgradeResourceStateResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwResp.UpgradedState)
+
+	return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto5.UpgradeResourceStateResponse(ctx, fwResp)), nil
 }
@@ -17,15 +17,20 @@ import (
 func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ValidateDataSourceConfig")
 
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	dataSourceSchema, diags := s.FrameworkServer.DataSourceSchema(ctx, proto5Req.TypeName)
@@ -33,7 +38,7 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ValidateDataSourceConfigRequest(ctx, proto5Req, dataSource, dataSourceSchema)
@@ -41,10 +46,12 @@ func (s *Server) ValidateDataSourceConfig(ctx context.Context, proto5Req *tfprot
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
 
-	return toproto5.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, dataSourceSchema, fwResp.Diagnostics, fwReq.Config)
+
+	return modifyProtocolResponse(ctx, s, "ValidateDataSourceConfig", toproto5.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 }
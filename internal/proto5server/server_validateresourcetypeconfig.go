@@ -17,15 +17,20 @@ import (
 func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ValidateResourceTypeConfig")
 
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto5Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto5Req.TypeName)
@@ -33,7 +38,7 @@ func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto5.ValidateResourceTypeConfigRequest(ctx, proto5Req, resource, resourceSchema)
@@ -41,10 +46,12 @@ func (s *Server) ValidateResourceTypeConfig(ctx context.Context, proto5Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
 
-	return toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwReq.Config)
+
+	return modifyProtocolResponse(ctx, s, "ValidateResourceTypeConfig", toproto5.ValidateResourceTypeConfigResponse(ctx, fwResp)), nil
 }
@@ -10,8 +10,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -146,6 +148,57 @@ func TestServerValidateResourceTypeConfig(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-sensitive": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.ResourceWithValidateConfig{
+										Resource: &testprovider.Resource{
+											SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+												resp.Schema = schema.Schema{
+													Attributes: map[string]schema.Attribute{
+														"test": schema.StringAttribute{
+															Required:  true,
+															Sensitive: true,
+														},
+													},
+												}
+											},
+											MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+												resp.TypeName = "test_resource"
+											},
+										},
+										ValidateConfigMethod: func(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+											var value types.String
+
+											resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &value)...)
+											resp.Diagnostics.AddAttributeError(path.Root("test"), "error summary", "got: "+value.ValueString())
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov5.ValidateResourceTypeConfigRequest{
+				Config:   &testDynamicValue,
+				TypeName: "test_resource",
+			},
+			expectedResponse: &tfprotov5.ValidateResourceTypeConfigResponse{
+				Diagnostics: []*tfprotov5.Diagnostic{
+					{
+						Severity:  tfprotov5.DiagnosticSeverityError,
+						Summary:   "error summary",
+						Detail:    "The diagnostic detail was redacted because the attribute is marked as sensitive in the schema.",
+						Attribute: tftypes.NewAttributePath().WithAttributeName("test"),
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
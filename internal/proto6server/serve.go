@@ -12,6 +12,7 @@ import (
 )
 
 var _ tfprotov6.ProviderServer = &Server{}
+var _ tfprotov6.ProviderServerWithResourceIdentity = &Server{}
 
 // Provider server implementation.
 type Server struct {
@@ -17,15 +17,20 @@ import (
 func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ApplyResourceChange")
 
 	fwResp := &fwserver.ApplyResourceChangeResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -33,7 +38,15 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
+	}
+
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto6Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -41,18 +54,28 @@ func (s *Server) ApplyResourceChange(ctx context.Context, proto6Req *tfprotov6.A
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
-	fwReq, diags := fromproto6.ApplyResourceChangeRequest(ctx, proto6Req, resource, resourceSchema, providerMetaSchema)
+	fwReq, diags := fromproto6.ApplyResourceChangeRequest(ctx, proto6Req, resource, resourceSchema, identitySchema, providerMetaSchema)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
+	}
+
+	fwReq.ProviderMeta, diags = s.FrameworkServer.ResourceProviderMeta(ctx, proto6Req.TypeName, fwReq.ProviderMeta)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ApplyResourceChange(ctx, fwReq, fwResp)
 
-	return toproto6.ApplyResourceChangeResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwReq.Config, fwReq.PlannedState, fwReq.PriorState, fwResp.NewState)
+
+	return modifyProtocolResponse(ctx, s, "ApplyResourceChange", toproto6.ApplyResourceChangeResponse(ctx, fwResp)), nil
 }
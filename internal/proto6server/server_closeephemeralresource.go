@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// CloseEphemeralResource satisfies the tfprotov6.ProviderServer interface.
+func (s *Server) CloseEphemeralResource(ctx context.Context, proto6Req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "CloseEphemeralResource")
+
+	fwResp := &fwserver.CloseEphemeralResourceResponse{}
+
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
+	ephemeralResource, diags := s.FrameworkServer.EphemeralResource(ctx, proto6Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "CloseEphemeralResource", toproto6.CloseEphemeralResourceResponse(ctx, fwResp)), nil
+	}
+
+	fwReq, diags := fromproto6.CloseEphemeralResourceRequest(ctx, proto6Req, ephemeralResource)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "CloseEphemeralResource", toproto6.CloseEphemeralResourceResponse(ctx, fwResp)), nil
+	}
+
+	s.FrameworkServer.CloseEphemeralResource(ctx, fwReq, fwResp)
+
+	return modifyProtocolResponse(ctx, s, "CloseEphemeralResource", toproto6.CloseEphemeralResourceResponse(ctx, fwResp)), nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestServerCloseEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testPrivate := privatestate.MustMarshalToJson(map[string][]byte{
+		"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
+	})
+
+	testProvider := func(ephemeralResource ephemeral.EphemeralResource) *testprovider.ProviderWithEphemeralResources {
+		return &testprovider.ProviderWithEphemeralResources{
+			EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+				return []func() ephemeral.EphemeralResource{
+					func() ephemeral.EphemeralResource {
+						return ephemeralResource
+					},
+				}
+			},
+		}
+	}
+
+	testEphemeralResource := &testprovider.EphemeralResource{
+		MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+			resp.TypeName = "test_ephemeral_resource"
+		},
+	}
+
+	testCases := map[string]struct {
+		server           *Server
+		request          *tfprotov6.CloseEphemeralResourceRequest
+		expectedError    error
+		expectedResponse *tfprotov6.CloseEphemeralResourceResponse
+	}{
+		"ephemeral-resource-without-close": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(testEphemeralResource),
+				},
+			},
+			request: &tfprotov6.CloseEphemeralResourceRequest{
+				Private:  testPrivate,
+				TypeName: "test_ephemeral_resource",
+			},
+			expectedResponse: &tfprotov6.CloseEphemeralResourceResponse{},
+		},
+		"request-private": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(&testprovider.EphemeralResourceWithClose{
+						EphemeralResource: testEphemeralResource,
+						CloseMethod: func(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+							expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
+
+							got, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+							resp.Diagnostics.Append(diags...)
+
+							if string(got) != expected {
+								resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+							}
+						},
+					}),
+				},
+			},
+			request: &tfprotov6.CloseEphemeralResourceRequest{
+				Private:  testPrivate,
+				TypeName: "test_ephemeral_resource",
+			},
+			expectedResponse: &tfprotov6.CloseEphemeralResourceResponse{},
+		},
+		"response-diagnostics": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(&testprovider.EphemeralResourceWithClose{
+						EphemeralResource: testEphemeralResource,
+						CloseMethod: func(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+							resp.Diagnostics.AddWarning("warning summary", "warning detail")
+							resp.Diagnostics.AddError("error summary", "error detail")
+						},
+					}),
+				},
+			},
+			request: &tfprotov6.CloseEphemeralResourceRequest{
+				TypeName: "test_ephemeral_resource",
+			},
+			expectedResponse: &tfprotov6.CloseEphemeralResourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityWarning,
+						Summary:  "warning summary",
+						Detail:   "warning detail",
+					},
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "error summary",
+						Detail:   "error detail",
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.CloseEphemeralResource(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, got); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
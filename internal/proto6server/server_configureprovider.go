@@ -7,9 +7,11 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -17,15 +19,20 @@ import (
 func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ConfigureProvider")
 
 	fwResp := &provider.ConfigureResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ConfigureProvider", toproto6.ConfigureProviderResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ConfigureProviderRequest(ctx, proto6Req, providerSchema)
@@ -33,10 +40,19 @@ func (s *Server) ConfigureProvider(ctx context.Context, proto6Req *tfprotov6.Con
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ConfigureProvider", toproto6.ConfigureProviderResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ConfigureProvider(ctx, fwReq, fwResp)
 
-	return toproto6.ConfigureProviderResponse(ctx, fwResp), nil
+	// The request is nil if the protocol request was nil.
+	var config tfsdk.Config
+
+	if fwReq != nil {
+		config = fwReq.Config
+	}
+
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, providerSchema, fwResp.Diagnostics, config)
+
+	return modifyProtocolResponse(ctx, s, "ConfigureProvider", toproto6.ConfigureProviderResponse(ctx, fwResp)), nil
 }
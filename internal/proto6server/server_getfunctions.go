@@ -17,11 +17,16 @@ import (
 func (s *Server) GetFunctions(ctx context.Context, protoReq *tfprotov6.GetFunctionsRequest) (*tfprotov6.GetFunctionsResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "GetFunctions")
 
 	fwReq := fromproto6.GetFunctionsRequest(ctx, protoReq)
 	fwResp := &fwserver.GetFunctionsResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	s.FrameworkServer.GetFunctions(ctx, fwReq, fwResp)
 
-	return toproto6.GetFunctionsResponse(ctx, fwResp), nil
+	return modifyProtocolResponse(ctx, s, "GetFunctions", toproto6.GetFunctionsResponse(ctx, fwResp)), nil
 }
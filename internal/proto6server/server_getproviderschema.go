@@ -17,11 +17,16 @@ import (
 func (s *Server) GetProviderSchema(ctx context.Context, proto6Req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "GetProviderSchema")
 
 	fwReq := fromproto6.GetProviderSchemaRequest(ctx, proto6Req)
 	fwResp := &fwserver.GetProviderSchemaResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	s.FrameworkServer.GetProviderSchema(ctx, fwReq, fwResp)
 
-	return toproto6.GetProviderSchemaResponse(ctx, fwResp), nil
+	return modifyProtocolResponse(ctx, s, "GetProviderSchema", toproto6.GetProviderSchemaResponse(ctx, fwResp)), nil
 }
@@ -15,11 +15,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
@@ -103,7 +108,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 						},
 					},
 				},
-				Functions: map[string]*tfprotov6.Function{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -169,7 +175,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
-				Functions: map[string]*tfprotov6.Function{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -209,7 +216,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
-				Functions: map[string]*tfprotov6.Function{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -257,7 +265,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &tfprotov6.GetProviderSchemaRequest{},
 			expectedResponse: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"function1": {
 						Parameters: []*tfprotov6.FunctionParameter{},
@@ -329,7 +338,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
-				Functions: map[string]*tfprotov6.Function{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -369,7 +379,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
-				Functions: map[string]*tfprotov6.Function{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -398,8 +409,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &tfprotov6.GetProviderSchemaRequest{},
 			expectedResponse: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -437,8 +449,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &tfprotov6.GetProviderSchemaRequest{},
 			expectedResponse: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -505,8 +518,9 @@ func TestServerGetProviderSchema(t *testing.T) {
 			},
 			request: &tfprotov6.GetProviderSchemaRequest{},
 			expectedResponse: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -540,6 +554,80 @@ func TestServerGetProviderSchema(t *testing.T) {
 				},
 			},
 		},
+		"resourceschemas-static-default-validator-reads-config": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.Provider{
+						ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+							return []func() resource.Resource{
+								func() resource.Resource {
+									return &testprovider.Resource{
+										SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+											resp.Schema = resourceschema.Schema{
+												Attributes: map[string]resourceschema.Attribute{
+													"test1": resourceschema.StringAttribute{
+														Computed: true,
+														Optional: true,
+														Default:  stringdefault.StaticString("test-value"),
+														Validators: []validator.String{
+															testvalidator.String{
+																ValidateStringMethod: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+																	var test2 types.String
+
+																	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test2"), &test2)...)
+																},
+															},
+														},
+													},
+													"test2": resourceschema.StringAttribute{
+														Optional: true,
+													},
+												},
+											}
+										},
+										MetadataMethod: func(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+											resp.TypeName = "test_resource"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.GetProviderSchemaRequest{},
+			expectedResponse: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				Provider: &tfprotov6.Schema{
+					Block: &tfprotov6.SchemaBlock{},
+				},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Computed: true,
+									Name:     "test1",
+									Optional: true,
+									Type:     tftypes.String,
+								},
+								{
+									Name:     "test2",
+									Optional: true,
+									Type:     tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				ServerCapabilities: &tfprotov6.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+					PlanDestroy:               true,
+				},
+			},
+		},
 		"resourceschemas-duplicate-type-name": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -595,7 +683,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
-				Functions: map[string]*tfprotov6.Function{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -635,7 +724,8 @@ func TestServerGetProviderSchema(t *testing.T) {
 							"This is always an issue with the provider and should be reported to the provider developers.",
 					},
 				},
-				Functions: map[string]*tfprotov6.Function{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{},
 				},
@@ -769,6 +859,11 @@ func TestServerGetProviderSchema_logging(t *testing.T) {
 			"@message": "Called provider defined Provider DataSources",
 			"@module":  "sdk.framework",
 		},
+		{
+			"@level":   "trace",
+			"@message": "Checking EphemeralResourceTypes lock",
+			"@module":  "sdk.framework",
+		},
 		{
 			"@level":   "trace",
 			"@message": "Checking FunctionTypes lock",
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+)
+
+// GetResourceIdentitySchemas satisfies the
+// tfprotov6.ProviderServerWithResourceIdentity interface.
+func (s *Server) GetResourceIdentitySchemas(ctx context.Context, proto6Req *tfprotov6.GetResourceIdentitySchemasRequest) (*tfprotov6.GetResourceIdentitySchemasResponse, error) {
+	ctx = s.registerContext(ctx)
+	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "GetResourceIdentitySchemas")
+
+	fwReq := &fwserver.GetResourceIdentitySchemasRequest{}
+	fwResp := &fwserver.GetResourceIdentitySchemasResponse{}
+
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
+	s.FrameworkServer.GetResourceIdentitySchemas(ctx, fwReq, fwResp)
+
+	return modifyProtocolResponse(ctx, s, "GetResourceIdentitySchemas", toproto6.GetResourceIdentitySchemasResponse(ctx, fwResp)), nil
+}
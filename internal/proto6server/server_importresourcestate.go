@@ -17,15 +17,20 @@ import (
 func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ImportResourceState")
 
 	fwResp := &fwserver.ImportResourceStateResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -33,7 +38,7 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ImportResourceStateRequest(ctx, proto6Req, resource, resourceSchema)
@@ -41,10 +46,18 @@ func (s *Server) ImportResourceState(ctx context.Context, proto6Req *tfprotov6.I
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ImportResourceState(ctx, fwReq, fwResp)
 
-	return toproto6.ImportResourceStateResponse(ctx, fwResp), nil
+	importedStates := make([]any, 0, len(fwResp.ImportedResources))
+
+	for _, importedResource := range fwResp.ImportedResources {
+		importedStates = append(importedStates, importedResource.State)
+	}
+
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, importedStates...)
+
+	return modifyProtocolResponse(ctx, s, "ImportResourceState", toproto6.ImportResourceStateResponse(ctx, fwResp)), nil
 }
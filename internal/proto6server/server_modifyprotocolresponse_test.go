@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklogtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+)
+
+func TestServerModifyProtocolResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		modify        func(context.Context, string, any) any
+		expectedRPCs  []string
+		expectedTypes []string
+	}{
+		"unmodified": {
+			modify: func(_ context.Context, _ string, resp any) any {
+				return resp
+			},
+			expectedRPCs:  []string{"GetMetadata"},
+			expectedTypes: []string{},
+		},
+		"modified": {
+			modify: func(_ context.Context, _ string, resp any) any {
+				metadataResp, ok := resp.(*tfprotov6.GetMetadataResponse)
+
+				if !ok {
+					return resp
+				}
+
+				return &tfprotov6.GetMetadataResponse{
+					DataSources: []tfprotov6.DataSourceMetadata{
+						{TypeName: "test_experimental"},
+					},
+					Diagnostics:        metadataResp.Diagnostics,
+					ServerCapabilities: metadataResp.ServerCapabilities,
+				}
+			},
+			expectedRPCs:  []string{"GetMetadata"},
+			expectedTypes: []string{"test_experimental"},
+		},
+		"nil": {
+			modify: func(_ context.Context, _ string, _ any) any {
+				return nil
+			},
+			expectedRPCs:  []string{"GetMetadata"},
+			expectedTypes: []string{},
+		},
+		"nil-typed": {
+			modify: func(_ context.Context, _ string, _ any) any {
+				return (*tfprotov6.GetMetadataResponse)(nil)
+			},
+			expectedRPCs:  []string{"GetMetadata"},
+			expectedTypes: []string{},
+		},
+		"unexpected-type": {
+			modify: func(_ context.Context, _ string, _ any) any {
+				return &tfprotov6.ReadResourceResponse{}
+			},
+			expectedRPCs:  []string{"GetMetadata"},
+			expectedTypes: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotRPCs []string
+
+			server := &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithModifyProtocolResponse{
+						Provider: &testprovider.Provider{},
+						ModifyProtocolResponseMethod: func(ctx context.Context, rpc string, resp any) any {
+							gotRPCs = append(gotRPCs, rpc)
+
+							return testCase.modify(ctx, rpc, resp)
+						},
+					},
+				},
+			}
+
+			got, err := server.GetMetadata(context.Background(), &tfprotov6.GetMetadataRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got == nil {
+				t.Fatal("expected response, got nil")
+			}
+
+			gotTypes := []string{}
+
+			for _, dataSource := range got.DataSources {
+				gotTypes = append(gotTypes, dataSource.TypeName)
+			}
+
+			if diff := cmp.Diff(gotTypes, testCase.expectedTypes); diff != "" {
+				t.Errorf("unexpected types difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotRPCs, testCase.expectedRPCs); diff != "" {
+				t.Errorf("unexpected rpcs difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerModifyProtocolResponse_Error(t *testing.T) {
+	t.Parallel()
+
+	var gotRPC string
+	var gotResp any
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.ProviderWithModifyProtocolResponse{
+				Provider: &testprovider.Provider{},
+				ModifyProtocolResponseMethod: func(_ context.Context, rpc string, resp any) any {
+					gotRPC = rpc
+					gotResp = resp
+
+					return resp
+				},
+			},
+		},
+	}
+
+	got, err := server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName: "test_resource",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if gotRPC != "ReadResource" {
+		t.Errorf("expected ReadResource rpc, got: %s", gotRPC)
+	}
+
+	if gotResp != got {
+		t.Errorf("expected modifier to receive the returned response, got: %v", gotResp)
+	}
+
+	if len(got.Diagnostics) != 1 || got.Diagnostics[0].Summary != "Resource Type Not Found" {
+		t.Errorf("expected Resource Type Not Found diagnostic, got: %v", got.Diagnostics)
+	}
+}
+
+func TestServerModifyProtocolResponse_NilLogging(t *testing.T) {
+	t.Parallel()
+
+	var output bytes.Buffer
+
+	ctx := tfsdklogtest.RootLogger(context.Background(), &output)
+	ctx = logging.InitContext(ctx)
+
+	server := &Server{
+		FrameworkServer: fwserver.Server{
+			Provider: &testprovider.ProviderWithModifyProtocolResponse{
+				Provider: &testprovider.Provider{},
+				ModifyProtocolResponseMethod: func(_ context.Context, _ string, _ any) any {
+					return nil
+				},
+			},
+		},
+	}
+
+	resp := &tfprotov6.GetMetadataResponse{}
+
+	got := modifyProtocolResponse(ctx, server, "GetMetadata", resp)
+
+	if got != resp {
+		t.Errorf("expected original response, got: %v", got)
+	}
+
+	entries, err := tfsdklogtest.MultilineJSONDecode(&output)
+
+	if err != nil {
+		t.Fatalf("unable to read multiple line JSON: %s", err)
+	}
+
+	for _, entry := range entries {
+		if entry["@level"] == "error" {
+			t.Errorf("unexpected error log entry: %v", entry)
+		}
+	}
+}
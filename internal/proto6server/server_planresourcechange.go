@@ -17,15 +17,20 @@ import (
 func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "PlanResourceChange")
 
 	fwResp := &fwserver.PlanResourceChangeResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -33,7 +38,15 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
+	}
+
+	identitySchema, diags := s.FrameworkServer.ResourceIdentitySchema(ctx, proto6Req.TypeName)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	providerMetaSchema, diags := s.FrameworkServer.ProviderMetaSchema(ctx)
@@ -41,18 +54,28 @@ func (s *Server) PlanResourceChange(ctx context.Context, proto6Req *tfprotov6.Pl
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
-	fwReq, diags := fromproto6.PlanResourceChangeRequest(ctx, proto6Req, resource, resourceSchema, providerMetaSchema)
+	fwReq, diags := fromproto6.PlanResourceChangeRequest(ctx, proto6Req, resource, resourceSchema, identitySchema, providerMetaSchema)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
+	}
+
+	fwReq.ProviderMeta, diags = s.FrameworkServer.ResourceProviderMeta(ctx, proto6Req.TypeName, fwReq.ProviderMeta)
+
+	fwResp.Diagnostics.Append(diags...)
+
+	if fwResp.Diagnostics.HasError() {
+		return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.PlanResourceChange(ctx, fwReq, fwResp)
 
-	return toproto6.PlanResourceChangeResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwReq.Config, fwReq.ProposedNewState, fwReq.PriorState, fwResp.PlannedState)
+
+	return modifyProtocolResponse(ctx, s, "PlanResourceChange", toproto6.PlanResourceChangeResponse(ctx, fwResp)), nil
 }
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package proto6server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestServerRenewEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	testPrivate := privatestate.MustMarshalToJson(map[string][]byte{
+		".frameworkKey":  []byte(`{"fKeyOne": {"k0": "zero", "k1": 1}}`),
+		"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
+	})
+
+	testRenewAt := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testProvider := func(ephemeralResource ephemeral.EphemeralResource) *testprovider.ProviderWithEphemeralResources {
+		return &testprovider.ProviderWithEphemeralResources{
+			EphemeralResourcesMethod: func(_ context.Context) []func() ephemeral.EphemeralResource {
+				return []func() ephemeral.EphemeralResource{
+					func() ephemeral.EphemeralResource {
+						return ephemeralResource
+					},
+				}
+			},
+		}
+	}
+
+	testEphemeralResource := &testprovider.EphemeralResource{
+		MetadataMethod: func(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+			resp.TypeName = "test_ephemeral_resource"
+		},
+	}
+
+	testCases := map[string]struct {
+		server           *Server
+		request          *tfprotov6.RenewEphemeralResourceRequest
+		expectedError    error
+		expectedResponse *tfprotov6.RenewEphemeralResourceResponse
+	}{
+		"ephemeral-resource-type-not-found": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(testEphemeralResource),
+				},
+			},
+			request: &tfprotov6.RenewEphemeralResourceRequest{
+				TypeName: "test_other_ephemeral_resource",
+			},
+			expectedResponse: &tfprotov6.RenewEphemeralResourceResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Ephemeral Resource Type Not Found",
+						Detail:   "No ephemeral resource type named \"test_other_ephemeral_resource\" was found in the provider.",
+					},
+				},
+			},
+		},
+		"request-private": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(&testprovider.EphemeralResourceWithRenew{
+						EphemeralResource: testEphemeralResource,
+						RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+							expected := `{"pKeyOne": {"k0": "zero", "k1": 1}}`
+
+							got, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+							resp.Diagnostics.Append(diags...)
+
+							if string(got) != expected {
+								resp.Diagnostics.AddError("unexpected req.Private.Provider value: %s", string(got))
+							}
+						},
+					}),
+				},
+			},
+			request: &tfprotov6.RenewEphemeralResourceRequest{
+				Private:  testPrivate,
+				TypeName: "test_ephemeral_resource",
+			},
+			expectedResponse: &tfprotov6.RenewEphemeralResourceResponse{
+				Private: testPrivate,
+			},
+		},
+		"response-renew-at": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: testProvider(&testprovider.EphemeralResourceWithRenew{
+						EphemeralResource: testEphemeralResource,
+						RenewMethod: func(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+							resp.RenewAt = testRenewAt
+						},
+					}),
+				},
+			},
+			request: &tfprotov6.RenewEphemeralResourceRequest{
+				TypeName: "test_ephemeral_resource",
+			},
+			expectedResponse: &tfprotov6.RenewEphemeralResourceResponse{
+				RenewAt: testRenewAt,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.server.RenewEphemeralResource(context.Background(), testCase.request)
+
+			if diff := cmp.Diff(testCase.expectedError, err); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResponse, got); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
@@ -17,11 +17,16 @@ import (
 func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "UpgradeResourceState")
 
 	fwResp := &fwserver.UpgradeResourceStateResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	if proto6Req == nil {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
@@ -29,7 +34,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -37,7 +42,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.UpgradeResourceStateRequest(ctx, proto6Req, resource, resourceSchema)
@@ -45,10 +50,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, proto6Req *tfprotov6.
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.UpgradeResourceState(ctx, fwReq, fwResp)
 
-	return toproto6.UpgradeResourceStateResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwResp.UpgradedState)
+
+	return modifyProtocolResponse(ctx, s, "UpgradeResourceState", toproto6.UpgradeResourceStateResponse(ctx, fwResp)), nil
 }
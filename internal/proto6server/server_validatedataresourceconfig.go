@@ -17,15 +17,20 @@ import (
 func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ValidateDataResourceConfig")
 
 	fwResp := &fwserver.ValidateDataSourceConfigResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	dataSource, diags := s.FrameworkServer.DataSource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	dataSourceSchema, diags := s.FrameworkServer.DataSourceSchema(ctx, proto6Req.TypeName)
@@ -33,7 +38,7 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ValidateDataSourceConfigRequest(ctx, proto6Req, dataSource, dataSourceSchema)
@@ -41,10 +46,12 @@ func (s *Server) ValidateDataResourceConfig(ctx context.Context, proto6Req *tfpr
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateDataSourceConfig(ctx, fwReq, fwResp)
 
-	return toproto6.ValidateDataSourceConfigResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, dataSourceSchema, fwResp.Diagnostics, fwReq.Config)
+
+	return modifyProtocolResponse(ctx, s, "ValidateDataResourceConfig", toproto6.ValidateDataSourceConfigResponse(ctx, fwResp)), nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -17,15 +18,20 @@ import (
 func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ValidateProviderConfig")
 
 	fwResp := &fwserver.ValidateProviderConfigResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	providerSchema, diags := s.FrameworkServer.ProviderSchema(ctx)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateProviderConfig", toproto6.ValidateProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ValidateProviderConfigRequest(ctx, proto6Req, providerSchema)
@@ -33,10 +39,19 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, proto6Req *tfprotov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateProviderConfig", toproto6.ValidateProviderConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateProviderConfig(ctx, fwReq, fwResp)
 
-	return toproto6.ValidateProviderConfigResponse(ctx, fwResp), nil
+	// The request is nil if the protocol request was nil.
+	var config *tfsdk.Config
+
+	if fwReq != nil {
+		config = fwReq.Config
+	}
+
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, providerSchema, fwResp.Diagnostics, config)
+
+	return modifyProtocolResponse(ctx, s, "ValidateProviderConfig", toproto6.ValidateProviderConfigResponse(ctx, fwResp)), nil
 }
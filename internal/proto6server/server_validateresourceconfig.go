@@ -17,15 +17,20 @@ import (
 func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
 	ctx = s.registerContext(ctx)
 	ctx = logging.InitContext(ctx)
+	ctx, done := s.FrameworkServer.InterceptRequest(ctx, "ValidateResourceConfig")
 
 	fwResp := &fwserver.ValidateResourceConfigResponse{}
 
+	defer func() {
+		done(fwResp.Diagnostics.ToError())
+	}()
+
 	resource, diags := s.FrameworkServer.Resource(ctx, proto6Req.TypeName)
 
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	resourceSchema, diags := s.FrameworkServer.ResourceSchema(ctx, proto6Req.TypeName)
@@ -33,7 +38,7 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	fwReq, diags := fromproto6.ValidateResourceConfigRequest(ctx, proto6Req, resource, resourceSchema)
@@ -41,10 +46,12 @@ func (s *Server) ValidateResourceConfig(ctx context.Context, proto6Req *tfprotov
 	fwResp.Diagnostics.Append(diags...)
 
 	if fwResp.Diagnostics.HasError() {
-		return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+		return modifyProtocolResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 	}
 
 	s.FrameworkServer.ValidateResourceConfig(ctx, fwReq, fwResp)
 
-	return toproto6.ValidateResourceConfigResponse(ctx, fwResp), nil
+	fwResp.Diagnostics = fwserver.SchemaRedactSensitiveDiagnostics(ctx, resourceSchema, fwResp.Diagnostics, fwReq.Config)
+
+	return modifyProtocolResponse(ctx, s, "ValidateResourceConfig", toproto6.ValidateResourceConfigResponse(ctx, fwResp)), nil
 }
@@ -291,10 +291,27 @@ func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 		}
 	}
 
-	res, err := typ.ValueFromTerraform(ctx, val)
-	if err != nil {
-		return target, append(diags, valueFromTerraformErrorDiag(err, path))
+	var res attr.Value
+
+	if typeWithValidation, ok := typ.(xattr.TypeWithValueFromTerraformWithValidation); ok {
+		var valueDiags diag.Diagnostics
+
+		res, valueDiags = typeWithValidation.ValueFromTerraformWithValidation(ctx, val, path)
+
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return target, diags
+		}
+	} else {
+		var err error
+
+		res, err = typ.ValueFromTerraform(ctx, val)
+		if err != nil {
+			return target, append(diags, valueFromTerraformErrorDiag(err, path))
+		}
 	}
+
 	if reflect.TypeOf(res) != target.Type() {
 		diags.Append(diag.WithPath(path, DiagNewAttributeValueIntoWrongType{
 			ValType:    reflect.TypeOf(res),
@@ -479,6 +479,61 @@ func TestNewAttributeValue(t *testing.T) {
 	}
 }
 
+func TestNewAttributeValue_ValueFromTerraformWithValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"value": {
+			val: tftypes.NewValue(tftypes.String, "hello"),
+			expected: testtypes.String{
+				InternalString: types.StringValue("hello"),
+				CreatedBy:      testtypes.StringTypeWithValueFromTerraformWithValidation{},
+			},
+		},
+		"error": {
+			val: tftypes.NewValue(tftypes.String, "invalid"),
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestErrorDiagnostic(path.Root("test")),
+			},
+		},
+		"warning": {
+			val: tftypes.NewValue(tftypes.String, "warning"),
+			expected: testtypes.String{
+				InternalString: types.StringValue("warning"),
+				CreatedBy:      testtypes.StringTypeWithValueFromTerraformWithValidation{},
+			},
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestWarningDiagnostic(path.Root("test")),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, diags := refl.NewAttributeValue(context.Background(), testtypes.StringTypeWithValueFromTerraformWithValidation{}, tc.val, reflect.ValueOf(testtypes.String{}), refl.Options{}, path.Root("test"))
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(res.Interface(), tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestFromAttributeValue(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testdefaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+var _ defaults.StringWithPlan = StringWithPlan{}
+
+// Declarative defaults.StringWithPlan for unit testing.
+type StringWithPlan struct {
+	String
+
+	// defaults.StringWithPlan interface methods
+	DefaultStringWithPlanMethod func(context.Context, defaults.StringWithPlanRequest, *defaults.StringResponse)
+}
+
+// DefaultStringWithPlan satisfies the defaults.StringWithPlan interface.
+func (v StringWithPlan) DefaultStringWithPlan(ctx context.Context, req defaults.StringWithPlanRequest, resp *defaults.StringResponse) {
+	if v.DefaultStringWithPlanMethod == nil {
+		return
+	}
+
+	v.DefaultStringWithPlanMethod(ctx, req, resp)
+}
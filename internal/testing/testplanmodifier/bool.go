@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Bool = &Bool{}
+var (
+	_ planmodifier.Bool              = &Bool{}
+	_ planmodifier.SkipWhenUnchanged = &Bool{}
+)
 
 // Declarative planmodifier.Bool for unit testing.
 type Bool struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Bool interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyBoolMethod      func(context.Context, planmodifier.BoolRequest, *planmodifier.BoolResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Bool) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Bool interface.
 func (v Bool) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Dynamic = &Dynamic{}
+var (
+	_ planmodifier.Dynamic           = &Dynamic{}
+	_ planmodifier.SkipWhenUnchanged = &Dynamic{}
+)
 
 // Declarative planmodifier.Dynamic for unit testing.
 type Dynamic struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Dynamic interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyDynamicMethod   func(context.Context, planmodifier.DynamicRequest, *planmodifier.DynamicResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Dynamic) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Dynamic interface.
 func (v Dynamic) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
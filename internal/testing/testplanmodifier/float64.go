@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Float64 = &Float64{}
+var (
+	_ planmodifier.Float64           = &Float64{}
+	_ planmodifier.SkipWhenUnchanged = &Float64{}
+)
 
 // Declarative planmodifier.Float64 for unit testing.
 type Float64 struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Float64 interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyFloat64Method   func(context.Context, planmodifier.Float64Request, *planmodifier.Float64Response)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Float64) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Float64 interface.
 func (v Float64) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
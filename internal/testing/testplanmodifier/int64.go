@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Int64 = &Int64{}
+var (
+	_ planmodifier.Int64             = &Int64{}
+	_ planmodifier.SkipWhenUnchanged = &Int64{}
+)
 
 // Declarative planmodifier.Int64 for unit testing.
 type Int64 struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Int64 interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyInt64Method     func(context.Context, planmodifier.Int64Request, *planmodifier.Int64Response)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Int64) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Int64 interface.
 func (v Int64) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.List = &List{}
+var (
+	_ planmodifier.List              = &List{}
+	_ planmodifier.SkipWhenUnchanged = &List{}
+)
 
 // Declarative planmodifier.List for unit testing.
 type List struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// List interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyListMethod      func(context.Context, planmodifier.ListRequest, *planmodifier.ListResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v List) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.List interface.
 func (v List) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Map = &Map{}
+var (
+	_ planmodifier.Map               = &Map{}
+	_ planmodifier.SkipWhenUnchanged = &Map{}
+)
 
 // Declarative planmodifier.Map for unit testing.
 type Map struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Map interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyMapMethod       func(context.Context, planmodifier.MapRequest, *planmodifier.MapResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Map) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Map interface.
 func (v Map) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Number = &Number{}
+var (
+	_ planmodifier.Number            = &Number{}
+	_ planmodifier.SkipWhenUnchanged = &Number{}
+)
 
 // Declarative planmodifier.Number for unit testing.
 type Number struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Number interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyNumberMethod    func(context.Context, planmodifier.NumberRequest, *planmodifier.NumberResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Number) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Number interface.
 func (v Number) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Object = &Object{}
+var (
+	_ planmodifier.Object            = &Object{}
+	_ planmodifier.SkipWhenUnchanged = &Object{}
+)
 
 // Declarative planmodifier.Object for unit testing.
 type Object struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Object interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyObjectMethod    func(context.Context, planmodifier.ObjectRequest, *planmodifier.ObjectResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Object) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Object interface.
 func (v Object) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.Set = &Set{}
+var (
+	_ planmodifier.Set               = &Set{}
+	_ planmodifier.SkipWhenUnchanged = &Set{}
+)
 
 // Declarative planmodifier.Set for unit testing.
 type Set struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// Set interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifySetMethod       func(context.Context, planmodifier.SetRequest, *planmodifier.SetResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v Set) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.Set interface.
 func (v Set) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
@@ -9,16 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-var _ planmodifier.String = &String{}
+var (
+	_ planmodifier.String            = &String{}
+	_ planmodifier.SkipWhenUnchanged = &String{}
+)
 
 // Declarative planmodifier.String for unit testing.
 type String struct {
+	// SkipWhenUnchanged interface methods
+	SkipWhenUnchangedMethod func(context.Context) bool
+
 	// String interface methods
 	DescriptionMethod         func(context.Context) string
 	MarkdownDescriptionMethod func(context.Context) string
 	PlanModifyStringMethod    func(context.Context, planmodifier.StringRequest, *planmodifier.StringResponse)
 }
 
+// SkipWhenUnchanged satisfies the planmodifier.SkipWhenUnchanged interface.
+func (v String) SkipWhenUnchanged(ctx context.Context) bool {
+	if v.SkipWhenUnchangedMethod == nil {
+		return false
+	}
+
+	return v.SkipWhenUnchangedMethod(ctx)
+}
+
 // Description satisfies the planmodifier.String interface.
 func (v String) Description(ctx context.Context) string {
 	if v.DescriptionMethod == nil {
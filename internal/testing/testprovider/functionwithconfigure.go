@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &FunctionWithConfigure{}
+var _ function.FunctionWithConfigure = &FunctionWithConfigure{}
+
+// Declarative function.FunctionWithConfigure for unit testing.
+type FunctionWithConfigure struct {
+	*Function
+
+	// FunctionWithConfigure interface methods
+	ConfigureMethod func(context.Context, function.ConfigureRequest, *function.ConfigureResponse)
+}
+
+// Configure satisfies the function.FunctionWithConfigure interface.
+func (f *FunctionWithConfigure) Configure(ctx context.Context, req function.ConfigureRequest, resp *function.ConfigureResponse) {
+	if f.ConfigureMethod == nil {
+		return
+	}
+
+	f.ConfigureMethod(ctx, req, resp)
+}
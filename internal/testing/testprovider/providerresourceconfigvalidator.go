@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.ResourceConfigValidator = &ProviderResourceConfigValidator{}
+
+// Declarative provider.ResourceConfigValidator for unit testing.
+type ProviderResourceConfigValidator struct {
+	// ResourceConfigValidator interface methods
+	DescriptionMethod            func(context.Context) string
+	MarkdownDescriptionMethod    func(context.Context) string
+	ValidateResourceConfigMethod func(context.Context, provider.ValidateResourceConfigRequest, *provider.ValidateResourceConfigResponse)
+}
+
+// Description satisfies the provider.ResourceConfigValidator interface.
+func (v *ProviderResourceConfigValidator) Description(ctx context.Context) string {
+	if v.DescriptionMethod == nil {
+		return ""
+	}
+
+	return v.DescriptionMethod(ctx)
+}
+
+// MarkdownDescription satisfies the provider.ResourceConfigValidator interface.
+func (v *ProviderResourceConfigValidator) MarkdownDescription(ctx context.Context) string {
+	if v.MarkdownDescriptionMethod == nil {
+		return ""
+	}
+
+	return v.MarkdownDescriptionMethod(ctx)
+}
+
+// ValidateResourceConfig satisfies the provider.ResourceConfigValidator interface.
+func (v *ProviderResourceConfigValidator) ValidateResourceConfig(ctx context.Context, req provider.ValidateResourceConfigRequest, resp *provider.ValidateResourceConfigResponse) {
+	if v.ValidateResourceConfigMethod == nil {
+		return
+	}
+
+	v.ValidateResourceConfigMethod(ctx, req, resp)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var (
+	_ provider.Provider                      = &ProviderWithFunctionsCacheKey{}
+	_ provider.ProviderWithFunctions         = &ProviderWithFunctionsCacheKey{}
+	_ provider.ProviderWithFunctionsCacheKey = &ProviderWithFunctionsCacheKey{}
+)
+
+// Declarative provider.ProviderWithFunctionsCacheKey for unit testing.
+type ProviderWithFunctionsCacheKey struct {
+	*ProviderWithFunctions
+
+	// ProviderWithFunctionsCacheKey interface methods
+	FunctionsCacheKeyMethod func(context.Context) string
+}
+
+// FunctionsCacheKey satisfies the provider.ProviderWithFunctionsCacheKey
+// interface.
+func (p *ProviderWithFunctionsCacheKey) FunctionsCacheKey(ctx context.Context) string {
+	if p.FunctionsCacheKeyMethod == nil {
+		return ""
+	}
+
+	return p.FunctionsCacheKeyMethod(ctx)
+}
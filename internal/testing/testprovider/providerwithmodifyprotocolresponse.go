@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithModifyProtocolResponse{}
+var _ provider.ProviderWithModifyProtocolResponse = &ProviderWithModifyProtocolResponse{}
+
+// Declarative provider.ProviderWithModifyProtocolResponse for unit testing.
+type ProviderWithModifyProtocolResponse struct {
+	*Provider
+
+	// ProviderWithModifyProtocolResponse interface methods
+	ModifyProtocolResponseMethod func(context.Context, string, any) any
+}
+
+// ModifyProtocolResponse satisfies the provider.ProviderWithModifyProtocolResponse interface.
+func (p *ProviderWithModifyProtocolResponse) ModifyProtocolResponse(ctx context.Context, rpc string, resp any) any {
+	if p.ModifyProtocolResponseMethod == nil {
+		return resp
+	}
+
+	return p.ModifyProtocolResponseMethod(ctx, rpc, resp)
+}
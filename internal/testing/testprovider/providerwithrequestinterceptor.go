@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithRequestInterceptor{}
+var _ provider.ProviderWithRequestInterceptor = &ProviderWithRequestInterceptor{}
+
+// Declarative provider.ProviderWithRequestInterceptor for unit testing.
+type ProviderWithRequestInterceptor struct {
+	*Provider
+
+	// ProviderWithRequestInterceptor interface methods
+	InterceptRequestMethod func(context.Context, string) (context.Context, func(error))
+}
+
+// InterceptRequest satisfies the provider.ProviderWithRequestInterceptor interface.
+func (p *ProviderWithRequestInterceptor) InterceptRequest(ctx context.Context, rpc string) (context.Context, func(error)) {
+	if p.InterceptRequestMethod == nil {
+		return ctx, nil
+	}
+
+	return p.InterceptRequestMethod(ctx, rpc)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+var _ provider.Provider = &ProviderWithResourceConfigValidators{}
+var _ provider.ProviderWithResourceConfigValidators = &ProviderWithResourceConfigValidators{}
+
+// Declarative provider.ProviderWithResourceConfigValidators for unit testing.
+type ProviderWithResourceConfigValidators struct {
+	*Provider
+
+	// ProviderWithResourceConfigValidators interface methods
+	ResourceConfigValidatorsMethod func(context.Context) []provider.ResourceConfigValidator
+}
+
+// ResourceConfigValidators satisfies the provider.ProviderWithResourceConfigValidators interface.
+func (p *ProviderWithResourceConfigValidators) ResourceConfigValidators(ctx context.Context) []provider.ResourceConfigValidator {
+	if p.ResourceConfigValidatorsMethod == nil {
+		return nil
+	}
+
+	return p.ResourceConfigValidatorsMethod(ctx)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithConcurrentPlanModifiers{}
+var _ resource.ResourceWithConcurrentPlanModifiers = &ResourceWithConcurrentPlanModifiers{}
+
+// Declarative resource.ResourceWithConcurrentPlanModifiers for unit testing.
+type ResourceWithConcurrentPlanModifiers struct {
+	*Resource
+
+	// ResourceWithConcurrentPlanModifiers interface methods
+	ConcurrentPlanModifiersMethod func(context.Context, resource.ConcurrentPlanModifiersRequest, *resource.ConcurrentPlanModifiersResponse)
+}
+
+// ConcurrentPlanModifiers satisfies the resource.ResourceWithConcurrentPlanModifiers interface.
+func (r *ResourceWithConcurrentPlanModifiers) ConcurrentPlanModifiers(ctx context.Context, req resource.ConcurrentPlanModifiersRequest, resp *resource.ConcurrentPlanModifiersResponse) {
+	if r.ConcurrentPlanModifiersMethod == nil {
+		return
+	}
+
+	r.ConcurrentPlanModifiersMethod(ctx, req, resp)
+}
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithConditionalAttributes{}
+var _ resource.ResourceWithConditionalAttributes = &ResourceWithConditionalAttributes{}
+
+// Declarative resource.ResourceWithConditionalAttributes for unit testing.
+type ResourceWithConditionalAttributes struct {
+	*Resource
+
+	// ResourceWithConditionalAttributes interface methods
+	ConditionalAttributesMethod func(context.Context) []resource.ConditionalAttribute
+}
+
+// ConditionalAttributes satisfies the resource.ResourceWithConditionalAttributes interface.
+func (p *ResourceWithConditionalAttributes) ConditionalAttributes(ctx context.Context) []resource.ConditionalAttribute {
+	if p.ConditionalAttributesMethod == nil {
+		return nil
+	}
+
+	return p.ConditionalAttributesMethod(ctx)
+}
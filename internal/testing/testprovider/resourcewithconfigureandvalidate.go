@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithConfigureAndValidate{}
+var _ resource.ResourceWithConfigure = &ResourceWithConfigureAndValidate{}
+var _ resource.ResourceWithConfigureAndValidate = &ResourceWithConfigureAndValidate{}
+
+// Declarative resource.ResourceWithConfigureAndValidate for unit testing.
+type ResourceWithConfigureAndValidate struct {
+	*Resource
+
+	// ResourceWithConfigure interface methods
+	ConfigureMethod func(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse)
+
+	// ResourceWithConfigureAndValidate interface methods
+	ValidateConfiguredConfigMethod func(context.Context, resource.ValidateConfiguredConfigRequest, *resource.ValidateConfiguredConfigResponse)
+}
+
+// Configure satisfies the resource.ResourceWithConfigure interface.
+func (r *ResourceWithConfigureAndValidate) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if r.ConfigureMethod == nil {
+		return
+	}
+
+	r.ConfigureMethod(ctx, req, resp)
+}
+
+// ValidateConfiguredConfig satisfies the resource.ResourceWithConfigureAndValidate interface.
+func (r *ResourceWithConfigureAndValidate) ValidateConfiguredConfig(ctx context.Context, req resource.ValidateConfiguredConfigRequest, resp *resource.ValidateConfiguredConfigResponse) {
+	if r.ValidateConfiguredConfigMethod == nil {
+		return
+	}
+
+	r.ValidateConfiguredConfigMethod(ctx, req, resp)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithConfigValidatorsStopOnError{}
+var _ resource.ResourceWithConfigValidators = &ResourceWithConfigValidatorsStopOnError{}
+var _ resource.ResourceWithConfigValidatorsStopOnError = &ResourceWithConfigValidatorsStopOnError{}
+
+// Declarative resource.ResourceWithConfigValidatorsStopOnError for unit testing.
+type ResourceWithConfigValidatorsStopOnError struct {
+	*Resource
+
+	// ResourceWithConfigValidators interface methods
+	ConfigValidatorsMethod func(context.Context) []resource.ConfigValidator
+
+	// ResourceWithConfigValidatorsStopOnError interface methods
+	ConfigValidatorsStopOnErrorMethod func(context.Context) bool
+}
+
+// ConfigValidators satisfies the resource.ResourceWithConfigValidators interface.
+func (p *ResourceWithConfigValidatorsStopOnError) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if p.ConfigValidatorsMethod == nil {
+		return nil
+	}
+
+	return p.ConfigValidatorsMethod(ctx)
+}
+
+// ConfigValidatorsStopOnError satisfies the resource.ResourceWithConfigValidatorsStopOnError interface.
+func (p *ResourceWithConfigValidatorsStopOnError) ConfigValidatorsStopOnError(ctx context.Context) bool {
+	if p.ConfigValidatorsStopOnErrorMethod == nil {
+		return false
+	}
+
+	return p.ConfigValidatorsStopOnErrorMethod(ctx)
+}
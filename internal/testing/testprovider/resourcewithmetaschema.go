@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithMetaSchema{}
+var _ resource.ResourceWithMetaSchema = &ResourceWithMetaSchema{}
+
+// Declarative resource.ResourceWithMetaSchema for unit testing.
+type ResourceWithMetaSchema struct {
+	*Resource
+
+	// ResourceWithMetaSchema interface methods
+	MetaSchemaMethod func(context.Context, resource.MetaSchemaRequest, *resource.MetaSchemaResponse)
+}
+
+// MetaSchema satisfies the resource.ResourceWithMetaSchema interface.
+func (r *ResourceWithMetaSchema) MetaSchema(ctx context.Context, req resource.MetaSchemaRequest, resp *resource.MetaSchemaResponse) {
+	if r.MetaSchemaMethod == nil {
+		return
+	}
+
+	r.MetaSchemaMethod(ctx, req, resp)
+}
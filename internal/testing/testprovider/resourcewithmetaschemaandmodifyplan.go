@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithMetaSchemaAndModifyPlan{}
+var _ resource.ResourceWithMetaSchema = &ResourceWithMetaSchemaAndModifyPlan{}
+var _ resource.ResourceWithModifyPlan = &ResourceWithMetaSchemaAndModifyPlan{}
+
+// Declarative resource.ResourceWithMetaSchemaAndModifyPlan for unit testing.
+type ResourceWithMetaSchemaAndModifyPlan struct {
+	*Resource
+
+	// ResourceWithMetaSchema interface methods
+	MetaSchemaMethod func(context.Context, resource.MetaSchemaRequest, *resource.MetaSchemaResponse)
+
+	// ResourceWithModifyPlan interface methods
+	ModifyPlanMethod func(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse)
+}
+
+// MetaSchema satisfies the resource.ResourceWithMetaSchema interface.
+func (r *ResourceWithMetaSchemaAndModifyPlan) MetaSchema(ctx context.Context, req resource.MetaSchemaRequest, resp *resource.MetaSchemaResponse) {
+	if r.MetaSchemaMethod == nil {
+		return
+	}
+
+	r.MetaSchemaMethod(ctx, req, resp)
+}
+
+// ModifyPlan satisfies the resource.ResourceWithModifyPlan interface.
+func (r *ResourceWithMetaSchemaAndModifyPlan) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.ModifyPlanMethod == nil {
+		return
+	}
+
+	r.ModifyPlanMethod(ctx, req, resp)
+}
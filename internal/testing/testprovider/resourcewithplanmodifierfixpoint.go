@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithPlanModifierFixpoint{}
+var _ resource.ResourceWithModifyPlan = &ResourceWithPlanModifierFixpoint{}
+var _ resource.ResourceWithPlanModifierFixpoint = &ResourceWithPlanModifierFixpoint{}
+
+// Declarative resource.ResourceWithPlanModifierFixpoint for unit testing.
+type ResourceWithPlanModifierFixpoint struct {
+	*Resource
+
+	// ResourceWithModifyPlan interface methods
+	ModifyPlanMethod func(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse)
+
+	// ResourceWithPlanModifierFixpoint interface methods
+	PlanModifierFixpointMethod func(context.Context, resource.PlanModifierFixpointRequest, *resource.PlanModifierFixpointResponse)
+}
+
+// ModifyPlan satisfies the resource.ResourceWithModifyPlan interface.
+func (r *ResourceWithPlanModifierFixpoint) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.ModifyPlanMethod == nil {
+		return
+	}
+
+	r.ModifyPlanMethod(ctx, req, resp)
+}
+
+// PlanModifierFixpoint satisfies the resource.ResourceWithPlanModifierFixpoint interface.
+func (r *ResourceWithPlanModifierFixpoint) PlanModifierFixpoint(ctx context.Context, req resource.PlanModifierFixpointRequest, resp *resource.PlanModifierFixpointResponse) {
+	if r.PlanModifierFixpointMethod == nil {
+		return
+	}
+
+	r.PlanModifierFixpointMethod(ctx, req, resp)
+}
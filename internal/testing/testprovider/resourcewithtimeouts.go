@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithTimeouts{}
+var _ resource.ResourceWithTimeouts = &ResourceWithTimeouts{}
+
+// Declarative resource.ResourceWithTimeouts for unit testing.
+type ResourceWithTimeouts struct {
+	*Resource
+
+	// ResourceWithTimeouts interface methods
+	TimeoutsMethod func(context.Context, resource.TimeoutsRequest, *resource.TimeoutsResponse)
+}
+
+// Timeouts satisfies the resource.ResourceWithTimeouts interface.
+func (r *ResourceWithTimeouts) Timeouts(ctx context.Context, req resource.TimeoutsRequest, resp *resource.TimeoutsResponse) {
+	if r.TimeoutsMethod == nil {
+		return
+	}
+
+	r.TimeoutsMethod(ctx, req, resp)
+}
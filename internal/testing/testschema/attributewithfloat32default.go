@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ fwschema.AttributeWithFloat32DefaultValue = AttributeWithFloat32DefaultValue{}
+
+type AttributeWithFloat32DefaultValue struct {
+	Computed            bool
+	DeprecationMessage  string
+	Description         string
+	MarkdownDescription string
+	Optional            bool
+	Required            bool
+	Sensitive           bool
+	Default             defaults.Float32
+}
+
+// ApplyTerraform5AttributePathStep satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (any, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// Float32DefaultValue satisfies the fwxschema.AttributeWithFloat32DefaultValue interface.
+func (a AttributeWithFloat32DefaultValue) Float32DefaultValue() defaults.Float32 {
+	return a.Default
+}
+
+// Equal satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) Equal(o fwschema.Attribute) bool {
+	_, ok := o.(AttributeWithFloat32DefaultValue)
+
+	if !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) GetDeprecationMessage() string {
+	return a.DeprecationMessage
+}
+
+// GetDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) GetType() attr.Type {
+	return types.Float32Type
+}
+
+// IsComputed satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) IsComputed() bool {
+	return a.Computed
+}
+
+// IsOptional satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) IsOptional() bool {
+	return a.Optional
+}
+
+// IsRequired satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) IsRequired() bool {
+	return a.Required
+}
+
+// IsSensitive satisfies the fwschema.Attribute interface.
+func (a AttributeWithFloat32DefaultValue) IsSensitive() bool {
+	return a.Sensitive
+}
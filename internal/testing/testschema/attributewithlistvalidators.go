@@ -12,13 +12,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwxschema.AttributeWithListValidators = AttributeWithListValidators{}
+var (
+	_ fwxschema.AttributeWithListValidators    = AttributeWithListValidators{}
+	_ fwxschema.AttributeWithElementValidators = AttributeWithListValidators{}
+)
 
 type AttributeWithListValidators struct {
 	Computed            bool
 	DeprecationMessage  string
 	Description         string
 	ElementType         attr.Type
+	ElementValidators   []validator.Describer
 	MarkdownDescription string
 	Optional            bool
 	Required            bool
@@ -52,6 +56,11 @@ func (a AttributeWithListValidators) GetDescription() string {
 	return a.Description
 }
 
+// GetElementValidators satisfies the fwxschema.AttributeWithElementValidators interface.
+func (a AttributeWithListValidators) GetElementValidators() []validator.Describer {
+	return a.ElementValidators
+}
+
 // GetMarkdownDescription satisfies the fwschema.Attribute interface.
 func (a AttributeWithListValidators) GetMarkdownDescription() string {
 	return a.MarkdownDescription
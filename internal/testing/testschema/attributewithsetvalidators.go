@@ -12,13 +12,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwxschema.AttributeWithSetValidators = AttributeWithSetValidators{}
+var (
+	_ fwxschema.AttributeWithSetValidators     = AttributeWithSetValidators{}
+	_ fwxschema.AttributeWithElementValidators = AttributeWithSetValidators{}
+)
 
 type AttributeWithSetValidators struct {
 	Computed            bool
 	DeprecationMessage  string
 	Description         string
 	ElementType         attr.Type
+	ElementValidators   []validator.Describer
 	MarkdownDescription string
 	Optional            bool
 	Required            bool
@@ -52,6 +56,11 @@ func (a AttributeWithSetValidators) GetDescription() string {
 	return a.Description
 }
 
+// GetElementValidators satisfies the fwxschema.AttributeWithElementValidators interface.
+func (a AttributeWithSetValidators) GetElementValidators() []validator.Describer {
+	return a.ElementValidators
+}
+
 // GetMarkdownDescription satisfies the fwschema.Attribute interface.
 func (a AttributeWithSetValidators) GetMarkdownDescription() string {
 	return a.MarkdownDescription
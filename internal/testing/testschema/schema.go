@@ -16,12 +16,13 @@ import (
 var _ fwschema.Schema = Schema{}
 
 type Schema struct {
-	Attributes          map[string]fwschema.Attribute
-	Blocks              map[string]fwschema.Block
-	DeprecationMessage  string
-	Description         string
-	MarkdownDescription string
-	Version             int64
+	Attributes                   map[string]fwschema.Attribute
+	Blocks                       map[string]fwschema.Block
+	DeprecationMessage           string
+	DeprecationWarningsOnUnknown bool
+	Description                  string
+	MarkdownDescription          string
+	Version                      int64
 }
 
 // ApplyTerraform5AttributePathStep satisfies the fwschema.Schema interface.
@@ -54,6 +55,12 @@ func (s Schema) GetDeprecationMessage() string {
 	return s.DeprecationMessage
 }
 
+// GetDeprecationWarningsOnUnknown satisfies the
+// fwschema.SchemaWithDeprecationWarningsOnUnknown interface.
+func (s Schema) GetDeprecationWarningsOnUnknown() bool {
+	return s.DeprecationWarningsOnUnknown
+}
+
 // GetDescription satisfies the fwschema.Schema interface.
 func (s Schema) GetDescription() string {
 	return s.Description
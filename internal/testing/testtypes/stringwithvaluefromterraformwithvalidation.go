@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ xattr.TypeWithValueFromTerraformWithValidation = StringTypeWithValueFromTerraformWithValidation{}
+
+// StringTypeWithValueFromTerraformWithValidation returns an error diagnostic
+// from ValueFromTerraformWithValidation when the value is "invalid" and a
+// warning diagnostic when the value is "warning".
+type StringTypeWithValueFromTerraformWithValidation struct {
+	StringType
+}
+
+func (t StringTypeWithValueFromTerraformWithValidation) Equal(o attr.Type) bool {
+	other, ok := o.(StringTypeWithValueFromTerraformWithValidation)
+	if !ok {
+		return false
+	}
+	return t == other
+}
+
+func (t StringTypeWithValueFromTerraformWithValidation) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	res, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	newString, ok := res.(String)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", res)
+	}
+	newString.CreatedBy = t
+	return newString, nil
+}
+
+func (t StringTypeWithValueFromTerraformWithValidation) ValueFromTerraformWithValidation(ctx context.Context, in tftypes.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, err := t.ValueFromTerraform(ctx, in)
+	if err != nil {
+		diags.AddAttributeError(path, "Value Conversion Error", err.Error())
+
+		return nil, diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return res, diags
+	}
+
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(path, "Value Conversion Error", err.Error())
+
+		return nil, diags
+	}
+
+	switch s {
+	case "invalid":
+		diags.Append(TestErrorDiagnostic(path))
+
+		return nil, diags
+	case "warning":
+		diags.Append(TestWarningDiagnostic(path))
+	}
+
+	return res, diags
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Object = &ObjectWithExpressions{}
+var _ validator.ExpressionProvider = &ObjectWithExpressions{}
+
+// Declarative validator.Object and validator.ExpressionProvider for unit
+// testing.
+type ObjectWithExpressions struct {
+	Object
+
+	// ExpressionProvider interface methods
+	ExpressionsMethod func(context.Context) path.Expressions
+}
+
+// Expressions satisfies the validator.ExpressionProvider interface.
+func (v ObjectWithExpressions) Expressions(ctx context.Context) path.Expressions {
+	if v.ExpressionsMethod == nil {
+		return nil
+	}
+
+	return v.ExpressionsMethod(ctx)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &StringWithExpressions{}
+var _ validator.ExpressionProvider = &StringWithExpressions{}
+
+// Declarative validator.String and validator.ExpressionProvider for unit
+// testing.
+type StringWithExpressions struct {
+	String
+
+	// ExpressionProvider interface methods
+	ExpressionsMethod func(context.Context) path.Expressions
+}
+
+// Expressions satisfies the validator.ExpressionProvider interface.
+func (v StringWithExpressions) Expressions(ctx context.Context) path.Expressions {
+	if v.ExpressionsMethod == nil {
+		return nil
+	}
+
+	return v.ExpressionsMethod(ctx)
+}
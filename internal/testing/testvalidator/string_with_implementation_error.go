@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &StringWithImplementationError{}
+var _ validator.ImplementationErrorProvider = &StringWithImplementationError{}
+
+// Declarative validator.String and validator.ImplementationErrorProvider for
+// unit testing.
+type StringWithImplementationError struct {
+	String
+
+	// ImplementationErrorProvider interface methods
+	ImplementationErrorMethod func(context.Context) error
+}
+
+// ImplementationError satisfies the validator.ImplementationErrorProvider
+// interface.
+func (v StringWithImplementationError) ImplementationError(ctx context.Context) error {
+	if v.ImplementationErrorMethod == nil {
+		return nil
+	}
+
+	return v.ImplementationErrorMethod(ctx)
+}
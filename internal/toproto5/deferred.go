@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// DataSourceDeferred returns the *tfprotov5.Deferred equivalent of a
+// *datasource.Deferred.
+func DataSourceDeferred(fw *datasource.Deferred) *tfprotov5.Deferred {
+	if fw == nil {
+		return nil
+	}
+
+	return &tfprotov5.Deferred{
+		Reason: tfprotov5.DeferredReason(fw.Reason),
+	}
+}
+
+// ResourceDeferred returns the *tfprotov5.Deferred equivalent of a
+// *resource.Deferred.
+func ResourceDeferred(fw *resource.Deferred) *tfprotov5.Deferred {
+	if fw == nil {
+		return nil
+	}
+
+	return &tfprotov5.Deferred{
+		Reason: tfprotov5.DeferredReason(fw.Reason),
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       *resource.Deferred
+		expected *tfprotov5.Deferred
+	}{
+		"nil": {
+			fw:       nil,
+			expected: nil,
+		},
+		"reason": {
+			fw: &resource.Deferred{
+				Reason: resource.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov5.Deferred{
+				Reason: tfprotov5.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.ResourceDeferred(testCase.fw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataSourceDeferred(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       *datasource.Deferred
+		expected *tfprotov5.Deferred
+	}{
+		"nil": {
+			fw:       nil,
+			expected: nil,
+		},
+		"reason": {
+			fw: &datasource.Deferred{
+				Reason: datasource.DeferredReasonAbsentPrereq,
+			},
+			expected: &tfprotov5.Deferred{
+				Reason: tfprotov5.DeferredReasonAbsentPrereq,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.DataSourceDeferred(testCase.fw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
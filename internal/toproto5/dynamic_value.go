@@ -19,6 +19,9 @@ import (
 // developers from needing to understand Terraform's differences between
 // block and attribute values where blocks are technically never null, but from
 // a developer perspective this distinction introduces unnecessary complexity.
+//
+// Set values are also sorted into a canonical element ordering, so equal sets
+// always produce byte-identical DynamicValues.
 func DynamicValue(ctx context.Context, data *fwschemadata.Data) (*tfprotov5.DynamicValue, diag.Diagnostics) {
 	if data == nil {
 		return nil, nil
@@ -29,6 +32,13 @@ func DynamicValue(ctx context.Context, data *fwschemadata.Data) (*tfprotov5.Dyna
 	// Prevent Terraform core errors for null list/set blocks.
 	diags.Append(data.ReifyNullCollectionBlocks(ctx)...)
 
+	// Ensure equal sets always produce byte-identical DynamicValues.
+	diags.Append(data.CanonicalizeSetElements(ctx)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	proto5, err := tfprotov5.NewDynamicValue(data.Schema.Type().TerraformType(ctx), data.TerraformValue)
 
 	if err != nil {
@@ -1227,6 +1227,61 @@ func TestDynamicValue(t *testing.T) {
 				},
 			)),
 		},
+		"set-attribute-unordered": {
+			fw: &fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionState,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Optional: true,
+							Type: types.SetType{
+								ElemType: types.StringType,
+							},
+						},
+					},
+				},
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Set{
+								ElementType: tftypes.String,
+							},
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(
+							tftypes.Set{
+								ElementType: tftypes.String,
+							},
+							[]tftypes.Value{
+								tftypes.NewValue(tftypes.String, "test-value-2"),
+								tftypes.NewValue(tftypes.String, "test-value-1"),
+							},
+						),
+					},
+				),
+			},
+			expected: DynamicValueMust(tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.Set{
+							ElementType: tftypes.String,
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.Set{
+							ElementType: tftypes.String,
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "test-value-1"),
+							tftypes.NewValue(tftypes.String, "test-value-2"),
+						},
+					),
+				},
+			)),
+		},
 	}
 
 	for name, testCase := range testCases {
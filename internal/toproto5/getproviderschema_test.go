@@ -23,8 +23,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// TODO: Tuple type support
-// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/54
 func TestGetProviderSchemaResponse(t *testing.T) {
 	t.Parallel()
 
@@ -846,6 +844,45 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				ResourceSchemas: map[string]*tfprotov5.Schema{},
 			},
 		},
+		"data-source-attribute-type-tuple": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Attributes: map[string]datasourceschema.Attribute{
+							"test_attribute": datasourceschema.TupleAttribute{
+								Required: true,
+								ElementTypes: []attr.Type{
+									types.StringType,
+									types.BoolType,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{
+					"test_data_source": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Required: true,
+									Type: tftypes.Tuple{
+										ElementTypes: []tftypes.Type{
+											tftypes.String,
+											tftypes.Bool,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Functions:       map[string]*tfprotov5.Function{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{},
+			},
+		},
 		"data-source-attribute-type-dynamic": {
 			input: &fwserver.GetProviderSchemaResponse{
 				DataSourceSchemas: map[string]fwschema.Schema{
@@ -2777,6 +2814,38 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-attribute-write-only": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test_attribute": resourceschema.StringAttribute{
+								Optional:  true,
+								WriteOnly: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Functions:         map[string]*tfprotov5.Function{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:      "test_attribute",
+									Optional:  true,
+									Type:      tftypes.String,
+									WriteOnly: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-attribute-type-bool": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
@@ -3353,6 +3422,45 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-attribute-type-tuple": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test_attribute": resourceschema.TupleAttribute{
+								Required: true,
+								ElementTypes: []attr.Type{
+									types.StringType,
+									types.BoolType,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov5.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov5.Schema{},
+				Functions:         map[string]*tfprotov5.Function{},
+				ResourceSchemas: map[string]*tfprotov5.Schema{
+					"test_resource": {
+						Block: &tfprotov5.SchemaBlock{
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Required: true,
+									Type: tftypes.Tuple{
+										ElementTypes: []tftypes.Type{
+											tftypes.String,
+											tftypes.Bool,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-attribute-type-dynamic": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
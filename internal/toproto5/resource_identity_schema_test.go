@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResourceIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    fwschema.Schema
+		expected *tfprotov5.ResourceIdentitySchema
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input:    identityschema.Schema{},
+			expected: &tfprotov5.ResourceIdentitySchema{},
+		},
+		"attributes": {
+			input: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"test_string": identityschema.StringAttribute{
+						RequiredForImport: true,
+						Description:       "test description",
+					},
+					"test_bool": identityschema.BoolAttribute{
+						OptionalForImport: true,
+					},
+					"test_list": identityschema.ListAttribute{
+						ElementType:       types.StringType,
+						OptionalForImport: true,
+					},
+				},
+			},
+			expected: &tfprotov5.ResourceIdentitySchema{
+				IdentityAttributes: []*tfprotov5.ResourceIdentitySchemaAttribute{
+					{
+						Name:              "test_bool",
+						Type:              tftypes.Bool,
+						OptionalForImport: true,
+					},
+					{
+						Name:              "test_list",
+						Type:              tftypes.List{ElementType: tftypes.String},
+						OptionalForImport: true,
+					},
+					{
+						Name:              "test_string",
+						Type:              tftypes.String,
+						RequiredForImport: true,
+						Description:       "test description",
+					},
+				},
+			},
+		},
+		"version": {
+			input: identityschema.Schema{
+				Version: 1,
+			},
+			expected: &tfprotov5.ResourceIdentitySchema{
+				Version: 1,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := toproto5.ResourceIdentitySchema(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -36,6 +36,10 @@ func SchemaAttribute(ctx context.Context, name string, path *tftypes.AttributePa
 		Type:      a.GetType().TerraformType(ctx),
 	}
 
+	if attributeWithWriteOnly, ok := a.(fwschema.AttributeWithWriteOnly); ok {
+		schemaAttribute.WriteOnly = attributeWithWriteOnly.IsWriteOnly()
+	}
+
 	if a.GetDeprecationMessage() != "" {
 		schemaAttribute.Deprecated = true
 	}
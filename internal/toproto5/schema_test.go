@@ -103,7 +103,10 @@ func TestSchema(t *testing.T) {
 						Type:     types.SetType{ElemType: types.StringType},
 						Required: true,
 					},
-					// TODO: add tuple support when it lands
+					"tuple": testschema.Attribute{
+						Type:     types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}},
+						Optional: true,
+					},
 				},
 			},
 			expected: &tfprotov5.Schema{
@@ -134,6 +137,11 @@ func TestSchema(t *testing.T) {
 							Type:     tftypes.Set{ElementType: tftypes.String},
 							Required: true,
 						},
+						{
+							Name:     "tuple",
+							Type:     tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Number}},
+							Optional: true,
+						},
 					},
 				},
 			},
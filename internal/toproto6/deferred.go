@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// DataSourceDeferred returns the *tfprotov6.Deferred equivalent of a
+// *datasource.Deferred.
+func DataSourceDeferred(fw *datasource.Deferred) *tfprotov6.Deferred {
+	if fw == nil {
+		return nil
+	}
+
+	return &tfprotov6.Deferred{
+		Reason: tfprotov6.DeferredReason(fw.Reason),
+	}
+}
+
+// ResourceDeferred returns the *tfprotov6.Deferred equivalent of a
+// *resource.Deferred.
+func ResourceDeferred(fw *resource.Deferred) *tfprotov6.Deferred {
+	if fw == nil {
+		return nil
+	}
+
+	return &tfprotov6.Deferred{
+		Reason: tfprotov6.DeferredReason(fw.Reason),
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func BenchmarkDynamicValueListBlock1000(b *testing.B) {
+	benchmarkDynamicValueListBlock(b, 1000)
+}
+
+func BenchmarkDynamicValueListBlock10000(b *testing.B) {
+	benchmarkDynamicValueListBlock(b, 10000)
+}
+
+func benchmarkDynamicValueListBlock(b *testing.B, blocks int) {
+	ctx := context.Background()
+
+	blockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_dynamic": tftypes.DynamicPseudoType,
+			"test_number":  tftypes.Number,
+			"test_string":  tftypes.String,
+		},
+	}
+
+	blockValues := make([]tftypes.Value, blocks)
+
+	for i := 0; i < blocks; i++ {
+		blockValues[i] = tftypes.NewValue(
+			blockType,
+			map[string]tftypes.Value{
+				"test_dynamic": tftypes.NewValue(tftypes.String, "test-dynamic-value"+strconv.Itoa(i)),
+				"test_number":  tftypes.NewValue(tftypes.Number, i),
+				"test_string":  tftypes.NewValue(tftypes.String, "test-string-value"+strconv.Itoa(i)),
+			},
+		)
+	}
+
+	data := fwschemadata.Data{
+		Description: fwschemadata.DataDescriptionState,
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"test_attribute": testschema.Attribute{
+					Optional: true,
+					Type:     types.StringType,
+				},
+			},
+			Blocks: map[string]fwschema.Block{
+				"test_block": testschema.Block{
+					NestedObject: testschema.NestedBlockObject{
+						Attributes: map[string]fwschema.Attribute{
+							"test_dynamic": testschema.Attribute{
+								Optional: true,
+								Type:     types.DynamicType,
+							},
+							"test_number": testschema.Attribute{
+								Optional: true,
+								Type:     types.NumberType,
+							},
+							"test_string": testschema.Attribute{
+								Optional: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+					NestingMode: fwschema.BlockNestingModeList,
+				},
+			},
+		},
+		TerraformValue: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test_attribute": tftypes.String,
+					"test_block":     tftypes.List{ElementType: blockType},
+				},
+			},
+			map[string]tftypes.Value{
+				"test_attribute": tftypes.NewValue(tftypes.String, nil),
+				"test_block":     tftypes.NewValue(tftypes.List{ElementType: blockType}, blockValues),
+			},
+		),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		// DynamicValue may replace the TerraformValue, so use a copy.
+		input := data
+
+		_, diags := toproto6.DynamicValue(ctx, &input)
+
+		if diags.HasError() {
+			b.Fatalf("unexpected DynamicValue diagnostics: %v", diags)
+		}
+	}
+}
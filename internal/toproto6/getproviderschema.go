@@ -18,11 +18,12 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 	}
 
 	protov6 := &tfprotov6.GetProviderSchemaResponse{
-		DataSourceSchemas:  make(map[string]*tfprotov6.Schema, len(fw.DataSourceSchemas)),
-		Diagnostics:        Diagnostics(ctx, fw.Diagnostics),
-		Functions:          make(map[string]*tfprotov6.Function, len(fw.FunctionDefinitions)),
-		ResourceSchemas:    make(map[string]*tfprotov6.Schema, len(fw.ResourceSchemas)),
-		ServerCapabilities: ServerCapabilities(ctx, fw.ServerCapabilities),
+		DataSourceSchemas:        make(map[string]*tfprotov6.Schema, len(fw.DataSourceSchemas)),
+		Diagnostics:              Diagnostics(ctx, fw.Diagnostics),
+		EphemeralResourceSchemas: make(map[string]*tfprotov6.Schema, len(fw.EphemeralResourceSchemas)),
+		Functions:                make(map[string]*tfprotov6.Function, len(fw.FunctionDefinitions)),
+		ResourceSchemas:          make(map[string]*tfprotov6.Schema, len(fw.ResourceSchemas)),
+		ServerCapabilities:       ServerCapabilities(ctx, fw.ServerCapabilities),
 	}
 
 	var err error
@@ -65,6 +66,20 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 		}
 	}
 
+	for ephemeralResourceType, ephemeralResourceSchema := range fw.EphemeralResourceSchemas {
+		protov6.EphemeralResourceSchemas[ephemeralResourceType], err = Schema(ctx, ephemeralResourceSchema)
+
+		if err != nil {
+			protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
+				Severity: tfprotov6.DiagnosticSeverityError,
+				Summary:  "Error converting ephemeral resource schema",
+				Detail:   "The schema for the ephemeral resource \"" + ephemeralResourceType + "\" couldn't be converted into a usable type. This is always a problem with the provider. Please report the following to the provider developer:\n\n" + err.Error(),
+			})
+
+			return protov6
+		}
+	}
+
 	for name, functionDefinition := range fw.FunctionDefinitions {
 		protov6.Functions[name] = Function(ctx, functionDefinition)
 	}
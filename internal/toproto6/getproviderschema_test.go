@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	ephemeralschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
@@ -23,8 +24,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// TODO: Tuple type support
-// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/54
 func TestGetProviderSchemaResponse(t *testing.T) {
 	t.Parallel()
 
@@ -80,8 +79,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-computed": {
@@ -110,8 +110,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-deprecated": {
@@ -142,8 +143,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-optional": {
@@ -172,8 +174,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-optional-computed": {
@@ -204,8 +207,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-required": {
@@ -234,8 +238,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-sensitive": {
@@ -266,8 +271,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-bool": {
@@ -296,8 +302,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-float64": {
@@ -326,8 +333,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-int64": {
@@ -356,8 +364,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-list-string": {
@@ -393,8 +402,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-nested-attributes": {
@@ -439,8 +449,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-object": {
@@ -480,8 +491,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-list-string": {
@@ -513,8 +525,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-map-nested-attributes": {
@@ -559,8 +572,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-map-string": {
@@ -592,8 +606,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-number": {
@@ -622,8 +637,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-object": {
@@ -659,8 +675,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-nested-attributes": {
@@ -705,8 +722,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-object": {
@@ -746,8 +764,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-set-string": {
@@ -783,8 +802,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-set-string": {
@@ -816,8 +836,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-single-nested-attributes": {
@@ -860,8 +881,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-string": {
@@ -890,8 +912,49 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
+			},
+		},
+		"data-source-attribute-type-tuple": {
+			input: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": datasourceschema.Schema{
+						Attributes: map[string]datasourceschema.Attribute{
+							"test_attribute": datasourceschema.TupleAttribute{
+								Required: true,
+								ElementTypes: []attr.Type{
+									types.StringType,
+									types.BoolType,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{
+					"test_data_source": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Required: true,
+									Type: tftypes.Tuple{
+										ElementTypes: []tftypes.Type{
+											tftypes.String,
+											tftypes.Bool,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-attribute-type-dynamic": {
@@ -920,8 +983,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-block-list": {
@@ -964,8 +1028,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-block-set": {
@@ -1008,8 +1073,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
-				Functions:       map[string]*tfprotov6.Function{},
-				ResourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
 			},
 		},
 		"data-source-block-single": {
@@ -1050,6 +1116,56 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 						},
 					},
 				},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas:          map[string]*tfprotov6.Schema{},
+			},
+		},
+		"ephemeral-resource-multiple-ephemeral-resources": {
+			input: &fwserver.GetProviderSchemaResponse{
+				EphemeralResourceSchemas: map[string]fwschema.Schema{
+					"test_ephemeral_resource_1": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test_attribute": ephemeralschema.BoolAttribute{
+								Computed: true,
+							},
+						},
+					},
+					"test_ephemeral_resource_2": ephemeralschema.Schema{
+						Attributes: map[string]ephemeralschema.Attribute{
+							"test_attribute": ephemeralschema.BoolAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_ephemeral_resource_1": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Computed: true,
+									Name:     "test_attribute",
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+					"test_ephemeral_resource_2": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Required: true,
+									Type:     tftypes.Bool,
+								},
+							},
+						},
+					},
+				},
 				Functions:       map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{},
 			},
@@ -1066,7 +1182,8 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"testfunction1": {
 						Parameters: []*tfprotov6.FunctionParameter{},
@@ -1094,7 +1211,8 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"testfunction": {
 						DeprecationMessage: "test deprecation message",
@@ -1117,7 +1235,8 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"testfunction": {
 						Description: "test description",
@@ -1144,7 +1263,8 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"testfunction": {
 						Parameters: []*tfprotov6.FunctionParameter{
@@ -1175,7 +1295,8 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"testfunction": {
 						Parameters: []*tfprotov6.FunctionParameter{},
@@ -1197,7 +1318,8 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"testfunction": {
 						Parameters: []*tfprotov6.FunctionParameter{},
@@ -1220,7 +1342,8 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
 				Functions: map[string]*tfprotov6.Function{
 					"testfunction": {
 						Parameters: []*tfprotov6.FunctionParameter{},
@@ -1247,8 +1370,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1275,8 +1399,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1302,8 +1427,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1330,8 +1456,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1358,8 +1485,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1385,8 +1513,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1412,8 +1541,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1442,8 +1572,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1480,8 +1611,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1521,8 +1653,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1555,8 +1688,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1591,8 +1725,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1628,8 +1763,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1657,8 +1793,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1687,8 +1824,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1725,8 +1863,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1766,8 +1905,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1802,8 +1942,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1834,8 +1975,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1868,8 +2010,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1904,8 +2047,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1931,8 +2075,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -1964,8 +2109,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						BlockTypes: []*tfprotov6.SchemaNestedBlock{
@@ -2005,8 +2151,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						BlockTypes: []*tfprotov6.SchemaNestedBlock{
@@ -2044,8 +2191,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				Provider: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						BlockTypes: []*tfprotov6.SchemaNestedBlock{
@@ -2079,8 +2227,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2106,8 +2255,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2133,8 +2283,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2160,8 +2311,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2187,8 +2339,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2217,8 +2370,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2255,8 +2409,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2296,8 +2451,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2330,8 +2486,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2366,8 +2523,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2403,8 +2561,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2432,8 +2591,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2462,8 +2622,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2500,8 +2661,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2541,8 +2703,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2577,8 +2740,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2609,8 +2773,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2643,8 +2808,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2679,8 +2845,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ProviderMeta: &tfprotov6.Schema{
 					Block: &tfprotov6.SchemaBlock{
 						Attributes: []*tfprotov6.SchemaAttribute{
@@ -2715,8 +2882,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource_1": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2756,8 +2924,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2787,8 +2956,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2818,8 +2988,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2849,8 +3020,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2880,8 +3052,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2911,8 +3084,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2929,6 +3103,39 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-attribute-write-only": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test_attribute": resourceschema.StringAttribute{
+								Optional:  true,
+								WriteOnly: true,
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:      "test_attribute",
+									Optional:  true,
+									Type:      tftypes.String,
+									WriteOnly: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-attribute-type-bool": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
@@ -2942,8 +3149,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -2972,8 +3180,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3002,8 +3211,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3035,8 +3245,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3076,8 +3287,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3120,8 +3332,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3157,8 +3370,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3196,8 +3410,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3236,8 +3451,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3268,8 +3484,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3301,8 +3518,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3342,8 +3560,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3386,8 +3605,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3425,8 +3645,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3460,8 +3681,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3497,8 +3719,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3536,8 +3759,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3553,6 +3777,46 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 		},
+		"resource-attribute-type-tuple": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": resourceschema.Schema{
+						Attributes: map[string]resourceschema.Attribute{
+							"test_attribute": resourceschema.TupleAttribute{
+								Required: true,
+								ElementTypes: []attr.Type{
+									types.StringType,
+									types.BoolType,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &tfprotov6.GetProviderSchemaResponse{
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
+				ResourceSchemas: map[string]*tfprotov6.Schema{
+					"test_resource": {
+						Block: &tfprotov6.SchemaBlock{
+							Attributes: []*tfprotov6.SchemaAttribute{
+								{
+									Name:     "test_attribute",
+									Required: true,
+									Type: tftypes.Tuple{
+										ElementTypes: []tftypes.Type{
+											tftypes.String,
+											tftypes.Bool,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"resource-attribute-type-dynamic": {
 			input: &fwserver.GetProviderSchemaResponse{
 				ResourceSchemas: map[string]fwschema.Schema{
@@ -3566,8 +3830,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3602,8 +3867,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3646,8 +3912,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3688,8 +3955,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block: &tfprotov6.SchemaBlock{
@@ -3722,8 +3990,9 @@ func TestGetProviderSchemaResponse(t *testing.T) {
 				},
 			},
 			expected: &tfprotov6.GetProviderSchemaResponse{
-				DataSourceSchemas: map[string]*tfprotov6.Schema{},
-				Functions:         map[string]*tfprotov6.Function{},
+				DataSourceSchemas:        map[string]*tfprotov6.Schema{},
+				EphemeralResourceSchemas: map[string]*tfprotov6.Schema{},
+				Functions:                map[string]*tfprotov6.Function{},
 				ResourceSchemas: map[string]*tfprotov6.Schema{
 					"test_resource": {
 						Block:   &tfprotov6.SchemaBlock{},
@@ -20,6 +20,7 @@ func ReadResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse
 
 	proto6 := &tfprotov6.ReadResourceResponse{
 		Diagnostics: Diagnostics(ctx, fw.Diagnostics),
+		Deferred:    ResourceDeferred(fw.Deferred),
 	}
 
 	newState, diags := State(ctx, fw.NewState)
@@ -27,6 +28,11 @@ func ReadResourceResponse(ctx context.Context, fw *fwserver.ReadResourceResponse
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
 	proto6.NewState = newState
 
+	newIdentity, diags := ResourceIdentity(ctx, fw.NewIdentity)
+
+	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
+	proto6.NewIdentity = newIdentity
+
 	newPrivate, diags := fw.Private.Bytes(ctx)
 
 	proto6.Diagnostics = append(proto6.Diagnostics, Diagnostics(ctx, diags)...)
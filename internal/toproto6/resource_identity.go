@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ResourceIdentity returns the *tfprotov6.ResourceIdentityData for a
+// *tfsdk.ResourceIdentity. Missing or null identity data returns nil.
+func ResourceIdentity(ctx context.Context, fw *tfsdk.ResourceIdentity) (*tfprotov6.ResourceIdentityData, diag.Diagnostics) {
+	if fw == nil || fw.Raw.IsNull() {
+		return nil, nil
+	}
+
+	data := &fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionResourceIdentity,
+		Schema:         fw.Schema,
+		TerraformValue: fw.Raw,
+	}
+
+	identityData, diags := DynamicValue(ctx, data)
+
+	if identityData == nil {
+		return nil, diags
+	}
+
+	return &tfprotov6.ResourceIdentityData{
+		IdentityData: identityData,
+	}, diags
+}
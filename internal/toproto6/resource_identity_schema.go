@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package toproto6
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// ResourceIdentitySchema returns the *tfprotov6.ResourceIdentitySchema
+// equivalent of a resource identity Schema.
+func ResourceIdentitySchema(ctx context.Context, s fwschema.Schema) (*tfprotov6.ResourceIdentitySchema, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	result := &tfprotov6.ResourceIdentitySchema{
+		Version: s.GetVersion(),
+	}
+
+	for name, attr := range s.GetAttributes() {
+		result.IdentityAttributes = append(result.IdentityAttributes, &tfprotov6.ResourceIdentitySchemaAttribute{
+			Name:              name,
+			Type:              attr.GetType().TerraformType(ctx),
+			RequiredForImport: attr.IsRequired(),
+			OptionalForImport: attr.IsOptional(),
+			Description:       attr.GetDescription(),
+		})
+	}
+
+	sort.Slice(result.IdentityAttributes, func(i, j int) bool {
+		return result.IdentityAttributes[i].Name < result.IdentityAttributes[j].Name
+	})
+
+	return result, nil
+}
@@ -192,7 +192,27 @@ func TestSchemaAttribute(t *testing.T) {
 				Optional: true,
 			},
 		},
-		// TODO: add tuple attribute when we support it
+		"attr-tuple": {
+			name: "tuple",
+			attr: testschema.Attribute{
+				Type: types.TupleType{ElemTypes: []attr.Type{
+					types.StringType,
+					types.NumberType,
+					types.BoolType,
+				}},
+				Optional: true,
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov6.SchemaAttribute{
+				Name: "tuple",
+				Type: tftypes.Tuple{ElementTypes: []tftypes.Type{
+					tftypes.String,
+					tftypes.Number,
+					tftypes.Bool,
+				}},
+				Optional: true,
+			},
+		},
 		"required": {
 			name: "string",
 			attr: testschema.Attribute{
@@ -28,6 +28,8 @@ import (
 //   - AtAnySetValue(): Step into a set at any attr.Value element
 //   - AtListIndex(): Step into a list at a specific index
 //   - AtMapKey(): Step into a map at a specific key
+//   - AtMatching(): Step into a list, map, or set at any element where a
+//     value relative to the element equals a given value
 //   - AtName(): Step into an attribute or block with a specific name
 //   - AtParent(): Step backwards one step
 //   - AtSetValue(): Step into a set at a specific attr.Value element
@@ -107,6 +109,33 @@ func (e Expression) AtMapKey(key string) Expression {
 	return copiedPath
 }
 
+// AtMatching returns a copied expression with a new element step at the end,
+// which matches any list, map, or set element where the value at the path
+// relative to the element equals the given value. Use an empty path, such as
+// path.Empty(), to compare the element value itself. The returned path is
+// safe to modify without affecting the original.
+//
+// For example, to express the "port" attribute of any element of a root list
+// nested attribute named "rule" where the "protocol" attribute is "tcp":
+//
+//	path.MatchRoot("rule").AtMatching(path.Root("protocol"), types.StringValue("tcp")).AtName("port")
+//
+// The condition is evaluated against the underlying data when finding paths,
+// such as with the tfsdk.Config, tfsdk.Plan, and tfsdk.State PathMatches
+// methods. The Matches and MatchesParent methods of the expression always
+// return false for paths reaching this step, since the condition cannot be
+// evaluated without the data.
+func (e Expression) AtMatching(relativePath Path, value attr.Value) Expression {
+	copiedPath := e.Copy()
+
+	copiedPath.steps.Append(ExpressionStepElementMatching{
+		RelativePath: relativePath,
+		Value:        value,
+	})
+
+	return copiedPath
+}
+
 // AtName returns a copied expression with a new attribute or block name step
 // at the end. The returned path is safe to modify without affecting the
 // original.
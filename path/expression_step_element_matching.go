@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Ensure ExpressionStepElementMatching satisfies the ExpressionStep
+// interface.
+var _ ExpressionStep = ExpressionStepElementMatching{}
+
+// ExpressionStepElementMatching is an attribute path expression for matching
+// any list, map, or set element where the value at a path relative to the
+// element equals a given value.
+//
+// Matching against the element value requires the underlying data, so the
+// Matches method always returns false. Expressions containing this step must
+// be matched with schema based data structures, such as with the
+// tfsdk.Config, tfsdk.Plan, and tfsdk.State PathMatches methods, which
+// evaluate the condition against each element.
+type ExpressionStepElementMatching struct {
+	// RelativePath is the path, relative to the element, of the value to
+	// compare. An empty path compares the element value itself.
+	RelativePath Path
+
+	// Value is the value which the value at RelativePath must equal.
+	Value attr.Value
+}
+
+// Equal returns true if the given ExpressionStep is a
+// ExpressionStepElementMatching and the RelativePath and Value are
+// equivalent.
+func (s ExpressionStepElementMatching) Equal(o ExpressionStep) bool {
+	other, ok := o.(ExpressionStepElementMatching)
+
+	if !ok {
+		return false
+	}
+
+	if !s.RelativePath.Equal(other.RelativePath) {
+		return false
+	}
+
+	if s.Value == nil || other.Value == nil {
+		return s.Value == nil && other.Value == nil
+	}
+
+	return s.Value.Equal(other.Value)
+}
+
+// Matches always returns false, since the RelativePath and Value condition
+// cannot be evaluated without the underlying data. Use the PathMatches method
+// of the tfsdk.Config, tfsdk.Plan, or tfsdk.State types instead.
+func (s ExpressionStepElementMatching) Matches(_ PathStep) bool {
+	return false
+}
+
+// String returns the human-readable representation of the element
+// expression. It is intended for logging and error messages and is not
+// protected by compatibility guarantees.
+func (s ExpressionStepElementMatching) String() string {
+	value := "<nil>"
+
+	if s.Value != nil {
+		value = s.Value.String()
+	}
+
+	return fmt.Sprintf("[Matching(%s == %s)]", s.RelativePath.String(), value)
+}
+
+// unexported satisfies the Step interface.
+func (s ExpressionStepElementMatching) unexported() {}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpressionStepElementMatchingEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepElementMatching
+		other    path.ExpressionStep
+		expected bool
+	}{
+		"ExpressionStepAttributeNameExact": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			other:    path.ExpressionStepAttributeNameExact("test"),
+			expected: false,
+		},
+		"ExpressionStepElementKeyIntAny": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementKeyIntAny{},
+			expected: false,
+		},
+		"ExpressionStepElementMatching-different-relative-path": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementMatching{RelativePath: path.Root("not-test"), Value: types.StringValue("test")},
+			expected: false,
+		},
+		"ExpressionStepElementMatching-different-value": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("not-test")},
+			expected: false,
+		},
+		"ExpressionStepElementMatching-different-value-nil": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementMatching{RelativePath: path.Root("test")},
+			expected: false,
+		},
+		"ExpressionStepElementMatching-equal": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			other:    path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			expected: true,
+		},
+		"ExpressionStepElementMatching-equal-nil": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Empty()},
+			other:    path.ExpressionStepElementMatching{RelativePath: path.Empty()},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepElementMatchingMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepElementMatching
+		pathStep path.PathStep
+		expected bool
+	}{
+		"StepAttributeName": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			pathStep: path.PathStepAttributeName("test"),
+			expected: false,
+		},
+		"StepElementKeyInt": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyInt(0),
+			expected: false,
+		},
+		"StepElementKeyString": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyString("test"),
+			expected: false,
+		},
+		"StepElementKeyValue": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test")},
+			pathStep: path.PathStepElementKeyValue{Value: types.StringValue("test")},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Matches(testCase.pathStep)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepElementMatchingString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepElementMatching
+		expected string
+	}{
+		"relative-path": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test"), Value: types.StringValue("test-value")},
+			expected: `[Matching(test == "test-value")]`,
+		},
+		"empty-relative-path": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Empty(), Value: types.StringValue("test-value")},
+			expected: `[Matching( == "test-value")]`,
+		},
+		"nil-value": {
+			step:     path.ExpressionStepElementMatching{RelativePath: path.Root("test")},
+			expected: `[Matching(test == <nil>)]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
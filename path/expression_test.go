@@ -178,6 +178,44 @@ func TestExpressionAtMapKey(t *testing.T) {
 	}
 }
 
+func TestExpressionAtMatching(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression   path.Expression
+		relativePath path.Path
+		value        attr.Value
+		expected     path.Expression
+	}{
+		"shallow": {
+			expression:   path.MatchRoot("test"),
+			relativePath: path.Root("test2"),
+			value:        types.StringValue("test-value"),
+			expected:     path.MatchRoot("test").AtMatching(path.Root("test2"), types.StringValue("test-value")),
+		},
+		"deep": {
+			expression:   path.MatchRoot("test1").AtListIndex(0).AtName("test2"),
+			relativePath: path.Empty(),
+			value:        types.StringValue("test-value"),
+			expected:     path.MatchRoot("test1").AtListIndex(0).AtName("test2").AtMatching(path.Empty(), types.StringValue("test-value")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.expression.AtMatching(testCase.relativePath, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpressionAtName(t *testing.T) {
 	t.Parallel()
 
@@ -465,6 +503,16 @@ func TestExpressionMatches(t *testing.T) {
 			path:       path.Root("test").AtSetValue(types.StringValue("test-value")),
 			expected:   true,
 		},
+		"AttributeNameExact-ElementMatching-ElementKeyInt": {
+			expression: path.MatchRoot("test").AtMatching(path.Root("test2"), types.StringValue("test-value")),
+			path:       path.Root("test").AtListIndex(1),
+			expected:   false,
+		},
+		"AttributeNameExact-ElementMatching-AttributeName": {
+			expression: path.MatchRoot("test").AtMatching(path.Root("test2"), types.StringValue("test-value")),
+			path:       path.Root("test").AtName("test2"),
+			expected:   false,
+		},
 		"AttributeNameExact-ElementKeyValueExact-different": {
 			expression: path.MatchRoot("test").AtSetValue(types.StringValue("test-value")),
 			path:       path.Root("test").AtSetValue(types.StringValue("not-test-value")),
@@ -995,6 +1043,10 @@ func TestExpressionString(t *testing.T) {
 			expression: path.MatchRoot("test").AtSetValue(types.StringValue("test-value")),
 			expected:   `test[Value("test-value")]`,
 		},
+		"AttributeNameExact-ElementMatching": {
+			expression: path.MatchRoot("test").AtMatching(path.Root("test2"), types.StringValue("test-value")),
+			expected:   `test[Matching(test2 == "test-value")]`,
+		},
 		"AttributeNameExact-ElementKeyValue-AttributeNameExact": {
 			expression: path.MatchRoot("test").AtSetValue(types.ObjectValueMust(
 				map[string]attr.Type{
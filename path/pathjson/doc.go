@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package pathjson contains functions for converting path.Path to and from a
+// stable JSON representation, such as for tooling which stores the paths of
+// changed attributes in audit logs. Unlike the path.Path type String method,
+// the JSON representation is protected by compatibility guarantees and
+// round-trips exactly, apart from the framework types of set element values
+// as described by the Unmarshal function. This package is separate from the core path package to
+// prevent import cycles.
+//
+// A path is represented as a JSON array of steps, where each step is a JSON
+// object with exactly one of these properties:
+//
+//   - "attribute_name": a JSON string, for object attribute names.
+//   - "element_key_int": a JSON number, for list and tuple element indices.
+//   - "element_key_string": a JSON string, for map element keys.
+//   - "element_key_value": a JSON object, for set element values.
+//
+// Set element values are represented as a JSON object with these properties:
+//
+//   - "type": the Terraform type signature of the value as JSON, such as
+//     "string" or ["object",{"name":"string"}].
+//   - "msgpack": the base64 encoded Terraform MessagePack representation of
+//     the value, which preserves null and unknown values exactly.
+//   - "value": the value as plain JSON data, as returned by the
+//     attrjson.Value function. This property is informational, for human
+//     readers of the JSON, and is ignored by Unmarshal.
+//
+// For example, the path to the name attribute of a set element within the
+// second element of a list attribute is represented as:
+//
+//	[
+//	  {"attribute_name": "list_attribute"},
+//	  {"element_key_int": 1},
+//	  {"attribute_name": "set_attribute"},
+//	  {"element_key_value": {"type": "string", "msgpack": "pWhlbGxv", "value": "hello"}}
+//	]
+package pathjson
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrjson"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// step is the JSON representation of a path.PathStep.
+type step struct {
+	AttributeName    *string       `json:"attribute_name,omitempty"`
+	ElementKeyInt    *int64        `json:"element_key_int,omitempty"`
+	ElementKeyString *string       `json:"element_key_string,omitempty"`
+	ElementKeyValue  *elementValue `json:"element_key_value,omitempty"`
+}
+
+// elementValue is the JSON representation of a path.PathStepElementKeyValue
+// value.
+type elementValue struct {
+	Type    json.RawMessage `json:"type"`
+	MsgPack []byte          `json:"msgpack"`
+	Value   any             `json:"value"`
+}
+
+// Marshal returns the JSON representation of the given path. Refer to the
+// package documentation for details about the representation.
+func Marshal(ctx context.Context, p path.Path) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	steps := make([]step, 0, len(p.Steps()))
+
+	for _, pathStep := range p.Steps() {
+		switch s := pathStep.(type) {
+		case path.PathStepAttributeName:
+			name := string(s)
+			steps = append(steps, step{AttributeName: &name})
+		case path.PathStepElementKeyInt:
+			index := int64(s)
+			steps = append(steps, step{ElementKeyInt: &index})
+		case path.PathStepElementKeyString:
+			key := string(s)
+			steps = append(steps, step{ElementKeyString: &key})
+		case path.PathStepElementKeyValue:
+			value, valueDiags := marshalElementValue(ctx, s.Value)
+
+			diags.Append(valueDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			steps = append(steps, step{ElementKeyValue: value})
+		default:
+			diags.AddError(
+				"Path JSON Marshal Error",
+				"An unexpected error was encountered trying to marshal a path to JSON. "+
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Path: %s\nUnsupported path step type: %T", p, pathStep),
+			)
+
+			return nil, diags
+		}
+	}
+
+	data, err := json.Marshal(steps)
+
+	if err != nil {
+		diags.AddError(
+			"Path JSON Marshal Error",
+			"An unexpected error was encountered trying to marshal a path to JSON. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Path: %s\nError: %s", p, err),
+		)
+
+		return nil, diags
+	}
+
+	return data, diags
+}
+
+// Unmarshal returns the path of the given JSON representation, as created by
+// the Marshal function. Refer to the package documentation for details about
+// the representation.
+//
+// Set element values are created with the framework type registered for the
+// Terraform type via attr.RegisterType, otherwise the base framework type,
+// such as basetypes.StringValue. Since the Terraform number type is used for
+// all numeric framework types, numeric values are created as
+// basetypes.NumberValue. Register any custom types used for set elements to
+// round-trip their values exactly.
+func Unmarshal(ctx context.Context, data []byte) (path.Path, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var steps []step
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&steps); err != nil {
+		diags.AddError(
+			"Invalid Path JSON",
+			"The path JSON could not be decoded. "+
+				"A path must be a JSON array of step objects.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return path.Empty(), diags
+	}
+
+	result := path.Empty()
+
+	for stepIndex, s := range steps {
+		if count := s.count(); count != 1 {
+			diags.AddError(
+				"Invalid Path JSON",
+				"The path JSON contains an invalid step. "+
+					"Each step must contain exactly one of the attribute_name, element_key_int, element_key_string, or element_key_value properties.\n\n"+
+					fmt.Sprintf("Step Index: %d\nProperties: %d", stepIndex, count),
+			)
+
+			return path.Empty(), diags
+		}
+
+		switch {
+		case s.AttributeName != nil:
+			result = result.AtName(*s.AttributeName)
+		case s.ElementKeyInt != nil:
+			result = result.AtListIndex(int(*s.ElementKeyInt))
+		case s.ElementKeyString != nil:
+			result = result.AtMapKey(*s.ElementKeyString)
+		case s.ElementKeyValue != nil:
+			value, valueDiags := unmarshalElementValue(ctx, stepIndex, *s.ElementKeyValue)
+
+			diags.Append(valueDiags...)
+
+			if diags.HasError() {
+				return path.Empty(), diags
+			}
+
+			result = result.AtSetValue(value)
+		}
+	}
+
+	return result, diags
+}
+
+// count returns the number of properties set in the step.
+func (s step) count() int {
+	var count int
+
+	if s.AttributeName != nil {
+		count++
+	}
+
+	if s.ElementKeyInt != nil {
+		count++
+	}
+
+	if s.ElementKeyString != nil {
+		count++
+	}
+
+	if s.ElementKeyValue != nil {
+		count++
+	}
+
+	return count
+}
+
+// marshalElementValue returns the JSON representation of a set element value.
+func marshalElementValue(ctx context.Context, value attr.Value) (*elementValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil {
+		diags.AddError(
+			"Path JSON Marshal Error",
+			"An unexpected error was encountered trying to marshal a path to JSON. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Missing set element value.",
+		)
+
+		return nil, diags
+	}
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Path JSON Marshal Error",
+			"An unexpected error was encountered trying to marshal a path to JSON. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Unable to convert set element value: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	typeJSON, err := tfValue.Type().MarshalJSON()
+
+	if err != nil {
+		diags.AddError(
+			"Path JSON Marshal Error",
+			"An unexpected error was encountered trying to marshal a path to JSON. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Unable to marshal set element type: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(tfValue.Type(), tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Path JSON Marshal Error",
+			"An unexpected error was encountered trying to marshal a path to JSON. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Unable to marshal set element value: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	plainValue, plainValueDiags := attrjson.Value(ctx, value)
+
+	diags.Append(plainValueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &elementValue{
+		Type:    typeJSON,
+		MsgPack: dynamicValue.MsgPack,
+		Value:   plainValue,
+	}, diags
+}
+
+// unmarshalElementValue returns the set element value of the given JSON
+// representation.
+func unmarshalElementValue(ctx context.Context, stepIndex int, in elementValue) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	addError := func(detail string) {
+		diags.AddError(
+			"Invalid Path JSON",
+			"The path JSON contains an invalid set element value.\n\n"+
+				fmt.Sprintf("Step Index: %d\n", stepIndex)+
+				detail,
+		)
+	}
+
+	if len(in.Type) == 0 {
+		addError("Missing type property.")
+
+		return nil, diags
+	}
+
+	tfType, err := parseType(in.Type)
+
+	if err != nil {
+		addError("Unable to parse type: " + err.Error())
+
+		return nil, diags
+	}
+
+	tfValue, err := tfprotov6.DynamicValue{MsgPack: in.MsgPack}.Unmarshal(tfType)
+
+	if err != nil {
+		addError("Unable to parse value: " + err.Error())
+
+		return nil, diags
+	}
+
+	value, err := basetypes.DynamicType{}.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		addError("Unable to convert value: " + err.Error())
+
+		return nil, diags
+	}
+
+	if dynamicValue, ok := value.(basetypes.DynamicValue); ok && dynamicValue.UnderlyingValue() != nil {
+		return dynamicValue.UnderlyingValue(), diags
+	}
+
+	return value, diags
+}
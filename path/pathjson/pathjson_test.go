@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathjson_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/path/pathjson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path          path.Path
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			path:     path.Empty(),
+			expected: `[]`,
+		},
+		"attribute-name": {
+			path:     path.Root("test"),
+			expected: `[{"attribute_name":"test"}]`,
+		},
+		"element-key-int": {
+			path:     path.Root("test").AtListIndex(1),
+			expected: `[{"attribute_name":"test"},{"element_key_int":1}]`,
+		},
+		"element-key-int-zero": {
+			path:     path.Root("test").AtListIndex(0),
+			expected: `[{"attribute_name":"test"},{"element_key_int":0}]`,
+		},
+		"element-key-string": {
+			path:     path.Root("test").AtMapKey(`key with "quotes"`),
+			expected: `[{"attribute_name":"test"},{"element_key_string":"key with \"quotes\""}]`,
+		},
+		"element-key-value": {
+			path:     path.Root("test").AtSetValue(types.StringValue("hello")),
+			expected: `[{"attribute_name":"test"},{"element_key_value":{"type":"string","msgpack":"pWhlbGxv","value":"hello"}}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := pathjson.Marshal(context.Background(), testCase.path)
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testObjectAttrTypes := map[string]attr.Type{
+		"bool":   types.BoolType,
+		"list":   types.ListType{ElemType: types.StringType},
+		"number": types.NumberType,
+		"string": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		path path.Path
+	}{
+		"empty": {
+			path: path.Empty(),
+		},
+		"nested": {
+			path: path.Root("list").AtListIndex(2).AtName("map").AtMapKey("key").AtName("attr"),
+		},
+		"set-value-string": {
+			path: path.Root("set").AtSetValue(types.StringValue("hello")),
+		},
+		"set-value-string-null": {
+			path: path.Root("set").AtSetValue(types.StringNull()),
+		},
+		"set-value-string-unknown": {
+			path: path.Root("set").AtSetValue(types.StringUnknown()),
+		},
+		"set-value-number": {
+			path: path.Root("set").AtSetValue(types.NumberValue(big.NewFloat(1.5))),
+		},
+		"set-value-object": {
+			path: path.Root("set").AtSetValue(
+				types.ObjectValueMust(
+					testObjectAttrTypes,
+					map[string]attr.Value{
+						"bool": types.BoolValue(true),
+						"list": types.ListValueMust(types.StringType, []attr.Value{
+							types.StringValue("one"),
+							types.StringUnknown(),
+						}),
+						"number": types.NumberNull(),
+						"string": types.StringValue("<unknown>"),
+					},
+				),
+			).AtName("string"),
+		},
+		"set-value-tuple": {
+			path: path.Root("set").AtSetValue(
+				types.TupleValueMust(
+					[]attr.Type{types.StringType, types.BoolType},
+					[]attr.Value{types.StringValue("one"), types.BoolValue(false)},
+				),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, diags := pathjson.Marshal(context.Background(), testCase.path)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected Marshal diagnostics: %v", diags)
+			}
+
+			got, diags := pathjson.Unmarshal(context.Background(), data)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected Unmarshal diagnostics: %v", diags)
+			}
+
+			if !got.Equal(testCase.path) {
+				t.Errorf("expected %s, got %s", testCase.path, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          string
+		expected      path.Path
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			data:     `[]`,
+			expected: path.Empty(),
+		},
+		"steps": {
+			data:     `[{"attribute_name":"test"},{"element_key_int":1},{"element_key_string":"key"}]`,
+			expected: path.Root("test").AtListIndex(1).AtMapKey("key"),
+		},
+		"element-key-value-ignores-value": {
+			data:     `[{"element_key_value":{"type":"string","msgpack":"pWhlbGxv","value":"ignored"}}]`,
+			expected: path.Empty().AtSetValue(types.StringValue("hello")),
+		},
+		"invalid-json": {
+			data:     `{}`,
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path JSON",
+					"The path JSON could not be decoded. "+
+						"A path must be a JSON array of step objects.\n\n"+
+						"Error: json: cannot unmarshal object into Go value of type []pathjson.step",
+				),
+			},
+		},
+		"invalid-step-property": {
+			data:     `[{"attribute":"test"}]`,
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path JSON",
+					"The path JSON could not be decoded. "+
+						"A path must be a JSON array of step objects.\n\n"+
+						`Error: json: unknown field "attribute"`,
+				),
+			},
+		},
+		"invalid-step-empty": {
+			data:     `[{"attribute_name":"test"},{}]`,
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path JSON",
+					"The path JSON contains an invalid step. "+
+						"Each step must contain exactly one of the attribute_name, element_key_int, element_key_string, or element_key_value properties.\n\n"+
+						"Step Index: 1\nProperties: 0",
+				),
+			},
+		},
+		"invalid-step-multiple": {
+			data:     `[{"attribute_name":"test","element_key_int":0}]`,
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path JSON",
+					"The path JSON contains an invalid step. "+
+						"Each step must contain exactly one of the attribute_name, element_key_int, element_key_string, or element_key_value properties.\n\n"+
+						"Step Index: 0\nProperties: 2",
+				),
+			},
+		},
+		"invalid-element-key-value-type-missing": {
+			data:     `[{"element_key_value":{"msgpack":"pWhlbGxv"}}]`,
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path JSON",
+					"The path JSON contains an invalid set element value.\n\n"+
+						"Step Index: 0\n"+
+						"Missing type property.",
+				),
+			},
+		},
+		"invalid-element-key-value-type": {
+			data:     `[{"element_key_value":{"type":["list"],"msgpack":"pWhlbGxv"}}]`,
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path JSON",
+					"The path JSON contains an invalid set element value.\n\n"+
+						"Step Index: 0\n"+
+						"Unable to parse type: complex type must be a JSON array with at least two elements",
+				),
+			},
+		},
+		"invalid-element-key-value-msgpack": {
+			data:     `[{"element_key_value":{"type":"bool","msgpack":"pWhlbGxv"}}]`,
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path JSON",
+					"The path JSON contains an invalid set element value.\n\n"+
+						"Step Index: 0\n"+
+						"Unable to parse value: couldn't decode bool: msgpack: invalid code=a5 decoding bool",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := pathjson.Unmarshal(context.Background(), []byte(testCase.data))
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pathjson
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// parseType returns the Terraform type of the given JSON type signature, as
+// created by the tftypes.Type MarshalJSON method, such as "string" or
+// ["list","string"].
+func parseType(data json.RawMessage) (tftypes.Type, error) {
+	var primitive string
+
+	if err := json.Unmarshal(data, &primitive); err == nil {
+		switch primitive {
+		case "bool":
+			return tftypes.Bool, nil
+		case "dynamic":
+			return tftypes.DynamicPseudoType, nil
+		case "number":
+			return tftypes.Number, nil
+		case "string":
+			return tftypes.String, nil
+		default:
+			return nil, fmt.Errorf("unsupported primitive type %q", primitive)
+		}
+	}
+
+	var complexType []json.RawMessage
+
+	if err := json.Unmarshal(data, &complexType); err != nil {
+		return nil, errors.New("type must be a JSON string or array")
+	}
+
+	if len(complexType) < 2 {
+		return nil, errors.New("complex type must be a JSON array with at least two elements")
+	}
+
+	var kind string
+
+	if err := json.Unmarshal(complexType[0], &kind); err != nil {
+		return nil, errors.New("complex type kind must be a JSON string")
+	}
+
+	switch kind {
+	case "list", "map", "set":
+		if len(complexType) != 2 {
+			return nil, fmt.Errorf("%s type must be a JSON array with two elements", kind)
+		}
+
+		elementType, err := parseType(complexType[1])
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch kind {
+		case "list":
+			return tftypes.List{ElementType: elementType}, nil
+		case "map":
+			return tftypes.Map{ElementType: elementType}, nil
+		default:
+			return tftypes.Set{ElementType: elementType}, nil
+		}
+	case "object":
+		if len(complexType) > 3 {
+			return nil, errors.New("object type must be a JSON array with two or three elements")
+		}
+
+		var attributes map[string]json.RawMessage
+
+		if err := json.Unmarshal(complexType[1], &attributes); err != nil {
+			return nil, errors.New("object attribute types must be a JSON object")
+		}
+
+		result := tftypes.Object{
+			AttributeTypes: make(map[string]tftypes.Type, len(attributes)),
+		}
+
+		for name, attributeData := range attributes {
+			attributeType, err := parseType(attributeData)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result.AttributeTypes[name] = attributeType
+		}
+
+		if len(complexType) == 3 {
+			var optionalAttributes []string
+
+			if err := json.Unmarshal(complexType[2], &optionalAttributes); err != nil {
+				return nil, errors.New("object optional attributes must be a JSON array of strings")
+			}
+
+			result.OptionalAttributes = make(map[string]struct{}, len(optionalAttributes))
+
+			for _, name := range optionalAttributes {
+				result.OptionalAttributes[name] = struct{}{}
+			}
+		}
+
+		return result, nil
+	case "tuple":
+		if len(complexType) != 2 {
+			return nil, errors.New("tuple type must be a JSON array with two elements")
+		}
+
+		var elements []json.RawMessage
+
+		if err := json.Unmarshal(complexType[1], &elements); err != nil {
+			return nil, errors.New("tuple element types must be a JSON array")
+		}
+
+		result := tftypes.Tuple{
+			ElementTypes: make([]tftypes.Type, 0, len(elements)),
+		}
+
+		for _, elementData := range elements {
+			elementType, err := parseType(elementData)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result.ElementTypes = append(result.ElementTypes, elementType)
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unsupported complex type kind %q", kind)
+	}
+}
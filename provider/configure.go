@@ -24,6 +24,34 @@ type ConfigureRequest struct {
 	// that's implementing the Provider interface, for use in later
 	// resource CRUD operations.
 	Config tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ConfigureProvider RPC, such as forward-compatible Terraform
+	// behavior changes.
+	ClientCapabilities ConfigureProviderClientCapabilities
+
+	// InstanceID is a framework generated identifier for this provider
+	// instance. It is unique to the provider server and is the same for
+	// every Configure call the provider server receives.
+	//
+	// The protocol does not include the provider configuration address,
+	// such as an alias, in any request. Terraform starts a separate provider
+	// instance for each provider configuration, so this value can be saved
+	// into the DataSourceData, EphemeralResourceData, FunctionData, and
+	// ResourceData response fields to distinguish provider configurations,
+	// such as in logging. It changes between Terraform commands, so it should
+	// not be saved into state.
+	InstanceID string
+}
+
+// ConfigureProviderClientCapabilities allows Terraform to publish information
+// regarding optionally supported protocol features for the
+// ConfigureProvider RPC, such as forward-compatible Terraform behavior
+// changes.
+type ConfigureProviderClientCapabilities struct {
+	// DeferralAllowed indicates whether the Terraform client initiating
+	// the request allows a deferral response.
+	DeferralAllowed bool
 }
 
 // ConfigureResponse represents a response to a
@@ -36,6 +64,20 @@ type ConfigureResponse struct {
 	// that implements the Configure method.
 	DataSourceData any
 
+	// EphemeralResourceData is provider-defined data, clients, etc. that is
+	// passed to [ephemeral.ConfigureRequest.ProviderData] for each
+	// EphemeralResource type that implements the Configure method.
+	EphemeralResourceData any
+
+	// FunctionData is provider-defined data, clients, etc. that is passed
+	// to [function.ConfigureRequest.ProviderData] for each Function type
+	// that implements the Configure method.
+	//
+	// Terraform calls functions during configuration validation and
+	// planning, which may happen before the provider is configured, so
+	// functions receive nil ProviderData in those calls.
+	FunctionData any
+
 	// Diagnostics report errors or warnings related to configuring the
 	// provider. An empty slice indicates success, with no warnings or
 	// errors generated.
@@ -21,4 +21,11 @@ type MetadataResponse struct {
 	// This is not connected to any framework functionality currently, but may
 	// be in the future.
 	Version string
+
+	// PreferredProtocolVersion is an optional major version of the Terraform
+	// Plugin Protocol the provider prefers to be served with, either 5 or 6.
+	// It is informational only, such as for tooling and testing, and does not
+	// affect protocol negotiation. Refer to the providerserver package
+	// PreferredProtocolVersion function for introspecting it.
+	PreferredProtocolVersion int
 }
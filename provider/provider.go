@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -17,8 +18,12 @@ import (
 //
 //   - Validation: Schema-based or entire configuration
 //     via ProviderWithConfigValidators or ProviderWithValidateConfig.
-//   - Functions: ProviderWithFunctions
+//   - Ephemeral Resources: ProviderWithEphemeralResources
+//   - Functions: ProviderWithFunctions, optionally with definition caching
+//     via ProviderWithFunctionsCacheKey
 //   - Meta Schema: ProviderWithMetaSchema
+//   - Resource Validation: Declarative validation of every resource
+//     configuration via ProviderWithResourceConfigValidators.
 type Provider interface {
 	// Metadata should return the metadata for the provider, such as
 	// a type name and version data.
@@ -38,6 +43,13 @@ type Provider interface {
 	// Values from provider configuration are often used to initialise an
 	// API client, which should be stored on the struct implementing the
 	// Provider interface.
+	//
+	// Long-running provider servers, such as those used in testing, may call
+	// Configure more than once with different configuration values. Each
+	// call fully replaces the ConfigureResponse data from any prior call,
+	// so data sources, ephemeral resources, resources, and functions always
+	// receive the data from the latest call. If a call returns error
+	// diagnostics, no provider data is retained from prior calls.
 	Configure(context.Context, ConfigureRequest, *ConfigureResponse)
 
 	// DataSources returns a slice of functions to instantiate each DataSource
@@ -63,6 +75,9 @@ type Provider interface {
 //
 // Validation will include ConfigValidators and ValidateConfig, if both are
 // implemented, in addition to any Attribute or Type validation.
+//
+// ConfigValidators are also called before Configure. Any error diagnostics
+// prevent Configure from being called.
 type ProviderWithConfigValidators interface {
 	Provider
 
@@ -70,6 +85,24 @@ type ProviderWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// ProviderWithEphemeralResources is an interface type that extends Provider to
+// include ephemeral resources for usage in practitioner configurations.
+//
+// Ephemeral resources are supported in Terraform version 1.10 and later and
+// are only available when the provider is served with Terraform Plugin
+// Protocol version 6, such as via the providerserver.NewProtocol6 function.
+type ProviderWithEphemeralResources interface {
+	Provider
+
+	// EphemeralResources returns a slice of functions to instantiate each
+	// EphemeralResource implementation.
+	//
+	// The ephemeral resource type name is determined by the
+	// EphemeralResource implementing the Metadata method. All ephemeral
+	// resources must have unique names.
+	EphemeralResources(context.Context) []func() ephemeral.EphemeralResource
+}
+
 // ProviderWithFunctions is an interface type that extends Provider to
 // include provider defined functions for usage in practitioner configurations.
 //
@@ -88,6 +121,29 @@ type ProviderWithFunctions interface {
 	Functions(context.Context) []func() function.Function
 }
 
+// ProviderWithFunctionsCacheKey is an interface type that extends
+// ProviderWithFunctions to enable caching of all function definitions for
+// the lifetime of the provider server.
+//
+// By default, the framework calls the Functions method once per provider
+// server, however every function Definition method is called for each
+// GetFunctions and GetProviderSchema RPC. Implementing this interface also
+// caches the validated definitions of all functions, which benefits providers
+// with many functions or expensive definitions, such as those built via
+// reflection.
+//
+// Cached function data is invalidated only when the returned key changes
+// from the key returned when the cache was populated. Providers with a static
+// set of functions can return a constant key.
+type ProviderWithFunctionsCacheKey interface {
+	ProviderWithFunctions
+
+	// FunctionsCacheKey returns a key identifying the current functions and
+	// their definitions. This method is called for each function related
+	// RPC, so it should be inexpensive.
+	FunctionsCacheKey(context.Context) string
+}
+
 // ProviderWithMetaSchema is a provider with a provider meta schema, which
 // is configured by practitioners via the provider_meta configuration block
 // and the configuration data is included with certain data source and resource
@@ -109,6 +165,73 @@ type ProviderWithMetaSchema interface {
 	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
 }
 
+// ProviderWithRequestInterceptor is an interface type that extends Provider to
+// observe every RPC handled by the framework, such as for centralized logging,
+// tracing, or metrics.
+type ProviderWithRequestInterceptor interface {
+	Provider
+
+	// InterceptRequest is called before the framework handles each RPC, where
+	// rpc is the protocol operation name, such as "ReadResource". The returned
+	// context, which must be derived from the given context, is used for the
+	// remainder of the RPC and can be enriched with logging fields or spans.
+	//
+	// The returned function, if not nil, is called after the RPC has been
+	// handled with an error containing any error diagnostics of the response,
+	// or nil if there were none. Warning diagnostics are not included. For
+	// CallFunction, the error is the function error, if any.
+	//
+	// Interceptors can only observe requests. They cannot modify request or
+	// response data, and a nil context return is ignored.
+	InterceptRequest(ctx context.Context, rpc string) (context.Context, func(err error))
+}
+
+// ProviderWithModifyProtocolResponse is an interface type that extends
+// Provider to modify the protocol response of every RPC handled by the
+// framework, after the framework has translated it to the
+// terraform-plugin-go type and before it is returned to Terraform.
+//
+// This is intended for advanced use cases, such as experimenting with
+// protocol fields which the framework does not yet support. Most providers
+// should not implement this interface, as invalid responses can cause
+// Terraform errors or unexpected behaviors.
+//
+// This functionality is currently experimental and subject to change or
+// break without warning. It is not protected by version compatibility
+// guarantees.
+type ProviderWithModifyProtocolResponse interface {
+	Provider
+
+	// ModifyProtocolResponse is called with the rpc protocol operation name,
+	// such as "ReadResource", and the response, which is the
+	// terraform-plugin-go response pointer type of the protocol version the
+	// provider is served with, such as *tfprotov6.ReadResourceResponse. The
+	// returned value is returned to Terraform instead of the response. It
+	// must be the same type as the response, otherwise the response is
+	// returned unmodified. A nil return is also ignored. StopProvider
+	// responses and protocol version 5 ephemeral resource responses are not
+	// passed to this method.
+	ModifyProtocolResponse(ctx context.Context, rpc string, resp any) any
+}
+
+// ProviderWithResourceConfigValidators is an interface type that extends
+// Provider to include declarative validations which apply to the
+// configuration of every resource, such as shared naming conventions or tag
+// requirements.
+//
+// During the ValidateResourceConfig RPC, these validators are called after
+// the resource Configure method and before any resource ConfigValidators,
+// ValidateConfig, and Attribute or Type validation. Every validator is
+// called, regardless of earlier error diagnostics, and all warning and error
+// diagnostics are returned along with the resource validation diagnostics.
+type ProviderWithResourceConfigValidators interface {
+	Provider
+
+	// ResourceConfigValidators returns a list of validators which will all be
+	// performed during validation of every resource configuration.
+	ResourceConfigValidators(context.Context) []ResourceConfigValidator
+}
+
 // ProviderWithValidateConfig is an interface type that extends Provider to include imperative validation.
 //
 // Declaring validation using this methodology simplifies one-off
@@ -117,6 +240,9 @@ type ProviderWithMetaSchema interface {
 //
 // Validation will include ConfigValidators and ValidateConfig, if both are
 // implemented, in addition to any Attribute or Type validation.
+//
+// ValidateConfig is also called before Configure. Any error diagnostics
+// prevent Configure from being called.
 type ProviderWithValidateConfig interface {
 	Provider
 
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "context"
+
+// ResourceConfigValidator describes reusable configuration validation
+// functionality which is applied by the provider to every resource.
+type ResourceConfigValidator interface {
+	// Description describes the validation in plain text formatting.
+	//
+	// This information may be automatically added to resource plain text
+	// descriptions by external tooling.
+	Description(context.Context) string
+
+	// MarkdownDescription describes the validation in Markdown formatting.
+	//
+	// This information may be automatically added to resource Markdown
+	// descriptions by external tooling.
+	MarkdownDescription(context.Context) string
+
+	// ValidateResourceConfig performs the validation.
+	//
+	// This method name is separate from the resource.ConfigValidator
+	// interface ValidateResource method name to allow generic validators.
+	ValidateResourceConfig(context.Context, ValidateResourceConfigRequest, *ValidateResourceConfigResponse)
+}
@@ -16,6 +16,7 @@ import (
 
 // Schema must satify the fwschema.Schema interface.
 var _ fwschema.Schema = Schema{}
+var _ fwschema.SchemaWithDeprecationWarningsOnUnknown = Schema{}
 
 // Schema defines the structure and value types of provider configuration data.
 // This type is used as the provider.SchemaResponse type Schema field, which is
@@ -57,6 +58,16 @@ type Schema struct {
 	//  - "Remove this provider as it no longer is valid."
 	//
 	DeprecationMessage string
+
+	// DeprecationWarningsOnUnknown enables attribute and block deprecation
+	// warnings for unknown configuration values, such as values which refer
+	// to resources which are not yet created. By default, deprecation
+	// warnings are only raised when the configuration value is known and not
+	// null, which prevents warnings when the deprecated attribute or block
+	// may not actually be set. Null configuration values never raise
+	// deprecation warnings as they cannot be distinguished from the attribute
+	// or block being omitted from the configuration.
+	DeprecationWarningsOnUnknown bool
 }
 
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
@@ -94,6 +105,12 @@ func (s Schema) GetDeprecationMessage() string {
 	return s.DeprecationMessage
 }
 
+// GetDeprecationWarningsOnUnknown returns the DeprecationWarningsOnUnknown
+// field value.
+func (s Schema) GetDeprecationWarningsOnUnknown() bool {
+	return s.DeprecationWarningsOnUnknown
+}
+
 // GetDescription returns the Description field value.
 func (s Schema) GetDescription() string {
 	return s.Description
@@ -139,6 +156,8 @@ func (s Schema) Validate() diag.Diagnostics {
 func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	diags.Append(fwschema.ConflictingAttributeBlockNames(s.GetAttributes(), s.GetBlocks(), path.Empty())...)
+
 	for attributeName, attribute := range s.GetAttributes() {
 		req := fwschema.ValidateImplementationRequest{
 			Name: attributeName,
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ValidateResourceConfigRequest represents a request to validate the
+// configuration of any resource with a provider-level validator. An instance
+// of this request struct is supplied as an argument to the
+// ResourceConfigValidator type ValidateResourceConfig method.
+type ValidateResourceConfigRequest struct {
+	// TypeName is the type name of the resource being validated, such as
+	// examplecloud_thing.
+	TypeName string
+
+	// Config is the configuration the user supplied for the resource.
+	//
+	// This configuration may contain unknown values if a user uses
+	// interpolation or other functionality that would prevent Terraform
+	// from knowing the value at request time.
+	Config tfsdk.Config
+}
+
+// ValidateResourceConfigResponse represents a response to a
+// ValidateResourceConfigRequest. An instance of this response struct is
+// supplied as an argument to the ResourceConfigValidator type
+// ValidateResourceConfig method.
+type ValidateResourceConfigResponse struct {
+	// Diagnostics report errors or warnings related to validating the
+	// resource configuration. An empty slice indicates success, with no
+	// warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// PreferredProtocolVersion returns the Terraform Plugin Protocol major
+// version the given Provider prefers, as set in the provider Metadata method
+// MetadataResponse type PreferredProtocolVersion field, so tooling and tests
+// can introspect it. A zero value signals no preference.
+//
+// The preference is informational only and does not affect which protocol
+// version the provider is served with, which is always determined by the
+// function used to serve the provider, such as NewProtocol5 or NewProtocol6.
+func PreferredProtocolVersion(ctx context.Context, p provider.Provider) (int, diag.Diagnostics) {
+	server := &fwserver.Server{
+		Provider: p,
+	}
+
+	return server.ProviderPreferredProtocolVersion(ctx)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+func TestPreferredProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	p := &testprovider.Provider{
+		MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+			resp.TypeName = "testprovidertype"
+			resp.PreferredProtocolVersion = 6
+		},
+	}
+
+	got, diags := PreferredProtocolVersion(context.Background(), p)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if got != 6 {
+		t.Errorf("expected 6, got: %d", got)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package providerserver
+
+// ProviderServerOpt is an option for the ProviderServer implementations
+// returned by NewProtocol5, NewProtocol5WithError, NewProtocol6, and
+// NewProtocol6WithError.
+type ProviderServerOpt func(*providerServerOpts)
+
+// providerServerOpts contains the options applied by ProviderServerOpt.
+type providerServerOpts struct {
+	collectAllDiagnostics bool
+}
+
+// WithCollectAllDiagnostics enables best-effort validation and planning,
+// which continues past recoverable errors, such as attribute plan modifier
+// errors or resource config validator errors with
+// ResourceWithConfigValidatorsStopOnError, to return as many diagnostics as
+// possible at once. This is equivalent to the ServeOpts type
+// CollectAllDiagnostics field.
+//
+// This is intended for tooling, such as acceptance testing in CI via
+// NewProtocol6WithError, and should not be enabled for normal provider usage.
+func WithCollectAllDiagnostics() ProviderServerOpt {
+	return func(opts *providerServerOpts) {
+		opts.collectAllDiagnostics = true
+	}
+}
+
+// newProviderServerOpts returns the providerServerOpts with all the given
+// options applied.
+func newProviderServerOpts(opts []ProviderServerOpt) providerServerOpts {
+	var result providerServerOpts
+
+	for _, opt := range opts {
+		opt(&result)
+	}
+
+	return result
+}
@@ -21,11 +21,14 @@ import (
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server.Serve()
 // function and various terraform-plugin-mux functions.
-func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
+func NewProtocol5(p provider.Provider, opts ...ProviderServerOpt) func() tfprotov5.ProviderServer {
+	serverOpts := newProviderServerOpts(opts)
+
 	return func() tfprotov5.ProviderServer {
 		return &proto5server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				CollectAllDiagnostics: serverOpts.collectAllDiagnostics,
+				Provider:              p,
 			},
 		}
 	}
@@ -36,11 +39,14 @@ func NewProtocol5(p provider.Provider) func() tfprotov5.ProviderServer {
 // github.com/hashicorp/terraform-plugin-testing/helper/resource.TestCase.ProtoV5ProviderFactories.
 //
 // The error return is not currently used, but it may be in the future.
-func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer, error) {
+func NewProtocol5WithError(p provider.Provider, opts ...ProviderServerOpt) func() (tfprotov5.ProviderServer, error) {
+	serverOpts := newProviderServerOpts(opts)
+
 	return func() (tfprotov5.ProviderServer, error) {
 		return &proto5server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				CollectAllDiagnostics: serverOpts.collectAllDiagnostics,
+				Provider:              p,
 			},
 		}, nil
 	}
@@ -50,11 +56,14 @@ func NewProtocol5WithError(p provider.Provider) func() (tfprotov5.ProviderServer
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server.Serve()
 // function and various terraform-plugin-mux functions.
-func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
+func NewProtocol6(p provider.Provider, opts ...ProviderServerOpt) func() tfprotov6.ProviderServer {
+	serverOpts := newProviderServerOpts(opts)
+
 	return func() tfprotov6.ProviderServer {
 		return &proto6server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				CollectAllDiagnostics: serverOpts.collectAllDiagnostics,
+				Provider:              p,
 			},
 		}
 	}
@@ -65,11 +74,14 @@ func NewProtocol6(p provider.Provider) func() tfprotov6.ProviderServer {
 // github.com/hashicorp/terraform-plugin-testing/helper/resource.TestCase.ProtoV6ProviderFactories.
 //
 // The error return is not currently used, but it may be in the future.
-func NewProtocol6WithError(p provider.Provider) func() (tfprotov6.ProviderServer, error) {
+func NewProtocol6WithError(p provider.Provider, opts ...ProviderServerOpt) func() (tfprotov6.ProviderServer, error) {
+	serverOpts := newProviderServerOpts(opts)
+
 	return func() (tfprotov6.ProviderServer, error) {
 		return &proto6server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				CollectAllDiagnostics: serverOpts.collectAllDiagnostics,
+				Provider:              p,
 			},
 		}, nil
 	}
@@ -98,7 +110,8 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 
 				return &proto5server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						CollectAllDiagnostics: opts.CollectAllDiagnostics,
+						Provider:              provider,
 					},
 				}
 			},
@@ -118,7 +131,8 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 
 				return &proto6server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						CollectAllDiagnostics: opts.CollectAllDiagnostics,
+						Provider:              provider,
 					},
 				}
 			},
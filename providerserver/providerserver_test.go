@@ -7,6 +7,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto6server"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -81,3 +83,47 @@ func TestNewProtocol6WithError(t *testing.T) {
 		t.Fatalf("unexpected error calling ProviderServer: %s", err)
 	}
 }
+
+func TestNewProtocol5WithError_WithCollectAllDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	provider := &testprovider.Provider{}
+
+	providerServer, err := NewProtocol5WithError(provider, WithCollectAllDiagnostics())()
+
+	if err != nil {
+		t.Fatalf("unexpected error creating ProviderServer: %s", err)
+	}
+
+	server, ok := providerServer.(*proto5server.Server)
+
+	if !ok {
+		t.Fatalf("unexpected ProviderServer type: %T", providerServer)
+	}
+
+	if !server.FrameworkServer.CollectAllDiagnostics {
+		t.Error("expected CollectAllDiagnostics to be enabled")
+	}
+}
+
+func TestNewProtocol6WithError_WithCollectAllDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	provider := &testprovider.Provider{}
+
+	providerServer, err := NewProtocol6WithError(provider, WithCollectAllDiagnostics())()
+
+	if err != nil {
+		t.Fatalf("unexpected error creating ProviderServer: %s", err)
+	}
+
+	server, ok := providerServer.(*proto6server.Server)
+
+	if !ok {
+		t.Fatalf("unexpected ProviderServer type: %T", providerServer)
+	}
+
+	if !server.FrameworkServer.CollectAllDiagnostics {
+		t.Error("expected CollectAllDiagnostics to be enabled")
+	}
+}
@@ -18,6 +18,19 @@ type ServeOpts struct {
 	// For example: registry.terraform.io/hashicorp/random.
 	Address string
 
+	// CollectAllDiagnostics enables best-effort validation and planning,
+	// which continues past recoverable errors, such as attribute plan
+	// modifier errors or resource config validator errors, to return as many
+	// diagnostics as possible at once. Errors which affect the whole request,
+	// such as failing to decode the configuration, always stop the request.
+	//
+	// This is intended for tooling, such as acceptance testing in CI, and
+	// should not be enabled for normal provider usage. Use the
+	// WithCollectAllDiagnostics option to enable it for servers created with
+	// NewProtocol5, NewProtocol5WithError, NewProtocol6, or
+	// NewProtocol6WithError.
+	CollectAllDiagnostics bool
+
 	// Debug runs the provider in a mode acceptable for debugging and testing
 	// processes, such as delve, by managing the process lifecycle. Information
 	// needed for Terraform CLI to connect to the provider is output to stdout.
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ConcurrentPlanModifiersRequest represents a request for the Resource to
+// declare which attribute plan modifiers are independent and can be run
+// concurrently. An instance of this request struct is supplied as an argument
+// to the ResourceWithConcurrentPlanModifiers type ConcurrentPlanModifiers
+// method.
+type ConcurrentPlanModifiersRequest struct{}
+
+// ConcurrentPlanModifiersResponse represents a response to a
+// ConcurrentPlanModifiersRequest. An instance of this response struct is
+// supplied as an argument to the ResourceWithConcurrentPlanModifiers type
+// ConcurrentPlanModifiers method.
+type ConcurrentPlanModifiersResponse struct {
+	// Paths are the top level attributes and blocks whose plan modifiers,
+	// including any nested attribute and block plan modifiers, are
+	// independent of all other attribute plan modifiers. Each path must be
+	// a top level attribute or block name, such as path.Root("example").
+	//
+	// Plan modifiers of these attributes are run concurrently after all
+	// other attribute plan modifiers. Any private state data changes are
+	// made against a copy of the private state data and merged afterwards.
+	Paths path.Paths
+
+	// MaxConcurrency is the maximum number of attributes whose plan
+	// modifiers are run at the same time. If zero or less, the value of
+	// runtime.GOMAXPROCS is used.
+	MaxConcurrency int
+
+	// Diagnostics report errors or warnings related to declaring the
+	// concurrent plan modifiers. An empty slice indicates success, with no
+	// warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ConditionalAttribute declares an attribute which is either required or
+// computed, depending on the configuration of other attributes. Terraform
+// schemas cannot express this statically, so the attribute must be both
+// Optional and Computed in the schema and the framework enforces the
+// requirement during the ValidateResourceConfig and PlanResourceChange RPCs.
+//
+// Terraform raises "Provider produced inconsistent result" errors for values
+// which differ from the configuration, so the Computed requirement is what
+// allows the resource logic to set the value when it is not configured.
+//
+// When neither condition is met, the attribute remains Optional and Computed.
+// Conditions with unknown values are skipped until the values are known.
+type ConditionalAttribute struct {
+	// Attribute is the expression of the conditional attribute. Each
+	// matching attribute must be Optional and Computed in the schema.
+	Attribute path.Expression
+
+	// RequiredIf are expressions of other attributes which make the
+	// attribute required when any matching attribute is configured with a
+	// non-null value. An error diagnostic is raised if the attribute is not
+	// configured.
+	RequiredIf path.Expressions
+
+	// ComputedIf are expressions of other attributes which make the
+	// attribute only computed when any matching attribute is configured with
+	// a non-null value. An error diagnostic is raised if the attribute is
+	// configured, otherwise the resource logic is expected to set the value.
+	ComputedIf path.Expressions
+}
@@ -4,6 +4,8 @@
 package resource
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -25,6 +27,12 @@ type CreateRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
+
+	// Timeout is the resolved timeout of the Create operation, if the resource
+	// implements ResourceWithTimeouts. The context passed to the Create
+	// method has the matching deadline. A zero value means there is no
+	// timeout.
+	Timeout time.Duration
 }
 
 // CreateResponse represents a response to a CreateRequest. An
@@ -35,6 +43,13 @@ type CreateResponse struct {
 	// State is the state of the resource following the Create operation.
 	// This field is pre-populated from CreateRequest.Plan and
 	// should be set during the resource's Create operation.
+	//
+	// If the resource was partially created before an error occurred, set
+	// this field to the partial state alongside the error diagnostics. The
+	// framework always returns this state to Terraform, even when there
+	// are error diagnostics, so Terraform can track the partially created
+	// resource for a subsequent apply or destroy. Leave this field as the
+	// null value if no remote object was created.
 	State tfsdk.State
 
 	// Private is the private state resource data following the Create operation.
@@ -46,4 +61,10 @@ type CreateResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Identity is the resource identity data of the resource following the
+	// Create operation, if the resource implements ResourceWithIdentity.
+	// This field is pre-populated from the planned identity data and
+	// should be set during the resource's Create operation.
+	Identity *tfsdk.ResourceIdentity
 }
@@ -4,6 +4,8 @@
 package resource
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -20,11 +22,23 @@ type DeleteRequest struct {
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
 
+	// Timeout is the resolved timeout of the Delete operation, if the resource
+	// implements ResourceWithTimeouts. The context passed to the Delete
+	// method has the matching deadline. A zero value means there is no
+	// timeout.
+	Timeout time.Duration
+
 	// Private is provider-defined resource private state data which was previously
 	// stored with the resource state.
 	//
 	// Use the GetKey method to read data.
 	Private *privatestate.ProviderData
+
+	// Identity is the current resource identity data of the resource, if the
+	// resource implements ResourceWithIdentity. This field is nil if the
+	// resource does not implement ResourceWithIdentity and contains a null
+	// value if Terraform did not send identity data.
+	Identity *tfsdk.ResourceIdentity
 }
 
 // DeleteResponse represents a response to a DeleteRequest. An
@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+)
+
+// IdentitySchemaRequest represents a request for the Resource to return its
+// resource identity schema. An instance of this request struct is supplied as
+// an argument to the ResourceWithIdentity type IdentitySchema method.
+type IdentitySchemaRequest struct{}
+
+// IdentitySchemaResponse represents a response to an IdentitySchemaRequest.
+// An instance of this response struct is supplied as an argument to the
+// ResourceWithIdentity type IdentitySchema method.
+type IdentitySchemaResponse struct {
+	// IdentitySchema is the resource identity schema of the resource.
+	IdentitySchema identityschema.Schema
+
+	// Diagnostics report errors or warnings related to retrieving the
+	// resource identity schema. An empty slice indicates success, with no
+	// warnings or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identityschema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Attribute define a value field inside the Schema. Implementations in this
+// package include:
+//   - BoolAttribute
+//   - Float64Attribute
+//   - Int64Attribute
+//   - ListAttribute
+//   - NumberAttribute
+//   - StringAttribute
+//
+// Resource identity data only supports primitive values and lists of
+// primitive values, so there are no nested attribute implementations.
+type Attribute interface {
+	fwschema.Attribute
+}
+
+// isPrimitiveType returns true if the given type is a framework primitive
+// type or a custom type based on one.
+func isPrimitiveType(t attr.Type) bool {
+	switch t.(type) {
+	case basetypes.BoolTypable,
+		basetypes.Float64Typable,
+		basetypes.Int64Typable,
+		basetypes.NumberTypable,
+		basetypes.StringTypable:
+		return true
+	default:
+		return false
+	}
+}
+
+func invalidImportConfigurabilityDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the resource identity schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has both RequiredForImport and OptionalForImport set to true. ", attributePath)+
+			"At most one of these fields can be enabled.",
+	)
+}
+
+func invalidListElementTypeDiag(attributePath path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Invalid Attribute Implementation",
+		"When validating the resource identity schema, an implementation issue was found. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			fmt.Sprintf("%q has an ElementType which is not a primitive type. ", attributePath)+
+			"Resource identity list attributes only support bool, float64, int64, number, and string element types.",
+	)
+}
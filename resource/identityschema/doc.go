@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package identityschema contains all available resource identity schema
+// functionality for resources. Resource identity schemas define the structure
+// and value types for resource identity data, which uniquely identifies the
+// remote object of a managed resource. Identity schemas are implemented via
+// the resource.ResourceWithIdentity type IdentitySchema method.
+package identityschema
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identityschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ Attribute                                    = Float64Attribute{}
+	_ fwschema.AttributeWithValidateImplementation = Float64Attribute{}
+)
+
+// Float64Attribute represents a resource identity schema attribute that is
+// a 64-bit floating point number. When retrieving the value for this attribute, use types.Float64 as the
+// value type unless the CustomType field is set.
+//
+// Terraform configurations set this attribute in import block identity
+// expressions that return a number or directly via a floating point value.
+//
+//	example_attribute = 123.45
+type Float64Attribute struct {
+	// CustomType enables the use of a custom attribute type in place of the
+	// default types.Float64Type. When retrieving data, the basetypes.Float64Valuable
+	// associated with this custom type must be used in place of types.Float64.
+	CustomType basetypes.Float64Typable
+
+	// RequiredForImport indicates whether the practitioner must enter a value
+	// for this attribute when importing the resource by identity.
+	// RequiredForImport and OptionalForImport cannot both be true.
+	RequiredForImport bool
+
+	// OptionalForImport indicates whether the practitioner can choose to
+	// enter a value for this attribute when importing the resource by
+	// identity. OptionalForImport and RequiredForImport cannot both be true.
+	OptionalForImport bool
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this attribute is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this attribute is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription string
+}
+
+// ApplyTerraform5AttributePathStep always returns an error as it is not
+// possible to step further into a Float64Attribute.
+func (a Float64Attribute) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// Equal returns true if the given Attribute is a Float64Attribute
+// and all fields are equal.
+func (a Float64Attribute) Equal(o fwschema.Attribute) bool {
+	if _, ok := o.(Float64Attribute); !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage always returns an empty string as there is no
+// deprecation validation support for resource identity schemas.
+func (a Float64Attribute) GetDeprecationMessage() string {
+	return ""
+}
+
+// GetDescription returns the Description field value.
+func (a Float64Attribute) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription returns the MarkdownDescription field value.
+func (a Float64Attribute) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType returns types.Float64Type or the CustomType field value if defined.
+func (a Float64Attribute) GetType() attr.Type {
+	if a.CustomType != nil {
+		return a.CustomType
+	}
+
+	return types.Float64Type
+}
+
+// IsComputed always returns false as resource identity data is always set by
+// the provider rather than being planned.
+func (a Float64Attribute) IsComputed() bool {
+	return false
+}
+
+// IsOptional returns the OptionalForImport field value.
+func (a Float64Attribute) IsOptional() bool {
+	return a.OptionalForImport
+}
+
+// IsRequired returns the RequiredForImport field value.
+func (a Float64Attribute) IsRequired() bool {
+	return a.RequiredForImport
+}
+
+// IsSensitive always returns false as resource identity data cannot be
+// sensitive.
+func (a Float64Attribute) IsSensitive() bool {
+	return false
+}
+
+// ValidateImplementation contains logic for validating the
+// provider-defined implementation of the attribute to prevent unexpected
+// errors or panics. This logic runs during the GetResourceIdentitySchemas RPC
+// and should never include false positives.
+func (a Float64Attribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.RequiredForImport && a.OptionalForImport {
+		resp.Diagnostics.Append(invalidImportConfigurabilityDiag(req.Path))
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identityschema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure the implementation satisifies the desired interfaces.
+var (
+	_ Attribute                                    = NumberAttribute{}
+	_ fwschema.AttributeWithValidateImplementation = NumberAttribute{}
+)
+
+// NumberAttribute represents a resource identity schema attribute that is
+// a generic number with up to 512 bits of floating point or integer precision. When retrieving the value for this attribute, use types.Number as the
+// value type unless the CustomType field is set.
+//
+// Terraform configurations set this attribute in import block identity
+// expressions that return a number or directly via a floating point or integer value.
+//
+//	example_attribute = 123
+type NumberAttribute struct {
+	// CustomType enables the use of a custom attribute type in place of the
+	// default types.NumberType. When retrieving data, the basetypes.NumberValuable
+	// associated with this custom type must be used in place of types.Number.
+	CustomType basetypes.NumberTypable
+
+	// RequiredForImport indicates whether the practitioner must enter a value
+	// for this attribute when importing the resource by identity.
+	// RequiredForImport and OptionalForImport cannot both be true.
+	RequiredForImport bool
+
+	// OptionalForImport indicates whether the practitioner can choose to
+	// enter a value for this attribute when importing the resource by
+	// identity. OptionalForImport and RequiredForImport cannot both be true.
+	OptionalForImport bool
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this attribute is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this attribute is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription string
+}
+
+// ApplyTerraform5AttributePathStep always returns an error as it is not
+// possible to step further into a NumberAttribute.
+func (a NumberAttribute) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return a.GetType().ApplyTerraform5AttributePathStep(step)
+}
+
+// Equal returns true if the given Attribute is a NumberAttribute
+// and all fields are equal.
+func (a NumberAttribute) Equal(o fwschema.Attribute) bool {
+	if _, ok := o.(NumberAttribute); !ok {
+		return false
+	}
+
+	return fwschema.AttributesEqual(a, o)
+}
+
+// GetDeprecationMessage always returns an empty string as there is no
+// deprecation validation support for resource identity schemas.
+func (a NumberAttribute) GetDeprecationMessage() string {
+	return ""
+}
+
+// GetDescription returns the Description field value.
+func (a NumberAttribute) GetDescription() string {
+	return a.Description
+}
+
+// GetMarkdownDescription returns the MarkdownDescription field value.
+func (a NumberAttribute) GetMarkdownDescription() string {
+	return a.MarkdownDescription
+}
+
+// GetType returns types.NumberType or the CustomType field value if defined.
+func (a NumberAttribute) GetType() attr.Type {
+	if a.CustomType != nil {
+		return a.CustomType
+	}
+
+	return types.NumberType
+}
+
+// IsComputed always returns false as resource identity data is always set by
+// the provider rather than being planned.
+func (a NumberAttribute) IsComputed() bool {
+	return false
+}
+
+// IsOptional returns the OptionalForImport field value.
+func (a NumberAttribute) IsOptional() bool {
+	return a.OptionalForImport
+}
+
+// IsRequired returns the RequiredForImport field value.
+func (a NumberAttribute) IsRequired() bool {
+	return a.RequiredForImport
+}
+
+// IsSensitive always returns false as resource identity data cannot be
+// sensitive.
+func (a NumberAttribute) IsSensitive() bool {
+	return false
+}
+
+// ValidateImplementation contains logic for validating the
+// provider-defined implementation of the attribute to prevent unexpected
+// errors or panics. This logic runs during the GetResourceIdentitySchemas RPC
+// and should never include false positives.
+func (a NumberAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.RequiredForImport && a.OptionalForImport {
+		resp.Diagnostics.Append(invalidImportConfigurabilityDiag(req.Path))
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identityschema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   identityschema.Schema
+		expected attr.Type
+	}{
+		"empty": {
+			schema: identityschema.Schema{},
+			expected: types.ObjectType{
+				AttrTypes: map[string]attr.Type{},
+			},
+		},
+		"attributes": {
+			schema: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"testattr1": identityschema.StringAttribute{},
+					"testattr2": identityschema.ListAttribute{
+						ElementType: types.Int64Type,
+					},
+				},
+			},
+			expected: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"testattr1": types.StringType,
+					"testattr2": types.ListType{
+						ElemType: types.Int64Type,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.Type()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateImplementation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   identityschema.Schema
+		expected diag.Diagnostics
+	}{
+		"empty": {
+			schema:   identityschema.Schema{},
+			expected: nil,
+		},
+		"valid": {
+			schema: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"id": identityschema.StringAttribute{
+						RequiredForImport: true,
+					},
+					"tags": identityschema.ListAttribute{
+						ElementType:       types.StringType,
+						OptionalForImport: true,
+					},
+				},
+			},
+			expected: nil,
+		},
+		"attribute-both-required-and-optional": {
+			schema: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"id": identityschema.StringAttribute{
+						OptionalForImport: true,
+						RequiredForImport: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the resource identity schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"id\" has both RequiredForImport and OptionalForImport set to true. "+
+						"At most one of these fields can be enabled.",
+				),
+			},
+		},
+		"list-attribute-missing-element-type": {
+			schema: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"tags": identityschema.ListAttribute{
+						OptionalForImport: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"tags\" is missing the CustomType or ElementType field on a collection Attribute. "+
+						"One of these fields is required to prevent other unexpected errors or panics.",
+				),
+			},
+		},
+		"list-attribute-non-primitive-element-type": {
+			schema: identityschema.Schema{
+				Attributes: map[string]identityschema.Attribute{
+					"tags": identityschema.ListAttribute{
+						ElementType: types.ListType{
+							ElemType: types.StringType,
+						},
+						OptionalForImport: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Attribute Implementation",
+					"When validating the resource identity schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"tags\" has an ElementType which is not a primitive type. "+
+						"Resource identity list attributes only support bool, float64, int64, number, and string element types.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.ValidateImplementation(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ package resource
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
@@ -60,3 +63,54 @@ func ImportStatePassthroughID(ctx context.Context, attrPath path.Path, req Impor
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, req.ID)...)
 }
+
+// ImportStatePassthroughWithIDParts is a helper function to split the import
+// identifier on the given separator and set each part to the state attribute
+// path in the same position. Each attribute must accept a string value.
+//
+// If the import identifier does not contain the same number of non-empty
+// parts as attribute paths, an error diagnostic is returned which describes
+// the expected format, such as "region,name" for a separator of "," and
+// attribute paths of path.Root("region") and path.Root("name").
+func ImportStatePassthroughWithIDParts(ctx context.Context, separator string, attrPaths path.Paths, req ImportStateRequest, resp *ImportStateResponse) {
+	if separator == "" || len(attrPaths) == 0 {
+		resp.Diagnostics.AddError(
+			"Resource Import Passthrough Missing Separator or Attribute Paths",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStatePassthroughWithIDParts must set a separator and at least one valid attribute path that can accept a string value.",
+		)
+
+		return
+	}
+
+	formatParts := make([]string, 0, len(attrPaths))
+
+	for _, attrPath := range attrPaths {
+		if attrPath.Equal(path.Empty()) {
+			resp.Diagnostics.AddError(
+				"Resource Import Passthrough Missing Attribute Path",
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Resource ImportState method call to ImportStatePassthroughWithIDParts paths must be set to valid attribute paths that can accept a string value.",
+			)
+
+			return
+		}
+
+		formatParts = append(formatParts, attrPath.String())
+	}
+
+	idParts := strings.Split(req.ID, separator)
+
+	if len(idParts) != len(attrPaths) || slices.Contains(idParts, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier in the format %s. Got: %q", strings.Join(formatParts, separator), req.ID),
+		)
+
+		return
+	}
+
+	for idx, attrPath := range attrPaths {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, idParts[idx])...)
+	}
+}
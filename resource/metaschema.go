@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+)
+
+// MetaSchemaRequest represents a request for the Resource to return its
+// provider meta schema. An instance of this request struct is supplied as an
+// argument to the ResourceWithMetaSchema type MetaSchema method.
+type MetaSchemaRequest struct{}
+
+// MetaSchemaResponse represents a response to a MetaSchemaRequest. An
+// instance of this response struct is supplied as an argument to the
+// ResourceWithMetaSchema type MetaSchema method.
+type MetaSchemaResponse struct {
+	// Schema is the provider meta schema of the resource.
+	Schema metaschema.Schema
+
+	// Diagnostics report errors or warnings related to retrieving the
+	// provider meta schema. An empty slice indicates success, with no warnings
+	// or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -4,6 +4,9 @@
 package resource
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +31,16 @@ type ModifyPlanRequest struct {
 	// value.
 	Plan tfsdk.Plan
 
+	// ProposedNewState is the planned new state for the resource exactly as
+	// proposed by Terraform, before the framework applied attribute defaults,
+	// marked unconfigured computed values as unknown, or ran attribute plan
+	// modifiers. Use this to reason about the original proposal, such as
+	// whether a value in Plan was set by Terraform or by a plan modifier.
+	//
+	// This field is informational only. Changes must be made via the
+	// ModifyPlanResponse.Plan field.
+	ProposedNewState tfsdk.Plan
+
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
 
@@ -39,6 +52,148 @@ type ModifyPlanRequest struct {
 	// Use the GetKey method to read data. Use the SetKey method on
 	// ModifyPlanResponse.Private to update or remove a value.
 	Private *privatestate.ProviderData
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the PlanResourceChange RPC, such as forward-compatible Terraform
+	// behavior changes.
+	ClientCapabilities ModifyPlanClientCapabilities
+
+	// Identity is the planned resource identity data of the resource, if the
+	// resource implements ResourceWithIdentity. This field is nil if the
+	// resource does not implement ResourceWithIdentity and contains a null
+	// value if Terraform did not send identity data.
+	Identity *tfsdk.ResourceIdentity
+}
+
+// ModifyPlanClientCapabilities allows Terraform to publish information
+// regarding optionally supported protocol features for the
+// PlanResourceChange RPC, such as forward-compatible Terraform behavior
+// changes.
+type ModifyPlanClientCapabilities struct {
+	// DeferralAllowed indicates whether the Terraform client initiating
+	// the request allows a deferral response.
+	DeferralAllowed bool
+}
+
+// GetPrivate fetches the private state data previously stored at the given
+// key and unmarshals the JSON data into the target, which must be a pointer.
+// This enables comparing configuration values against data stored during a
+// previous apply, such as the original practitioner input of a value that the
+// API normalizes, without calling the API during planning.
+//
+// If no data is found at the key, false is returned and the target is
+// unmodified. If the key is reserved for framework usage or the data cannot
+// be unmarshalled into the target, an error diagnostic is returned.
+func (r ModifyPlanRequest) GetPrivate(ctx context.Context, key string, target any) (bool, diag.Diagnostics) {
+	return r.Private.GetKeyAs(ctx, key, target)
+}
+
+// IsConfigUnknown returns true if the configuration value at the given path
+// is unknown, such as when a practitioner references an attribute of another
+// resource which is not yet known.
+func (r ModifyPlanRequest) IsConfigUnknown(ctx context.Context, p path.Path) (bool, diag.Diagnostics) {
+	var value attr.Value
+
+	diags := r.Config.GetAttribute(ctx, p, &value)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return value.IsUnknown(), diags
+}
+
+// IsPlanUnknown returns true if the planned value at the given path is
+// unknown, regardless of the origin of the unknown value. Use UnknownOrigin to
+// determine why the planned value is unknown.
+func (r ModifyPlanRequest) IsPlanUnknown(ctx context.Context, p path.Path) (bool, diag.Diagnostics) {
+	var value attr.Value
+
+	diags := r.Plan.GetAttribute(ctx, p, &value)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return value.IsUnknown(), diags
+}
+
+// UnknownOrigin compares the configuration and planned values at the given
+// path to determine why the planned value is unknown. This enables providers
+// to avoid replacing unknown values which originate from the configuration,
+// such as when setting a computed value during plan modification.
+func (r ModifyPlanRequest) UnknownOrigin(ctx context.Context, p path.Path) (UnknownOrigin, diag.Diagnostics) {
+	planUnknown, diags := r.IsPlanUnknown(ctx, p)
+
+	if diags.HasError() || !planUnknown {
+		return UnknownOriginNone, diags
+	}
+
+	configUnknown, configDiags := r.IsConfigUnknown(ctx, p)
+
+	diags.Append(configDiags...)
+
+	if diags.HasError() {
+		return UnknownOriginNone, diags
+	}
+
+	if configUnknown {
+		return UnknownOriginConfig, diags
+	}
+
+	var configValue attr.Value
+
+	diags.Append(r.Config.GetAttribute(ctx, p, &configValue)...)
+
+	if diags.HasError() {
+		return UnknownOriginNone, diags
+	}
+
+	if configValue.IsNull() {
+		return UnknownOriginComputed, diags
+	}
+
+	return UnknownOriginPlan, diags
+}
+
+// UnknownOrigin describes why a planned value is unknown, as returned by the
+// ModifyPlanRequest type UnknownOrigin method.
+type UnknownOrigin int32
+
+const (
+	// UnknownOriginNone indicates the planned value is not unknown.
+	UnknownOriginNone UnknownOrigin = 0
+
+	// UnknownOriginConfig indicates the planned value is unknown because the
+	// configuration value is unknown, such as when a practitioner references
+	// an attribute of another resource which is not yet known.
+	UnknownOriginConfig UnknownOrigin = 1
+
+	// UnknownOriginComputed indicates the planned value is unknown because
+	// the configuration value is null and the attribute is computed, so the
+	// value has not yet been determined by the provider.
+	UnknownOriginComputed UnknownOrigin = 2
+
+	// UnknownOriginPlan indicates the planned value is unknown even though
+	// the configuration value is known and not null, such as when a plan
+	// modifier marked the value as unknown.
+	UnknownOriginPlan UnknownOrigin = 3
+)
+
+// String returns a human-readable representation of the unknown origin.
+func (o UnknownOrigin) String() string {
+	switch o {
+	case UnknownOriginNone:
+		return "None"
+	case UnknownOriginConfig:
+		return "Config"
+	case UnknownOriginComputed:
+		return "Computed"
+	case UnknownOriginPlan:
+		return "Plan"
+	}
+
+	return "Invalid"
 }
 
 // ModifyPlanResponse represents a response to a
@@ -55,6 +210,29 @@ type ModifyPlanResponse struct {
 	// recreated.
 	RequiresReplace path.Paths
 
+	// RequiresReplaceExpressions is a list of attribute path expressions
+	// that require the resource to be replaced. After ModifyPlan returns,
+	// the framework expands each expression against Plan and adds every
+	// matching path to those sent to Terraform, alongside RequiresReplace.
+	//
+	// This is useful for attributes underneath list or set nested attributes
+	// and blocks, where exact paths are not known ahead of the plan, such as
+	// path.MatchRoot("disks").AtAnySetValue().AtName("size"). Expressions
+	// which cannot fully match, such as when a parent collection is null or
+	// unknown in the plan, add no paths.
+	RequiresReplaceExpressions path.Expressions
+
+	// RequiresReplaceReasons is a list of attribute paths that require the
+	// resource to be replaced, each with an explanation for practitioners.
+	// After ModifyPlan returns, the framework adds each path to those sent
+	// to Terraform, alongside RequiresReplace, and returns a warning
+	// diagnostic at the path with the reason, since Terraform otherwise only
+	// shows that the attribute forces replacement. The warning is only
+	// returned when the planned value at the path differs from the prior
+	// state value, as Terraform ignores paths without changes. Destroy plans
+	// are skipped.
+	RequiresReplaceReasons []RequiresReplaceReason
+
 	// Private is the private state resource data following the ModifyPlan operation.
 	// This field is pre-populated from ModifyPlanRequest.Private and
 	// can be modified during the resource's ModifyPlan operation.
@@ -65,4 +243,31 @@ type ModifyPlanResponse struct {
 	// indicates a successful plan modification with no warnings or errors
 	// generated.
 	Diagnostics diag.Diagnostics
+
+	// Deferred indicates that Terraform should defer planning this
+	// resource until a followup apply operation.
+	//
+	// This field can only be set if
+	// `(resource.ModifyPlanRequest).ClientCapabilities.DeferralAllowed` is
+	// true.
+	Deferred *Deferred
+
+	// Identity is the resource identity data of the resource following the
+	// ModifyPlan operation, if the resource implements ResourceWithIdentity.
+	// This field is pre-populated from ModifyPlanRequest.Identity and should be set
+	// during the resource's ModifyPlan operation.
+	Identity *tfsdk.ResourceIdentity
+}
+
+// RequiresReplaceReason is an attribute path that requires the resource to be
+// replaced, with an explanation of why, for the ModifyPlanResponse type
+// RequiresReplaceReasons field.
+type RequiresReplaceReason struct {
+	// Path is the attribute path that requires the resource to be replaced.
+	Path path.Path
+
+	// Reason is a practitioner-facing explanation of why a change to the
+	// attribute requires the resource to be replaced, such as "The remote
+	// system does not support renaming volumes."
+	Reason string
 }
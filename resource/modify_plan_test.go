@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestModifyPlanRequestUnknownOrigin(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_attribute": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	testValue := func(value any) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_attribute": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+
+	testCases := map[string]struct {
+		config                tftypes.Value
+		plan                  tftypes.Value
+		path                  path.Path
+		expected              resource.UnknownOrigin
+		expectedConfigUnknown bool
+		expectedPlanUnknown   bool
+		expectedDiagnostics   diag.Diagnostics
+	}{
+		"known": {
+			config:   testValue("test-config-value"),
+			plan:     testValue("test-config-value"),
+			path:     path.Root("test_attribute"),
+			expected: resource.UnknownOriginNone,
+		},
+		"config-unknown": {
+			config:                testValue(tftypes.UnknownValue),
+			plan:                  testValue(tftypes.UnknownValue),
+			path:                  path.Root("test_attribute"),
+			expected:              resource.UnknownOriginConfig,
+			expectedConfigUnknown: true,
+			expectedPlanUnknown:   true,
+		},
+		"config-null-plan-unknown": {
+			config:              testValue(nil),
+			plan:                testValue(tftypes.UnknownValue),
+			path:                path.Root("test_attribute"),
+			expected:            resource.UnknownOriginComputed,
+			expectedPlanUnknown: true,
+		},
+		"config-known-plan-unknown": {
+			config:              testValue("test-config-value"),
+			plan:                testValue(tftypes.UnknownValue),
+			path:                path.Root("test_attribute"),
+			expected:            resource.UnknownOriginPlan,
+			expectedPlanUnknown: true,
+		},
+		"invalid-path": {
+			config:   testValue("test-config-value"),
+			plan:     testValue(tftypes.UnknownValue),
+			path:     path.Root("not_test_attribute"),
+			expected: resource.UnknownOriginNone,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("not_test_attribute"),
+					"Plan Read Error",
+					"An unexpected error was encountered trying to retrieve type information at a given path. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Error: AttributeName(\"not_test_attribute\") still remains in the path: could not find attribute or block \"not_test_attribute\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{
+					Raw:    testCase.config,
+					Schema: testSchema,
+				},
+				Plan: tfsdk.Plan{
+					Raw:    testCase.plan,
+					Schema: testSchema,
+				},
+			}
+
+			got, diags := req.UnknownOrigin(context.Background(), testCase.path)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if testCase.expectedDiagnostics.HasError() {
+				return
+			}
+
+			configUnknown, _ := req.IsConfigUnknown(context.Background(), testCase.path)
+
+			if configUnknown != testCase.expectedConfigUnknown {
+				t.Errorf("expected config unknown %t, got %t", testCase.expectedConfigUnknown, configUnknown)
+			}
+
+			planUnknown, _ := req.IsPlanUnknown(context.Background(), testCase.path)
+
+			if planUnknown != testCase.expectedPlanUnknown {
+				t.Errorf("expected plan unknown %t, got %t", testCase.expectedPlanUnknown, planUnknown)
+			}
+		})
+	}
+}
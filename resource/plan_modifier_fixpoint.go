@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultPlanModifierFixpointMaxIterations is the maximum number of
+// additional attribute plan modifier and ModifyPlan passes when the
+// PlanModifierFixpointResponse type MaxIterations field is zero or less.
+const DefaultPlanModifierFixpointMaxIterations = 5
+
+// PlanModifierFixpointRequest represents a request for the Resource to
+// configure repeated plan modification. An instance of this request struct is
+// supplied as an argument to the ResourceWithPlanModifierFixpoint type
+// PlanModifierFixpoint method.
+type PlanModifierFixpointRequest struct{}
+
+// PlanModifierFixpointResponse represents a response to a
+// PlanModifierFixpointRequest. An instance of this response struct is
+// supplied as an argument to the ResourceWithPlanModifierFixpoint type
+// PlanModifierFixpoint method.
+type PlanModifierFixpointResponse struct {
+	// MaxIterations is the maximum number of additional attribute plan
+	// modifier and ModifyPlan passes. An error diagnostic is returned if the
+	// plan is still changing after this number of passes. If zero or less,
+	// DefaultPlanModifierFixpointMaxIterations is used.
+	MaxIterations int
+
+	// Diagnostics report errors or warnings related to configuring repeated
+	// plan modification. An empty slice indicates success, with no warnings
+	// or errors generated.
+	Diagnostics diag.Diagnostics
+}
@@ -4,6 +4,8 @@
 package resource
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -29,6 +31,31 @@ type ReadRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
+
+	// Timeout is the resolved timeout of the Read operation, if the resource
+	// implements ResourceWithTimeouts. The context passed to the Read
+	// method has the matching deadline. A zero value means there is no
+	// timeout.
+	Timeout time.Duration
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the Read RPC, such as forward-compatible Terraform behavior changes.
+	ClientCapabilities ReadClientCapabilities
+
+	// Identity is the current resource identity data of the resource, if the
+	// resource implements ResourceWithIdentity. This field is nil if the
+	// resource does not implement ResourceWithIdentity and contains a null
+	// value if Terraform did not send identity data.
+	Identity *tfsdk.ResourceIdentity
+}
+
+// ReadClientCapabilities allows Terraform to publish information regarding
+// optionally supported protocol features for the Read RPC, such as
+// forward-compatible Terraform behavior changes.
+type ReadClientCapabilities struct {
+	// DeferralAllowed indicates whether the Terraform client initiating
+	// the request allows a deferral response.
+	DeferralAllowed bool
 }
 
 // ReadResponse represents a response to a ReadRequest. An
@@ -44,10 +71,27 @@ type ReadResponse struct {
 	// Private is the private state resource data following the Read operation.
 	// This field is pre-populated from ReadResourceRequest.Private and
 	// can be modified during the resource's Read operation.
+	//
+	// If the resource is removed with State.RemoveResource() and no error
+	// diagnostics are returned, the framework automatically discards all
+	// private state data, so it does not need to be cleared separately.
 	Private *privatestate.ProviderData
 
 	// Diagnostics report errors or warnings related to reading the
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Deferred indicates that Terraform should defer reading this
+	// resource until a followup apply operation.
+	//
+	// This field can only be set if
+	// `(resource.ReadRequest).ClientCapabilities.DeferralAllowed` is true.
+	Deferred *Deferred
+
+	// Identity is the resource identity data of the resource following the
+	// Read operation, if the resource implements ResourceWithIdentity.
+	// This field is pre-populated from ReadRequest.Identity and should be set
+	// during the resource's Read operation.
+	Identity *tfsdk.ResourceIdentity
 }
@@ -16,9 +16,17 @@ import (
 //   - Import: ResourceWithImportState
 //   - Validation: Schema-based or entire configuration
 //     via ResourceWithConfigValidators or ResourceWithValidateConfig.
+//   - Validation with provider-level data or clients:
+//     ResourceWithConfigureAndValidate
+//   - Conditionally Required or Computed Attributes:
+//     ResourceWithConditionalAttributes
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
 //   - State Upgrades: ResourceWithUpgradeState
+//   - Provider Meta: ResourceWithMetaSchema
+//   - Timeouts: ResourceWithTimeouts
+//   - Concurrent Plan Modification: ResourceWithConcurrentPlanModifiers
+//   - Resource Identity: ResourceWithIdentity
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -85,6 +93,37 @@ type ResourceWithConfigValidators interface {
 	ConfigValidators(context.Context) []ConfigValidator
 }
 
+// ResourceWithConditionalAttributes is an interface type that extends Resource
+// to declare attributes which are required or computed depending on the
+// configuration of other attributes.
+//
+// Each conditional attribute must be Optional and Computed in the schema. The
+// requirements are verified in addition to any other validation, once during
+// validation and again during planning, when more values may be known.
+type ResourceWithConditionalAttributes interface {
+	Resource
+
+	// ConditionalAttributes returns the conditional attribute declarations.
+	ConditionalAttributes(context.Context) []ConditionalAttribute
+}
+
+// ResourceWithConfigValidatorsStopOnError is an interface type that extends
+// ResourceWithConfigValidators to control whether the remaining
+// ConfigValidators are skipped after one returns an error diagnostic.
+//
+// This is useful when ConfigValidators are expensive, such as those which
+// call external services, and later validation has no value once the
+// configuration is known to be invalid. Warning diagnostics never cause
+// remaining ConfigValidators to be skipped. ValidateConfig and any Attribute
+// or Type validation are still performed.
+type ResourceWithConfigValidatorsStopOnError interface {
+	ResourceWithConfigValidators
+
+	// ConfigValidatorsStopOnError should return true if the framework should
+	// stop calling ConfigValidators after the first error diagnostic.
+	ConfigValidatorsStopOnError(context.Context) bool
+}
+
 // Optional interface on top of Resource that enables provider control over
 // the ImportResourceState RPC. This RPC is called by Terraform when the
 // `terraform import` command is executed. Afterwards, the ReadResource RPC
@@ -127,6 +166,27 @@ type ResourceWithModifyPlan interface {
 	ModifyPlan(context.Context, ModifyPlanRequest, *ModifyPlanResponse)
 }
 
+// ResourceWithPlanModifierFixpoint represents a resource instance with a
+// ModifyPlan function whose plan changes should be processed by attribute plan
+// modifiers again.
+//
+// When the ModifyPlan method changes the plan, the framework runs all
+// attribute plan modifiers against the modified plan, then calls the
+// ModifyPlan method again, until the ModifyPlan method no longer changes the
+// plan. An error diagnostic is returned if the ModifyPlan method returns a
+// previously returned plan, which would otherwise repeat indefinitely, or if
+// the plan is still changing after the maximum number of iterations.
+//
+// Diagnostics and paths requiring replacement, including any
+// RequiresReplaceReasons, are taken from the final iteration only.
+type ResourceWithPlanModifierFixpoint interface {
+	ResourceWithModifyPlan
+
+	// PlanModifierFixpoint should configure repeated plan modification,
+	// such as the maximum number of iterations.
+	PlanModifierFixpoint(context.Context, PlanModifierFixpointRequest, *PlanModifierFixpointResponse)
+}
+
 // Optional interface on top of [Resource] that enables provider control over
 // the MoveResourceState RPC. This RPC is called by Terraform when there is a
 // `moved` configuration block that changes the resource type and where this
@@ -156,6 +216,71 @@ type ResourceWithMoveState interface {
 	MoveState(context.Context) []StateMover
 }
 
+// ResourceWithMetaSchema is an interface type that extends Resource to
+// return a resource-specific provider meta schema. If implemented, this
+// schema is used instead of the provider.ProviderWithMetaSchema schema when
+// reading the provider_meta configuration data for this resource, such as the
+// ModifyPlanRequest type ProviderMeta field.
+//
+// Terraform always sends provider_meta configuration data based on the
+// provider meta schema, so the framework decodes the data with that schema
+// and then selects the attributes of this schema. Every attribute must be
+// defined in the provider meta schema with the same underlying type,
+// otherwise an error diagnostic is returned. This is intended for resources
+// which only require a subset of the provider meta attributes or custom
+// attribute types.
+//
+// This functionality is currently experimental and subject to change or break
+// without warning. It is not protected by version compatibility guarantees.
+type ResourceWithMetaSchema interface {
+	Resource
+
+	// MetaSchema should return the provider meta schema for this resource.
+	//
+	// This functionality is currently experimental and subject to change or
+	// break without warning. It is not protected by version compatibility
+	// guarantees.
+	MetaSchema(context.Context, MetaSchemaRequest, *MetaSchemaResponse)
+}
+
+// ResourceWithIdentity is an interface type that extends Resource to
+// support resource identity, which is a set of attributes that uniquely
+// identify the remote object of the resource. If implemented, the framework
+// populates the Identity field of the Read, ModifyPlan, Create, Update, and
+// Delete request and response types.
+//
+// Resource identity requires Terraform 1.12 and later. Earlier versions of
+// Terraform do not send or store identity data, in which case the request
+// Identity fields contain a null value and any identity set in the response
+// is ignored by Terraform.
+type ResourceWithIdentity interface {
+	Resource
+
+	// IdentitySchema should return the resource identity schema for this
+	// resource.
+	IdentitySchema(context.Context, IdentitySchemaRequest, *IdentitySchemaResponse)
+}
+
+// ResourceWithTimeouts is an interface type that extends Resource to
+// automatically resolve practitioner configurable operation timeouts.
+//
+// The resource schema must contain a top level "timeouts" single nested
+// attribute or block with optional string attributes named after each
+// supported operation: "create", "read", "update", and "delete". Configured
+// values are parsed with time.ParseDuration, falling back to the defaults
+// returned by the Timeouts method. The resolved timeout is set in the Timeout
+// field of the operation request and the context passed to the operation
+// method has the matching deadline.
+//
+// Create and Update timeouts are read from the configuration, while Read and
+// Delete timeouts are read from the prior state.
+type ResourceWithTimeouts interface {
+	Resource
+
+	// Timeouts should return the default timeouts for this resource.
+	Timeouts(context.Context, TimeoutsRequest, *TimeoutsResponse)
+}
+
 // Optional interface on top of Resource that enables provider control over
 // the UpgradeResourceState RPC. This RPC is automatically called by Terraform
 // when the current Schema type Version field is greater than the stored state.
@@ -196,3 +321,43 @@ type ResourceWithValidateConfig interface {
 	// ValidateConfig performs the validation.
 	ValidateConfig(context.Context, ValidateConfigRequest, *ValidateConfigResponse)
 }
+
+// ResourceWithConfigureAndValidate is an interface type that extends
+// ResourceWithConfigure to include imperative validation which can use
+// provider-level data or clients, such as verifying configuration values
+// against a remote system API.
+//
+// Validation via ResourceWithValidateConfig occurs before the provider is
+// configured, so provider-level data is not available. Instead, the
+// ValidateConfiguredConfig method is called during the PlanResourceChange RPC
+// after the resource Configure method, and before any defaults or plan
+// modification. It is not called when planning to destroy the resource.
+//
+// The provider may not be configured yet, such as when the provider
+// configuration contains unknown values, in which case the
+// ConfigureRequest.ProviderData will be nil. Implementations should skip any
+// validation which requires provider-level data in that situation.
+type ResourceWithConfigureAndValidate interface {
+	ResourceWithConfigure
+
+	// ValidateConfiguredConfig performs the validation.
+	ValidateConfiguredConfig(context.Context, ValidateConfiguredConfigRequest, *ValidateConfiguredConfigResponse)
+}
+
+// ResourceWithConcurrentPlanModifiers is an interface type that extends
+// Resource to run the plan modifiers of independent attributes concurrently.
+// This can reduce planning time for resources with many attributes whose plan
+// modifiers perform slow operations, such as remote API calls.
+//
+// Attribute plan modifiers always receive the original plan, however they may
+// otherwise depend on each other, such as through private state data. Only
+// attributes whose plan modifiers have no such dependencies should be
+// declared. Results are merged in attribute name order, so the resulting
+// plan, private state data, and diagnostics are deterministic.
+type ResourceWithConcurrentPlanModifiers interface {
+	Resource
+
+	// ConcurrentPlanModifiers should return the attributes whose plan
+	// modifiers can be run concurrently.
+	ConcurrentPlanModifiers(context.Context, ConcurrentPlanModifiersRequest, *ConcurrentPlanModifiersResponse)
+}
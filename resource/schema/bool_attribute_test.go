@@ -512,6 +512,40 @@ func TestBoolAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeIsWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  bool
+	}{
+		"not-write-only": {
+			attribute: schema.BoolAttribute{},
+			expected:  false,
+		},
+		"write-only": {
+			attribute: schema.BoolAttribute{
+				WriteOnly: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsWriteOnly()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeValidateImplementation(t *testing.T) {
 	t.Parallel()
 
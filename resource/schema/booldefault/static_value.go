@@ -40,3 +40,8 @@ func (d staticBoolDefault) MarkdownDescription(_ context.Context) string {
 func (d staticBoolDefault) DefaultBool(_ context.Context, req defaults.BoolRequest, resp *defaults.BoolResponse) {
 	resp.PlanValue = types.BoolValue(d.defaultVal)
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticBoolDefault) IsStatic() bool {
+	return true
+}
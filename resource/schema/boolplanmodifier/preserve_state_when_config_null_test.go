@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPreserveStateWhenConfigNullModifierPlanModifyBool(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.BoolRequest
+		expected *planmodifier.BoolResponse
+	}{
+		"create": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolUnknown(),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.BoolNull(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"destroy": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.BoolNull(),
+				State:      testState,
+				StateValue: types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolNull(),
+			},
+		},
+		"config-known": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolValue(false),
+				Plan:        testPlan,
+				PlanValue:   types.BoolValue(false),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(false),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolUnknown(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolUnknown(),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+		"config-null-plan-unknown-state-known": {
+			// this is the situation where the configuration value was
+			// previously set and has been removed
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolUnknown(),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"config-null-plan-unknown-state-null": {
+			// unlike UseStateForUnknown, a null state value is preserved
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolUnknown(),
+				State:       testState,
+				StateValue:  types.BoolNull(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolNull(),
+			},
+		},
+		"config-null-plan-state": {
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolValue(true),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"config-null-plan-modified": {
+			// a default or prior plan modifier changed the planned value
+			request: planmodifier.BoolRequest{
+				ConfigValue: types.BoolNull(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolValue(false),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(false),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.BoolResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			boolplanmodifier.PreserveStateWhenConfigNull().PlanModifyBool(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRejectUpdateModifierPlanModifyBool(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.BoolRequest
+		expected *planmodifier.BoolResponse
+	}{
+		"create": {
+			request: planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolValue(true),
+				Plan:        testPlan,
+				PlanValue:   types.BoolValue(true),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.BoolNull(),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"destroy": {
+			request: planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolNull(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.BoolNull(),
+				State:      testState,
+				StateValue: types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolNull(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolValue(true),
+				Plan:        testPlan,
+				PlanValue:   types.BoolValue(true),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"planvalue-statevalue-different": {
+			request: planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolValue(false),
+				Plan:        testPlan,
+				PlanValue:   types.BoolValue(false),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.BoolValue(false),
+			},
+		},
+		"planvalue-null-statevalue-known": {
+			request: planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolNull(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolNull(),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.BoolNull(),
+			},
+		},
+		"planvalue-known-statevalue-null": {
+			request: planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolValue(true),
+				Plan:        testPlan,
+				PlanValue:   types.BoolValue(true),
+				State:       testState,
+				StateValue:  types.BoolNull(),
+			},
+			expected: &planmodifier.BoolResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.BoolValue(true),
+			},
+		},
+		"planvalue-unknown": {
+			request: planmodifier.BoolRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.BoolUnknown(),
+				Plan:        testPlan,
+				PlanValue:   types.BoolUnknown(),
+				State:       testState,
+				StateValue:  types.BoolValue(true),
+			},
+			expected: &planmodifier.BoolResponse{
+				PlanValue: types.BoolUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.BoolResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			boolplanmodifier.RejectUpdate().PlanModifyBool(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
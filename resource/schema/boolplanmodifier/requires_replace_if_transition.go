@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package boolplanmodifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// RequiresReplaceIfTransition returns a plan modifier that conditionally
+// requires resource replacement if:
+//
+//   - The resource is planned for update.
+//   - The state value is known and equal to from.
+//   - The plan value is known and equal to to.
+//
+// For example, RequiresReplaceIfTransition(true, false) enables in-place
+// updates when the value changes from false to true, but requires resource
+// replacement when the value changes from true to false. Null and unknown
+// state or plan values never require resource replacement.
+//
+// Use RequiresReplace if the resource replacement should occur on any value
+// change. Use RequiresReplaceIf if the resource replacement should check other
+// provider-defined conditional logic.
+func RequiresReplaceIfTransition(from, to bool) planmodifier.Bool {
+	description := fmt.Sprintf("If the value of this attribute changes from %t to %t, Terraform will destroy and recreate the resource.", from, to)
+
+	return RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.BoolRequest, resp *RequiresReplaceIfFuncResponse) {
+			if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
+				return
+			}
+
+			if req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+				return
+			}
+
+			if req.StateValue.ValueBool() != from || req.PlanValue.ValueBool() != to {
+				return
+			}
+
+			resp.RequiresReplace = true
+		},
+		description,
+		description,
+	)
+}
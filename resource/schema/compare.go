@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// SchemaChangeSeverity classifies the impact of a SchemaChange.
+type SchemaChangeSeverity int
+
+const (
+	// SchemaChangeSeverityNonBreaking represents a change which does not
+	// require practitioner configuration or existing state data updates.
+	SchemaChangeSeverityNonBreaking SchemaChangeSeverity = 0
+
+	// SchemaChangeSeverityBreaking represents a change which may require
+	// practitioner configuration updates or a state upgrade for existing
+	// state data.
+	SchemaChangeSeverityBreaking SchemaChangeSeverity = 1
+)
+
+// String returns a human-readable representation of the severity.
+func (s SchemaChangeSeverity) String() string {
+	switch s {
+	case SchemaChangeSeverityNonBreaking:
+		return "non-breaking"
+	case SchemaChangeSeverityBreaking:
+		return "breaking"
+	}
+
+	return "unknown"
+}
+
+// SchemaChangeKind describes the type of a SchemaChange.
+type SchemaChangeKind string
+
+const (
+	// SchemaChangeKindAttributeAdded represents a new attribute. The change
+	// is breaking if the attribute is Required.
+	SchemaChangeKindAttributeAdded SchemaChangeKind = "attribute_added"
+
+	// SchemaChangeKindAttributeRemoved represents a removed attribute.
+	SchemaChangeKindAttributeRemoved SchemaChangeKind = "attribute_removed"
+
+	// SchemaChangeKindAttributeTypeChanged represents an attribute with a
+	// different value type or nesting mode.
+	SchemaChangeKindAttributeTypeChanged SchemaChangeKind = "attribute_type_changed"
+
+	// SchemaChangeKindAttributeRequired represents an existing attribute
+	// which is now Required.
+	SchemaChangeKindAttributeRequired SchemaChangeKind = "attribute_required"
+
+	// SchemaChangeKindAttributeNotRequired represents an existing Required
+	// attribute which is now Optional.
+	SchemaChangeKindAttributeNotRequired SchemaChangeKind = "attribute_not_required"
+
+	// SchemaChangeKindAttributeNotConfigurable represents an existing
+	// Required or Optional attribute which is now only Computed.
+	SchemaChangeKindAttributeNotConfigurable SchemaChangeKind = "attribute_not_configurable"
+
+	// SchemaChangeKindAttributeConfigurable represents an existing Computed
+	// only attribute which is now Optional.
+	SchemaChangeKindAttributeConfigurable SchemaChangeKind = "attribute_configurable"
+
+	// SchemaChangeKindBlockAdded represents a new block.
+	SchemaChangeKindBlockAdded SchemaChangeKind = "block_added"
+
+	// SchemaChangeKindBlockRemoved represents a removed block.
+	SchemaChangeKindBlockRemoved SchemaChangeKind = "block_removed"
+
+	// SchemaChangeKindBlockNestingModeChanged represents a block with a
+	// different nesting mode.
+	SchemaChangeKindBlockNestingModeChanged SchemaChangeKind = "block_nesting_mode_changed"
+)
+
+// SchemaChange is a difference between two schemas, as returned by
+// CompareSchemas.
+type SchemaChange struct {
+	// Path is the location of the changed attribute or block.
+	Path path.Path
+
+	// Kind is the type of change.
+	Kind SchemaChangeKind
+
+	// Severity is the impact of the change.
+	Severity SchemaChangeSeverity
+
+	// Description is a human-readable explanation of the change.
+	Description string
+}
+
+// CompareSchemas returns the differences between two versions of a resource
+// schema, sorted by path. Only the structure of attributes and blocks is
+// compared, such as value types and whether an attribute is Required,
+// Optional, or Computed. Descriptions, validators, plan modifiers, and other
+// behaviors are not compared.
+//
+// This is intended for provider unit testing, to detect breaking changes
+// before they are released. For example, a test can fail when any change
+// has SchemaChangeSeverityBreaking severity and the new schema Version was
+// not incremented with a corresponding state upgrade.
+func CompareSchemas(oldSchema, newSchema Schema) []SchemaChange {
+	var changes []SchemaChange
+
+	changes = append(changes, compareAttributes(oldSchema.GetAttributes(), newSchema.GetAttributes(), path.Empty())...)
+	changes = append(changes, compareBlocks(oldSchema.GetBlocks(), newSchema.GetBlocks(), path.Empty())...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path.String() < changes[j].Path.String()
+	})
+
+	return changes
+}
+
+// compareAttributes returns the differences between two sets of attributes.
+func compareAttributes(oldAttributes, newAttributes map[string]fwschema.Attribute, parentPath path.Path) []SchemaChange {
+	var changes []SchemaChange
+
+	for name, oldAttribute := range oldAttributes {
+		attributePath := parentPath.AtName(name)
+		newAttribute, ok := newAttributes[name]
+
+		if !ok {
+			changes = append(changes, SchemaChange{
+				Path:        attributePath,
+				Kind:        SchemaChangeKindAttributeRemoved,
+				Severity:    SchemaChangeSeverityBreaking,
+				Description: fmt.Sprintf("Attribute %s was removed.", attributePath),
+			})
+
+			continue
+		}
+
+		changes = append(changes, compareAttribute(oldAttribute, newAttribute, attributePath)...)
+	}
+
+	for name, newAttribute := range newAttributes {
+		if _, ok := oldAttributes[name]; ok {
+			continue
+		}
+
+		attributePath := parentPath.AtName(name)
+		change := SchemaChange{
+			Path:        attributePath,
+			Kind:        SchemaChangeKindAttributeAdded,
+			Severity:    SchemaChangeSeverityNonBreaking,
+			Description: fmt.Sprintf("Attribute %s was added.", attributePath),
+		}
+
+		if newAttribute.IsRequired() {
+			change.Severity = SchemaChangeSeverityBreaking
+			change.Description = fmt.Sprintf("Required attribute %s was added.", attributePath)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// compareAttribute returns the differences between two versions of the same
+// attribute.
+func compareAttribute(oldAttribute, newAttribute fwschema.Attribute, attributePath path.Path) []SchemaChange {
+	var changes []SchemaChange
+
+	oldConfigurable := oldAttribute.IsRequired() || oldAttribute.IsOptional()
+	newConfigurable := newAttribute.IsRequired() || newAttribute.IsOptional()
+
+	switch {
+	case oldConfigurable && !newConfigurable:
+		changes = append(changes, SchemaChange{
+			Path:        attributePath,
+			Kind:        SchemaChangeKindAttributeNotConfigurable,
+			Severity:    SchemaChangeSeverityBreaking,
+			Description: fmt.Sprintf("Attribute %s is no longer configurable.", attributePath),
+		})
+	case !oldAttribute.IsRequired() && newAttribute.IsRequired():
+		changes = append(changes, SchemaChange{
+			Path:        attributePath,
+			Kind:        SchemaChangeKindAttributeRequired,
+			Severity:    SchemaChangeSeverityBreaking,
+			Description: fmt.Sprintf("Attribute %s is now required.", attributePath),
+		})
+	case oldAttribute.IsRequired() && !newAttribute.IsRequired():
+		changes = append(changes, SchemaChange{
+			Path:        attributePath,
+			Kind:        SchemaChangeKindAttributeNotRequired,
+			Severity:    SchemaChangeSeverityNonBreaking,
+			Description: fmt.Sprintf("Attribute %s is no longer required.", attributePath),
+		})
+	case !oldConfigurable && newConfigurable:
+		changes = append(changes, SchemaChange{
+			Path:        attributePath,
+			Kind:        SchemaChangeKindAttributeConfigurable,
+			Severity:    SchemaChangeSeverityNonBreaking,
+			Description: fmt.Sprintf("Attribute %s is now configurable.", attributePath),
+		})
+	}
+
+	oldNested, oldOk := oldAttribute.(fwschema.NestedAttribute)
+	newNested, newOk := newAttribute.(fwschema.NestedAttribute)
+
+	// Nested attributes are compared by nesting mode and underlying
+	// attributes, so changes are reported at the most specific path.
+	if oldOk && newOk && oldNested.GetNestingMode() == newNested.GetNestingMode() {
+		changes = append(changes, compareAttributes(
+			oldNested.GetNestedObject().GetAttributes(),
+			newNested.GetNestedObject().GetAttributes(),
+			attributePath,
+		)...)
+
+		return changes
+	}
+
+	if oldOk == newOk && oldAttribute.GetType().Equal(newAttribute.GetType()) {
+		return changes
+	}
+
+	changes = append(changes, SchemaChange{
+		Path:        attributePath,
+		Kind:        SchemaChangeKindAttributeTypeChanged,
+		Severity:    SchemaChangeSeverityBreaking,
+		Description: fmt.Sprintf("Attribute %s type changed from %s to %s.", attributePath, oldAttribute.GetType(), newAttribute.GetType()),
+	})
+
+	return changes
+}
+
+// compareBlocks returns the differences between two sets of blocks.
+func compareBlocks(oldBlocks, newBlocks map[string]fwschema.Block, parentPath path.Path) []SchemaChange {
+	var changes []SchemaChange
+
+	for name, oldBlock := range oldBlocks {
+		blockPath := parentPath.AtName(name)
+		newBlock, ok := newBlocks[name]
+
+		if !ok {
+			changes = append(changes, SchemaChange{
+				Path:        blockPath,
+				Kind:        SchemaChangeKindBlockRemoved,
+				Severity:    SchemaChangeSeverityBreaking,
+				Description: fmt.Sprintf("Block %s was removed.", blockPath),
+			})
+
+			continue
+		}
+
+		if oldBlock.GetNestingMode() != newBlock.GetNestingMode() {
+			changes = append(changes, SchemaChange{
+				Path:        blockPath,
+				Kind:        SchemaChangeKindBlockNestingModeChanged,
+				Severity:    SchemaChangeSeverityBreaking,
+				Description: fmt.Sprintf("Block %s nesting mode changed.", blockPath),
+			})
+
+			continue
+		}
+
+		oldObject := oldBlock.GetNestedObject()
+		newObject := newBlock.GetNestedObject()
+
+		changes = append(changes, compareAttributes(oldObject.GetAttributes(), newObject.GetAttributes(), blockPath)...)
+		changes = append(changes, compareBlocks(oldObject.GetBlocks(), newObject.GetBlocks(), blockPath)...)
+	}
+
+	for name := range newBlocks {
+		if _, ok := oldBlocks[name]; ok {
+			continue
+		}
+
+		blockPath := parentPath.AtName(name)
+
+		changes = append(changes, SchemaChange{
+			Path:        blockPath,
+			Kind:        SchemaChangeKindBlockAdded,
+			Severity:    SchemaChangeSeverityNonBreaking,
+			Description: fmt.Sprintf("Block %s was added.", blockPath),
+		})
+	}
+
+	return changes
+}
@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCompareSchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldSchema schema.Schema
+		newSchema schema.Schema
+		expected  []schema.SchemaChange
+	}{
+		"no-changes": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required:    true,
+						Description: "changed descriptions are ignored",
+					},
+				},
+			},
+			expected: nil,
+		},
+		"attribute-added-optional": {
+			oldSchema: schema.Schema{},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeAdded,
+					Severity:    schema.SchemaChangeSeverityNonBreaking,
+					Description: "Attribute test_attr was added.",
+				},
+			},
+		},
+		"attribute-added-required": {
+			oldSchema: schema.Schema{},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeAdded,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Required attribute test_attr was added.",
+				},
+			},
+		},
+		"attribute-removed": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeRemoved,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr was removed.",
+				},
+			},
+		},
+		"attribute-type-changed": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeTypeChanged,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr type changed from basetypes.StringType to basetypes.Int64Type.",
+				},
+			},
+		},
+		"attribute-element-type-changed": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeTypeChanged,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr type changed from types.ListType[basetypes.StringType] to types.SetType[basetypes.StringType].",
+				},
+			},
+		},
+		"attribute-required": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeRequired,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr is now required.",
+				},
+			},
+		},
+		"attribute-not-required": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeNotRequired,
+					Severity:    schema.SchemaChangeSeverityNonBreaking,
+					Description: "Attribute test_attr is no longer required.",
+				},
+			},
+		},
+		"attribute-not-configurable": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeNotConfigurable,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr is no longer configurable.",
+				},
+			},
+		},
+		"attribute-configurable": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeConfigurable,
+					Severity:    schema.SchemaChangeSeverityNonBreaking,
+					Description: "Attribute test_attr is now configurable.",
+				},
+			},
+		},
+		"nested-attribute-changes": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr_a": schema.StringAttribute{
+									Optional: true,
+								},
+								"nested_attr_b": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr_a": schema.BoolAttribute{
+									Optional: true,
+								},
+								"nested_attr_c": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr").AtName("nested_attr_a"),
+					Kind:        schema.SchemaChangeKindAttributeTypeChanged,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr.nested_attr_a type changed from basetypes.StringType to basetypes.BoolType.",
+				},
+				{
+					Path:        path.Root("test_attr").AtName("nested_attr_b"),
+					Kind:        schema.SchemaChangeKindAttributeRemoved,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr.nested_attr_b was removed.",
+				},
+				{
+					Path:        path.Root("test_attr").AtName("nested_attr_c"),
+					Kind:        schema.SchemaChangeKindAttributeAdded,
+					Severity:    schema.SchemaChangeSeverityNonBreaking,
+					Description: "Attribute test_attr.nested_attr_c was added.",
+				},
+			},
+		},
+		"nested-attribute-nesting-mode-changed": {
+			oldSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			newSchema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_attr": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"nested_attr": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_attr"),
+					Kind:        schema.SchemaChangeKindAttributeTypeChanged,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_attr type changed from types.ListType[types.ObjectType[\"nested_attr\":basetypes.StringType]] to types.ObjectType[\"nested_attr\":basetypes.StringType].",
+				},
+			},
+		},
+		"block-changes": {
+			oldSchema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block_a": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+					"test_block_b": schema.ListNestedBlock{},
+					"test_block_c": schema.ListNestedBlock{},
+				},
+			},
+			newSchema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"test_block_a": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_attr": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+					},
+					"test_block_c": schema.SetNestedBlock{},
+					"test_block_d": schema.SingleNestedBlock{},
+				},
+			},
+			expected: []schema.SchemaChange{
+				{
+					Path:        path.Root("test_block_a").AtName("nested_attr"),
+					Kind:        schema.SchemaChangeKindAttributeRequired,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Attribute test_block_a.nested_attr is now required.",
+				},
+				{
+					Path:        path.Root("test_block_b"),
+					Kind:        schema.SchemaChangeKindBlockRemoved,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Block test_block_b was removed.",
+				},
+				{
+					Path:        path.Root("test_block_c"),
+					Kind:        schema.SchemaChangeKindBlockNestingModeChanged,
+					Severity:    schema.SchemaChangeSeverityBreaking,
+					Description: "Block test_block_c nesting mode changed.",
+				},
+				{
+					Path:        path.Root("test_block_d"),
+					Kind:        schema.SchemaChangeKindBlockAdded,
+					Severity:    schema.SchemaChangeSeverityNonBreaking,
+					Description: "Block test_block_d was added.",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schema.CompareSchemas(testCase.oldSchema, testCase.newSchema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -19,12 +19,39 @@ type Bool interface {
 	DefaultBool(context.Context, BoolRequest, *BoolResponse)
 }
 
+// BoolWithPlan is an optional interface for Bool schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultBool as with any other default, then
+// calls DefaultBoolWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type BoolWithPlan interface {
+	Bool
+
+	// DefaultBoolWithPlan should set the default value.
+	DefaultBoolWithPlan(context.Context, BoolWithPlanRequest, *BoolResponse)
+}
+
 type BoolRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// BoolWithPlanRequest is a request for a BoolWithPlan default value.
+type BoolWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type BoolResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
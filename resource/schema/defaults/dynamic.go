@@ -19,12 +19,39 @@ type Dynamic interface {
 	DefaultDynamic(context.Context, DynamicRequest, *DynamicResponse)
 }
 
+// DynamicWithPlan is an optional interface for Dynamic schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultDynamic as with any other default, then
+// calls DefaultDynamicWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type DynamicWithPlan interface {
+	Dynamic
+
+	// DefaultDynamicWithPlan should set the default value.
+	DefaultDynamicWithPlan(context.Context, DynamicWithPlanRequest, *DynamicResponse)
+}
+
 type DynamicRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// DynamicWithPlanRequest is a request for a DynamicWithPlan default value.
+type DynamicWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type DynamicResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Float32 is a schema default value for types.Float32 attributes.
+type Float32 interface {
+	Describer
+
+	// DefaultFloat32 should set the default value.
+	DefaultFloat32(context.Context, Float32Request, *Float32Response)
+}
+
+// Float32WithPlan is an optional interface for Float32 schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultFloat32 as with any other default, then
+// calls DefaultFloat32WithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type Float32WithPlan interface {
+	Float32
+
+	// DefaultFloat32WithPlan should set the default value.
+	DefaultFloat32WithPlan(context.Context, Float32WithPlanRequest, *Float32Response)
+}
+
+type Float32Request struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+}
+
+// Float32WithPlanRequest is a request for a Float32WithPlan default value.
+type Float32WithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
+type Float32Response struct {
+	// Diagnostics report errors or warnings related to setting the
+	// default value resource configuration. An empty slice
+	// indicates success, with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned new state for the attribute.
+	PlanValue types.Float32
+}
@@ -19,12 +19,39 @@ type Float64 interface {
 	DefaultFloat64(context.Context, Float64Request, *Float64Response)
 }
 
+// Float64WithPlan is an optional interface for Float64 schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultFloat64 as with any other default, then
+// calls DefaultFloat64WithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type Float64WithPlan interface {
+	Float64
+
+	// DefaultFloat64WithPlan should set the default value.
+	DefaultFloat64WithPlan(context.Context, Float64WithPlanRequest, *Float64Response)
+}
+
 type Float64Request struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// Float64WithPlanRequest is a request for a Float64WithPlan default value.
+type Float64WithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type Float64Response struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
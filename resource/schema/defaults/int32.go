@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Int32 is a schema default value for types.Int32 attributes.
+type Int32 interface {
+	Describer
+
+	// DefaultInt32 should set the default value.
+	DefaultInt32(context.Context, Int32Request, *Int32Response)
+}
+
+// Int32WithPlan is an optional interface for Int32 schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultInt32 as with any other default, then
+// calls DefaultInt32WithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type Int32WithPlan interface {
+	Int32
+
+	// DefaultInt32WithPlan should set the default value.
+	DefaultInt32WithPlan(context.Context, Int32WithPlanRequest, *Int32Response)
+}
+
+type Int32Request struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+}
+
+// Int32WithPlanRequest is a request for a Int32WithPlan default value.
+type Int32WithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
+type Int32Response struct {
+	// Diagnostics report errors or warnings related to setting the
+	// default value resource configuration. An empty slice
+	// indicates success, with no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// PlanValue is the planned new state for the attribute.
+	PlanValue types.Int32
+}
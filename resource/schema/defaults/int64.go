@@ -19,12 +19,39 @@ type Int64 interface {
 	DefaultInt64(context.Context, Int64Request, *Int64Response)
 }
 
+// Int64WithPlan is an optional interface for Int64 schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultInt64 as with any other default, then
+// calls DefaultInt64WithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type Int64WithPlan interface {
+	Int64
+
+	// DefaultInt64WithPlan should set the default value.
+	DefaultInt64WithPlan(context.Context, Int64WithPlanRequest, *Int64Response)
+}
+
 type Int64Request struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// Int64WithPlanRequest is a request for a Int64WithPlan default value.
+type Int64WithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type Int64Response struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
@@ -19,12 +19,39 @@ type List interface {
 	DefaultList(context.Context, ListRequest, *ListResponse)
 }
 
+// ListWithPlan is an optional interface for List schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultList as with any other default, then
+// calls DefaultListWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type ListWithPlan interface {
+	List
+
+	// DefaultListWithPlan should set the default value.
+	DefaultListWithPlan(context.Context, ListWithPlanRequest, *ListResponse)
+}
+
 type ListRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// ListWithPlanRequest is a request for a ListWithPlan default value.
+type ListWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type ListResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
@@ -19,12 +19,39 @@ type Map interface {
 	DefaultMap(context.Context, MapRequest, *MapResponse)
 }
 
+// MapWithPlan is an optional interface for Map schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultMap as with any other default, then
+// calls DefaultMapWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type MapWithPlan interface {
+	Map
+
+	// DefaultMapWithPlan should set the default value.
+	DefaultMapWithPlan(context.Context, MapWithPlanRequest, *MapResponse)
+}
+
 type MapRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// MapWithPlanRequest is a request for a MapWithPlan default value.
+type MapWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type MapResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
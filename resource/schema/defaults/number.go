@@ -19,12 +19,39 @@ type Number interface {
 	DefaultNumber(context.Context, NumberRequest, *NumberResponse)
 }
 
+// NumberWithPlan is an optional interface for Number schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultNumber as with any other default, then
+// calls DefaultNumberWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type NumberWithPlan interface {
+	Number
+
+	// DefaultNumberWithPlan should set the default value.
+	DefaultNumberWithPlan(context.Context, NumberWithPlanRequest, *NumberResponse)
+}
+
 type NumberRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// NumberWithPlanRequest is a request for a NumberWithPlan default value.
+type NumberWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type NumberResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
@@ -19,12 +19,39 @@ type Object interface {
 	DefaultObject(context.Context, ObjectRequest, *ObjectResponse)
 }
 
+// ObjectWithPlan is an optional interface for Object schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultObject as with any other default, then
+// calls DefaultObjectWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type ObjectWithPlan interface {
+	Object
+
+	// DefaultObjectWithPlan should set the default value.
+	DefaultObjectWithPlan(context.Context, ObjectWithPlanRequest, *ObjectResponse)
+}
+
 type ObjectRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// ObjectWithPlanRequest is a request for a ObjectWithPlan default value.
+type ObjectWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type ObjectResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package defaults
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// PlanReader is the read-only subset of tfsdk.Plan methods available to
+// schema defaults which depend on other planned attribute values, such as
+// StringWithPlan. The framework supplies a tfsdk.Plan, which is referenced
+// through this interface to prevent an import cycle.
+type PlanReader interface {
+	// GetAttribute retrieves the attribute or block found at `path` and
+	// populates the `target` with the value.
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+
+	// PathMatches returns all matching path.Paths from the given
+	// path.Expression.
+	PathMatches(context.Context, path.Expression) (path.Paths, diag.Diagnostics)
+}
@@ -19,12 +19,39 @@ type Set interface {
 	DefaultSet(context.Context, SetRequest, *SetResponse)
 }
 
+// SetWithPlan is an optional interface for Set schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultSet as with any other default, then
+// calls DefaultSetWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type SetWithPlan interface {
+	Set
+
+	// DefaultSetWithPlan should set the default value.
+	DefaultSetWithPlan(context.Context, SetWithPlanRequest, *SetResponse)
+}
+
 type SetRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// SetWithPlanRequest is a request for a SetWithPlan default value.
+type SetWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type SetResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
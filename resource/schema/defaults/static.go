@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package defaults
+
+// Static is an optional interface for schema defaults which always respond
+// with the same value, regardless of the request or any external state such
+// as environment variables. The framework verifies static default values
+// against the validators of the attribute when the provider schema is
+// retrieved, since a default value which fails validation cannot be fixed
+// by practitioners.
+//
+// The Static{TYPE} functions of the typed default packages, such as
+// stringdefault.StaticString, implement this interface.
+type Static interface {
+	// IsStatic should return true if the default value does not depend on
+	// the request or any external state.
+	IsStatic() bool
+}
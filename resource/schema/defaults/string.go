@@ -19,12 +19,39 @@ type String interface {
 	DefaultString(context.Context, StringRequest, *StringResponse)
 }
 
+// StringWithPlan is an optional interface for String schema defaults which
+// depend on other planned attribute values, such as a sibling attribute.
+//
+// The framework first calls DefaultString as with any other default, then
+// calls DefaultStringWithPlan after all other schema defaults have been applied
+// and before any plan modifiers. If the response PlanValue is null or unknown,
+// such as when a depended upon value is not yet known, the attribute is
+// marked as unknown (known after apply).
+type StringWithPlan interface {
+	String
+
+	// DefaultStringWithPlan should set the default value.
+	DefaultStringWithPlan(context.Context, StringWithPlanRequest, *StringResponse)
+}
+
 type StringRequest struct {
 	// Path contains the path of the attribute for setting the
 	// default value. Use this path for any response diagnostics.
 	Path path.Path
 }
 
+// StringWithPlanRequest is a request for a StringWithPlan default value.
+type StringWithPlanRequest struct {
+	// Path contains the path of the attribute for setting the
+	// default value. Use this path for any response diagnostics.
+	Path path.Path
+
+	// Plan contains the planned new state for the resource, after all other
+	// schema defaults have been applied. Use this to read other attribute
+	// values, which may be unknown.
+	Plan PlanReader
+}
+
 type StringResponse struct {
 	// Diagnostics report errors or warnings related to setting the
 	// default value resource configuration. An empty slice
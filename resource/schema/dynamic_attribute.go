@@ -69,6 +69,15 @@ type DynamicAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that the practitioner configuration value of this
+	// Attribute is only available during resource operations, such as via
+	// the Config field of the CreateRequest and UpdateRequest types, and is
+	// never stored in the plan or state. WriteOnly cannot be combined with
+	// Computed.
+	//
+	// Write-only attributes require Terraform 1.11 and later.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -215,6 +224,11 @@ func (a DynamicAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a DynamicAttribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // DynamicDefaultValue returns the Default field value.
 func (a DynamicAttribute) DynamicDefaultValue() defaults.Dynamic {
 	return a.Default
@@ -235,6 +249,10 @@ func (a DynamicAttribute) DynamicValidators() []validator.Dynamic {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a DynamicAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsWriteOnly() && a.IsComputed() {
+		resp.Diagnostics.Append(writeOnlyComputedAttributeDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.DynamicDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -40,3 +40,8 @@ func (d staticValueDefault) MarkdownDescription(_ context.Context) string {
 func (d staticValueDefault) DefaultDynamic(_ context.Context, req defaults.DynamicRequest, resp *defaults.DynamicResponse) {
 	resp.PlanValue = d.defaultVal
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticValueDefault) IsStatic() bool {
+	return true
+}
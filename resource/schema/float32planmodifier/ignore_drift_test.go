@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIgnoreDriftModifierPlanModifyFloat32(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Float32Request
+		expected *planmodifier.Float32Response
+	}{
+		"create": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(2.4),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(2.4),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Float32Null(),
+				State:      testState,
+				StateValue: types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"state-null": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(2.4),
+				State:       testState,
+				StateValue:  types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(2.4),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Unknown(),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Unknown(),
+			},
+		},
+		"config-equal": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(1.2),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"config-different": {
+			// this is the situation where drift is ignored
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(2.4),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"config-null": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Unknown(),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float32planmodifier.IgnoreDrift().PlanModifyFloat32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// PreserveStateWhenConfigNull returns a plan modifier that copies the prior
+// state value, including a null value, into the planned value when the
+// configuration value is null. Use this for Optional and Computed attributes
+// which should keep their prior value when not configured, such as after
+// being removed from the configuration.
+//
+// Unlike UseStateForUnknown, which only replaces unknown planned values with
+// known prior state values, this plan modifier also preserves a null prior
+// state value. It does nothing when the resource is being created or
+// destroyed, when the configuration value is not null, or when the planned
+// value was changed to a known value by a default or prior plan modifier.
+func PreserveStateWhenConfigNull() planmodifier.Float32 {
+	return preserveStateWhenConfigNullModifier{}
+}
+
+// preserveStateWhenConfigNullModifier implements the plan modifier.
+type preserveStateWhenConfigNullModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m preserveStateWhenConfigNullModifier) Description(_ context.Context) string {
+	return "If not configured, the value of this attribute in state will not change."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m preserveStateWhenConfigNullModifier) MarkdownDescription(_ context.Context) string {
+	return "If not configured, the value of this attribute in state will not change."
+}
+
+// PlanModifyFloat32 implements the plan modification logic.
+func (m preserveStateWhenConfigNullModifier) PlanModifyFloat32(ctx context.Context, req planmodifier.Float32Request, resp *planmodifier.Float32Response) {
+	// Do nothing on resource creation or destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a configuration value, including unknown values.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	// Do nothing if the planned value was changed to a known value, such as
+	// by a default or prior plan modifier.
+	if !req.PlanValue.IsUnknown() && !req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
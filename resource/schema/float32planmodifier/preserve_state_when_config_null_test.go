@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPreserveStateWhenConfigNullModifierPlanModifyFloat32(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Float32Request
+		expected *planmodifier.Float32Response
+	}{
+		"create": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Unknown(),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Unknown(),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Float32Null(),
+				State:      testState,
+				StateValue: types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"config-known": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(2.4),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(2.4),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Unknown(),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Unknown(),
+			},
+		},
+		"config-null-plan-unknown-state-known": {
+			// this is the situation where the configuration value was
+			// previously set and has been removed
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Unknown(),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"config-null-plan-unknown-state-null": {
+			// unlike UseStateForUnknown, a null state value is preserved
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Unknown(),
+				State:       testState,
+				StateValue:  types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"config-null-plan-state": {
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(1.2),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"config-null-plan-modified": {
+			// a default or prior plan modifier changed the planned value
+			request: planmodifier.Float32Request{
+				ConfigValue: types.Float32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(2.4),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(2.4),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float32planmodifier.PreserveStateWhenConfigNull().PlanModifyFloat32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRejectUpdateModifierPlanModifyFloat32(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Float32Request
+		expected *planmodifier.Float32Response
+	}{
+		"create": {
+			request: planmodifier.Float32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float32Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(1.2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Float32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float32Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Float32Null(),
+				State:      testState,
+				StateValue: types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.Float32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float32Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(1.2),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"planvalue-statevalue-different": {
+			request: planmodifier.Float32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float32Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(2.4),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Float32Value(2.4),
+			},
+		},
+		"planvalue-null-statevalue-known": {
+			request: planmodifier.Float32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Null(),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Float32Null(),
+			},
+		},
+		"planvalue-known-statevalue-null": {
+			request: planmodifier.Float32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float32Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Value(1.2),
+				State:       testState,
+				StateValue:  types.Float32Null(),
+			},
+			expected: &planmodifier.Float32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Float32Value(1.2),
+			},
+		},
+		"planvalue-unknown": {
+			request: planmodifier.Float32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float32Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Float32Unknown(),
+				State:       testState,
+				StateValue:  types.Float32Value(1.2),
+			},
+			expected: &planmodifier.Float32Response{
+				PlanValue: types.Float32Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float32planmodifier.RejectUpdate().PlanModifyFloat32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
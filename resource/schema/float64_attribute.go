@@ -71,6 +71,15 @@ type Float64Attribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that the practitioner configuration value of this
+	// Attribute is only available during resource operations, such as via
+	// the Config field of the CreateRequest and UpdateRequest types, and is
+	// never stored in the plan or state. WriteOnly cannot be combined with
+	// Computed.
+	//
+	// Write-only attributes require Terraform 1.11 and later.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -232,11 +241,20 @@ func (a Float64Attribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a Float64Attribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ValidateImplementation contains logic for validating the
 // provider-defined implementation of the attribute to prevent unexpected
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a Float64Attribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsWriteOnly() && a.IsComputed() {
+		resp.Diagnostics.Append(writeOnlyComputedAttributeDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.Float64DefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
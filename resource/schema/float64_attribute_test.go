@@ -511,6 +511,40 @@ func TestFloat64AttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeIsWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  bool
+	}{
+		"not-write-only": {
+			attribute: schema.Float64Attribute{},
+			expected:  false,
+		},
+		"write-only": {
+			attribute: schema.Float64Attribute{
+				WriteOnly: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsWriteOnly()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeValidateImplementation(t *testing.T) {
 	t.Parallel()
 
@@ -40,3 +40,8 @@ func (d staticFloat64Default) MarkdownDescription(_ context.Context) string {
 func (d staticFloat64Default) DefaultFloat64(_ context.Context, req defaults.Float64Request, resp *defaults.Float64Response) {
 	resp.PlanValue = types.Float64Value(d.defaultVal)
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticFloat64Default) IsStatic() bool {
+	return true
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIgnoreDriftModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Float64Request
+		expected *planmodifier.Float64Response
+	}{
+		"create": {
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(2.4),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(2.4),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Float64Null(),
+				State:      testState,
+				StateValue: types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+		"state-null": {
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(2.4),
+				State:       testState,
+				StateValue:  types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(2.4),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Unknown(),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+		"config-equal": {
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(1.2),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"config-different": {
+			// this is the situation where drift is ignored
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(2.4),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"config-null": {
+			request: planmodifier.Float64Request{
+				ConfigValue: types.Float64Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Unknown(),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float64planmodifier.IgnoreDrift().PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
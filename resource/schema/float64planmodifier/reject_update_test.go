@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package float64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRejectUpdateModifierPlanModifyFloat64(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Float64Request
+		expected *planmodifier.Float64Response
+	}{
+		"create": {
+			request: planmodifier.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(1.2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Float64Null(),
+				State:      testState,
+				StateValue: types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Null(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(1.2),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"planvalue-statevalue-different": {
+			request: planmodifier.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(2.4),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(2.4),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Float64Value(2.4),
+			},
+		},
+		"planvalue-null-statevalue-known": {
+			request: planmodifier.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Null(),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Float64Null(),
+			},
+		},
+		"planvalue-known-statevalue-null": {
+			request: planmodifier.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Value(1.2),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Value(1.2),
+				State:       testState,
+				StateValue:  types.Float64Null(),
+			},
+			expected: &planmodifier.Float64Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Float64Value(1.2),
+			},
+		},
+		"planvalue-unknown": {
+			request: planmodifier.Float64Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Float64Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Float64Unknown(),
+				State:       testState,
+				StateValue:  types.Float64Value(1.2),
+			},
+			expected: &planmodifier.Float64Response{
+				PlanValue: types.Float64Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Float64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			float64planmodifier.RejectUpdate().PlanModifyFloat64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
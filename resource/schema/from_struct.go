@@ -0,0 +1,594 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// FromStruct returns a Schema with Attributes inferred from the `tfsdk` and
+// `tfschema` field tags and Go types of the given struct value. This enables
+// simple schemas to be declared once with the Go model struct, rather than
+// duplicating every attribute. Description, validator, plan modifier, and
+// default fields can be set on the returned Schema afterwards.
+//
+// The `tfsdk` field tag sets the attribute name, as with the tfsdk.Config,
+// tfsdk.Plan, and tfsdk.State Get methods. Fields tagged with `tfsdk:"-"` and
+// unexported fields are ignored. The optional `tfschema` field tag is a comma
+// separated list of the following options:
+//
+//   - computed, which sets the attribute Computed field
+//   - optional, which sets the attribute Optional field
+//   - required, which sets the attribute Required field
+//   - sensitive, which sets the attribute Sensitive field
+//
+// If none of computed, optional, or required is given, the attribute is
+// Optional. The required option cannot be combined with computed or optional.
+//
+// The following Go types are supported for struct fields:
+//
+//   - Framework primitive value types, such as [types.String], and custom
+//     value types based on them, which become the associated attribute type,
+//     such as [StringAttribute], with CustomType set for custom value types
+//   - bool, which becomes [BoolAttribute]
+//   - int32, which becomes [Int32Attribute]
+//   - Other integer types, which become [Int64Attribute]
+//   - float32, which becomes [Float32Attribute]
+//   - float64, which becomes [Float64Attribute]
+//   - *big.Float and *big.Int, which become [NumberAttribute]
+//   - string, which becomes [StringAttribute]
+//   - Structs, which become [SingleNestedAttribute]
+//   - Slices of structs, which become [ListNestedAttribute]
+//   - Slices of other supported types, which become [ListAttribute]
+//   - Maps with string keys and struct values, which become
+//     [MapNestedAttribute]
+//   - Maps with string keys and other supported value types, which become
+//     [MapAttribute]
+//   - Pointers to supported types, which become the type of the element
+//
+// Framework collection and object value types, such as [types.List], cannot
+// be used as their element or attribute types are not known. Blocks are not
+// supported. Define the attribute directly for those cases.
+func FromStruct(value any) (Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil {
+		diags.AddError(
+			"Invalid Schema Struct",
+			"An unexpected error was encountered trying to build a schema from a Go struct. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Received nil value, expected struct.",
+		)
+
+		return Schema{}, diags
+	}
+
+	typ := reflect.TypeOf(value)
+
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct {
+		diags.AddError(
+			"Invalid Schema Struct",
+			"An unexpected error was encountered trying to build a schema from a Go struct. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Received %s, expected struct.", typ),
+		)
+
+		return Schema{}, diags
+	}
+
+	attributes, diags := attributesFromGoStruct(context.Background(), typ, path.Empty())
+
+	if diags.HasError() {
+		return Schema{}, diags
+	}
+
+	return Schema{
+		Attributes: attributes,
+	}, diags
+}
+
+// fromStructOptions are the attribute options from the `tfschema` field tag.
+type fromStructOptions struct {
+	computed  bool
+	optional  bool
+	required  bool
+	sensitive bool
+}
+
+var (
+	attrValueReflectType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	bigFloatReflectType  = reflect.TypeOf(big.Float{})
+	bigIntReflectType    = reflect.TypeOf(big.Int{})
+)
+
+// attributesFromGoStruct returns the attributes for the fields of the given
+// Go struct type.
+func attributesFromGoStruct(ctx context.Context, typ reflect.Type, p path.Path) (map[string]Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := make(map[string]Attribute, typ.NumField())
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Tag.Get(`tfsdk`)
+
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			diags.Append(fromStructDiag(p, typ, fmt.Sprintf(`Missing "tfsdk" struct tag on field %s.`, field.Name)))
+
+			return nil, diags
+		}
+
+		if _, ok := attributes[name]; ok {
+			diags.Append(fromStructDiag(p, typ, fmt.Sprintf("Duplicate %q struct tag on field %s.", name, field.Name)))
+
+			return nil, diags
+		}
+
+		opts, optsDiags := fromStructOptionsFromTag(p.AtName(name), field)
+
+		diags.Append(optsDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attribute, attributeDiags := attributeFromGoType(ctx, field.Type, opts, p.AtName(name))
+
+		diags.Append(attributeDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attributes[name] = attribute
+	}
+
+	return attributes, diags
+}
+
+// fromStructOptionsFromTag returns the attribute options from the `tfschema`
+// field tag.
+func fromStructOptionsFromTag(p path.Path, field reflect.StructField) (fromStructOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var opts fromStructOptions
+
+	tag, ok := field.Tag.Lookup(`tfschema`)
+
+	if ok && tag != "" {
+		for _, option := range strings.Split(tag, ",") {
+			switch strings.TrimSpace(option) {
+			case "computed":
+				opts.computed = true
+			case "optional":
+				opts.optional = true
+			case "required":
+				opts.required = true
+			case "sensitive":
+				opts.sensitive = true
+			default:
+				diags.Append(fromStructDiag(p, field.Type, fmt.Sprintf("Unknown %q struct tag option %q on field %s.", "tfschema", option, field.Name)))
+
+				return opts, diags
+			}
+		}
+	}
+
+	if opts.required && (opts.computed || opts.optional) {
+		diags.Append(fromStructDiag(p, field.Type, fmt.Sprintf("The required option cannot be combined with computed or optional on field %s.", field.Name)))
+
+		return opts, diags
+	}
+
+	if !opts.computed && !opts.optional && !opts.required {
+		opts.optional = true
+	}
+
+	return opts, diags
+}
+
+// attributeFromGoType returns the Attribute equivalent of the given Go type.
+func attributeFromGoType(ctx context.Context, typ reflect.Type, opts fromStructOptions, p path.Path) (Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typ.Kind() != reflect.Pointer && typ.Kind() != reflect.Interface && typ.Implements(attrValueReflectType) {
+		return attributeFromAttrValueType(ctx, typ, opts, p)
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		if typ.Elem() == bigFloatReflectType || typ.Elem() == bigIntReflectType {
+			return NumberAttribute{
+				Computed:  opts.computed,
+				Optional:  opts.optional,
+				Required:  opts.required,
+				Sensitive: opts.sensitive,
+			}, diags
+		}
+
+		return attributeFromGoType(ctx, typ.Elem(), opts, p)
+	case reflect.Bool:
+		return BoolAttribute{
+			Computed:  opts.computed,
+			Optional:  opts.optional,
+			Required:  opts.required,
+			Sensitive: opts.sensitive,
+		}, diags
+	case reflect.Int32:
+		return Int32Attribute{
+			Computed:  opts.computed,
+			Optional:  opts.optional,
+			Required:  opts.required,
+			Sensitive: opts.sensitive,
+		}, diags
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Int64Attribute{
+			Computed:  opts.computed,
+			Optional:  opts.optional,
+			Required:  opts.required,
+			Sensitive: opts.sensitive,
+		}, diags
+	case reflect.Float32:
+		return Float32Attribute{
+			Computed:  opts.computed,
+			Optional:  opts.optional,
+			Required:  opts.required,
+			Sensitive: opts.sensitive,
+		}, diags
+	case reflect.Float64:
+		return Float64Attribute{
+			Computed:  opts.computed,
+			Optional:  opts.optional,
+			Required:  opts.required,
+			Sensitive: opts.sensitive,
+		}, diags
+	case reflect.String:
+		return StringAttribute{
+			Computed:  opts.computed,
+			Optional:  opts.optional,
+			Required:  opts.required,
+			Sensitive: opts.sensitive,
+		}, diags
+	case reflect.Struct:
+		if _, ok := goStructType(typ); !ok {
+			break
+		}
+
+		attributes, attributesDiags := attributesFromGoStruct(ctx, typ, p)
+
+		diags.Append(attributesDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return SingleNestedAttribute{
+			Attributes: attributes,
+			Computed:   opts.computed,
+			Optional:   opts.optional,
+			Required:   opts.required,
+			Sensitive:  opts.sensitive,
+		}, diags
+	case reflect.Array, reflect.Slice:
+		if nestedType, ok := goStructType(typ.Elem()); ok {
+			attributes, attributesDiags := attributesFromGoStruct(ctx, nestedType, p.AtListIndex(0))
+
+			diags.Append(attributesDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			return ListNestedAttribute{
+				NestedObject: NestedAttributeObject{
+					Attributes: attributes,
+				},
+				Computed:  opts.computed,
+				Optional:  opts.optional,
+				Required:  opts.required,
+				Sensitive: opts.sensitive,
+			}, diags
+		}
+
+		elemType, elemDiags := attrTypeFromGoType(ctx, typ.Elem(), p.AtListIndex(0))
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return ListAttribute{
+			ElementType: elemType,
+			Computed:    opts.computed,
+			Optional:    opts.optional,
+			Required:    opts.required,
+			Sensitive:   opts.sensitive,
+		}, diags
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			diags.Append(fromStructDiag(p, typ, "Map keys must be strings."))
+
+			return nil, diags
+		}
+
+		if nestedType, ok := goStructType(typ.Elem()); ok {
+			attributes, attributesDiags := attributesFromGoStruct(ctx, nestedType, p.AtMapKey("*"))
+
+			diags.Append(attributesDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			return MapNestedAttribute{
+				NestedObject: NestedAttributeObject{
+					Attributes: attributes,
+				},
+				Computed:  opts.computed,
+				Optional:  opts.optional,
+				Required:  opts.required,
+				Sensitive: opts.sensitive,
+			}, diags
+		}
+
+		elemType, elemDiags := attrTypeFromGoType(ctx, typ.Elem(), p.AtMapKey("*"))
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return MapAttribute{
+			ElementType: elemType,
+			Computed:    opts.computed,
+			Optional:    opts.optional,
+			Required:    opts.required,
+			Sensitive:   opts.sensitive,
+		}, diags
+	}
+
+	diags.Append(fromStructDiag(p, typ, "Unsupported Go type."))
+
+	return nil, diags
+}
+
+// attributeFromAttrValueType returns the Attribute equivalent of the given
+// framework value type. Custom types are set as the CustomType.
+func attributeFromAttrValueType(ctx context.Context, typ reflect.Type, opts fromStructOptions, p path.Path) (Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	value, _ := reflect.Zero(typ).Interface().(attr.Value)
+	attrType := value.Type(ctx)
+
+	switch t := attrType.(type) {
+	case basetypes.BoolTypable:
+		a := BoolAttribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.BoolType); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	case basetypes.DynamicTypable:
+		a := DynamicAttribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.DynamicType); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	case basetypes.Float32Typable:
+		a := Float32Attribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.Float32Type); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	case basetypes.Float64Typable:
+		a := Float64Attribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.Float64Type); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	case basetypes.Int32Typable:
+		a := Int32Attribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.Int32Type); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	case basetypes.Int64Typable:
+		a := Int64Attribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.Int64Type); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	case basetypes.NumberTypable:
+		a := NumberAttribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.NumberType); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	case basetypes.StringTypable:
+		a := StringAttribute{Computed: opts.computed, Optional: opts.optional, Required: opts.required, Sensitive: opts.sensitive}
+
+		if _, ok := t.(basetypes.StringType); !ok {
+			a.CustomType = t
+		}
+
+		return a, diags
+	}
+
+	diags.Append(fromStructDiag(p, typ, "Framework collection and object value types cannot be inferred. Define the attribute directly instead."))
+
+	return nil, diags
+}
+
+// attrTypeFromGoType returns the attr.Type equivalent of the given Go type,
+// which is used for collection element types.
+func attrTypeFromGoType(ctx context.Context, typ reflect.Type, p path.Path) (attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if typ.Kind() != reflect.Pointer && typ.Kind() != reflect.Interface && typ.Implements(attrValueReflectType) {
+		value, _ := reflect.Zero(typ).Interface().(attr.Value)
+		attrType := value.Type(ctx)
+
+		switch attrType.(type) {
+		case attr.TypeWithAttributeTypes, attr.TypeWithElementType, attr.TypeWithElementTypes:
+			diags.Append(fromStructDiag(p, typ, "Framework collection and object value types cannot be inferred. Define the attribute directly instead."))
+
+			return nil, diags
+		}
+
+		return attrType, diags
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		if typ.Elem() == bigFloatReflectType || typ.Elem() == bigIntReflectType {
+			return basetypes.NumberType{}, diags
+		}
+
+		return attrTypeFromGoType(ctx, typ.Elem(), p)
+	case reflect.Bool:
+		return basetypes.BoolType{}, diags
+	case reflect.Int32:
+		return basetypes.Int32Type{}, diags
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return basetypes.Int64Type{}, diags
+	case reflect.Float32:
+		return basetypes.Float32Type{}, diags
+	case reflect.Float64:
+		return basetypes.Float64Type{}, diags
+	case reflect.String:
+		return basetypes.StringType{}, diags
+	case reflect.Array, reflect.Slice:
+		elemType, elemDiags := attrTypeFromGoType(ctx, typ.Elem(), p.AtListIndex(0))
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return basetypes.ListType{ElemType: elemType}, diags
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			diags.Append(fromStructDiag(p, typ, "Map keys must be strings."))
+
+			return nil, diags
+		}
+
+		elemType, elemDiags := attrTypeFromGoType(ctx, typ.Elem(), p.AtMapKey("*"))
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return basetypes.MapType{ElemType: elemType}, diags
+	case reflect.Struct:
+		attrTypes := make(map[string]attr.Type, typ.NumField())
+
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			name := field.Tag.Get(`tfsdk`)
+
+			if name == "-" {
+				continue
+			}
+
+			if name == "" {
+				diags.Append(fromStructDiag(p, typ, fmt.Sprintf(`Missing "tfsdk" struct tag on field %s.`, field.Name)))
+
+				return nil, diags
+			}
+
+			if _, ok := attrTypes[name]; ok {
+				diags.Append(fromStructDiag(p, typ, fmt.Sprintf("Duplicate %q struct tag on field %s.", name, field.Name)))
+
+				return nil, diags
+			}
+
+			attrType, attrTypeDiags := attrTypeFromGoType(ctx, field.Type, p.AtName(name))
+
+			diags.Append(attrTypeDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			attrTypes[name] = attrType
+		}
+
+		return basetypes.ObjectType{AttrTypes: attrTypes}, diags
+	}
+
+	diags.Append(fromStructDiag(p, typ, "Unsupported Go type."))
+
+	return nil, diags
+}
+
+// goStructType returns the underlying struct type of the given Go type, if it
+// is a struct or pointer to a struct which is not a framework value type.
+func goStructType(typ reflect.Type) (reflect.Type, bool) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ.Implements(attrValueReflectType) || typ == bigFloatReflectType || typ == bigIntReflectType {
+		return nil, false
+	}
+
+	return typ, true
+}
+
+func fromStructDiag(p path.Path, typ reflect.Type, detail string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Invalid Schema Struct",
+		"An unexpected error was encountered trying to build a schema from a Go struct. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Cannot infer schema attribute from Go type %s. %s", typ, detail),
+	)
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testtypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromStruct(t *testing.T) {
+	t.Parallel()
+
+	type nestedStruct struct {
+		Name types.String `tfsdk:"name" tfschema:"required"`
+	}
+
+	testCases := map[string]struct {
+		value         any
+		expected      schema.Schema
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			value: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received nil value, expected struct.",
+				),
+			},
+		},
+		"not-struct": {
+			value: "test",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received string, expected struct.",
+				),
+			},
+		},
+		"framework-types": {
+			value: struct {
+				Bool    types.Bool    `tfsdk:"bool"`
+				Dynamic types.Dynamic `tfsdk:"dynamic"`
+				Float32 types.Float32 `tfsdk:"float32"`
+				Float64 types.Float64 `tfsdk:"float64"`
+				Int32   types.Int32   `tfsdk:"int32"`
+				Int64   types.Int64   `tfsdk:"int64"`
+				Number  types.Number  `tfsdk:"number"`
+				String  types.String  `tfsdk:"string"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"bool":    schema.BoolAttribute{Optional: true},
+					"dynamic": schema.DynamicAttribute{Optional: true},
+					"float32": schema.Float32Attribute{Optional: true},
+					"float64": schema.Float64Attribute{Optional: true},
+					"int32":   schema.Int32Attribute{Optional: true},
+					"int64":   schema.Int64Attribute{Optional: true},
+					"number":  schema.NumberAttribute{Optional: true},
+					"string":  schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+		"framework-custom-type": {
+			value: struct {
+				String testtypes.StringValueWithSemanticEquals `tfsdk:"string"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{
+						CustomType: testtypes.StringTypeWithSemanticEquals{},
+						Optional:   true,
+					},
+				},
+			},
+		},
+		"go-types": {
+			value: &struct {
+				Bool     bool       `tfsdk:"bool"`
+				BigFloat *big.Float `tfsdk:"big_float"`
+				Float32  float32    `tfsdk:"float32"`
+				Float64  float64    `tfsdk:"float64"`
+				Int      int        `tfsdk:"int"`
+				Int32    int32      `tfsdk:"int32"`
+				Pointer  *string    `tfsdk:"pointer"`
+				String   string     `tfsdk:"string"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"bool":      schema.BoolAttribute{Optional: true},
+					"big_float": schema.NumberAttribute{Optional: true},
+					"float32":   schema.Float32Attribute{Optional: true},
+					"float64":   schema.Float64Attribute{Optional: true},
+					"int":       schema.Int64Attribute{Optional: true},
+					"int32":     schema.Int32Attribute{Optional: true},
+					"pointer":   schema.StringAttribute{Optional: true},
+					"string":    schema.StringAttribute{Optional: true},
+				},
+			},
+		},
+		"collections": {
+			value: struct {
+				List []types.String     `tfsdk:"list"`
+				Map  map[string][]int64 `tfsdk:"map"`
+				Skip string             `tfsdk:"-"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"map": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.Int64Type},
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"nested": {
+			value: struct {
+				List   []nestedStruct          `tfsdk:"list"`
+				Map    map[string]nestedStruct `tfsdk:"map"`
+				Single *nestedStruct           `tfsdk:"single" tfschema:"computed"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"map": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"single": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{Required: true},
+						},
+						Computed: true,
+					},
+				},
+			},
+		},
+		"options": {
+			value: struct {
+				Computed         types.String `tfsdk:"computed" tfschema:"computed"`
+				OptionalComputed types.String `tfsdk:"optional_computed" tfschema:"optional,computed"`
+				Required         types.String `tfsdk:"required" tfschema:"required"`
+				Sensitive        types.String `tfsdk:"sensitive" tfschema:"required, sensitive"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"computed":          schema.StringAttribute{Computed: true},
+					"optional_computed": schema.StringAttribute{Computed: true, Optional: true},
+					"required":          schema.StringAttribute{Required: true},
+					"sensitive":         schema.StringAttribute{Required: true, Sensitive: true},
+				},
+			},
+		},
+		"option-conflict": {
+			value: struct {
+				String types.String `tfsdk:"string" tfschema:"required,computed"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("string"),
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer schema attribute from Go type basetypes.StringValue. The required option cannot be combined with computed or optional on field String.",
+				),
+			},
+		},
+		"option-unknown": {
+			value: struct {
+				String types.String `tfsdk:"string" tfschema:"write_only"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("string"),
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Cannot infer schema attribute from Go type basetypes.StringValue. Unknown "tfschema" struct tag option "write_only" on field String.`,
+				),
+			},
+		},
+		"missing-tag": {
+			value: struct {
+				String types.String
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Cannot infer schema attribute from Go type struct { String basetypes.StringValue }. Missing "tfsdk" struct tag on field String.`,
+				),
+			},
+		},
+		"framework-collection": {
+			value: struct {
+				Nested struct {
+					List types.List `tfsdk:"list"`
+				} `tfsdk:"nested"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("nested").AtName("list"),
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer schema attribute from Go type basetypes.ListValue. Framework collection and object value types cannot be inferred. Define the attribute directly instead.",
+				),
+			},
+		},
+		"unsupported-map-key": {
+			value: struct {
+				Map map[int]string `tfsdk:"map"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("map"),
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer schema attribute from Go type map[int]string. Map keys must be strings.",
+				),
+			},
+		},
+		"unsupported-type": {
+			value: struct {
+				Func func() `tfsdk:"func"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("func"),
+					"Invalid Schema Struct",
+					"An unexpected error was encountered trying to build a schema from a Go struct. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot infer schema attribute from Go type func(). Unsupported Go type.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schema.FromStruct(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// IgnoreDrift returns a plan modifier that copies a non-null prior state
+// value into the planned value whenever the configuration value differs,
+// suppressing any difference between the configuration and state. Use this
+// for Optional and Computed attributes whose value is maintained by the
+// remote system after it is first set.
+//
+// Unlike UseStateForUnknown, which only replaces unknown planned values, this
+// plan modifier also replaces known planned values and makes the attribute
+// configuration inert once the attribute has a value in state. Changing the
+// configuration value will never cause an update, which may be surprising to
+// practitioners, so this plan modifier should be used sparingly and noted in
+// the attribute description. Terraform only allows the planned value to
+// differ from a configured value when the attribute is Computed.
+//
+// It does nothing when the resource is being created or destroyed, when the
+// prior state value is null, or when the configuration value is unknown.
+func IgnoreDrift() planmodifier.Int32 {
+	return ignoreDriftModifier{}
+}
+
+// ignoreDriftModifier implements the plan modifier.
+type ignoreDriftModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m ignoreDriftModifier) Description(_ context.Context) string {
+	return "Once set, changes to the configuration of this attribute are ignored and the value in state will not change."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m ignoreDriftModifier) MarkdownDescription(_ context.Context) string {
+	return "Once set, changes to the configuration of this attribute are ignored and the value in state will not change."
+}
+
+// PlanModifyInt32 implements the plan modification logic.
+func (m ignoreDriftModifier) PlanModifyInt32(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+	// Do nothing on resource creation or destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is no state value.
+	if req.StateValue.IsNull() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
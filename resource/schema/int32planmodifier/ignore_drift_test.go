@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIgnoreDriftModifierPlanModifyInt32(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Int32Request
+		expected *planmodifier.Int32Response
+	}{
+		"create": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Int32Null(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(2),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Int32Null(),
+				State:      testState,
+				StateValue: types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Null(),
+			},
+		},
+		"state-null": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(2),
+				State:       testState,
+				StateValue:  types.Int32Null(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(2),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Unknown(),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Unknown(),
+			},
+		},
+		"config-equal": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Value(1),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(1),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"config-different": {
+			// this is the situation where drift is ignored
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(2),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"config-null": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Unknown(),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			int32planmodifier.IgnoreDrift().PlanModifyInt32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPreserveStateWhenConfigNullModifierPlanModifyInt32(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Int32Request
+		expected *planmodifier.Int32Response
+	}{
+		"create": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Unknown(),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Int32Null(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Unknown(),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Int32Null(),
+				State:      testState,
+				StateValue: types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Null(),
+			},
+		},
+		"config-known": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(2),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(2),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Unknown(),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Unknown(),
+			},
+		},
+		"config-null-plan-unknown-state-known": {
+			// this is the situation where the configuration value was
+			// previously set and has been removed
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Unknown(),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"config-null-plan-unknown-state-null": {
+			// unlike UseStateForUnknown, a null state value is preserved
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Unknown(),
+				State:       testState,
+				StateValue:  types.Int32Null(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Null(),
+			},
+		},
+		"config-null-plan-state": {
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(1),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"config-null-plan-modified": {
+			// a default or prior plan modifier changed the planned value
+			request: planmodifier.Int32Request{
+				ConfigValue: types.Int32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(2),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(2),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			int32planmodifier.PreserveStateWhenConfigNull().PlanModifyInt32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
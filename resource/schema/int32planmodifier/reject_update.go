@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32planmodifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// RejectUpdate returns a plan modifier that raises an error diagnostic if
+// the resource is being updated and the planned value does not match the
+// prior state value. Use this for attributes which can only be set when the
+// resource is created, such as remote API fields which cannot be changed
+// afterwards, where resource replacement via RequiresReplace is undesirable.
+//
+// This plan modifier does nothing when the resource is being created or
+// destroyed, or when the planned value is unknown, such as a configuration
+// value referencing another unknown value or a Computed attribute without a
+// configuration value. Unknown planned values should be avoided by also
+// using a plan modifier such as UseStateForUnknown, if appropriate.
+func RejectUpdate() planmodifier.Int32 {
+	return rejectUpdateModifier{}
+}
+
+// rejectUpdateModifier implements the plan modifier.
+type rejectUpdateModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m rejectUpdateModifier) Description(_ context.Context) string {
+	return "The value of this attribute can only be set when the resource is created and cannot be updated."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m rejectUpdateModifier) MarkdownDescription(_ context.Context) string {
+	return "The value of this attribute can only be set when the resource is created and cannot be updated."
+}
+
+// PlanModifyInt32 implements the plan modification logic.
+func (m rejectUpdateModifier) PlanModifyInt32(ctx context.Context, req planmodifier.Int32Request, resp *planmodifier.Int32Response) {
+	// Do nothing on resource creation or destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if the planned value cannot be compared yet.
+	if req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Attribute Cannot Be Updated",
+		"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+			"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+	)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int32planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRejectUpdateModifierPlanModifyInt32(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Int32Request
+		expected *planmodifier.Int32Response
+	}{
+		"create": {
+			request: planmodifier.Int32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int32Value(1),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(1),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Int32Null(),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Int32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int32Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Int32Null(),
+				State:      testState,
+				StateValue: types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Null(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.Int32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int32Value(1),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(1),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"planvalue-statevalue-different": {
+			request: planmodifier.Int32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int32Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(2),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Int32Value(2),
+			},
+		},
+		"planvalue-null-statevalue-known": {
+			request: planmodifier.Int32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int32Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Null(),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Int32Null(),
+			},
+		},
+		"planvalue-known-statevalue-null": {
+			request: planmodifier.Int32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int32Value(1),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Value(1),
+				State:       testState,
+				StateValue:  types.Int32Null(),
+			},
+			expected: &planmodifier.Int32Response{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.Int32Value(1),
+			},
+		},
+		"planvalue-unknown": {
+			request: planmodifier.Int32Request{
+				Path:        path.Root("test"),
+				ConfigValue: types.Int32Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Int32Unknown(),
+				State:       testState,
+				StateValue:  types.Int32Value(1),
+			},
+			expected: &planmodifier.Int32Response{
+				PlanValue: types.Int32Unknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int32Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			int32planmodifier.RejectUpdate().PlanModifyInt32(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -40,3 +40,8 @@ func (d staticInt64Default) MarkdownDescription(_ context.Context) string {
 func (d staticInt64Default) DefaultInt64(_ context.Context, req defaults.Int64Request, resp *defaults.Int64Response) {
 	resp.PlanValue = types.Int64Value(d.defaultVal)
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticInt64Default) IsStatic() bool {
+	return true
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package int64planmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIgnoreDriftModifierPlanModifyInt64(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.Int64Request
+		expected *planmodifier.Int64Response
+	}{
+		"create": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int64Value(2),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.Int64Null(),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(2),
+			},
+		},
+		"destroy": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Null(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.Int64Null(),
+				State:      testState,
+				StateValue: types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Null(),
+			},
+		},
+		"state-null": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int64Value(2),
+				State:       testState,
+				StateValue:  types.Int64Null(),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(2),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Unknown(),
+				Plan:        testPlan,
+				PlanValue:   types.Int64Unknown(),
+				State:       testState,
+				StateValue:  types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Unknown(),
+			},
+		},
+		"config-equal": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Value(1),
+				Plan:        testPlan,
+				PlanValue:   types.Int64Value(1),
+				State:       testState,
+				StateValue:  types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+		"config-different": {
+			// this is the situation where drift is ignored
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Value(2),
+				Plan:        testPlan,
+				PlanValue:   types.Int64Value(2),
+				State:       testState,
+				StateValue:  types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+		"config-null": {
+			request: planmodifier.Int64Request{
+				ConfigValue: types.Int64Null(),
+				Plan:        testPlan,
+				PlanValue:   types.Int64Unknown(),
+				State:       testState,
+				StateValue:  types.Int64Value(1),
+			},
+			expected: &planmodifier.Int64Response{
+				PlanValue: types.Int64Value(1),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.Int64Response{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			int64planmodifier.IgnoreDrift().PlanModifyInt64(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ var (
 	_ fwschema.AttributeWithListDefaultValue       = ListAttribute{}
 	_ fwxschema.AttributeWithListPlanModifiers     = ListAttribute{}
 	_ fwxschema.AttributeWithListValidators        = ListAttribute{}
+	_ fwxschema.AttributeWithElementValidators     = ListAttribute{}
 )
 
 // ListAttribute represents a schema attribute that is a list with a single
@@ -84,6 +85,15 @@ type ListAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that the practitioner configuration value of this
+	// Attribute is only available during resource operations, such as via
+	// the Config field of the CreateRequest and UpdateRequest types, and is
+	// never stored in the plan or state. WriteOnly cannot be combined with
+	// Computed.
+	//
+	// Write-only attributes require Terraform 1.11 and later.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -144,6 +154,18 @@ type ListAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.List
 
+	// ElementValidators define value validation functionality for each
+	// element of the attribute. All elements of the slice are run against
+	// each known, non-null element, regardless of any previous error
+	// diagnostics. Each validator must implement the validator interface
+	// matching the element type, such as validator.String for an ElementType
+	// of types.StringType, otherwise an error diagnostic is returned when the
+	// schema is validated.
+	//
+	// Response diagnostics should use the request Path, which includes the
+	// index of the element.
+	ElementValidators []validator.Describer
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -232,6 +254,11 @@ func (a ListAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a ListAttribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // ListDefaultValue returns the Default field value.
 func (a ListAttribute) ListDefaultValue() defaults.List {
 	return a.Default
@@ -242,6 +269,11 @@ func (a ListAttribute) ListPlanModifiers() []planmodifier.List {
 	return a.PlanModifiers
 }
 
+// GetElementValidators returns the ElementValidators field value.
+func (a ListAttribute) GetElementValidators() []validator.Describer {
+	return a.ElementValidators
+}
+
 // ListValidators returns the Validators field value.
 func (a ListAttribute) ListValidators() []validator.List {
 	return a.Validators
@@ -252,6 +284,10 @@ func (a ListAttribute) ListValidators() []validator.List {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a ListAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsWriteOnly() && a.IsComputed() {
+		resp.Diagnostics.Append(writeOnlyComputedAttributeDiag(req.Path))
+	}
+
 	if a.CustomType == nil && a.ElementType == nil {
 		resp.Diagnostics.Append(fwschema.AttributeMissingElementTypeDiag(req.Path))
 	}
@@ -260,6 +296,10 @@ func (a ListAttribute) ValidateImplementation(ctx context.Context, req fwschema.
 		resp.Diagnostics.Append(fwtype.AttributeCollectionWithDynamicTypeDiag(req.Path))
 	}
 
+	if elementType, ok := a.GetType().(attr.TypeWithElementType); ok {
+		resp.Diagnostics.Append(fwxschema.ValidateElementValidatorsImplementation(ctx, req.Path, elementType.ElementType(), a.GetElementValidators())...)
+	}
+
 	if a.ListDefaultValue() != nil {
 		if !a.IsComputed() {
 			resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
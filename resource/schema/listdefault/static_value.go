@@ -40,3 +40,8 @@ func (d staticValueDefault) MarkdownDescription(_ context.Context) string {
 func (d staticValueDefault) DefaultList(ctx context.Context, req defaults.ListRequest, resp *defaults.ListResponse) {
 	resp.PlanValue = d.defaultVal
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticValueDefault) IsStatic() bool {
+	return true
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package listplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPreserveStateWhenConfigNullModifierPlanModifyList(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.ListRequest
+		expected *planmodifier.ListResponse
+	}{
+		"create": {
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.ListUnknown(types.StringType),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"destroy": {
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListNull(types.StringType),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.ListNull(types.StringType),
+				State:      testState,
+				StateValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListNull(types.StringType),
+			},
+		},
+		"config-known": {
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				Plan:        testPlan,
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				State:       testState,
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListUnknown(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.ListUnknown(types.StringType),
+				State:       testState,
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListUnknown(types.StringType),
+			},
+		},
+		"config-null-plan-unknown-state-known": {
+			// this is the situation where the configuration value was
+			// previously set and has been removed
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.ListUnknown(types.StringType),
+				State:       testState,
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"config-null-plan-unknown-state-null": {
+			// unlike UseStateForUnknown, a null state value is preserved
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.ListUnknown(types.StringType),
+				State:       testState,
+				StateValue:  types.ListNull(types.StringType),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListNull(types.StringType),
+			},
+		},
+		"config-null-plan-state": {
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				State:       testState,
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"config-null-plan-modified": {
+			// a default or prior plan modifier changed the planned value
+			request: planmodifier.ListRequest{
+				ConfigValue: types.ListNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				State:       testState,
+				StateValue:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.ListResponse{
+				PlanValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ListResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			listplanmodifier.PreserveStateWhenConfigNull().PlanModifyList(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -40,3 +40,8 @@ func (d staticValueDefault) MarkdownDescription(_ context.Context) string {
 func (d staticValueDefault) DefaultMap(ctx context.Context, req defaults.MapRequest, resp *defaults.MapResponse) {
 	resp.PlanValue = d.defaultVal
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticValueDefault) IsStatic() bool {
+	return true
+}
@@ -72,6 +72,15 @@ type NumberAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that the practitioner configuration value of this
+	// Attribute is only available during resource operations, such as via
+	// the Config field of the CreateRequest and UpdateRequest types, and is
+	// never stored in the plan or state. WriteOnly cannot be combined with
+	// Computed.
+	//
+	// Write-only attributes require Terraform 1.11 and later.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -218,6 +227,11 @@ func (a NumberAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a NumberAttribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // NumberDefaultValue returns the Default field value.
 func (a NumberAttribute) NumberDefaultValue() defaults.Number {
 	return a.Default
@@ -238,6 +252,10 @@ func (a NumberAttribute) NumberValidators() []validator.Number {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a NumberAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsWriteOnly() && a.IsComputed() {
+		resp.Diagnostics.Append(writeOnlyComputedAttributeDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.NumberDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -398,6 +398,40 @@ func TestNumberAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestNumberAttributeIsWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.NumberAttribute
+		expected  bool
+	}{
+		"not-write-only": {
+			attribute: schema.NumberAttribute{},
+			expected:  false,
+		},
+		"write-only": {
+			attribute: schema.NumberAttribute{
+				WriteOnly: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsWriteOnly()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNumberAttributeNumberDefaultValue(t *testing.T) {
 	t.Parallel()
 
@@ -41,3 +41,8 @@ func (d staticBigFloatDefault) MarkdownDescription(_ context.Context) string {
 func (d staticBigFloatDefault) DefaultNumber(ctx context.Context, req defaults.NumberRequest, resp *defaults.NumberResponse) {
 	resp.PlanValue = types.NumberValue(d.defaultVal)
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticBigFloatDefault) IsStatic() bool {
+	return true
+}
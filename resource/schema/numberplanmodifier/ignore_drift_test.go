@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numberplanmodifier_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIgnoreDriftModifierPlanModifyNumber(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.NumberRequest
+		expected *planmodifier.NumberResponse
+	}{
+		"create": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberValue(big.NewFloat(2.4)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(2.4)),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(2.4)),
+			},
+		},
+		"destroy": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.NumberNull(),
+				State:      testState,
+				StateValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberNull(),
+			},
+		},
+		"state-null": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberValue(big.NewFloat(2.4)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(2.4)),
+				State:       testState,
+				StateValue:  types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(2.4)),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberUnknown(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberUnknown(),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"config-equal": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberValue(big.NewFloat(1.2)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(1.2)),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"config-different": {
+			// this is the situation where drift is ignored
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberValue(big.NewFloat(2.4)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(2.4)),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"config-null": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberUnknown(),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.NumberResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			numberplanmodifier.IgnoreDrift().PlanModifyNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
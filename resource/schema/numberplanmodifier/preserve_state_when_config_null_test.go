@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numberplanmodifier_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPreserveStateWhenConfigNullModifierPlanModifyNumber(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.NumberRequest
+		expected *planmodifier.NumberResponse
+	}{
+		"create": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberUnknown(),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"destroy": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.NumberNull(),
+				State:      testState,
+				StateValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberNull(),
+			},
+		},
+		"config-known": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberValue(big.NewFloat(2.4)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(2.4)),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(2.4)),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberUnknown(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberUnknown(),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+		"config-null-plan-unknown-state-known": {
+			// this is the situation where the configuration value was
+			// previously set and has been removed
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberUnknown(),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"config-null-plan-unknown-state-null": {
+			// unlike UseStateForUnknown, a null state value is preserved
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberUnknown(),
+				State:       testState,
+				StateValue:  types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberNull(),
+			},
+		},
+		"config-null-plan-state": {
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(1.2)),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"config-null-plan-modified": {
+			// a default or prior plan modifier changed the planned value
+			request: planmodifier.NumberRequest{
+				ConfigValue: types.NumberNull(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(2.4)),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(2.4)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.NumberResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			numberplanmodifier.PreserveStateWhenConfigNull().PlanModifyNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
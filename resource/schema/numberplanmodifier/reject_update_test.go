@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numberplanmodifier_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRejectUpdateModifierPlanModifyNumber(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.NumberRequest
+		expected *planmodifier.NumberResponse
+	}{
+		"create": {
+			request: planmodifier.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(1.2)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(1.2)),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"destroy": {
+			request: planmodifier.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberNull(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.NumberNull(),
+				State:      testState,
+				StateValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberNull(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(1.2)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(1.2)),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"planvalue-statevalue-different": {
+			request: planmodifier.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(2.4)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(2.4)),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.NumberValue(big.NewFloat(2.4)),
+			},
+		},
+		"planvalue-null-statevalue-known": {
+			request: planmodifier.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberNull(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberNull(),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.NumberNull(),
+			},
+		},
+		"planvalue-known-statevalue-null": {
+			request: planmodifier.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberValue(big.NewFloat(1.2)),
+				Plan:        testPlan,
+				PlanValue:   types.NumberValue(big.NewFloat(1.2)),
+				State:       testState,
+				StateValue:  types.NumberNull(),
+			},
+			expected: &planmodifier.NumberResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.NumberValue(big.NewFloat(1.2)),
+			},
+		},
+		"planvalue-unknown": {
+			request: planmodifier.NumberRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.NumberUnknown(),
+				Plan:        testPlan,
+				PlanValue:   types.NumberUnknown(),
+				State:       testState,
+				StateValue:  types.NumberValue(big.NewFloat(1.2)),
+			},
+			expected: &planmodifier.NumberResponse{
+				PlanValue: types.NumberUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.NumberResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			numberplanmodifier.RejectUpdate().PlanModifyNumber(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
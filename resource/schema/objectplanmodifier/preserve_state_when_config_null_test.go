@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package objectplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPreserveStateWhenConfigNullModifierPlanModifyObject(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.ObjectRequest
+		expected *planmodifier.ObjectResponse
+	}{
+		"create": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				Plan:        testPlan,
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
+			},
+		},
+		"destroy": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				State:      testState,
+				StateValue: types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+			},
+		},
+		"config-known": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("other")}),
+				Plan:        testPlan,
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("other")}),
+				State:       testState,
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("other")}),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
+				Plan:        testPlan,
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
+				State:       testState,
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
+			},
+		},
+		"config-null-plan-unknown-state-known": {
+			// this is the situation where the configuration value was
+			// previously set and has been removed
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				Plan:        testPlan,
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
+				State:       testState,
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+		},
+		"config-null-plan-unknown-state-null": {
+			// unlike UseStateForUnknown, a null state value is preserved
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				Plan:        testPlan,
+				PlanValue:   types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
+				State:       testState,
+				StateValue:  types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+			},
+		},
+		"config-null-plan-state": {
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				Plan:        testPlan,
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+				State:       testState,
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+		},
+		"config-null-plan-modified": {
+			// a default or prior plan modifier changed the planned value
+			request: planmodifier.ObjectRequest{
+				ConfigValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
+				Plan:        testPlan,
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("other")}),
+				State:       testState,
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("test")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"testattr": types.StringType}, map[string]attr.Value{"testattr": types.StringValue("other")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.ObjectResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			objectplanmodifier.PreserveStateWhenConfigNull().PlanModifyObject(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
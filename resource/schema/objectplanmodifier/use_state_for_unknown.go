@@ -6,7 +6,9 @@ package objectplanmodifier
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // UseStateForUnknown returns a plan modifier that copies a known prior state
@@ -17,6 +19,14 @@ import (
 // and Computed attributes to an unknown value "(known after apply)" on update.
 // Using this plan modifier will instead display the prior state value in the
 // plan, unless a prior plan modifier adjusts the value.
+//
+// When the planned object is known, such as an Optional and Computed nested
+// attribute with some configured child attributes, unknown child attributes
+// without a configuration value are set to their prior state values. This
+// only occurs when every known planned child attribute value is equal to its
+// prior state value, since a configuration change may cause the computed
+// child attribute values to change. Nested child attribute values are
+// compared as a whole, so unknown values within them are not merged.
 func UseStateForUnknown() planmodifier.Object {
 	return useStateForUnknownModifier{}
 }
@@ -35,21 +45,77 @@ func (m useStateForUnknownModifier) MarkdownDescription(_ context.Context) strin
 }
 
 // PlanModifyObject implements the plan modification logic.
-func (m useStateForUnknownModifier) PlanModifyObject(_ context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+func (m useStateForUnknownModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
 	// Do nothing if there is no state value.
-	if req.StateValue.IsNull() {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() {
 		return
 	}
 
-	// Do nothing if there is a known planned value.
-	if !req.PlanValue.IsUnknown() {
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
 		return
 	}
 
-	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
-	if req.ConfigValue.IsUnknown() {
+	if req.PlanValue.IsUnknown() {
+		resp.PlanValue = req.StateValue
+
+		return
+	}
+
+	// Do nothing if there is a null planned value or no configured child
+	// attributes to compare.
+	if req.PlanValue.IsNull() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	configAttributes := req.ConfigValue.Attributes()
+	stateAttributes := req.StateValue.Attributes()
+	planAttributes := req.PlanValue.Attributes()
+	mergedAttributes := make(map[string]attr.Value, len(planAttributes))
+	merged := false
+
+	for name, planAttribute := range planAttributes {
+		stateAttribute, ok := stateAttributes[name]
+
+		// Do nothing if the prior state does not have the same attributes.
+		if !ok {
+			return
+		}
+
+		if !planAttribute.IsUnknown() {
+			// Do nothing if a known child attribute value is changing.
+			if !planAttribute.Equal(stateAttribute) {
+				return
+			}
+
+			mergedAttributes[name] = planAttribute
+
+			continue
+		}
+
+		configAttribute, ok := configAttributes[name]
+
+		// Do nothing if an unknown child attribute value is configured,
+		// otherwise interpolation gets messed up.
+		if !ok || !configAttribute.IsNull() {
+			return
+		}
+
+		mergedAttributes[name] = stateAttribute
+		merged = true
+	}
+
+	if !merged {
+		return
+	}
+
+	planValue, diags := types.ObjectValue(req.PlanValue.AttributeTypes(ctx), mergedAttributes)
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
 		return
 	}
 
-	resp.PlanValue = req.StateValue
+	resp.PlanValue = planValue
 }
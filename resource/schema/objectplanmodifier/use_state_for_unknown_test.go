@@ -78,6 +78,86 @@ func TestUseStateForUnknownModifierPlanModifyObject(t *testing.T) {
 				PlanValue: types.ObjectUnknown(map[string]attr.Type{"testattr": types.StringType}),
 			},
 		},
+		"known-plan-unknown-computed-child": {
+			// the object is configured, but the computed child attribute is not, so
+			// the prior state value of the computed child attribute is used
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringValue("computed"), "optional": types.StringValue("configured")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("configured")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringValue("configured")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringValue("computed"), "optional": types.StringValue("configured")}),
+			},
+		},
+		"known-plan-unknown-computed-child-null-state-child": {
+			// a null prior state child attribute value is preserved
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringValue("configured")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("configured")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringValue("configured")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringValue("configured")}),
+			},
+		},
+		"known-plan-unknown-computed-child-configured-child-changed": {
+			// a configured child attribute change may cause the computed child
+			// attribute value to change
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringValue("computed"), "optional": types.StringValue("configured")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("changed")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringValue("changed")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("changed")}),
+			},
+		},
+		"known-plan-unknown-computed-child-optional-child-added": {
+			// adding an optional child attribute is a configuration change
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringValue("computed"), "optional": types.StringNull()}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("added")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringValue("added")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("added")}),
+			},
+		},
+		"known-plan-unknown-computed-child-optional-child-removed": {
+			// removing an optional child attribute is a configuration change
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringValue("computed"), "optional": types.StringValue("removed")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringNull()}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringNull()}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringNull()}),
+			},
+		},
+		"known-plan-unknown-computed-child-unknown-config-child": {
+			// the computed child attribute is being interpolated, so it should
+			// remain unknown
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringValue("computed"), "optional": types.StringValue("configured")}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("configured")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("configured")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("configured")}),
+			},
+		},
+		"known-plan-unknown-computed-child-null-state": {
+			// when we first create the resource, use the unknown value
+			request: planmodifier.ObjectRequest{
+				StateValue:  types.ObjectNull(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}),
+				PlanValue:   types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("configured")}),
+				ConfigValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringNull(), "optional": types.StringValue("configured")}),
+			},
+			expected: &planmodifier.ObjectResponse{
+				PlanValue: types.ObjectValueMust(map[string]attr.Type{"computed": types.StringType, "optional": types.StringType}, map[string]attr.Value{"computed": types.StringUnknown(), "optional": types.StringValue("configured")}),
+			},
+		},
 		"under-list": {
 			request: planmodifier.ObjectRequest{
 				ConfigValue: types.ObjectNull(map[string]attr.Type{"testattr": types.StringType}),
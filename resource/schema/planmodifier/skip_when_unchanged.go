@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifier
+
+import (
+	"context"
+)
+
+// SkipWhenUnchanged is an optional interface for plan modifiers which do not
+// need to execute when the attribute value is unchanged.
+//
+// By default, the framework calls every attribute plan modifier. Implement
+// this interface and return true to allow the framework to skip calling the
+// plan modifier when the attribute configuration, plan, and prior state
+// values are all known and equal, which can reduce planning time for
+// resources with many attributes. Plan modifiers which depend on other
+// attribute values, raise diagnostics, or set private state regardless of
+// value changes should not implement this interface.
+type SkipWhenUnchanged interface {
+	// SkipWhenUnchanged should return true if the plan modifier does not
+	// need to be called when the attribute configuration, plan, and prior
+	// state values are all known and equal.
+	SkipWhenUnchanged(context.Context) bool
+}
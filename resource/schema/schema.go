@@ -17,6 +17,7 @@ import (
 
 // Schema must satify the fwschema.Schema interface.
 var _ fwschema.Schema = Schema{}
+var _ fwschema.SchemaWithDeprecationWarningsOnUnknown = Schema{}
 
 // Schema defines the structure and value types of resource data. This type
 // is used as the resource.SchemaResponse type Schema field, which is
@@ -61,6 +62,16 @@ type Schema struct {
 	//
 	DeprecationMessage string
 
+	// DeprecationWarningsOnUnknown enables attribute and block deprecation
+	// warnings for unknown configuration values, such as values which refer
+	// to resources which are not yet created. By default, deprecation
+	// warnings are only raised when the configuration value is known and not
+	// null, which prevents warnings when the deprecated attribute or block
+	// may not actually be set. Null configuration values never raise
+	// deprecation warnings as they cannot be distinguished from the attribute
+	// or block being omitted from the configuration.
+	DeprecationWarningsOnUnknown bool
+
 	// Version indicates the current version of the resource schema. Resource
 	// schema versioning enables state upgrades in conjunction with the
 	// [resource.ResourceWithStateUpgrades] interface. Versioning is only
@@ -107,6 +118,12 @@ func (s Schema) GetDeprecationMessage() string {
 	return s.DeprecationMessage
 }
 
+// GetDeprecationWarningsOnUnknown returns the DeprecationWarningsOnUnknown
+// field value.
+func (s Schema) GetDeprecationWarningsOnUnknown() bool {
+	return s.DeprecationWarningsOnUnknown
+}
+
 // GetDescription returns the Description field value.
 func (s Schema) GetDescription() string {
 	return s.Description
@@ -152,6 +169,8 @@ func (s Schema) Validate() diag.Diagnostics {
 func (s Schema) ValidateImplementation(ctx context.Context) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	diags.Append(fwschema.ConflictingAttributeBlockNames(s.GetAttributes(), s.GetBlocks(), path.Empty())...)
+
 	for attributeName, attribute := range s.GetAttributes() {
 		req := fwschema.ValidateImplementationRequest{
 			Name: attributeName,
@@ -209,3 +228,14 @@ func nonComputedAttributeWithDefaultDiag(path path.Path) diag.Diagnostic {
 			"This is an issue with the provider and should be reported to the provider developers.",
 	)
 }
+
+func writeOnlyComputedAttributeDiag(path path.Path) diag.Diagnostic {
+	// The diagnostic path is intentionally omitted as it is invalid in this
+	// context. Diagnostic paths are intended to be mapped to actual data,
+	// while this path information must be synthesized.
+	return diag.NewErrorDiagnostic(
+		"Schema Using Write-Only For Computed Attribute",
+		fmt.Sprintf("Attribute %q cannot be both write-only and computed. ", path.String())+
+			"This is an issue with the provider and should be reported to the provider developers.",
+	)
+}
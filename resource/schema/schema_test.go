@@ -1062,6 +1062,52 @@ func TestSchemaValidateImplementation(t *testing.T) {
 		"empty-schema": {
 			schema: schema.Schema{},
 		},
+		"attribute-block-conflicting-name": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"test": schema.ListNestedBlock{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Conflicting Attribute and Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is defined in both the Attributes and the Blocks of the schema. "+
+						"Attribute and block names must be unique within the same level of the schema.",
+				),
+			},
+		},
+		"nested-block-attribute-block-conflicting-name": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"single_nested_block": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"test": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"test": schema.ListNestedBlock{},
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Conflicting Attribute and Block Name",
+					"When validating the schema, an implementation issue was found. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"\"test\" is defined in both the Attributes and the Blocks of the single_nested_block block nested object. "+
+						"Attribute and block names must be unique within the same level of the schema.",
+				),
+			},
+		},
 		"attribute-using-reserved-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
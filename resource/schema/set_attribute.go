@@ -26,6 +26,7 @@ var (
 	_ fwschema.AttributeWithSetDefaultValue        = SetAttribute{}
 	_ fwxschema.AttributeWithSetPlanModifiers      = SetAttribute{}
 	_ fwxschema.AttributeWithSetValidators         = SetAttribute{}
+	_ fwxschema.AttributeWithElementValidators     = SetAttribute{}
 )
 
 // SetAttribute represents a schema attribute that is a set with a single
@@ -82,6 +83,15 @@ type SetAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that the practitioner configuration value of this
+	// Attribute is only available during resource operations, such as via
+	// the Config field of the CreateRequest and UpdateRequest types, and is
+	// never stored in the plan or state. WriteOnly cannot be combined with
+	// Computed.
+	//
+	// Write-only attributes require Terraform 1.11 and later.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -142,6 +152,18 @@ type SetAttribute struct {
 	// are run in addition to the validation defined by the type.
 	Validators []validator.Set
 
+	// ElementValidators define value validation functionality for each
+	// element of the attribute. All elements of the slice are run against
+	// each known, non-null element, regardless of any previous error
+	// diagnostics. Each validator must implement the validator interface
+	// matching the element type, such as validator.String for an ElementType
+	// of types.StringType, otherwise an error diagnostic is returned when the
+	// schema is validated.
+	//
+	// Response diagnostics should use the request Path, which includes the
+	// value of the element.
+	ElementValidators []validator.Describer
+
 	// PlanModifiers defines a sequence of modifiers for this attribute at
 	// plan time. Schema-based plan modifications occur before any
 	// resource-level plan modifications.
@@ -230,6 +252,11 @@ func (a SetAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a SetAttribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // SetDefaultValue returns the Default field value.
 func (a SetAttribute) SetDefaultValue() defaults.Set {
 	return a.Default
@@ -240,6 +267,11 @@ func (a SetAttribute) SetPlanModifiers() []planmodifier.Set {
 	return a.PlanModifiers
 }
 
+// GetElementValidators returns the ElementValidators field value.
+func (a SetAttribute) GetElementValidators() []validator.Describer {
+	return a.ElementValidators
+}
+
 // SetValidators returns the Validators field value.
 func (a SetAttribute) SetValidators() []validator.Set {
 	return a.Validators
@@ -250,6 +282,10 @@ func (a SetAttribute) SetValidators() []validator.Set {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a SetAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsWriteOnly() && a.IsComputed() {
+		resp.Diagnostics.Append(writeOnlyComputedAttributeDiag(req.Path))
+	}
+
 	if a.CustomType == nil && a.ElementType == nil {
 		resp.Diagnostics.Append(fwschema.AttributeMissingElementTypeDiag(req.Path))
 	}
@@ -258,6 +294,10 @@ func (a SetAttribute) ValidateImplementation(ctx context.Context, req fwschema.V
 		resp.Diagnostics.Append(fwtype.AttributeCollectionWithDynamicTypeDiag(req.Path))
 	}
 
+	if elementType, ok := a.GetType().(attr.TypeWithElementType); ok {
+		resp.Diagnostics.Append(fwxschema.ValidateElementValidatorsImplementation(ctx, req.Path, elementType.ElementType(), a.GetElementValidators())...)
+	}
+
 	if a.SetDefaultValue() != nil {
 		if !a.IsComputed() {
 			resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
@@ -40,3 +40,8 @@ func (d staticValueDefault) MarkdownDescription(_ context.Context) string {
 func (d staticValueDefault) DefaultSet(ctx context.Context, req defaults.SetRequest, resp *defaults.SetResponse) {
 	resp.PlanValue = d.defaultVal
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticValueDefault) IsStatic() bool {
+	return true
+}
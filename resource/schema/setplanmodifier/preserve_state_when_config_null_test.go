@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPreserveStateWhenConfigNullModifierPlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.SetRequest
+		expected *planmodifier.SetResponse
+	}{
+		"create": {
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetUnknown(types.StringType),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"destroy": {
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetNull(types.StringType),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.SetNull(types.StringType),
+				State:      testState,
+				StateValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+		"config-known": {
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				Plan:        testPlan,
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetUnknown(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetUnknown(types.StringType),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+		"config-null-plan-unknown-state-known": {
+			// this is the situation where the configuration value was
+			// previously set and has been removed
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetUnknown(types.StringType),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"config-null-plan-unknown-state-null": {
+			// unlike UseStateForUnknown, a null state value is preserved
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetUnknown(types.StringType),
+				State:       testState,
+				StateValue:  types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+		"config-null-plan-state": {
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"config-null-plan-modified": {
+			// a default or prior plan modifier changed the planned value
+			request: planmodifier.SetRequest{
+				ConfigValue: types.SetNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.SetResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			setplanmodifier.PreserveStateWhenConfigNull().PlanModifySet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package setplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRejectUpdateModifierPlanModifySet(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.SetRequest
+		expected *planmodifier.SetResponse
+	}{
+		"create": {
+			request: planmodifier.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				Plan:        testPlan,
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"destroy": {
+			request: planmodifier.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetNull(types.StringType),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.SetNull(types.StringType),
+				State:      testState,
+				StateValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				Plan:        testPlan,
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"planvalue-statevalue-different": {
+			request: planmodifier.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				Plan:        testPlan,
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("other")}),
+			},
+		},
+		"planvalue-null-statevalue-known": {
+			request: planmodifier.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetNull(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetNull(types.StringType),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.SetNull(types.StringType),
+			},
+		},
+		"planvalue-known-statevalue-null": {
+			request: planmodifier.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				Plan:        testPlan,
+				PlanValue:   types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+				State:       testState,
+				StateValue:  types.SetNull(types.StringType),
+			},
+			expected: &planmodifier.SetResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+		},
+		"planvalue-unknown": {
+			request: planmodifier.SetRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.SetUnknown(types.StringType),
+				Plan:        testPlan,
+				PlanValue:   types.SetUnknown(types.StringType),
+				State:       testState,
+				StateValue:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+			},
+			expected: &planmodifier.SetResponse{
+				PlanValue: types.SetUnknown(types.StringType),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.SetResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			setplanmodifier.RejectUpdate().PlanModifySet(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
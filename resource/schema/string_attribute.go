@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
@@ -23,6 +24,7 @@ var (
 	_ Attribute                                    = StringAttribute{}
 	_ fwschema.AttributeWithValidateImplementation = StringAttribute{}
 	_ fwschema.AttributeWithStringDefaultValue     = StringAttribute{}
+	_ fwschema.AttributeWithStringNormalizeFunc    = StringAttribute{}
 	_ fwxschema.AttributeWithStringPlanModifiers   = StringAttribute{}
 	_ fwxschema.AttributeWithStringValidators      = StringAttribute{}
 )
@@ -68,6 +70,15 @@ type StringAttribute struct {
 	// file is sensitive.
 	Sensitive bool
 
+	// WriteOnly indicates that the practitioner configuration value of this
+	// Attribute is only available during resource operations, such as via
+	// the Config field of the CreateRequest and UpdateRequest types, and is
+	// never stored in the plan or state. WriteOnly cannot be combined with
+	// Computed.
+	//
+	// Write-only attributes require Terraform 1.11 and later.
+	WriteOnly bool
+
 	// Description is used in various tooling, like the language server, to
 	// give practitioners more information about what this attribute is,
 	// what it's for, and how it should be used. It should be written as
@@ -152,6 +163,22 @@ type StringAttribute struct {
 	// computed and the value could be altered by other changes then a default
 	// should be avoided and a plan modifier should be used instead.
 	Default defaults.String
+
+	// NormalizeFunc converts a value into its normalized form, such as
+	// formatting a JSON string. After the resource Create, Read, and Update
+	// methods, if the normalized forms of the prior value and the new value
+	// are equal, the framework keeps the prior value in the state. This
+	// prevents differences between the configuration and the value returned
+	// by the API, such as reformatted JSON, from being shown as drift.
+	//
+	// The function is also called during configuration validation. Any
+	// returned diagnostics are associated with the attribute path.
+	//
+	// Since Terraform requires the planned value of a configured attribute to
+	// equal the configuration value, the framework never replaces values with
+	// the normalized form. For more complex value handling, implement a custom
+	// value type with semantic equality instead.
+	NormalizeFunc func(context.Context, string) (string, diag.Diagnostics)
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -214,11 +241,21 @@ func (a StringAttribute) IsSensitive() bool {
 	return a.Sensitive
 }
 
+// IsWriteOnly returns the WriteOnly field value.
+func (a StringAttribute) IsWriteOnly() bool {
+	return a.WriteOnly
+}
+
 // StringDefaultValue returns the Default field value.
 func (a StringAttribute) StringDefaultValue() defaults.String {
 	return a.Default
 }
 
+// StringNormalizeFunc returns the NormalizeFunc field value.
+func (a StringAttribute) StringNormalizeFunc() func(context.Context, string) (string, diag.Diagnostics) {
+	return a.NormalizeFunc
+}
+
 // StringPlanModifiers returns the PlanModifiers field value.
 func (a StringAttribute) StringPlanModifiers() []planmodifier.String {
 	return a.PlanModifiers
@@ -234,6 +271,10 @@ func (a StringAttribute) StringValidators() []validator.String {
 // errors or panics. This logic runs during the GetProviderSchema RPC and
 // should never include false positives.
 func (a StringAttribute) ValidateImplementation(ctx context.Context, req fwschema.ValidateImplementationRequest, resp *fwschema.ValidateImplementationResponse) {
+	if a.IsWriteOnly() && a.IsComputed() {
+		resp.Diagnostics.Append(writeOnlyComputedAttributeDiag(req.Path))
+	}
+
 	if !a.IsComputed() && a.StringDefaultValue() != nil {
 		resp.Diagnostics.Append(nonComputedAttributeWithDefaultDiag(req.Path))
 	}
@@ -397,6 +397,85 @@ func TestStringAttributeIsSensitive(t *testing.T) {
 	}
 }
 
+func TestStringAttributeIsWriteOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  bool
+	}{
+		"not-write-only": {
+			attribute: schema.StringAttribute{},
+			expected:  false,
+		},
+		"write-only": {
+			attribute: schema.StringAttribute{
+				WriteOnly: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.IsWriteOnly()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringAttributeStringNormalizeFunc(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.StringAttribute
+		expected  string
+	}{
+		"no-normalizefunc": {
+			attribute: schema.StringAttribute{},
+		},
+		"normalizefunc": {
+			attribute: schema.StringAttribute{
+				NormalizeFunc: func(_ context.Context, value string) (string, diag.Diagnostics) {
+					return strings.ToLower(value), nil
+				},
+			},
+			expected: "test",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			normalizeFunc := testCase.attribute.StringNormalizeFunc()
+
+			if normalizeFunc == nil {
+				if testCase.expected != "" {
+					t.Fatalf("expected NormalizeFunc, got none")
+				}
+
+				return
+			}
+
+			got, _ := normalizeFunc(context.Background(), "TEST")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestStringAttributeStringDefaultValue(t *testing.T) {
 	t.Parallel()
 
@@ -530,6 +609,25 @@ func TestStringAttributeValidateImplementation(t *testing.T) {
 			},
 			expected: &fwschema.ValidateImplementationResponse{},
 		},
+		"write-only-with-computed": {
+			attribute: schema.StringAttribute{
+				Computed:  true,
+				WriteOnly: true,
+			},
+			request: fwschema.ValidateImplementationRequest{
+				Name: "test",
+				Path: path.Root("test"),
+			},
+			expected: &fwschema.ValidateImplementationResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Schema Using Write-Only For Computed Attribute",
+						"Attribute \"test\" cannot be both write-only and computed. "+
+							"This is an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"default-without-computed": {
 			attribute: schema.StringAttribute{
 				Default: stringdefault.StaticString("test"),
@@ -40,3 +40,8 @@ func (d staticStringDefault) MarkdownDescription(_ context.Context) string {
 func (d staticStringDefault) DefaultString(_ context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
 	resp.PlanValue = types.StringValue(d.defaultVal)
 }
+
+// IsStatic implements the defaults.Static interface.
+func (d staticStringDefault) IsStatic() bool {
+	return true
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringdefault
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StaticStringFromEnv returns a string value default handler which uses the
+// value of the given environment variable, falling back to a static value
+// when the environment variable is unset or empty.
+//
+// The environment variable is read each time the default is applied during
+// planning, rather than when the schema is defined, so changes to the
+// environment, such as those made by acceptance tests, are respected.
+//
+// As with all defaults, the attribute must be Computed. Use Optional and
+// Computed together so practitioners can still set the value in
+// configuration, which always takes precedence over this default.
+func StaticStringFromEnv(envVar string, defaultVal string) defaults.String {
+	return staticStringFromEnvDefault{
+		defaultVal: defaultVal,
+		envVar:     envVar,
+	}
+}
+
+// staticStringFromEnvDefault is an environment variable default handler that
+// sets a value on a string attribute.
+type staticStringFromEnvDefault struct {
+	defaultVal string
+	envVar     string
+}
+
+// Description returns a human-readable description of the default value handler.
+func (d staticStringFromEnvDefault) Description(_ context.Context) string {
+	return fmt.Sprintf("value defaults to the %s environment variable, if set, otherwise %s", d.envVar, d.defaultVal)
+}
+
+// MarkdownDescription returns a markdown description of the default value handler.
+func (d staticStringFromEnvDefault) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value defaults to the `%s` environment variable, if set, otherwise `%s`", d.envVar, d.defaultVal)
+}
+
+// DefaultString implements the environment variable default value logic.
+func (d staticStringFromEnvDefault) DefaultString(_ context.Context, req defaults.StringRequest, resp *defaults.StringResponse) {
+	if v := os.Getenv(d.envVar); v != "" {
+		resp.PlanValue = types.StringValue(v)
+
+		return
+	}
+
+	resp.PlanValue = types.StringValue(d.defaultVal)
+}
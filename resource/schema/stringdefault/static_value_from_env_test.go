@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringdefault_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// These tests cannot use t.Parallel() as they modify the process environment
+// via t.Setenv().
+func TestStaticStringFromEnvDefaultString(t *testing.T) {
+	testCases := map[string]struct {
+		envValue   *string
+		defaultVal string
+		expected   *defaults.StringResponse
+	}{
+		"env-unset": {
+			defaultVal: "test-default",
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test-default"),
+			},
+		},
+		"env-empty": {
+			envValue:   pointer(""),
+			defaultVal: "test-default",
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test-default"),
+			},
+		},
+		"env-set": {
+			envValue:   pointer("test-env-value"),
+			defaultVal: "test-default",
+			expected: &defaults.StringResponse{
+				PlanValue: types.StringValue("test-env-value"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			const envVar = "TF_PLUGIN_FRAMEWORK_TEST_STRINGDEFAULT"
+
+			if testCase.envValue != nil {
+				t.Setenv(envVar, *testCase.envValue)
+			}
+
+			resp := &defaults.StringResponse{}
+
+			stringdefault.StaticStringFromEnv(envVar, testCase.defaultVal).DefaultString(context.Background(), defaults.StringRequest{}, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStaticStringFromEnvDefaultString_PerRequest(t *testing.T) {
+	const envVar = "TF_PLUGIN_FRAMEWORK_TEST_STRINGDEFAULT_PER_REQUEST"
+
+	d := stringdefault.StaticStringFromEnv(envVar, "test-default")
+
+	t.Setenv(envVar, "test-env-value-1")
+
+	resp := &defaults.StringResponse{}
+	d.DefaultString(context.Background(), defaults.StringRequest{}, resp)
+
+	if diff := cmp.Diff(types.StringValue("test-env-value-1"), resp.PlanValue); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	t.Setenv(envVar, "test-env-value-2")
+
+	resp = &defaults.StringResponse{}
+	d.DefaultString(context.Background(), defaults.StringRequest{}, resp)
+
+	if diff := cmp.Diff(types.StringValue("test-env-value-2"), resp.PlanValue); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func pointer[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HashOf returns a plan modifier that sets the planned value to the SHA-256
+// checksum, in hexadecimal, of the planned values of all attributes matching
+// the given path expressions. Use this for Computed attributes which
+// represent a checksum of other attributes, such as a content hash used to
+// detect changes. Relative path expressions are resolved against this
+// attribute, such as path.MatchRelative().AtParent() to refer to sibling
+// attributes.
+//
+// The planned value is unknown if any matching attribute value is unknown,
+// such as when it references another resource which is not yet created.
+// Values are canonically encoded before hashing, so the checksum does not
+// depend on map key or set element ordering. Null values are included in the
+// checksum.
+//
+// The matching attribute values are read from the plan before any attribute
+// plan modifiers are run, which includes configuration values and Default
+// values. Values set by other attribute plan modifiers, such as
+// UseStateForUnknown on a source attribute, are not reflected in the
+// checksum. If the checksum must include those values, compute it in the
+// resource ModifyPlan method instead, which receives the final planned
+// values.
+//
+// It does nothing when the resource is being destroyed or when the attribute
+// is configured. Use HashOfFunc to customize the checksum function.
+func HashOf(expressions ...path.Expression) planmodifier.String {
+	return HashOfFunc(SHA256Hash, expressions...)
+}
+
+// HashOfFunc returns a plan modifier that sets the planned value to the
+// result of the given function with the canonical encoding of the planned
+// values of all attributes matching the given path expressions. Refer to
+// HashOf for the full behavior.
+func HashOfFunc(f HashFunc, expressions ...path.Expression) planmodifier.String {
+	return hashOfModifier{
+		expressions: expressions,
+		hashFunc:    f,
+	}
+}
+
+// hashOfModifier implements the plan modifier.
+type hashOfModifier struct {
+	expressions path.Expressions
+	hashFunc    HashFunc
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m hashOfModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("The value of this attribute is a checksum of these attributes: %s", m.expressions)
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m hashOfModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString implements the plan modification logic.
+func (m hashOfModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a configuration value, as Terraform requires the
+	// planned value to match it.
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var matchedPaths path.Paths
+
+	for _, expression := range req.PathExpression.MergeExpressions(m.expressions...) {
+		paths, diags := req.Plan.PathMatches(ctx, expression)
+
+		resp.Diagnostics.Append(diags...)
+
+		matchedPaths = append(matchedPaths, paths...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Sort paths, as matches for maps and sets are not ordered.
+	sort.Slice(matchedPaths, func(i, j int) bool {
+		return matchedPaths[i].String() < matchedPaths[j].String()
+	})
+
+	var data strings.Builder
+
+	for _, matchedPath := range matchedPaths {
+		var matchedValue attr.Value
+
+		diags := req.Plan.GetAttribute(ctx, matchedPath, &matchedValue)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		tfValue, err := matchedValue.ToTerraformValue(ctx)
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Hash Plan Modifier Error",
+				"An unexpected error occurred while converting a value to compute the checksum. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Path: %s\nError: %s", matchedPath, err),
+			)
+
+			return
+		}
+
+		encoded, known := canonicalEncode(tfValue)
+
+		// Any unknown value, including nested values, means the checksum
+		// cannot be determined until apply.
+		if !known {
+			resp.PlanValue = types.StringUnknown()
+
+			return
+		}
+
+		data.WriteString(matchedPath.String())
+		data.WriteString("=")
+		data.WriteString(encoded)
+		data.WriteString("\n")
+	}
+
+	resp.PlanValue = types.StringValue(m.hashFunc([]byte(data.String())))
+}
+
+// canonicalEncode returns a deterministic string encoding of the value, with
+// sorted map keys, object attribute names, and set elements. The bool return
+// is false if the value is or contains an unknown value.
+func canonicalEncode(value tftypes.Value) (string, bool) {
+	if !value.IsKnown() {
+		return "", false
+	}
+
+	if value.IsNull() {
+		return "null", true
+	}
+
+	valueType := value.Type()
+
+	switch {
+	case valueType.Is(tftypes.String):
+		var s string
+
+		_ = value.As(&s)
+
+		encoded, _ := json.Marshal(s)
+
+		return string(encoded), true
+	case valueType.Is(tftypes.Number):
+		n := new(big.Float)
+
+		_ = value.As(&n)
+
+		return n.Text('g', -1), true
+	case valueType.Is(tftypes.Bool):
+		var b bool
+
+		_ = value.As(&b)
+
+		return fmt.Sprintf("%t", b), true
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		_ = value.As(&elements)
+
+		encodedElements := make([]string, 0, len(elements))
+
+		for _, element := range elements {
+			encoded, known := canonicalEncode(element)
+
+			if !known {
+				return "", false
+			}
+
+			encodedElements = append(encodedElements, encoded)
+		}
+
+		if valueType.Is(tftypes.Set{}) {
+			sort.Strings(encodedElements)
+		}
+
+		return "[" + strings.Join(encodedElements, ",") + "]", true
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		_ = value.As(&elements)
+
+		keys := make([]string, 0, len(elements))
+
+		for key := range elements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		encodedElements := make([]string, 0, len(keys))
+
+		for _, key := range keys {
+			encoded, known := canonicalEncode(elements[key])
+
+			if !known {
+				return "", false
+			}
+
+			encodedKey, _ := json.Marshal(key)
+
+			encodedElements = append(encodedElements, string(encodedKey)+":"+encoded)
+		}
+
+		return "{" + strings.Join(encodedElements, ",") + "}", true
+	default:
+		return value.String(), true
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashFunc is a function used in the HashOfFunc plan modifier to compute the
+// planned value from the canonical encoding of the source values.
+type HashFunc func(data []byte) string
+
+// SHA256Hash is the HashFunc used by the HashOf plan modifier, which returns
+// the hexadecimal encoding of the SHA-256 checksum of the data.
+func SHA256Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHashOfModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test": schema.StringAttribute{
+				Computed: true,
+			},
+			"source_map": schema.MapAttribute{
+				ElementType: types.NumberType,
+				Optional:    true,
+			},
+			"source_set": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"source_string": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test":          tftypes.String,
+			"source_map":    tftypes.Map{ElementType: tftypes.Number},
+			"source_set":    tftypes.Set{ElementType: tftypes.String},
+			"source_string": tftypes.String,
+		},
+	}
+
+	testPlan := func(sourceMap, sourceSet, sourceString tftypes.Value) tfsdk.Plan {
+		return tfsdk.Plan{
+			Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"source_map":    sourceMap,
+				"source_set":    sourceSet,
+				"source_string": sourceString,
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testSourceMap := tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+		"b": tftypes.NewValue(tftypes.Number, 2),
+		"a": tftypes.NewValue(tftypes.Number, 1.5),
+	})
+	testSourceSet := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "two"),
+		tftypes.NewValue(tftypes.String, "one"),
+	})
+	testSourceString := tftypes.NewValue(tftypes.String, "test \"value\"")
+
+	testExpressions := []path.Expression{
+		path.MatchRelative().AtParent().AtName("source_string"),
+		path.MatchRoot("source_map"),
+		path.MatchRoot("source_set"),
+	}
+
+	// Identity function to verify the canonical encoding.
+	identity := func(data []byte) string {
+		return string(data)
+	}
+
+	testCases := map[string]struct {
+		modifier planmodifier.String
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"destroy": {
+			modifier: stringplanmodifier.HashOfFunc(identity, testExpressions...),
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(testType, nil),
+					Schema: testSchema,
+				},
+				PlanValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"config-value": {
+			modifier: stringplanmodifier.HashOfFunc(identity, testExpressions...),
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringValue("configured"),
+				Plan:        testPlan(testSourceMap, testSourceSet, testSourceString),
+				PlanValue:   types.StringValue("configured"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("configured"),
+			},
+		},
+		"known": {
+			modifier: stringplanmodifier.HashOfFunc(identity, testExpressions...),
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan:        testPlan(testSourceMap, testSourceSet, testSourceString),
+				PlanValue:   types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue(
+					"source_map={\"a\":1.5,\"b\":2}\n" +
+						"source_set=[\"one\",\"two\"]\n" +
+						"source_string=\"test \\\"value\\\"\"\n",
+				),
+			},
+		},
+		"known-null": {
+			modifier: stringplanmodifier.HashOfFunc(identity, testExpressions...),
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan: testPlan(
+					tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, nil),
+					tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					tftypes.NewValue(tftypes.String, nil),
+				),
+				PlanValue: types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue(
+					"source_map=null\n" +
+						"source_set=null\n" +
+						"source_string=null\n",
+				),
+			},
+		},
+		"known-sha256": {
+			modifier: stringplanmodifier.HashOf(path.MatchRoot("source_string")),
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan:        testPlan(testSourceMap, testSourceSet, tftypes.NewValue(tftypes.String, "test")),
+				PlanValue:   types.StringUnknown(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue(stringplanmodifier.SHA256Hash([]byte("source_string=\"test\"\n"))),
+			},
+		},
+		"unknown": {
+			modifier: stringplanmodifier.HashOfFunc(identity, testExpressions...),
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan: testPlan(
+					testSourceMap,
+					testSourceSet,
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				),
+				PlanValue: types.StringValue("prior"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"unknown-element": {
+			modifier: stringplanmodifier.HashOfFunc(identity, testExpressions...),
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan: testPlan(
+					testSourceMap,
+					tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "one"),
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					testSourceString,
+				),
+				PlanValue: types.StringValue("prior"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.request.Path = path.Root("test")
+			testCase.request.PathExpression = path.MatchRoot("test")
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			testCase.modifier.PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIgnoreDriftModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"create": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringValue("other"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("other"),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("other"),
+			},
+		},
+		"destroy": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.StringNull(),
+				State:      testState,
+				StateValue: types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"state-null": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringValue("other"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("other"),
+				State:       testState,
+				StateValue:  types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("other"),
+			},
+		},
+		"config-unknown": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringUnknown(),
+				Plan:        testPlan,
+				PlanValue:   types.StringUnknown(),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+		"config-equal": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringValue("test"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("test"),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"config-different": {
+			// this is the situation where drift is ignored
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringValue("other"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("other"),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"config-null": {
+			request: planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				Plan:        testPlan,
+				PlanValue:   types.StringUnknown(),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.IgnoreDrift().PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRejectUpdateModifierPlanModifyString(t *testing.T) {
+	t.Parallel()
+
+	testPlan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}),
+	}
+
+	testCases := map[string]struct {
+		request  planmodifier.StringRequest
+		expected *planmodifier.StringResponse
+	}{
+		"create": {
+			request: planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("test"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("test"),
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				StateValue: types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"destroy": {
+			request: planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+				Plan: tfsdk.Plan{
+					Raw: tftypes.NewValue(tftypes.Object{}, nil),
+				},
+				PlanValue:  types.StringNull(),
+				State:      testState,
+				StateValue: types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringNull(),
+			},
+		},
+		"planvalue-statevalue-equal": {
+			request: planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("test"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("test"),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"planvalue-statevalue-different": {
+			request: planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("other"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("other"),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.StringValue("other"),
+			},
+		},
+		"planvalue-null-statevalue-known": {
+			request: planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringNull(),
+				Plan:        testPlan,
+				PlanValue:   types.StringNull(),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.StringNull(),
+			},
+		},
+		"planvalue-known-statevalue-null": {
+			request: planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue("test"),
+				Plan:        testPlan,
+				PlanValue:   types.StringValue("test"),
+				State:       testState,
+				StateValue:  types.StringNull(),
+			},
+			expected: &planmodifier.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Attribute Cannot Be Updated",
+						"The value of this attribute can only be set when the resource is created and cannot be updated. "+
+							"Revert the configuration change for this attribute, or replace the resource, such as with the -replace option of the terraform apply command.",
+					),
+				},
+				PlanValue: types.StringValue("test"),
+			},
+		},
+		"planvalue-unknown": {
+			request: planmodifier.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringUnknown(),
+				Plan:        testPlan,
+				PlanValue:   types.StringUnknown(),
+				State:       testState,
+				StateValue:  types.StringValue("test"),
+			},
+			expected: &planmodifier.StringResponse{
+				PlanValue: types.StringUnknown(),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{
+				PlanValue: testCase.request.PlanValue,
+			}
+
+			stringplanmodifier.RejectUpdate().PlanModifyString(context.Background(), testCase.request, resp)
+
+			if diff := cmp.Diff(testCase.expected, resp); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timeplanmodifier provides plan modifiers for types.Time attributes.
+package timeplanmodifier
@@ -21,6 +21,12 @@ import (
 // the `to` argument, causing Terraform to call this provider operation and the
 // framework to route the request to this [Resource] as the target.
 //
+// Implementations are tried in the order returned by the
+// [ResourceWithMoveState] MoveState method. If [StateMover.SourceTypeName] or
+// [StateMover.SourceSchemaVersion] are set and do not match the request, the
+// implementation is skipped without being called. This enables a single
+// [Resource] to migrate state from multiple source resource types.
+//
 // Each implementation is responsible for determining whether the request should
 // be handled or skipped. The implementation is considered skipped by the
 // framework when the response contains no error diagnostics or state.
@@ -46,6 +52,18 @@ type StateMover struct {
 	// [MoveStateRequest.SourceRawState].
 	SourceSchema *schema.Schema
 
+	// SourceTypeName is an optional source resource type name, such as
+	// examplecloud_legacy_thing, that this implementation supports. If set,
+	// the framework skips this implementation without calling [StateMover]
+	// when [MoveStateRequest.SourceTypeName] differs.
+	SourceTypeName string
+
+	// SourceSchemaVersion is an optional source resource schema version that
+	// this implementation supports. If set, the framework skips this
+	// implementation without calling [StateMover] when
+	// [MoveStateRequest.SourceSchemaVersion] differs.
+	SourceSchemaVersion *int64
+
 	// StateMove defines the logic for determining whether the request source
 	// resource information should match this implementation, and if so, the
 	// data transformation of the source resource state to the current schema
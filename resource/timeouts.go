@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// TimeoutsRequest represents a request for the Resource to return its
+// default operation timeouts. An instance of this request struct is supplied
+// as an argument to the ResourceWithTimeouts type Timeouts method.
+type TimeoutsRequest struct{}
+
+// TimeoutsResponse represents a response to a TimeoutsRequest. An instance of
+// this response struct is supplied as an argument to the ResourceWithTimeouts
+// type Timeouts method.
+//
+// Each field is the default timeout for the operation when the practitioner
+// has not configured a timeout. A zero value means the operation has no
+// timeout by default.
+type TimeoutsResponse struct {
+	// Create is the default timeout of the Create operation.
+	Create time.Duration
+
+	// Read is the default timeout of the Read operation.
+	Read time.Duration
+
+	// Update is the default timeout of the Update operation.
+	Update time.Duration
+
+	// Delete is the default timeout of the Delete operation.
+	Delete time.Duration
+
+	// Diagnostics report errors or warnings related to retrieving the
+	// default timeouts. An empty slice indicates success, with no warnings
+	// or errors generated.
+	Diagnostics diag.Diagnostics
+}
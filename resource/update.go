@@ -4,8 +4,11 @@
 package resource
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -30,13 +33,26 @@ type UpdateRequest struct {
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta tfsdk.Config
 
+	// Timeout is the resolved timeout of the Update operation, if the resource
+	// implements ResourceWithTimeouts. The context passed to the Update
+	// method has the matching deadline. A zero value means there is no
+	// timeout.
+	Timeout time.Duration
+
 	// Private is provider-defined resource private state data which was previously
-	// stored with the resource state. Any existing data is copied to
+	// stored with the resource state, including any data saved via
+	// ModifyPlanResponse.Private during planning. Any existing data is copied to
 	// UpdateResponse.Private to prevent accidental private state data loss.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
 	// UpdateResponse.Private to update or remove a value.
 	Private *privatestate.ProviderData
+
+	// Identity is the planned resource identity data of the resource, if the
+	// resource implements ResourceWithIdentity. This field is nil if the
+	// resource does not implement ResourceWithIdentity and contains a null
+	// value if Terraform did not send identity data.
+	Identity *tfsdk.ResourceIdentity
 }
 
 // UpdateResponse represents a response to an UpdateRequest. An
@@ -58,4 +74,30 @@ type UpdateResponse struct {
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
 	Diagnostics diag.Diagnostics
+
+	// Identity is the resource identity data of the resource following the
+	// Update operation, if the resource implements ResourceWithIdentity.
+	// This field is pre-populated from UpdateRequest.Identity and should be set
+	// during the resource's Update operation.
+	Identity *tfsdk.ResourceIdentity
+
+	// AppliedFromPlan are paths which were successfully applied by the
+	// Update operation. After the Update method returns, the framework sets
+	// the value of each path in State to the value in UpdateRequest.Plan,
+	// including when Diagnostics contains errors. Planned values at these
+	// paths must not contain unknown values.
+	//
+	// This is intended for APIs which update fields independently and can
+	// partially fail, so the successfully updated values are saved into
+	// state without requiring State to be set attribute by attribute.
+	AppliedFromPlan path.Paths
+
+	// KeptFromPriorState are paths which were not applied by the Update
+	// operation, such as due to a partial failure. After the Update method
+	// returns, the framework sets the value of each path in State to the
+	// value in UpdateRequest.State, including when Diagnostics contains
+	// errors. KeptFromPriorState is handled after AppliedFromPlan, so the
+	// prior value takes precedence for overlapping paths, such as a nested
+	// attribute kept from prior state beneath an object applied from plan.
+	KeptFromPriorState path.Paths
 }
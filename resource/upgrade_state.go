@@ -4,9 +4,16 @@
 package resource
 
 import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromflatmap"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Request information for the provider logic to update a resource state
@@ -23,7 +30,8 @@ type UpgradeStateRequest struct {
 	// This is advanced functionality for providers wanting to skip the full
 	// redeclaration of older schemas and instead use lower level handlers to
 	// transform data. A typical implementation for working with this data will
-	// call the Unmarshal() method.
+	// call the UnmarshalRawState() method, which also supports the flatmap
+	// format. The Flatmap field can also be read directly.
 	//
 	// TODO: Create framework defined type that is not protocol specific.
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/340
@@ -35,6 +43,25 @@ type UpgradeStateRequest struct {
 	State *tfsdk.State
 }
 
+// UnmarshalRawState returns the RawState converted to the given type, which
+// must be an object type. Unlike the RawState Unmarshal() method, states in
+// the flatmap format written by Terraform CLI 0.11 and earlier are also
+// supported. Any attributes in the JSON format which are not defined in the
+// given type are ignored.
+func (r UpgradeStateRequest) UnmarshalRawState(typ tftypes.Type) (tftypes.Value, error) {
+	if r.RawState == nil {
+		return tftypes.Value{}, errors.New("unable to unmarshal RawState: missing RawState")
+	}
+
+	unmarshalOpts := tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+			IgnoreUndefinedAttributes: true,
+		},
+	}
+
+	return fromflatmap.RawState(*r.RawState, typ, unmarshalOpts)
+}
+
 // Response information for the provider logic to update a resource state
 // from a prior state version to the current schema version. An instance of
 // this is supplied as a parameter to a StateUpgrader, which ultimately came
@@ -72,3 +99,85 @@ type UpgradeStateResponse struct {
 	// operation. No prior state data is copied automatically.
 	State tfsdk.State
 }
+
+// RenameAttribute moves the prior state value at the from path into the
+// upgraded State at the to path, for attributes renamed between schema
+// versions. The from path is read from the request State, which requires
+// the StateUpgrader type PriorSchema field, and the to path is written using
+// the current schema. Both paths can be nested, including collection element
+// paths, and the prior and current attribute types must match.
+//
+// If the from path is also defined in the current schema, its value in the
+// upgraded State is set to null. Null prior state values are not copied, as
+// the upgraded State value is already null unless otherwise set.
+//
+// Any other upgraded state data must still be populated, such as calling
+// Set() on the State field before calling this method, since parent values
+// of the to path are created with null values if they do not exist.
+func (r *UpgradeStateResponse) RenameAttribute(ctx context.Context, req UpgradeStateRequest, from path.Path, to path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.State == nil {
+		diags.AddAttributeError(
+			from,
+			"Unable to Rename Attribute",
+			"An unexpected error was encountered while renaming an attribute during the resource state upgrade. "+
+				"This is always an issue with the provider and should be reported to the provider developer:\n\n"+
+				"The prior state is not available. Set the StateUpgrader type PriorSchema field to populate the prior state.",
+		)
+
+		return diags
+	}
+
+	var value attr.Value
+
+	diags.Append(req.State.GetAttribute(ctx, from, &value)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if r.State.Raw.Type() == nil {
+		r.State.Raw = tftypes.NewValue(r.State.Schema.Type().TerraformType(ctx), nil)
+	}
+
+	if !value.IsNull() {
+		diags.Append(r.State.SetAttribute(ctx, to, value)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// The source attribute only needs to be nulled if it remains in the
+	// current schema, such as when it is kept for deprecation purposes.
+	if _, fromDiags := r.State.Schema.AttributeAtPath(ctx, from); fromDiags.HasError() {
+		return diags
+	}
+
+	var currentValue attr.Value
+
+	diags.Append(r.State.GetAttribute(ctx, from, &currentValue)...)
+
+	if diags.HasError() || currentValue.IsNull() {
+		return diags
+	}
+
+	nullValue, err := currentValue.Type(ctx).ValueFromTerraform(ctx, tftypes.NewValue(currentValue.Type(ctx).TerraformType(ctx), nil))
+
+	if err != nil {
+		diags.AddAttributeError(
+			from,
+			"Unable to Rename Attribute",
+			"An unexpected error was encountered while renaming an attribute during the resource state upgrade. "+
+				"This is always an issue with the provider and should be reported to the provider developer:\n\n"+
+				"Unable to create null value: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	diags.Append(r.State.SetAttribute(ctx, from, nullValue)...)
+
+	return diags
+}
@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestUpgradeStateResponseRenameAttribute(t *testing.T) {
+	t.Parallel()
+
+	testPriorSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"old_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+			"test_list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"old_nested_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testPriorType := testPriorSchema.Type().TerraformType(context.Background())
+	testPriorListType := testPriorType.(tftypes.Object).AttributeTypes["test_list"]
+	testPriorListElementType := testPriorListType.(tftypes.List).ElementType
+
+	testPriorState := func(oldAttribute any) *tfsdk.State {
+		return &tfsdk.State{
+			Raw: tftypes.NewValue(testPriorType, map[string]tftypes.Value{
+				"id":            tftypes.NewValue(tftypes.String, "test-id"),
+				"old_attribute": tftypes.NewValue(tftypes.String, oldAttribute),
+				"test_list": tftypes.NewValue(testPriorListType, []tftypes.Value{
+					tftypes.NewValue(testPriorListElementType, map[string]tftypes.Value{
+						"old_nested_attribute": tftypes.NewValue(tftypes.String, "test-nested-value"),
+					}),
+				}),
+			}),
+			Schema: testPriorSchema,
+		}
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"new_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+			"test_list": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"new_nested_attribute": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+	testListType := testType.(tftypes.Object).AttributeTypes["test_list"]
+	testListElementType := testListType.(tftypes.List).ElementType
+
+	testDeprecatedSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"new_attribute": schema.StringAttribute{
+				Optional: true,
+			},
+			"old_attribute": schema.StringAttribute{
+				Optional:           true,
+				DeprecationMessage: "Use new_attribute instead.",
+			},
+		},
+	}
+
+	testDeprecatedType := testDeprecatedSchema.Type().TerraformType(context.Background())
+
+	testCases := map[string]struct {
+		request          resource.UpgradeStateRequest
+		response         *resource.UpgradeStateResponse
+		from             path.Path
+		to               path.Path
+		expectedDiags    diag.Diagnostics
+		expectedResponse *resource.UpgradeStateResponse
+	}{
+		"missing-prior-state": {
+			request: resource.UpgradeStateRequest{},
+			response: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: testSchema,
+				},
+			},
+			from: path.Root("old_attribute"),
+			to:   path.Root("new_attribute"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("old_attribute"),
+					"Unable to Rename Attribute",
+					"An unexpected error was encountered while renaming an attribute during the resource state upgrade. "+
+						"This is always an issue with the provider and should be reported to the provider developer:\n\n"+
+						"The prior state is not available. Set the StateUpgrader type PriorSchema field to populate the prior state.",
+				),
+			},
+			expectedResponse: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: testSchema,
+				},
+			},
+		},
+		"root": {
+			request: resource.UpgradeStateRequest{
+				State: testPriorState("test-value"),
+			},
+			response: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"test_list":     tftypes.NewValue(testListType, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+			from: path.Root("old_attribute"),
+			to:   path.Root("new_attribute"),
+			expectedResponse: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+						"test_list":     tftypes.NewValue(testListType, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"root-state-unset": {
+			request: resource.UpgradeStateRequest{
+				State: testPriorState("test-value"),
+			},
+			response: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Schema: testSchema,
+				},
+			},
+			from: path.Root("old_attribute"),
+			to:   path.Root("new_attribute"),
+			expectedResponse: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, nil),
+						"new_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+						"test_list":     tftypes.NewValue(testListType, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"root-null": {
+			request: resource.UpgradeStateRequest{
+				State: testPriorState(nil),
+			},
+			response: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"test_list":     tftypes.NewValue(testListType, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+			from: path.Root("old_attribute"),
+			to:   path.Root("new_attribute"),
+			expectedResponse: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"test_list":     tftypes.NewValue(testListType, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"nested-list-element": {
+			request: resource.UpgradeStateRequest{
+				State: testPriorState("test-value"),
+			},
+			response: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"test_list": tftypes.NewValue(testListType, []tftypes.Value{
+							tftypes.NewValue(testListElementType, map[string]tftypes.Value{
+								"new_nested_attribute": tftypes.NewValue(tftypes.String, nil),
+							}),
+						}),
+					}),
+					Schema: testSchema,
+				},
+			},
+			from: path.Root("test_list").AtListIndex(0).AtName("old_nested_attribute"),
+			to:   path.Root("test_list").AtListIndex(0).AtName("new_nested_attribute"),
+			expectedResponse: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"test_list": tftypes.NewValue(testListType, []tftypes.Value{
+							tftypes.NewValue(testListElementType, map[string]tftypes.Value{
+								"new_nested_attribute": tftypes.NewValue(tftypes.String, "test-nested-value"),
+							}),
+						}),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"source-in-current-schema": {
+			request: resource.UpgradeStateRequest{
+				State: testPriorState("test-value"),
+			},
+			response: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testDeprecatedType, map[string]tftypes.Value{
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"old_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+					}),
+					Schema: testDeprecatedSchema,
+				},
+			},
+			from: path.Root("old_attribute"),
+			to:   path.Root("new_attribute"),
+			expectedResponse: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testDeprecatedType, map[string]tftypes.Value{
+						"new_attribute": tftypes.NewValue(tftypes.String, "test-value"),
+						"old_attribute": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: testDeprecatedSchema,
+				},
+			},
+		},
+		"type-mismatch": {
+			request: resource.UpgradeStateRequest{
+				State: testPriorState("test-value"),
+			},
+			response: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"test_list":     tftypes.NewValue(testListType, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+			from: path.Root("old_attribute"),
+			to:   path.Root("test_list"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Value Conversion Error",
+					"An unexpected error was encountered while verifying an attribute value matched its expected type to prevent unexpected behavior or panics. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected framework type from provider logic: types.ListType[types.ObjectType[\"new_nested_attribute\":basetypes.StringType]] / underlying type: tftypes.List[tftypes.Object[\"new_nested_attribute\":tftypes.String]]\n"+
+						"Received framework type from provider logic: basetypes.StringType / underlying type: tftypes.String\n"+
+						"Path: test_list",
+				),
+			},
+			expectedResponse: &resource.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"id":            tftypes.NewValue(tftypes.String, "test-id"),
+						"new_attribute": tftypes.NewValue(tftypes.String, nil),
+						"test_list":     tftypes.NewValue(testListType, nil),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.response.RenameAttribute(context.Background(), testCase.request, testCase.from, testCase.to)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,25 @@ type ValidateConfigRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// ClientCapabilities defines optionally supported protocol features for
+	// the ValidateResourceConfig RPC, such as forward-compatible Terraform
+	// behavior changes.
+	ClientCapabilities ValidateConfigClientCapabilities
+}
+
+// ValidateConfigClientCapabilities allows Terraform to publish information
+// regarding optionally supported protocol features for the
+// ValidateResourceConfig RPC, such as forward-compatible Terraform behavior
+// changes.
+type ValidateConfigClientCapabilities struct {
+	// WriteOnlyAttributesAllowed indicates that the Terraform client
+	// initiating the request supports write-only attributes for managed
+	// resources.
+	//
+	// The framework returns an error diagnostic for any configured
+	// write-only attribute when this is false.
+	WriteOnlyAttributesAllowed bool
 }
 
 // ValidateConfigResponse represents a response to a
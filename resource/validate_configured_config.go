@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ValidateConfiguredConfigRequest represents a request to validate the
+// configuration of a resource after the resource has been configured with
+// provider-level data. An instance of this request struct is supplied as an
+// argument to the Resource ValidateConfiguredConfig receiver method.
+type ValidateConfiguredConfigRequest struct {
+	// Config is the configuration the user supplied for the resource.
+	//
+	// This configuration may contain unknown values if a user uses
+	// interpolation or other functionality that would prevent Terraform
+	// from knowing the value at request time.
+	Config tfsdk.Config
+}
+
+// ValidateConfiguredConfigResponse represents a response to a
+// ValidateConfiguredConfigRequest. An instance of this response struct is
+// supplied as an argument to the Resource ValidateConfiguredConfig receiver
+// method.
+type ValidateConfiguredConfigResponse struct {
+	// Diagnostics report errors or warnings related to validating the resource
+	// configuration. An empty slice indicates success, with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+}
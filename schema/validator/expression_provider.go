@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ExpressionProvider is an optional interface on validators which reference
+// other attributes or blocks in the schema via path expressions, such as
+// validators that check whether other attributes are configured.
+//
+// When implemented, the framework verifies during the GetProviderSchema RPC
+// that every returned expression resolves to an attribute or block in the
+// schema, returning an error diagnostic to provider developers otherwise.
+// This catches mismatches, such as a misspelled attribute name, before any
+// configuration is validated.
+type ExpressionProvider interface {
+	// Expressions should return the path expressions referenced by the
+	// validator. Expressions are merged with the path expression of the
+	// attribute or block the validator is declared on, so relative
+	// expressions, such as path.MatchRelative().AtParent().AtName("other"),
+	// are supported.
+	Expressions(context.Context) path.Expressions
+}
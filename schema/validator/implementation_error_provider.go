@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validator
+
+import (
+	"context"
+)
+
+// ImplementationErrorProvider is an optional interface on validators which
+// can be created with invalid arguments, such as a regular expression which
+// is missing required named groups.
+//
+// When implemented, the framework verifies during the GetProviderSchema RPC
+// that the validator has no implementation error, returning an error
+// diagnostic to provider developers otherwise. This catches invalid
+// validators before any configuration is validated, without the validator
+// constructor panicking.
+type ImplementationErrorProvider interface {
+	// ImplementationError should return an error if the validator was
+	// created with invalid arguments, or nil otherwise.
+	ImplementationError(context.Context) error
+}
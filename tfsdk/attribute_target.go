@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeTarget is a path and target pair for retrieving multiple
+// attributes or blocks with the Config, Plan, and State type GetAttributes
+// methods.
+type AttributeTarget struct {
+	// Path is the attribute or block path to retrieve.
+	Path path.Path
+
+	// Target is populated with the value at Path, similar to the target of
+	// the GetAttribute methods.
+	Target interface{}
+}
+
+// getAttributes populates each target with the value at its path. All
+// targets are populated, even if an earlier target returns error
+// diagnostics, so every failing path is reported.
+func getAttributes(ctx context.Context, data fwschemadata.Data, targets []AttributeTarget) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, target := range targets {
+		diags.Append(data.GetAtPath(ctx, target.Path, target.Target)...)
+	}
+
+	return diags
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AttributeGetter is the interface for retrieving attribute values by path,
+// such as the Config, Plan, and State types.
+type AttributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// GetListElement retrieves the element at the given index of the list
+// attribute or block found at `listPath` and returns it as T, which can be a
+// Go type or framework value type supported by GetAttribute. Error
+// diagnostics are returned if the list is unknown or the index is out of
+// range, including when the list is null.
+func GetListElement[T any](ctx context.Context, data AttributeGetter, listPath path.Path, index int) (T, diag.Diagnostics) {
+	var result T
+
+	listValue, diags := getCollection[basetypes.ListValuable](ctx, data, listPath, "list")
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	list, listDiags := listValue.ToListValue(ctx)
+
+	diags.Append(listDiags...)
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	if index < 0 || index >= len(list.Elements()) {
+		diags.AddAttributeError(
+			listPath,
+			"Invalid List Element Index",
+			"An unexpected error was encountered trying to read a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot read list element at index %d as the list has %d elements.", index, len(list.Elements())),
+		)
+
+		return result, diags
+	}
+
+	diags.Append(data.GetAttribute(ctx, listPath.AtListIndex(index), &result)...)
+
+	return result, diags
+}
+
+// GetMapElement retrieves the element with the given key of the map
+// attribute found at `mapPath` and returns it as T, which can be a Go type or
+// framework value type supported by GetAttribute. Error diagnostics are
+// returned if the map is unknown or does not contain the key, including when
+// the map is null. Use GetAttribute with a map target to check whether a key
+// exists.
+func GetMapElement[T any](ctx context.Context, data AttributeGetter, mapPath path.Path, key string) (T, diag.Diagnostics) {
+	var result T
+
+	mapValue, diags := getCollection[basetypes.MapValuable](ctx, data, mapPath, "map")
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	m, mapDiags := mapValue.ToMapValue(ctx)
+
+	diags.Append(mapDiags...)
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	if _, ok := m.Elements()[key]; !ok {
+		diags.AddAttributeError(
+			mapPath,
+			"Missing Map Element Key",
+			"An unexpected error was encountered trying to read a map element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot read map element with key %q as the map does not contain the key.", key),
+		)
+
+		return result, diags
+	}
+
+	diags.Append(data.GetAttribute(ctx, mapPath.AtMapKey(key), &result)...)
+
+	return result, diags
+}
+
+// GetSetElements retrieves the elements of the set attribute or block found
+// at `setPath` and returns them as a slice of T, which can be a Go type or
+// framework value type supported by GetAttribute. Set elements are
+// identified by their value rather than an index or key, so all elements are
+// returned. A null set returns no elements. Error diagnostics are returned if
+// the set is unknown.
+func GetSetElements[T any](ctx context.Context, data AttributeGetter, setPath path.Path) ([]T, diag.Diagnostics) {
+	setValue, diags := getCollection[basetypes.SetValuable](ctx, data, setPath, "set")
+
+	if diags.HasError() || setValue.IsNull() {
+		return nil, diags
+	}
+
+	set, setDiags := setValue.ToSetValue(ctx)
+
+	diags.Append(setDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := make([]T, 0, len(set.Elements()))
+
+	for _, element := range set.Elements() {
+		var target T
+
+		diags.Append(data.GetAttribute(ctx, setPath.AtSetValue(element), &target)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result = append(result, target)
+	}
+
+	return result, diags
+}
+
+// getCollection retrieves the value found at the path and returns it as the
+// given collection value type. Error diagnostics are returned if the value
+// is not the collection type or is unknown.
+func getCollection[V attr.Value](ctx context.Context, data AttributeGetter, collectionPath path.Path, collectionType string) (V, diag.Diagnostics) {
+	var result V
+	var value attr.Value
+
+	diags := data.GetAttribute(ctx, collectionPath, &value)
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	result, ok := value.(V)
+
+	if !ok {
+		diags.AddAttributeError(
+			collectionPath,
+			"Invalid Collection Type",
+			fmt.Sprintf("An unexpected error was encountered trying to read a %s element. This is always an error in the provider. Please report the following to the provider developer:\n\n", collectionType)+
+				fmt.Sprintf("Cannot read %s element as the value is a %T.", collectionType, value),
+		)
+
+		return result, diags
+	}
+
+	if value.IsUnknown() {
+		diags.AddAttributeError(
+			collectionPath,
+			"Unknown Collection Value",
+			fmt.Sprintf("An unexpected error was encountered trying to read a %s element. This is always an error in the provider. Please report the following to the provider developer:\n\n", collectionType)+
+				fmt.Sprintf("Cannot read %s element as the %s value is unknown.", collectionType, collectionType),
+		)
+
+		return result, diags
+	}
+
+	return result, diags
+}
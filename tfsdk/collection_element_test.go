@@ -0,0 +1,383 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCollectionState returns a State with a string attribute and list, map,
+// and set of string attributes set to the given values.
+func testCollectionState(list, m, set tftypes.Value) tfsdk.State {
+	return tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"test_list":   tftypes.List{ElementType: tftypes.String},
+				"test_map":    tftypes.Map{ElementType: tftypes.String},
+				"test_set":    tftypes.Set{ElementType: tftypes.String},
+				"test_string": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"test_list":   list,
+			"test_map":    m,
+			"test_set":    set,
+			"test_string": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"test_list": testschema.Attribute{
+					Type:     types.ListType{ElemType: types.StringType},
+					Optional: true,
+				},
+				"test_map": testschema.Attribute{
+					Type:     types.MapType{ElemType: types.StringType},
+					Optional: true,
+				},
+				"test_set": testschema.Attribute{
+					Type:     types.SetType{ElemType: types.StringType},
+					Optional: true,
+				},
+				"test_string": testschema.Attribute{
+					Type:     types.StringType,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+var (
+	testCollectionList = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "zero"),
+		tftypes.NewValue(tftypes.String, "one"),
+	})
+	testCollectionMap = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"key": tftypes.NewValue(tftypes.String, "value"),
+	})
+	testCollectionSet = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "one"),
+		tftypes.NewValue(tftypes.String, "two"),
+	})
+)
+
+func TestGetListElement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state         tfsdk.State
+		path          path.Path
+		index         int
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"first": {
+			state:    testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:     path.Root("test_list"),
+			index:    0,
+			expected: "zero",
+		},
+		"last": {
+			state:    testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:     path.Root("test_list"),
+			index:    1,
+			expected: "one",
+		},
+		"index-out-of-range": {
+			state: testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:  path.Root("test_list"),
+			index: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid List Element Index",
+					"An unexpected error was encountered trying to read a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read list element at index 2 as the list has 2 elements.",
+				),
+			},
+		},
+		"index-negative": {
+			state: testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:  path.Root("test_list"),
+			index: -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid List Element Index",
+					"An unexpected error was encountered trying to read a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read list element at index -1 as the list has 2 elements.",
+				),
+			},
+		},
+		"list-null": {
+			state: testCollectionState(
+				tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				testCollectionMap,
+				testCollectionSet,
+			),
+			path:  path.Root("test_list"),
+			index: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid List Element Index",
+					"An unexpected error was encountered trying to read a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read list element at index 0 as the list has 0 elements.",
+				),
+			},
+		},
+		"list-unknown": {
+			state: testCollectionState(
+				tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				testCollectionMap,
+				testCollectionSet,
+			),
+			path:  path.Root("test_list"),
+			index: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Unknown Collection Value",
+					"An unexpected error was encountered trying to read a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read list element as the list value is unknown.",
+				),
+			},
+		},
+		"not-list": {
+			state: testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:  path.Root("test_string"),
+			index: 0,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_string"),
+					"Invalid Collection Type",
+					"An unexpected error was encountered trying to read a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read list element as the value is a basetypes.StringValue.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.GetListElement[string](context.Background(), testCase.state, testCase.path, testCase.index)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetListElement_ValueType(t *testing.T) {
+	t.Parallel()
+
+	config := tfsdk.Config(testCollectionState(testCollectionList, testCollectionMap, testCollectionSet))
+
+	got, diags := tfsdk.GetListElement[types.String](context.Background(), config, path.Root("test_list"), 1)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, types.StringValue("one")); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestGetMapElement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state         tfsdk.State
+		path          path.Path
+		key           string
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"key": {
+			state:    testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:     path.Root("test_map"),
+			key:      "key",
+			expected: "value",
+		},
+		"key-missing": {
+			state: testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:  path.Root("test_map"),
+			key:   "missing",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_map"),
+					"Missing Map Element Key",
+					"An unexpected error was encountered trying to read a map element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read map element with key \"missing\" as the map does not contain the key.",
+				),
+			},
+		},
+		"map-null": {
+			state: testCollectionState(
+				testCollectionList,
+				tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				testCollectionSet,
+			),
+			path: path.Root("test_map"),
+			key:  "key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_map"),
+					"Missing Map Element Key",
+					"An unexpected error was encountered trying to read a map element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read map element with key \"key\" as the map does not contain the key.",
+				),
+			},
+		},
+		"map-unknown": {
+			state: testCollectionState(
+				testCollectionList,
+				tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				testCollectionSet,
+			),
+			path: path.Root("test_map"),
+			key:  "key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_map"),
+					"Unknown Collection Value",
+					"An unexpected error was encountered trying to read a map element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read map element as the map value is unknown.",
+				),
+			},
+		},
+		"not-map": {
+			state: testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:  path.Root("test_list"),
+			key:   "key",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid Collection Type",
+					"An unexpected error was encountered trying to read a map element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read map element as the value is a basetypes.ListValue.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.GetMapElement[string](context.Background(), testCase.state, testCase.path, testCase.key)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetSetElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state         tfsdk.State
+		path          path.Path
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"elements": {
+			state:    testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:     path.Root("test_set"),
+			expected: []string{"one", "two"},
+		},
+		"set-empty": {
+			state: testCollectionState(
+				testCollectionList,
+				testCollectionMap,
+				tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+			),
+			path:     path.Root("test_set"),
+			expected: []string{},
+		},
+		"set-null": {
+			state: testCollectionState(
+				testCollectionList,
+				testCollectionMap,
+				tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			),
+			path:     path.Root("test_set"),
+			expected: nil,
+		},
+		"set-unknown": {
+			state: testCollectionState(
+				testCollectionList,
+				testCollectionMap,
+				tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+			),
+			path: path.Root("test_set"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_set"),
+					"Unknown Collection Value",
+					"An unexpected error was encountered trying to read a set element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read set element as the set value is unknown.",
+				),
+			},
+		},
+		"not-set": {
+			state: testCollectionState(testCollectionList, testCollectionMap, testCollectionSet),
+			path:  path.Root("test_list"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_list"),
+					"Invalid Collection Type",
+					"An unexpected error was encountered trying to read a set element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Cannot read set element as the value is a basetypes.ListValue.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.GetSetElements[string](context.Background(), testCase.state, testCase.path)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -35,6 +35,15 @@ func (c Config) GetAttribute(ctx context.Context, path path.Path, target interfa
 	return c.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributes retrieves the attributes or blocks found at each of the
+// AttributeTarget paths and populates each target with the value, similar
+// to calling GetAttribute for each AttributeTarget. Every AttributeTarget is
+// retrieved, even if an earlier one returns error diagnostics, and any
+// diagnostics include the path which could not be retrieved.
+func (c Config) GetAttributes(ctx context.Context, targets ...AttributeTarget) diag.Diagnostics {
+	return getAttributes(ctx, c.data(), targets)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -200,6 +200,103 @@ func TestConfigGetAttribute(t *testing.T) {
 	}
 }
 
+func TestConfigGetAttributes(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		config        tfsdk.Config
+		targets       func(name *string, count *int64) []tfsdk.AttributeTarget
+		expectedName  string
+		expectedCount int64
+		expectedDiags diag.Diagnostics
+	}
+
+	testConfig := tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"count": tftypes.Number,
+				"name":  tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"count": tftypes.NewValue(tftypes.Number, 2),
+			"name":  tftypes.NewValue(tftypes.String, "namevalue"),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"count": testschema.Attribute{
+					Type:     types.Int64Type,
+					Required: true,
+				},
+				"name": testschema.Attribute{
+					Type:     types.StringType,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataGetAtPath for more exhaustive unit
+		// testing. These test cases are to ensure each target is passed
+		// appropriately to the shared implementation.
+		"valid": {
+			config: testConfig,
+			targets: func(name *string, count *int64) []tfsdk.AttributeTarget {
+				return []tfsdk.AttributeTarget{
+					{Path: path.Root("name"), Target: name},
+					{Path: path.Root("count"), Target: count},
+				}
+			},
+			expectedName:  "namevalue",
+			expectedCount: 2,
+		},
+		"diagnostics": {
+			config: testConfig,
+			targets: func(name *string, count *int64) []tfsdk.AttributeTarget {
+				return []tfsdk.AttributeTarget{
+					{Path: path.Root("missing"), Target: name},
+					{Path: path.Root("count"), Target: count},
+				}
+			},
+			expectedCount: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(
+					path.Root("missing"),
+					diag.NewErrorDiagnostic(
+						"Configuration Read Error",
+						"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+					),
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotName string
+			var gotCount int64
+
+			diags := tc.config.GetAttributes(context.Background(), tc.targets(&gotName, &gotCount)...)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if gotName != tc.expectedName {
+				t.Errorf("expected name %q, got %q", tc.expectedName, gotName)
+			}
+
+			if gotCount != tc.expectedCount {
+				t.Errorf("expected count %d, got %d", tc.expectedCount, gotCount)
+			}
+		})
+	}
+}
+
 func TestConfigPathMatches(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+const (
+	// ChangeKindInvalid is used to indicate an invalid ChangeKind.
+	// Provider developers should not use it.
+	ChangeKindInvalid ChangeKind = 0
+
+	// ChangeKindAdded indicates the value was null and is now not null.
+	ChangeKindAdded ChangeKind = 1
+
+	// ChangeKindRemoved indicates the value was not null and is now null.
+	ChangeKindRemoved ChangeKind = 2
+
+	// ChangeKindModified indicates the value changed, including between an
+	// unknown and a known value.
+	ChangeKindModified ChangeKind = 3
+)
+
+// ChangeKind represents the classification of a change returned by Diff.
+type ChangeKind int32
+
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeKindAdded:
+		return "Added"
+	case ChangeKindRemoved:
+		return "Removed"
+	case ChangeKindModified:
+		return "Modified"
+	}
+
+	return "Invalid"
+}
+
+// DiffData is the schema data accepted by Diff, which is implemented by the
+// Config, Plan, and State types.
+type DiffData interface {
+	diffData() (fwschema.Schema, tftypes.Value)
+}
+
+func (c Config) diffData() (fwschema.Schema, tftypes.Value) {
+	return c.Schema, c.Raw
+}
+
+func (p Plan) diffData() (fwschema.Schema, tftypes.Value) {
+	return p.Schema, p.Raw
+}
+
+func (s State) diffData() (fwschema.Schema, tftypes.Value) {
+	return s.Schema, s.Raw
+}
+
+// Diff returns the changed paths between the old and new data, such as
+// between the prior State and the Plan, keyed by the path string.
+//
+// Changes are reported at the most specific path. Nested attributes, blocks,
+// and collection elements are compared individually, while a value which
+// changed between null and not null is reported as a single ChangeKindAdded
+// or ChangeKindRemoved change without its nested values. Set elements are
+// identified by their value, so a changed set element is reported as a
+// removed and an added element. A change between an unknown and a known
+// value is reported as ChangeKindModified.
+//
+// The old and new data must have the same schema. If either data is entirely
+// null, such as the prior State during resource creation, every non-null
+// value of the other data is reported from the root path.
+func Diff(ctx context.Context, oldData, newData DiffData) (map[string]ChangeKind, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schema, oldValue := oldData.diffData()
+	_, newValue := newData.diffData()
+
+	changes := make(map[string]ChangeKind)
+
+	if oldValue.Type() == nil || newValue.Type() == nil {
+		diags.AddError(
+			"Invalid Diff Data",
+			"An unexpected error was encountered when comparing data. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Missing data value.",
+		)
+
+		return changes, diags
+	}
+
+	d := differ{
+		changes: changes,
+		schema:  schema,
+	}
+
+	diags.Append(d.diff(ctx, tftypes.NewAttributePath(), oldValue, newValue)...)
+
+	return changes, diags
+}
+
+// differ records the changes found while recursively comparing values.
+type differ struct {
+	changes map[string]ChangeKind
+	schema  fwschema.Schema
+}
+
+func (d differ) record(ctx context.Context, tfPath *tftypes.AttributePath, kind ChangeKind) diag.Diagnostics {
+	fwPath, diags := fromtftypes.AttributePath(ctx, tfPath, d.schema)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.changes[fwPath.String()] = kind
+
+	return diags
+}
+
+func (d differ) diff(ctx context.Context, tfPath *tftypes.AttributePath, oldValue, newValue tftypes.Value) diag.Diagnostics {
+	if oldValue.Equal(newValue) {
+		return nil
+	}
+
+	switch {
+	case oldValue.IsNull():
+		// An entirely null root value, such as during resource creation,
+		// reports each added attribute and block.
+		if len(tfPath.Steps()) == 0 && oldValue.Type().Is(tftypes.Object{}) && newValue.IsKnown() {
+			return d.diff(ctx, tfPath, nullAttributes(oldValue.Type()), newValue)
+		}
+
+		return d.record(ctx, tfPath, ChangeKindAdded)
+	case newValue.IsNull():
+		if len(tfPath.Steps()) == 0 && newValue.Type().Is(tftypes.Object{}) && oldValue.IsKnown() {
+			return d.diff(ctx, tfPath, oldValue, nullAttributes(newValue.Type()))
+		}
+
+		return d.record(ctx, tfPath, ChangeKindRemoved)
+	case !oldValue.IsKnown() || !newValue.IsKnown():
+		return d.record(ctx, tfPath, ChangeKindModified)
+	case !oldValue.Type().Equal(newValue.Type()):
+		// Dynamic values can change their underlying type.
+		return d.record(ctx, tfPath, ChangeKindModified)
+	}
+
+	var diags diag.Diagnostics
+
+	switch typ := oldValue.Type().(type) {
+	case tftypes.Object:
+		var oldAttrs, newAttrs map[string]tftypes.Value
+
+		diags.Append(diffAs(oldValue, &oldAttrs)...)
+		diags.Append(diffAs(newValue, &newAttrs)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		for name := range typ.AttributeTypes {
+			diags.Append(d.diff(ctx, tfPath.WithAttributeName(name), oldAttrs[name], newAttrs[name])...)
+		}
+	case tftypes.List, tftypes.Tuple:
+		var oldElems, newElems []tftypes.Value
+
+		diags.Append(diffAs(oldValue, &oldElems)...)
+		diags.Append(diffAs(newValue, &newElems)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		for i := 0; i < max(len(oldElems), len(newElems)); i++ {
+			elemPath := tfPath.WithElementKeyInt(i)
+
+			switch {
+			case i >= len(oldElems):
+				diags.Append(d.record(ctx, elemPath, ChangeKindAdded)...)
+			case i >= len(newElems):
+				diags.Append(d.record(ctx, elemPath, ChangeKindRemoved)...)
+			default:
+				diags.Append(d.diff(ctx, elemPath, oldElems[i], newElems[i])...)
+			}
+		}
+	case tftypes.Map:
+		var oldElems, newElems map[string]tftypes.Value
+
+		diags.Append(diffAs(oldValue, &oldElems)...)
+		diags.Append(diffAs(newValue, &newElems)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		for key, oldElem := range oldElems {
+			newElem, ok := newElems[key]
+
+			if !ok {
+				diags.Append(d.record(ctx, tfPath.WithElementKeyString(key), ChangeKindRemoved)...)
+
+				continue
+			}
+
+			diags.Append(d.diff(ctx, tfPath.WithElementKeyString(key), oldElem, newElem)...)
+		}
+
+		for key := range newElems {
+			if _, ok := oldElems[key]; !ok {
+				diags.Append(d.record(ctx, tfPath.WithElementKeyString(key), ChangeKindAdded)...)
+			}
+		}
+	case tftypes.Set:
+		var oldElems, newElems []tftypes.Value
+
+		diags.Append(diffAs(oldValue, &oldElems)...)
+		diags.Append(diffAs(newValue, &newElems)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, oldElem := range oldElems {
+			if !containsValue(newElems, oldElem) {
+				diags.Append(d.record(ctx, tfPath.WithElementKeyValue(oldElem), ChangeKindRemoved)...)
+			}
+		}
+
+		for _, newElem := range newElems {
+			if !containsValue(oldElems, newElem) {
+				diags.Append(d.record(ctx, tfPath.WithElementKeyValue(newElem), ChangeKindAdded)...)
+			}
+		}
+	default:
+		diags.Append(d.record(ctx, tfPath, ChangeKindModified)...)
+	}
+
+	return diags
+}
+
+// nullAttributes returns a known object value with every attribute null, so
+// a null root value can be compared attribute by attribute.
+func nullAttributes(typ tftypes.Type) tftypes.Value {
+	//nolint:forcetypeassert // Type assertion is guaranteed by the caller
+	objectType := typ.(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tftypes.NewValue(objectType, attrs)
+}
+
+func containsValue(values []tftypes.Value, value tftypes.Value) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func diffAs(value tftypes.Value, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := value.As(target); err != nil {
+		diags.AddError(
+			"Invalid Diff Data",
+			"An unexpected error was encountered when comparing data. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unable to convert %s value: %s", value.Type(), err),
+		)
+	}
+
+	return diags
+}
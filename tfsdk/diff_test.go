@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test_dynamic": testschema.Attribute{
+				Optional: true,
+				Type:     types.DynamicType,
+			},
+			"test_list": testschema.Attribute{
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"test_map": testschema.Attribute{
+				Optional: true,
+				Type:     types.MapType{ElemType: types.StringType},
+			},
+			"test_set": testschema.Attribute{
+				Optional: true,
+				Type:     types.SetType{ElemType: types.StringType},
+			},
+			"test_single_nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"test_string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Optional:    true,
+			},
+			"test_string": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testNestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_string": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_dynamic":       tftypes.DynamicPseudoType,
+			"test_list":          tftypes.List{ElementType: tftypes.String},
+			"test_map":           tftypes.Map{ElementType: tftypes.String},
+			"test_set":           tftypes.Set{ElementType: tftypes.String},
+			"test_single_nested": testNestedType,
+			"test_string":        tftypes.String,
+		},
+	}
+
+	// testValue returns a value with all attributes null, except those given.
+	testValue := func(attrs map[string]tftypes.Value) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"test_dynamic":       tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			"test_list":          tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			"test_map":           tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"test_set":           tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"test_single_nested": tftypes.NewValue(testNestedType, nil),
+			"test_string":        tftypes.NewValue(tftypes.String, nil),
+		}
+
+		for name, value := range attrs {
+			values[name] = value
+		}
+
+		return tftypes.NewValue(testType, values)
+	}
+
+	testStrings := func(typ tftypes.Type, values ...string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			elems = append(elems, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return tftypes.NewValue(typ, elems)
+	}
+
+	testCases := map[string]struct {
+		old           tftypes.Value
+		new           tftypes.Value
+		expected      map[string]tfsdk.ChangeKind
+		expectedDiags diag.Diagnostics
+	}{
+		"no-changes": {
+			old: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			expected: map[string]tfsdk.ChangeKind{},
+		},
+		"root-null-old": {
+			old: tftypes.NewValue(testType, nil),
+			new: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_string": tfsdk.ChangeKindAdded,
+			},
+		},
+		"root-null-new": {
+			old: testValue(map[string]tftypes.Value{
+				"test_list":   testStrings(tftypes.List{ElementType: tftypes.String}, "one"),
+				"test_string": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			new: tftypes.NewValue(testType, nil),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_list":   tfsdk.ChangeKindRemoved,
+				"test_string": tfsdk.ChangeKindRemoved,
+			},
+		},
+		"primitive": {
+			old: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, "old"),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, "new"),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_string": tfsdk.ChangeKindModified,
+			},
+		},
+		"primitive-unknown": {
+			old: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, "old"),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_string": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_string": tfsdk.ChangeKindModified,
+			},
+		},
+		"dynamic-type-change": {
+			old: testValue(map[string]tftypes.Value{
+				"test_dynamic": tftypes.NewValue(tftypes.String, "1"),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_dynamic": tftypes.NewValue(tftypes.Number, 1),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_dynamic": tfsdk.ChangeKindModified,
+			},
+		},
+		"list": {
+			old: testValue(map[string]tftypes.Value{
+				"test_list": testStrings(tftypes.List{ElementType: tftypes.String}, "one", "two"),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_list": testStrings(tftypes.List{ElementType: tftypes.String}, "one", "changed", "three"),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_list[1]": tfsdk.ChangeKindModified,
+				"test_list[2]": tfsdk.ChangeKindAdded,
+			},
+		},
+		"list-removed-elements": {
+			old: testValue(map[string]tftypes.Value{
+				"test_list": testStrings(tftypes.List{ElementType: tftypes.String}, "one", "two"),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_list": testStrings(tftypes.List{ElementType: tftypes.String}, "one"),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_list[1]": tfsdk.ChangeKindRemoved,
+			},
+		},
+		"map": {
+			old: testValue(map[string]tftypes.Value{
+				"test_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"changed": tftypes.NewValue(tftypes.String, "old"),
+					"removed": tftypes.NewValue(tftypes.String, "removed"),
+					"same":    tftypes.NewValue(tftypes.String, "same"),
+				}),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"added":   tftypes.NewValue(tftypes.String, "added"),
+					"changed": tftypes.NewValue(tftypes.String, "new"),
+					"same":    tftypes.NewValue(tftypes.String, "same"),
+				}),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				`test_map["added"]`:   tfsdk.ChangeKindAdded,
+				`test_map["changed"]`: tfsdk.ChangeKindModified,
+				`test_map["removed"]`: tfsdk.ChangeKindRemoved,
+			},
+		},
+		"set": {
+			old: testValue(map[string]tftypes.Value{
+				"test_set": testStrings(tftypes.Set{ElementType: tftypes.String}, "one", "two"),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_set": testStrings(tftypes.Set{ElementType: tftypes.String}, "two", "three"),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				`test_set[Value("one")]`:   tfsdk.ChangeKindRemoved,
+				`test_set[Value("three")]`: tfsdk.ChangeKindAdded,
+			},
+		},
+		"nested-added": {
+			old: testValue(nil),
+			new: testValue(map[string]tftypes.Value{
+				"test_single_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"test_string": tftypes.NewValue(tftypes.String, "test"),
+				}),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_single_nested": tfsdk.ChangeKindAdded,
+			},
+		},
+		"nested-modified": {
+			old: testValue(map[string]tftypes.Value{
+				"test_single_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"test_string": tftypes.NewValue(tftypes.String, "old"),
+				}),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_single_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"test_string": tftypes.NewValue(tftypes.String, "new"),
+				}),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_single_nested.test_string": tfsdk.ChangeKindModified,
+			},
+		},
+		"nested-unknown": {
+			old: testValue(map[string]tftypes.Value{
+				"test_single_nested": tftypes.NewValue(testNestedType, map[string]tftypes.Value{
+					"test_string": tftypes.NewValue(tftypes.String, "old"),
+				}),
+			}),
+			new: testValue(map[string]tftypes.Value{
+				"test_single_nested": tftypes.NewValue(testNestedType, tftypes.UnknownValue),
+			}),
+			expected: map[string]tfsdk.ChangeKind{
+				"test_single_nested": tfsdk.ChangeKindModified,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tfsdk.Diff(
+				context.Background(),
+				tfsdk.State{Raw: testCase.old, Schema: testSchema},
+				tfsdk.Plan{Raw: testCase.new, Schema: testSchema},
+			)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeKindString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[tfsdk.ChangeKind]string{
+		tfsdk.ChangeKindInvalid:  "Invalid",
+		tfsdk.ChangeKindAdded:    "Added",
+		tfsdk.ChangeKindRemoved:  "Removed",
+		tfsdk.ChangeKindModified: "Modified",
+	}
+
+	for kind, expected := range testCases {
+		if got := kind.String(); got != expected {
+			t.Errorf("expected %q, got: %q", expected, got)
+		}
+	}
+}
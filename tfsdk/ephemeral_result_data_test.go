@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEphemeralResultDataGet(t *testing.T) {
+	t.Parallel()
+
+	type testResultData struct {
+		ID types.String `tfsdk:"id"`
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	// Refer to fwschemadata.TestDataGet for more exhaustive unit testing.
+	// This test is to ensure EphemeralResultData schema and data values are
+	// passed appropriately to the shared implementation.
+	result := tfsdk.EphemeralResultData{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test-id"),
+		}),
+		Schema: testSchema,
+	}
+
+	var got testResultData
+
+	diags := result.Get(context.Background(), &got)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	expected := testResultData{
+		ID: types.StringValue("test-id"),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var gotAttribute types.String
+
+	diags = result.GetAttribute(context.Background(), path.Root("id"), &gotAttribute)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(gotAttribute, types.StringValue("test-id")); diff != "" {
+		t.Errorf("unexpected attribute difference: %s", diff)
+	}
+}
+
+func TestEphemeralResultDataSet(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	testCases := map[string]struct {
+		val           interface{}
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			val:      nil,
+			expected: tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Ephemeral Result Data Write Error",
+					"An unexpected error was encountered trying to write the ephemeral result data. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot set nil as entire ephemeral result data",
+				),
+			},
+		},
+		"valid": {
+			val: struct {
+				ID types.String `tfsdk:"id"`
+			}{
+				ID: types.StringValue("test-id"),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test-id"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := tfsdk.EphemeralResultData{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			}
+
+			diags := result.Set(context.Background(), testCase.val)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(result.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEphemeralResultDataSetAttribute(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"region": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	result := tfsdk.EphemeralResultData{
+		Raw:    tftypes.NewValue(testType, nil),
+		Schema: testSchema,
+	}
+
+	diags := result.SetAttribute(context.Background(), path.Root("id"), "test-id")
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	expected := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":     tftypes.NewValue(tftypes.String, "test-id"),
+		"region": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	if diff := cmp.Diff(result.Raw, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -35,6 +35,15 @@ func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface
 	return p.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributes retrieves the attributes or blocks found at each of the
+// AttributeTarget paths and populates each target with the value, similar
+// to calling GetAttribute for each AttributeTarget. Every AttributeTarget is
+// retrieved, even if an earlier one returns error diagnostics, and any
+// diagnostics include the path which could not be retrieved.
+func (p Plan) GetAttributes(ctx context.Context, targets ...AttributeTarget) diag.Diagnostics {
+	return getAttributes(ctx, *p.data(), targets)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
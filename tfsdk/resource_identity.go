@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ResourceIdentity represents Terraform resource identity data, which is a
+// set of attributes that uniquely identify the remote object of a managed
+// resource, such as for importing the resource.
+type ResourceIdentity struct {
+	Raw    tftypes.Value
+	Schema fwschema.Schema
+}
+
+// Get populates the struct passed as `target` with the entire resource
+// identity.
+func (s ResourceIdentity) Get(ctx context.Context, target interface{}) diag.Diagnostics {
+	return s.data().Get(ctx, target)
+}
+
+// GetAttribute retrieves the attribute found at `path` and populates the
+// `target` with the value.
+func (s ResourceIdentity) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
+	return s.data().GetAtPath(ctx, path, target)
+}
+
+// Set populates the entire resource identity using the supplied Go value. The
+// value `val` should be a struct whose values have one of the attr.Value
+// types. Each field must be tagged with the corresponding schema field.
+func (s *ResourceIdentity) Set(ctx context.Context, val interface{}) diag.Diagnostics {
+	if val == nil {
+		err := fmt.Errorf("cannot set nil as entire resource identity")
+
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Resource Identity Write Error",
+				"An unexpected error was encountered trying to write the resource identity. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			),
+		}
+	}
+
+	data := s.data()
+	diags := data.Set(ctx, val)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
+// SetAttribute sets the attribute at `path` using the supplied Go value.
+//
+// The attribute path and value must be valid with the current schema. If the
+// attribute path already has a value, it will be overwritten.
+//
+// The value must not be an untyped nil. Use a typed nil or types package null
+// value function instead.
+func (s *ResourceIdentity) SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
+	data := s.data()
+	diags := data.SetAtPath(ctx, path, val)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
+func (s ResourceIdentity) data() fwschemadata.Data {
+	return fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionResourceIdentity,
+		Schema:         s.Schema,
+		TerraformValue: s.Raw,
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfsdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResourceIdentityGet(t *testing.T) {
+	t.Parallel()
+
+	type testIdentityData struct {
+		ID types.String `tfsdk:"id"`
+	}
+
+	testSchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	// Refer to fwschemadata.TestDataGet for more exhaustive unit testing.
+	// This test is to ensure ResourceIdentity schema and data values are
+	// passed appropriately to the shared implementation.
+	identity := tfsdk.ResourceIdentity{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test-id"),
+		}),
+		Schema: testSchema,
+	}
+
+	var got testIdentityData
+
+	diags := identity.Get(context.Background(), &got)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	expected := testIdentityData{
+		ID: types.StringValue("test-id"),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var gotAttribute types.String
+
+	diags = identity.GetAttribute(context.Background(), path.Root("id"), &gotAttribute)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(gotAttribute, types.StringValue("test-id")); diff != "" {
+		t.Errorf("unexpected attribute difference: %s", diff)
+	}
+}
+
+func TestResourceIdentitySet(t *testing.T) {
+	t.Parallel()
+
+	testSchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	testCases := map[string]struct {
+		val           interface{}
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			val:      nil,
+			expected: tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Identity Write Error",
+					"An unexpected error was encountered trying to write the resource identity. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot set nil as entire resource identity",
+				),
+			},
+		},
+		"valid": {
+			val: struct {
+				ID types.String `tfsdk:"id"`
+			}{
+				ID: types.StringValue("test-id"),
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test-id"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			identity := tfsdk.ResourceIdentity{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			}
+
+			diags := identity.Set(context.Background(), testCase.val)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(identity.Raw, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceIdentitySetAttribute(t *testing.T) {
+	t.Parallel()
+
+	testSchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+			},
+			"region": identityschema.StringAttribute{
+				OptionalForImport: true,
+			},
+		},
+	}
+
+	testType := testSchema.Type().TerraformType(context.Background())
+
+	identity := tfsdk.ResourceIdentity{
+		Raw:    tftypes.NewValue(testType, nil),
+		Schema: testSchema,
+	}
+
+	diags := identity.SetAttribute(context.Background(), path.Root("id"), "test-id")
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	expected := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"id":     tftypes.NewValue(tftypes.String, "test-id"),
+		"region": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	if diff := cmp.Diff(identity.Raw, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -37,6 +38,15 @@ func (s State) GetAttribute(ctx context.Context, path path.Path, target interfac
 	return s.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributes retrieves the attributes or blocks found at each of the
+// AttributeTarget paths and populates each target with the value, similar
+// to calling GetAttribute for each AttributeTarget. Every AttributeTarget is
+// retrieved, even if an earlier one returns error diagnostics, and any
+// diagnostics include the path which could not be retrieved.
+func (s State) GetAttributes(ctx context.Context, targets ...AttributeTarget) diag.Diagnostics {
+	return getAttributes(ctx, s.data(), targets)
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -46,6 +56,32 @@ func (s State) PathMatches(ctx context.Context, pathExpr path.Expression) (path.
 	return s.data().PathMatches(ctx, pathExpr)
 }
 
+// PathMatch returns the single path.Path matching the given path.Expression,
+// such as a set element or list element, for which the match function returns
+// true. If the match function is nil, all paths matching the expression are
+// considered. Error diagnostics are returned if no paths or multiple paths
+// match.
+func (s State) PathMatch(ctx context.Context, pathExpr path.Expression, match func(attr.Value) bool) (path.Path, diag.Diagnostics) {
+	return s.data().PathMatch(ctx, pathExpr, match)
+}
+
+// GetAttributeMatch retrieves the attribute or block found at the single
+// path matching the given path.Expression and match function, and populates
+// the `target` with the value. The matched path is returned, which can be
+// used with SetAttribute to update only that attribute or element. Error
+// diagnostics are returned if no paths or multiple paths match.
+func (s State) GetAttributeMatch(ctx context.Context, pathExpr path.Expression, match func(attr.Value) bool, target interface{}) (path.Path, diag.Diagnostics) {
+	matchedPath, diags := s.PathMatch(ctx, pathExpr, match)
+
+	if diags.HasError() {
+		return matchedPath, diags
+	}
+
+	diags.Append(s.GetAttribute(ctx, matchedPath, target)...)
+
+	return matchedPath, diags
+}
+
 // Set populates the entire state using the supplied Go value. The value `val`
 // should be a struct whose values have one of the attr.Value types. Each field
 // must be tagged with the corresponding schema field.
@@ -72,6 +108,85 @@ func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 	return diags
 }
 
+// Merge populates the state using the supplied Go value, only overwriting
+// top level attributes and blocks which have a non-null value in `val`. All
+// other existing state values are preserved. The value `val` should be a
+// struct whose values have one of the attr.Value types, or are pointers which
+// are nil when unset. Each field must be tagged with the corresponding schema
+// field.
+//
+// This is useful when state is populated in multiple steps, such as setting
+// some computed values after a remote API call, without reading and setting
+// the entire state again.
+func (s *State) Merge(ctx context.Context, val interface{}) diag.Diagnostics {
+	if val == nil {
+		err := fmt.Errorf("cannot merge nil into state; to remove a resource from state, call State.RemoveResource, instead")
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"State Write Error",
+				"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			),
+		}
+	}
+
+	data := s.data()
+	diags := data.Merge(ctx, val)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = data.TerraformValue
+
+	return diags
+}
+
+// SetFromPlan populates the entire state using the given plan, converting any
+// unknown values into null values. Terraform requires all values in the state
+// to be known after apply, but accepts any value, including null, for values
+// which were unknown in the plan.
+//
+// This is useful in the resource Create or Update methods when only some
+// computed values are set by the provider, such as calling SetAttribute for
+// each computed value afterwards. Unlike setting the entire state from a Go
+// struct, this preserves all other known planned values, such as attribute
+// defaults, which prevents Terraform "Provider produced inconsistent result
+// after apply" errors for values the provider did not explicitly set.
+func (s *State) SetFromPlan(ctx context.Context, plan Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.Raw.Type().Equal(s.Schema.Type().TerraformType(ctx)) {
+		diags.AddError(
+			"State Write Error",
+			"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("The plan type %s does not match the state schema type %s.", plan.Raw.Type(), s.Schema.Type().TerraformType(ctx)),
+		)
+
+		return diags
+	}
+
+	raw, err := tftypes.Transform(plan.Raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsKnown() {
+			return value, nil
+		}
+
+		return tftypes.NewValue(value.Type(), nil), nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"State Write Error",
+			"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	s.Raw = raw
+
+	return diags
+}
+
 // SetAttribute sets the attribute at `path` using the supplied Go value.
 //
 // The attribute path and value must be valid with the current schema. If the
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -200,6 +201,295 @@ func TestStateGetAttribute(t *testing.T) {
 	}
 }
 
+func TestStateGetAttributes(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		state         tfsdk.State
+		targets       func(name *string, count *int64) []tfsdk.AttributeTarget
+		expectedName  string
+		expectedCount int64
+		expectedDiags diag.Diagnostics
+	}
+
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"count": tftypes.Number,
+				"name":  tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"count": tftypes.NewValue(tftypes.Number, 2),
+			"name":  tftypes.NewValue(tftypes.String, "namevalue"),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"count": testschema.Attribute{
+					Type:     types.Int64Type,
+					Required: true,
+				},
+				"name": testschema.Attribute{
+					Type:     types.StringType,
+					Required: true,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataGetAtPath for more exhaustive unit
+		// testing. These test cases are to ensure each target is passed
+		// appropriately to the shared implementation.
+		"valid": {
+			state: testState,
+			targets: func(name *string, count *int64) []tfsdk.AttributeTarget {
+				return []tfsdk.AttributeTarget{
+					{Path: path.Root("name"), Target: name},
+					{Path: path.Root("count"), Target: count},
+				}
+			},
+			expectedName:  "namevalue",
+			expectedCount: 2,
+		},
+		"diagnostics": {
+			state: testState,
+			targets: func(name *string, count *int64) []tfsdk.AttributeTarget {
+				return []tfsdk.AttributeTarget{
+					{Path: path.Root("missing"), Target: name},
+					{Path: path.Root("count"), Target: count},
+				}
+			},
+			expectedCount: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(
+					path.Root("missing"),
+					diag.NewErrorDiagnostic(
+						"State Read Error",
+						"An unexpected error was encountered trying to retrieve type information at a given path. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Error: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+					),
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotName string
+			var gotCount int64
+
+			diags := tc.state.GetAttributes(context.Background(), tc.targets(&gotName, &gotCount)...)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if gotName != tc.expectedName {
+				t.Errorf("expected name %q, got %q", tc.expectedName, gotName)
+			}
+
+			if gotCount != tc.expectedCount {
+				t.Errorf("expected count %d, got %d", tc.expectedCount, gotCount)
+			}
+		})
+	}
+}
+
+func TestStateGetAttributeMatch(t *testing.T) {
+	t.Parallel()
+
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"names": tftypes.Set{ElementType: tftypes.String},
+			},
+		}, map[string]tftypes.Value{
+			"names": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"names": testschema.Attribute{
+					Type:     types.SetType{ElemType: types.StringType},
+					Required: true,
+				},
+			},
+		},
+	}
+
+	type testCase struct {
+		match         func(attr.Value) bool
+		expected      *string
+		expectedPath  path.Path
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataPathMatch for more exhaustive unit
+		// testing. These test cases are to ensure State schema and data values
+		// are passed appropriately to the shared implementation.
+		"valid": {
+			match: func(value attr.Value) bool {
+				return value.Equal(types.StringValue("two"))
+			},
+			expected:     pointer("two"),
+			expectedPath: path.Root("names").AtSetValue(types.StringValue("two")),
+		},
+		"diagnostics": {
+			match: func(value attr.Value) bool {
+				return value.Equal(types.StringValue("three"))
+			},
+			expected:     new(string),
+			expectedPath: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Path Expression Matched No Paths",
+					"The Terraform Provider expected exactly one path to match the path expression, but no paths matched. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developers.\n\n"+
+						"Path Expression: names[Value(*)]",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			target := new(string)
+
+			gotPath, diags := testState.GetAttributeMatch(context.Background(), path.MatchRoot("names").AtAnySetValue(), tc.match, target)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(gotPath, tc.expectedPath); diff != "" {
+				t.Errorf("unexpected path (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(target, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateMerge(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		state         tfsdk.State
+		val           interface{}
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		// Refer to fwschemadata.TestDataMerge for more exhaustive unit testing.
+		// These test cases are to ensure State schema and data values are
+		// passed appropriately to the shared implementation.
+		"valid": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":   tftypes.String,
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, nil),
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"id": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				ID   types.String `tfsdk:"id"`
+				Name types.String `tfsdk:"name"`
+			}{
+				ID:   types.StringValue("newid"),
+				Name: types.StringNull(),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "newid"),
+				"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+			}),
+		},
+		"nil": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			val: nil,
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Write Error",
+					"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot merge nil into state; to remove a resource from state, call State.RemoveResource, instead",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.state.Merge(context.Background(), tc.val)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateSet(t *testing.T) {
 	t.Parallel()
 
@@ -291,6 +581,139 @@ func TestStateSet(t *testing.T) {
 	}
 }
 
+func TestStateSetFromPlan(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"computed": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"computed_default": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"list": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Computed: true,
+			},
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed":         tftypes.String,
+			"computed_default": tftypes.String,
+			"list":             tftypes.List{ElementType: tftypes.String},
+			"name":             tftypes.String,
+		},
+	}
+
+	type testCase struct {
+		state         tfsdk.State
+		plan          tfsdk.Plan
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	testCases := map[string]testCase{
+		"known": {
+			state: tfsdk.State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"computed":         tftypes.NewValue(tftypes.String, "computed"),
+					"computed_default": tftypes.NewValue(tftypes.String, "default"),
+					"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "element"),
+					}),
+					"name": tftypes.NewValue(tftypes.String, "name"),
+				}),
+				Schema: testSchema,
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"computed":         tftypes.NewValue(tftypes.String, "computed"),
+				"computed_default": tftypes.NewValue(tftypes.String, "default"),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "element"),
+				}),
+				"name": tftypes.NewValue(tftypes.String, "name"),
+			}),
+		},
+		"unknown": {
+			state: tfsdk.State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"computed":         tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"computed_default": tftypes.NewValue(tftypes.String, "default"),
+					"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					"name": tftypes.NewValue(tftypes.String, "name"),
+				}),
+				Schema: testSchema,
+			},
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"computed":         tftypes.NewValue(tftypes.String, nil),
+				"computed_default": tftypes.NewValue(tftypes.String, "default"),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, nil),
+				}),
+				"name": tftypes.NewValue(tftypes.String, "name"),
+			}),
+		},
+		"mismatched-type": {
+			state: tfsdk.State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "name"),
+				}),
+			},
+			expected: tftypes.NewValue(testType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Write Error",
+					"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`The plan type tftypes.Object["name":tftypes.String] does not match the state schema type tftypes.Object["computed":tftypes.String, "computed_default":tftypes.String, "list":tftypes.List[tftypes.String], "name":tftypes.String].`,
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.state.SetFromPlan(context.Background(), tc.plan)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateSetAttribute(t *testing.T) {
 	t.Parallel()
 
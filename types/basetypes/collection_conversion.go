@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ListToSet returns the Set equivalent of the given List, with the same
+// element type. A null or unknown List returns a null or unknown Set.
+//
+// Duplicate known elements are removed, keeping the first occurrence, since
+// a Set cannot contain equal elements. This matches the Terraform toset()
+// function. Unknown elements are always kept, since they may become
+// different values.
+//
+// To convert between Go types instead, use the List type ElementsAs method
+// with a slice target, then NewSetValueFrom with that slice.
+func ListToSet(ctx context.Context, list ListValue) (SetValue, diag.Diagnostics) {
+	elementType := list.ElementType(ctx)
+
+	if list.IsNull() {
+		return NewSetNull(elementType), nil
+	}
+
+	if list.IsUnknown() {
+		return NewSetUnknown(elementType), nil
+	}
+
+	elements := make([]attr.Value, 0, len(list.elements))
+
+	for _, element := range list.elements {
+		if !element.IsUnknown() && containsValue(elements, element) {
+			continue
+		}
+
+		elements = append(elements, element)
+	}
+
+	return NewSetValue(elementType, elements)
+}
+
+// SetToList returns the List equivalent of the given Set, with the same
+// element type. A null or unknown Set returns a null or unknown List.
+//
+// The List elements are in the order the Set elements are stored, which is
+// not meaningful to Terraform. Sort the elements beforehand if a stable order
+// is required.
+//
+// To convert between Go types instead, use the Set type ElementsAs method
+// with a slice target, then NewListValueFrom with that slice.
+func SetToList(ctx context.Context, set SetValue) (ListValue, diag.Diagnostics) {
+	elementType := set.ElementType(ctx)
+
+	if set.IsNull() {
+		return NewListNull(elementType), nil
+	}
+
+	if set.IsUnknown() {
+		return NewListUnknown(elementType), nil
+	}
+
+	elements := make([]attr.Value, len(set.elements))
+
+	copy(elements, set.elements)
+
+	return NewListValue(elementType, elements)
+}
+
+// containsValue returns true if the given values include an equal value.
+func containsValue(values []attr.Value, value attr.Value) bool {
+	for _, v := range values {
+		if v.Equal(value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestListToSet(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"test_attr": StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		input         ListValue
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: NewSetNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+		"empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{}),
+		},
+		"elements": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("one"),
+				NewStringNull(),
+				NewStringValue("two"),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("one"),
+				NewStringNull(),
+				NewStringValue("two"),
+			}),
+		},
+		"elements-duplicate": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("one"),
+				NewStringValue("two"),
+				NewStringValue("one"),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("one"),
+				NewStringValue("two"),
+			}),
+		},
+		"elements-unknown": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringUnknown(),
+				NewStringValue("one"),
+				NewStringUnknown(),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringUnknown(),
+				NewStringValue("one"),
+				NewStringUnknown(),
+			}),
+		},
+		"element-type-object": {
+			input: NewListValueMust(testObjectType, []attr.Value{
+				NewObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+					"test_attr": NewStringValue("one"),
+				}),
+			}),
+			expected: NewSetValueMust(testObjectType, []attr.Value{
+				NewObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+					"test_attr": NewStringValue("one"),
+				}),
+			}),
+		},
+		"element-type-object-null": {
+			input:    NewListNull(testObjectType),
+			expected: NewSetNull(testObjectType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ListToSet(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetToList(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"test_attr": StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		input         SetValue
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: NewListNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: NewListUnknown(StringType{}),
+		},
+		"empty": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"elements": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("one"),
+				NewStringUnknown(),
+				NewStringValue("two"),
+			}),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("one"),
+				NewStringUnknown(),
+				NewStringValue("two"),
+			}),
+		},
+		"element-type-object": {
+			input: NewSetValueMust(testObjectType, []attr.Value{
+				NewObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+					"test_attr": NewStringValue("one"),
+				}),
+			}),
+			expected: NewListValueMust(testObjectType, []attr.Value{
+				NewObjectValueMust(testObjectType.AttrTypes, map[string]attr.Value{
+					"test_attr": NewStringValue("one"),
+				}),
+			}),
+		},
+		"element-type-object-unknown": {
+			input:    NewSetUnknown(testObjectType),
+			expected: NewListUnknown(testObjectType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := SetToList(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -110,10 +110,15 @@ func (t DynamicType) ValueType(_ context.Context) attr.Value {
 }
 
 // tftypeToFrameworkType is a helper function that returns the framework type equivalent for a given Terraform type.
+// Types registered via attr.RegisterType take precedence over the base framework types, which are used as a fallback.
 //
 // Custom dynamic type implementations shouldn't need to override this method, but if needed, they can implement similar logic
 // in their `ValueFromTerraform` implementation.
 func tftypeToFrameworkType(in tftypes.Type) (attr.Type, error) {
+	if registeredType := attr.RegisteredType(in); registeredType != nil {
+		return registeredType, nil
+	}
+
 	// Primitive types
 	if in.Is(tftypes.Bool) {
 		return BoolType{}, nil
@@ -441,3 +441,145 @@ func TestDynamicTypeValueFromTerraform(t *testing.T) {
 		})
 	}
 }
+
+type dynamicRegisteredObjectType struct {
+	ObjectType
+}
+
+func (t dynamicRegisteredObjectType) Equal(o attr.Type) bool {
+	other, ok := o.(dynamicRegisteredObjectType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t dynamicRegisteredObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	val, err := t.ObjectType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:forcetypeassert // ObjectType always returns ObjectValue
+	return dynamicRegisteredObjectValue{ObjectValue: val.(ObjectValue)}, nil
+}
+
+func (t dynamicRegisteredObjectType) ValueType(_ context.Context) attr.Value {
+	return dynamicRegisteredObjectValue{}
+}
+
+type dynamicRegisteredObjectValue struct {
+	ObjectValue
+}
+
+func (v dynamicRegisteredObjectValue) Equal(o attr.Value) bool {
+	other, ok := o.(dynamicRegisteredObjectValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.ObjectValue.Equal(other.ObjectValue)
+}
+
+func (v dynamicRegisteredObjectValue) Type(ctx context.Context) attr.Type {
+	return dynamicRegisteredObjectType{ObjectType: ObjectType{AttrTypes: v.AttributeTypes(ctx)}}
+}
+
+// The attr.RegisterType registry is global, so this test is intentionally not
+// parallel and uses an object type unique to this test.
+func TestDynamicTypeValueFromTerraform_RegisteredType(t *testing.T) {
+	registeredType := dynamicRegisteredObjectType{
+		ObjectType: ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"test_dynamic_registered": StringType{},
+			},
+		},
+	}
+
+	attr.RegisterType(registeredType)
+
+	registeredTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_dynamic_registered": tftypes.String,
+		},
+	}
+
+	registeredValue := dynamicRegisteredObjectValue{
+		ObjectValue: NewObjectValueMust(
+			map[string]attr.Type{
+				"test_dynamic_registered": StringType{},
+			},
+			map[string]attr.Value{
+				"test_dynamic_registered": NewStringValue("hello"),
+			},
+		),
+	}
+
+	tests := map[string]struct {
+		input    tftypes.Value
+		expected attr.Value
+	}{
+		"registered-type": {
+			input: tftypes.NewValue(registeredTfType, map[string]tftypes.Value{
+				"test_dynamic_registered": tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			expected: NewDynamicValue(registeredValue),
+		},
+		"registered-type-element": {
+			input: tftypes.NewValue(
+				tftypes.List{ElementType: registeredTfType},
+				[]tftypes.Value{
+					tftypes.NewValue(registeredTfType, map[string]tftypes.Value{
+						"test_dynamic_registered": tftypes.NewValue(tftypes.String, "hello"),
+					}),
+				},
+			),
+			expected: NewDynamicValue(
+				NewListValueMust(
+					registeredType,
+					[]attr.Value{registeredValue},
+				),
+			),
+		},
+		"unregistered-type-fallback": {
+			input: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test_dynamic_unregistered": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"test_dynamic_unregistered": tftypes.NewValue(tftypes.String, "hello"),
+				},
+			),
+			expected: NewDynamicValue(
+				NewObjectValueMust(
+					map[string]attr.Type{
+						"test_dynamic_unregistered": StringType{},
+					},
+					map[string]attr.Value{
+						"test_dynamic_unregistered": NewStringValue("hello"),
+					},
+				),
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := DynamicType{}.ValueFromTerraform(context.Background(), test.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
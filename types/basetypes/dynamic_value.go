@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -195,3 +196,368 @@ func (v DynamicValue) IsUnderlyingValueNull() bool {
 func (v DynamicValue) IsUnderlyingValueUnknown() bool {
 	return v.value != nil && v.value.IsUnknown()
 }
+
+// UnderlyingType returns the type of the concrete underlying value in the
+// DynamicValue. This will return nil if DynamicValue is null or unknown.
+func (v DynamicValue) UnderlyingType(ctx context.Context) attr.Type {
+	if v.value == nil {
+		return nil
+	}
+
+	return v.value.Type(ctx)
+}
+
+// IsUnderlyingValueBool returns true if the concrete underlying value in the
+// DynamicValue is a bool value, including custom bool value types. This
+// method will return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueBool() bool {
+	_, ok := v.value.(BoolValuable)
+
+	return ok
+}
+
+// AsBool returns the concrete underlying value in the DynamicValue as a
+// BoolValue. An error diagnostic is returned if the DynamicValue is null or
+// unknown, or the underlying value is not a bool value.
+func (v DynamicValue) AsBool(ctx context.Context) (BoolValue, diag.Diagnostics) {
+	valuable, ok := v.value.(BoolValuable)
+
+	if !ok {
+		return BoolValue{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "bool")}
+	}
+
+	return valuable.ToBoolValue(ctx)
+}
+
+// IsUnderlyingValueFloat64 returns true if the concrete underlying value in the
+// DynamicValue is a float64 value, including custom float64 value types, or a
+// number value which is exactly representable as a float64. This method will
+// return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueFloat64() bool {
+	if _, ok := v.value.(Float64Valuable); ok {
+		return true
+	}
+
+	_, ok := v.numberAsFloat64(context.Background())
+
+	return ok
+}
+
+// AsFloat64 returns the concrete underlying value in the DynamicValue as a
+// Float64Value. Number values, such as those received from Terraform, are
+// converted if they are exactly representable as a float64. An error
+// diagnostic is returned if the DynamicValue is null or unknown, or the
+// underlying value cannot be converted to a float64 value.
+func (v DynamicValue) AsFloat64(ctx context.Context) (Float64Value, diag.Diagnostics) {
+	if valuable, ok := v.value.(Float64Valuable); ok {
+		return valuable.ToFloat64Value(ctx)
+	}
+
+	if float64Value, ok := v.numberAsFloat64(ctx); ok {
+		return float64Value, nil
+	}
+
+	return Float64Value{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "float64")}
+}
+
+// IsUnderlyingValueInt64 returns true if the concrete underlying value in the
+// DynamicValue is an int64 value, including custom int64 value types, or a
+// number value which is exactly representable as an int64. This method will
+// return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueInt64() bool {
+	if _, ok := v.value.(Int64Valuable); ok {
+		return true
+	}
+
+	_, ok := v.numberAsInt64(context.Background())
+
+	return ok
+}
+
+// AsInt64 returns the concrete underlying value in the DynamicValue as a
+// Int64Value. Number values, such as those received from Terraform, are
+// converted if they are exactly representable as an int64. An error
+// diagnostic is returned if the DynamicValue is null or unknown, or the
+// underlying value cannot be converted to an int64 value.
+func (v DynamicValue) AsInt64(ctx context.Context) (Int64Value, diag.Diagnostics) {
+	if valuable, ok := v.value.(Int64Valuable); ok {
+		return valuable.ToInt64Value(ctx)
+	}
+
+	if int64Value, ok := v.numberAsInt64(ctx); ok {
+		return int64Value, nil
+	}
+
+	return Int64Value{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "int64")}
+}
+
+// IsUnderlyingValueNumber returns true if the concrete underlying value in the
+// DynamicValue is a number value, including custom number value types. This
+// method will return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueNumber() bool {
+	_, ok := v.value.(NumberValuable)
+
+	return ok
+}
+
+// AsNumber returns the concrete underlying value in the DynamicValue as a
+// NumberValue. An error diagnostic is returned if the DynamicValue is null or
+// unknown, or the underlying value is not a number value.
+func (v DynamicValue) AsNumber(ctx context.Context) (NumberValue, diag.Diagnostics) {
+	valuable, ok := v.value.(NumberValuable)
+
+	if !ok {
+		return NumberValue{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "number")}
+	}
+
+	return valuable.ToNumberValue(ctx)
+}
+
+// IsUnderlyingValueString returns true if the concrete underlying value in the
+// DynamicValue is a string value, including custom string value types. This
+// method will return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueString() bool {
+	_, ok := v.value.(StringValuable)
+
+	return ok
+}
+
+// AsString returns the concrete underlying value in the DynamicValue as a
+// StringValue. An error diagnostic is returned if the DynamicValue is null or
+// unknown, or the underlying value is not a string value.
+func (v DynamicValue) AsString(ctx context.Context) (StringValue, diag.Diagnostics) {
+	valuable, ok := v.value.(StringValuable)
+
+	if !ok {
+		return StringValue{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "string")}
+	}
+
+	return valuable.ToStringValue(ctx)
+}
+
+// IsUnderlyingValueList returns true if the concrete underlying value in the
+// DynamicValue is a list value, including custom list value types, or a tuple
+// value whose elements all have the same type. This method will return false
+// if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueList() bool {
+	if _, ok := v.value.(ListValuable); ok {
+		return true
+	}
+
+	_, ok := v.tupleAsList(context.Background())
+
+	return ok
+}
+
+// AsList returns the concrete underlying value in the DynamicValue as a
+// ListValue. Tuple values, which Terraform uses for list expressions in
+// dynamic values, are converted if all elements have the same type. An empty
+// tuple is converted to an empty list with a DynamicType element type. An
+// error diagnostic is returned if the DynamicValue is null or unknown, or the
+// underlying value cannot be converted to a list value.
+func (v DynamicValue) AsList(ctx context.Context) (ListValue, diag.Diagnostics) {
+	if valuable, ok := v.value.(ListValuable); ok {
+		return valuable.ToListValue(ctx)
+	}
+
+	if listValue, ok := v.tupleAsList(ctx); ok {
+		return listValue, nil
+	}
+
+	return ListValue{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "list")}
+}
+
+// IsUnderlyingValueMap returns true if the concrete underlying value in the
+// DynamicValue is a map value, including custom map value types. This
+// method will return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueMap() bool {
+	_, ok := v.value.(MapValuable)
+
+	return ok
+}
+
+// AsMap returns the concrete underlying value in the DynamicValue as a
+// MapValue. An error diagnostic is returned if the DynamicValue is null or
+// unknown, or the underlying value is not a map value.
+func (v DynamicValue) AsMap(ctx context.Context) (MapValue, diag.Diagnostics) {
+	valuable, ok := v.value.(MapValuable)
+
+	if !ok {
+		return MapValue{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "map")}
+	}
+
+	return valuable.ToMapValue(ctx)
+}
+
+// IsUnderlyingValueSet returns true if the concrete underlying value in the
+// DynamicValue is a set value, including custom set value types. This
+// method will return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueSet() bool {
+	_, ok := v.value.(SetValuable)
+
+	return ok
+}
+
+// AsSet returns the concrete underlying value in the DynamicValue as a
+// SetValue. An error diagnostic is returned if the DynamicValue is null or
+// unknown, or the underlying value is not a set value.
+func (v DynamicValue) AsSet(ctx context.Context) (SetValue, diag.Diagnostics) {
+	valuable, ok := v.value.(SetValuable)
+
+	if !ok {
+		return SetValue{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "set")}
+	}
+
+	return valuable.ToSetValue(ctx)
+}
+
+// IsUnderlyingValueObject returns true if the concrete underlying value in the
+// DynamicValue is an object value, including custom object value types. This
+// method will return false if the DynamicValue is null or unknown.
+func (v DynamicValue) IsUnderlyingValueObject() bool {
+	_, ok := v.value.(ObjectValuable)
+
+	return ok
+}
+
+// AsObject returns the concrete underlying value in the DynamicValue as a
+// ObjectValue. An error diagnostic is returned if the DynamicValue is null or
+// unknown, or the underlying value is not an object value.
+func (v DynamicValue) AsObject(ctx context.Context) (ObjectValue, diag.Diagnostics) {
+	valuable, ok := v.value.(ObjectValuable)
+
+	if !ok {
+		return ObjectValue{}, diag.Diagnostics{v.underlyingValueConversionDiagnostic(ctx, "object")}
+	}
+
+	return valuable.ToObjectValue(ctx)
+}
+
+// numberAsFloat64 returns the underlying number value as a Float64Value and
+// true, if the underlying value is a number value which is exactly
+// representable as a float64.
+func (v DynamicValue) numberAsFloat64(ctx context.Context) (Float64Value, bool) {
+	valuable, ok := v.value.(NumberValuable)
+
+	if !ok {
+		return Float64Value{}, false
+	}
+
+	numberValue, diags := valuable.ToNumberValue(ctx)
+
+	if diags.HasError() {
+		return Float64Value{}, false
+	}
+
+	if numberValue.IsNull() {
+		return NewFloat64Null(), true
+	}
+
+	if numberValue.IsUnknown() {
+		return NewFloat64Unknown(), true
+	}
+
+	f, accuracy := numberValue.ValueBigFloat().Float64()
+
+	if accuracy != big.Exact {
+		return Float64Value{}, false
+	}
+
+	return NewFloat64Value(f), true
+}
+
+// numberAsInt64 returns the underlying number value as an Int64Value and
+// true, if the underlying value is a number value which is exactly
+// representable as an int64.
+func (v DynamicValue) numberAsInt64(ctx context.Context) (Int64Value, bool) {
+	valuable, ok := v.value.(NumberValuable)
+
+	if !ok {
+		return Int64Value{}, false
+	}
+
+	numberValue, diags := valuable.ToNumberValue(ctx)
+
+	if diags.HasError() {
+		return Int64Value{}, false
+	}
+
+	if numberValue.IsNull() {
+		return NewInt64Null(), true
+	}
+
+	if numberValue.IsUnknown() {
+		return NewInt64Unknown(), true
+	}
+
+	bf := numberValue.ValueBigFloat()
+
+	if !bf.IsInt() {
+		return Int64Value{}, false
+	}
+
+	i, accuracy := bf.Int64()
+
+	if accuracy != big.Exact {
+		return Int64Value{}, false
+	}
+
+	return NewInt64Value(i), true
+}
+
+// tupleAsList returns the underlying tuple value as a ListValue and true, if
+// the underlying value is a tuple value whose elements all have the same type.
+func (v DynamicValue) tupleAsList(ctx context.Context) (ListValue, bool) {
+	tupleValue, ok := v.value.(TupleValue)
+
+	if !ok {
+		return ListValue{}, false
+	}
+
+	var elementType attr.Type = DynamicType{}
+
+	elementTypes := tupleValue.ElementTypes(ctx)
+
+	if len(elementTypes) > 0 {
+		elementType = elementTypes[0]
+	}
+
+	for _, tupleElementType := range elementTypes {
+		if !elementType.Equal(tupleElementType) {
+			return ListValue{}, false
+		}
+	}
+
+	if tupleValue.IsNull() {
+		return NewListNull(elementType), true
+	}
+
+	if tupleValue.IsUnknown() {
+		return NewListUnknown(elementType), true
+	}
+
+	listValue, diags := NewListValue(elementType, tupleValue.Elements())
+
+	if diags.HasError() {
+		return ListValue{}, false
+	}
+
+	return listValue, true
+}
+
+// underlyingValueConversionDiagnostic returns the error diagnostic for an
+// underlying value which could not be converted to the expected value type.
+func (v DynamicValue) underlyingValueConversionDiagnostic(ctx context.Context, expected string) diag.Diagnostic {
+	got := "no underlying value"
+
+	if v.value != nil {
+		got = v.value.Type(ctx).String()
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Dynamic Value Conversion Error",
+		"An unexpected error was encountered while converting a dynamic value. "+
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Expected underlying %s value, got: %s", expected, got),
+	)
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -788,3 +789,596 @@ func TestDynamicValueIsUnderlyingValueUnknown(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicValueUnderlyingType(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation attr.Type
+	}
+	tests := map[string]testCase{
+		"known-primitive": {
+			input:       NewDynamicValue(NewStringValue("hello world")),
+			expectation: StringType{},
+		},
+		"known-primitive-underlying-value-null": {
+			input:       NewDynamicValue(NewStringNull()),
+			expectation: StringType{},
+		},
+		"known-collection": {
+			input: NewDynamicValue(NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+				},
+			)),
+			expectation: ListType{ElemType: StringType{}},
+		},
+		"known-object": {
+			input: NewDynamicValue(NewObjectValueMust(
+				map[string]attr.Type{
+					"test_attr": BoolType{},
+				},
+				map[string]attr.Value{
+					"test_attr": NewBoolValue(true),
+				},
+			)),
+			expectation: ObjectType{AttrTypes: map[string]attr.Type{"test_attr": BoolType{}}},
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: nil,
+		},
+		"unknown": {
+			input:       NewDynamicUnknown(),
+			expectation: nil,
+		},
+		"zero-value": {
+			input:       DynamicValue{},
+			expectation: nil,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.UnderlyingType(context.Background())
+			if diff := cmp.Diff(got, test.expectation); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueIsUnderlyingValueString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-string": {
+			input:       NewDynamicValue(NewStringValue("hello world")),
+			expectation: true,
+		},
+		"known-string-underlying-value-null": {
+			input:       NewDynamicValue(NewStringNull()),
+			expectation: true,
+		},
+		"known-bool": {
+			input:       NewDynamicValue(NewBoolValue(true)),
+			expectation: false,
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: false,
+		},
+		"unknown": {
+			input:       NewDynamicUnknown(),
+			expectation: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.IsUnderlyingValueString()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %t, got %t", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueIsUnderlyingValueObject(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-object": {
+			input: NewDynamicValue(NewObjectValueMust(
+				map[string]attr.Type{
+					"test_attr": StringType{},
+				},
+				map[string]attr.Value{
+					"test_attr": NewStringValue("hello"),
+				},
+			)),
+			expectation: true,
+		},
+		"known-map": {
+			input: NewDynamicValue(NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"test_key": NewStringValue("hello"),
+				},
+			)),
+			expectation: false,
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.IsUnderlyingValueObject()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %t, got %t", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueAsString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input         DynamicValue
+		expected      StringValue
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"known-string": {
+			input:    NewDynamicValue(NewStringValue("hello world")),
+			expected: NewStringValue("hello world"),
+		},
+		"known-string-underlying-value-null": {
+			input:    NewDynamicValue(NewStringNull()),
+			expected: NewStringNull(),
+		},
+		"known-string-underlying-value-unknown": {
+			input:    NewDynamicValue(NewStringUnknown()),
+			expected: NewStringUnknown(),
+		},
+		"known-bool": {
+			input:    NewDynamicValue(NewBoolValue(true)),
+			expected: StringValue{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dynamic Value Conversion Error",
+					"An unexpected error was encountered while converting a dynamic value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected underlying string value, got: basetypes.BoolType",
+				),
+			},
+		},
+		"null": {
+			input:    NewDynamicNull(),
+			expected: StringValue{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dynamic Value Conversion Error",
+					"An unexpected error was encountered while converting a dynamic value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected underlying string value, got: no underlying value",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.input.AsString(context.Background())
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, test.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueAsObject(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input         DynamicValue
+		expected      ObjectValue
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"known-object": {
+			input: NewDynamicValue(NewObjectValueMust(
+				map[string]attr.Type{
+					"test_attr": StringType{},
+				},
+				map[string]attr.Value{
+					"test_attr": NewStringValue("hello"),
+				},
+			)),
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"test_attr": StringType{},
+				},
+				map[string]attr.Value{
+					"test_attr": NewStringValue("hello"),
+				},
+			),
+		},
+		"known-list": {
+			input: NewDynamicValue(NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+				},
+			)),
+			expected: ObjectValue{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dynamic Value Conversion Error",
+					"An unexpected error was encountered while converting a dynamic value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected underlying object value, got: types.ListType[basetypes.StringType]",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.input.AsObject(context.Background())
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, test.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueIsUnderlyingValueInt64(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-int64": {
+			input:       NewDynamicValue(NewInt64Value(123)),
+			expectation: true,
+		},
+		"known-number-integer": {
+			input:       NewDynamicValue(NewNumberValue(big.NewFloat(123))),
+			expectation: true,
+		},
+		"known-number-fractional": {
+			input:       NewDynamicValue(NewNumberValue(big.NewFloat(1.5))),
+			expectation: false,
+		},
+		"known-number-overflow": {
+			input:       NewDynamicValue(NewNumberValue(new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 64)))),
+			expectation: false,
+		},
+		"known-string": {
+			input:       NewDynamicValue(NewStringValue("123")),
+			expectation: false,
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.IsUnderlyingValueInt64()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %t, got %t", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueIsUnderlyingValueFloat64(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-float64": {
+			input:       NewDynamicValue(NewFloat64Value(1.5)),
+			expectation: true,
+		},
+		"known-number-exact": {
+			input:       NewDynamicValue(NewNumberValue(big.NewFloat(1.5))),
+			expectation: true,
+		},
+		"known-number-inexact": {
+			input:       NewDynamicValue(NewNumberValue(new(big.Float).SetPrec(256).Quo(big.NewFloat(1), big.NewFloat(3)))),
+			expectation: false,
+		},
+		"known-string": {
+			input:       NewDynamicValue(NewStringValue("1.5")),
+			expectation: false,
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.IsUnderlyingValueFloat64()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %t, got %t", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueIsUnderlyingValueList(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       DynamicValue
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"known-list": {
+			input:       NewDynamicValue(NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello")})),
+			expectation: true,
+		},
+		"known-tuple-same-element-types": {
+			input: NewDynamicValue(NewTupleValueMust(
+				[]attr.Type{StringType{}, StringType{}},
+				[]attr.Value{NewStringValue("hello"), NewStringValue("world")},
+			)),
+			expectation: true,
+		},
+		"known-tuple-empty": {
+			input:       NewDynamicValue(NewTupleValueMust([]attr.Type{}, []attr.Value{})),
+			expectation: true,
+		},
+		"known-tuple-mixed-element-types": {
+			input: NewDynamicValue(NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("hello"), NewBoolValue(true)},
+			)),
+			expectation: false,
+		},
+		"known-set": {
+			input:       NewDynamicValue(NewSetValueMust(StringType{}, []attr.Value{NewStringValue("hello")})),
+			expectation: false,
+		},
+		"null": {
+			input:       NewDynamicNull(),
+			expectation: false,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.IsUnderlyingValueList()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %t, got %t", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueAsInt64(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input         DynamicValue
+		expected      Int64Value
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"known-int64": {
+			input:    NewDynamicValue(NewInt64Value(123)),
+			expected: NewInt64Value(123),
+		},
+		"known-number-integer": {
+			input:    NewDynamicValue(NewNumberValue(big.NewFloat(123))),
+			expected: NewInt64Value(123),
+		},
+		"known-number-underlying-value-null": {
+			input:    NewDynamicValue(NewNumberNull()),
+			expected: NewInt64Null(),
+		},
+		"known-number-underlying-value-unknown": {
+			input:    NewDynamicValue(NewNumberUnknown()),
+			expected: NewInt64Unknown(),
+		},
+		"known-number-fractional": {
+			input:    NewDynamicValue(NewNumberValue(big.NewFloat(1.5))),
+			expected: Int64Value{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dynamic Value Conversion Error",
+					"An unexpected error was encountered while converting a dynamic value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected underlying int64 value, got: basetypes.NumberType",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.input.AsInt64(context.Background())
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, test.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueAsFloat64(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input         DynamicValue
+		expected      Float64Value
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"known-float64": {
+			input:    NewDynamicValue(NewFloat64Value(1.5)),
+			expected: NewFloat64Value(1.5),
+		},
+		"known-number-exact": {
+			input:    NewDynamicValue(NewNumberValue(big.NewFloat(1.5))),
+			expected: NewFloat64Value(1.5),
+		},
+		"known-number-inexact": {
+			input:    NewDynamicValue(NewNumberValue(new(big.Float).SetPrec(256).Quo(big.NewFloat(1), big.NewFloat(3)))),
+			expected: Float64Value{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dynamic Value Conversion Error",
+					"An unexpected error was encountered while converting a dynamic value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected underlying float64 value, got: basetypes.NumberType",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.input.AsFloat64(context.Background())
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, test.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicValueAsList(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input         DynamicValue
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"known-list": {
+			input:    NewDynamicValue(NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello")})),
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello")}),
+		},
+		"known-tuple-same-element-types": {
+			input: NewDynamicValue(NewTupleValueMust(
+				[]attr.Type{StringType{}, StringType{}},
+				[]attr.Value{NewStringValue("hello"), NewStringValue("world")},
+			)),
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello"), NewStringValue("world")}),
+		},
+		"known-tuple-empty": {
+			input:    NewDynamicValue(NewTupleValueMust([]attr.Type{}, []attr.Value{})),
+			expected: NewListValueMust(DynamicType{}, []attr.Value{}),
+		},
+		"known-tuple-underlying-value-null": {
+			input:    NewDynamicValue(NewTupleNull([]attr.Type{StringType{}})),
+			expected: NewListNull(StringType{}),
+		},
+		"known-tuple-mixed-element-types": {
+			input: NewDynamicValue(NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("hello"), NewBoolValue(true)},
+			)),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Dynamic Value Conversion Error",
+					"An unexpected error was encountered while converting a dynamic value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected underlying list value, got: types.TupleType[basetypes.StringType, basetypes.BoolType]",
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.input.AsList(context.Background())
+
+			// The zero-value ListValue has no element type, so only
+			// compare values when a conversion is expected.
+			if !test.expectedDiags.HasError() {
+				if diff := cmp.Diff(got, test.expected); diff != "" {
+					t.Errorf("Unexpected diff (-expected, +got): %s", diff)
+				}
+			}
+
+			if diff := cmp.Diff(diags, test.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-expected, +got): %s", diff)
+			}
+		})
+	}
+}
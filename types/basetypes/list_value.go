@@ -61,6 +61,26 @@ func NewListUnknown(elementType attr.Type) ListValue {
 	}
 }
 
+// NewListUnknownWithLength creates a List with a known value containing the
+// given number of unknown elements. This is intended for plan modification of
+// computed values where the number of elements is known during planning, but
+// the element values are not, so Terraform shows each element as known after
+// apply instead of the entire List. Access the value via the List type
+// Elements method.
+func NewListUnknownWithLength(ctx context.Context, elementType attr.Type, length int) (ListValue, diag.Diagnostics) {
+	elements, diags := unknownElements(ctx, elementType, length)
+
+	if diags.HasError() {
+		return NewListUnknown(elementType), diags
+	}
+
+	return ListValue{
+		elementType: elementType,
+		elements:    elements,
+		state:       attr.ValueStateKnown,
+	}, diags
+}
+
 // NewListValue creates a List with a known value. Access the value via the List
 // type Elements or ElementsAs methods.
 func NewListValue(elementType attr.Type, elements []attr.Value) (ListValue, diag.Diagnostics) {
@@ -122,6 +122,75 @@ func NewObjectValue(attributeTypes map[string]attr.Type, attributes map[string]a
 	}, nil
 }
 
+// NewObjectValueFromAttributes creates a Object with a known value, inferring
+// the attribute types from the Type method of each attribute value. Null and
+// unknown attribute values are supported, as their types are known. Error
+// diagnostics are returned and the Object is unknown if any attribute value
+// is nil or its type cannot be determined, such as a list value created
+// without an element type. Access the value via the Object type Attributes
+// or As methods.
+func NewObjectValueFromAttributes(attributes map[string]attr.Value) (ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	attributeTypes := make(map[string]attr.Type, len(attributes))
+
+	for name, attribute := range attributes {
+		var attributeType attr.Type
+
+		if attribute != nil {
+			attributeType = attribute.Type(ctx)
+		}
+
+		if !attrTypeIsDeterminate(attributeType) {
+			diags.AddError(
+				"Indeterminate Object Attribute Type",
+				"While creating a Object value, an attribute value with an indeterminate type was detected. "+
+					"A Object attribute value must be non-nil and have a fully defined type, including any element or attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Indeterminate Object Attribute Name: %s", name),
+			)
+
+			continue
+		}
+
+		attributeTypes[name] = attributeType
+	}
+
+	if diags.HasError() {
+		return NewObjectUnknown(attributeTypes), diags
+	}
+
+	return NewObjectValue(attributeTypes, attributes)
+}
+
+// attrTypeIsDeterminate returns false if the given type or any of its
+// underlying element or attribute types are nil or missing.
+func attrTypeIsDeterminate(t attr.Type) bool {
+	switch t := t.(type) {
+	case nil, missingType:
+		return false
+	case attr.TypeWithElementType:
+		return attrTypeIsDeterminate(t.ElementType())
+	case attr.TypeWithElementTypes:
+		for _, elementType := range t.ElementTypes() {
+			if !attrTypeIsDeterminate(elementType) {
+				return false
+			}
+		}
+	case attr.TypeWithAttributeTypes:
+		for _, attributeType := range t.AttributeTypes() {
+			if !attrTypeIsDeterminate(attributeType) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 // NewObjectValueFrom creates a Object with a known value, using reflection rules.
 // The attributes must be a map of string attribute names to attribute values
 // which can convert into the given attribute type or a struct with tfsdk field
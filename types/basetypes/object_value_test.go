@@ -171,6 +171,122 @@ func TestNewObjectValue(t *testing.T) {
 	}
 }
 
+func TestNewObjectValueFromAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes    map[string]attr.Value
+		expected      ObjectValue
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-no-attributes": {
+			attributes: map[string]attr.Value{},
+			expected:   NewObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+		},
+		"valid-attributes": {
+			attributes: map[string]attr.Value{
+				"null":    NewStringNull(),
+				"unknown": NewBoolUnknown(),
+				"known":   NewInt64Value(1),
+				"list":    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+				"object": NewObjectNull(map[string]attr.Type{
+					"nested": StringType{},
+				}),
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"null":    StringType{},
+					"unknown": BoolType{},
+					"known":   Int64Type{},
+					"list":    ListType{ElemType: StringType{}},
+					"object": ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested": StringType{},
+						},
+					},
+				},
+				map[string]attr.Value{
+					"null":    NewStringNull(),
+					"unknown": NewBoolUnknown(),
+					"known":   NewInt64Value(1),
+					"list":    NewListValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+					"object": NewObjectNull(map[string]attr.Type{
+						"nested": StringType{},
+					}),
+				},
+			),
+		},
+		"invalid-nil-attribute": {
+			attributes: map[string]attr.Value{
+				"string": NewStringValue("test"),
+				"nil":    nil,
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"string": StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Indeterminate Object Attribute Type",
+					"While creating a Object value, an attribute value with an indeterminate type was detected. "+
+						"A Object attribute value must be non-nil and have a fully defined type, including any element or attribute types. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Indeterminate Object Attribute Name: nil",
+				),
+			},
+		},
+		"invalid-missing-element-type": {
+			attributes: map[string]attr.Value{
+				"list": NewListNull(nil),
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Indeterminate Object Attribute Type",
+					"While creating a Object value, an attribute value with an indeterminate type was detected. "+
+						"A Object attribute value must be non-nil and have a fully defined type, including any element or attribute types. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Indeterminate Object Attribute Name: list",
+				),
+			},
+		},
+		"invalid-missing-nested-attribute-type": {
+			attributes: map[string]attr.Value{
+				"object": NewObjectUnknown(map[string]attr.Type{
+					"nested": nil,
+				}),
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Indeterminate Object Attribute Type",
+					"While creating a Object value, an attribute value with an indeterminate type was detected. "+
+						"A Object attribute value must be non-nil and have a fully defined type, including any element or attribute types. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Indeterminate Object Attribute Name: object",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewObjectValueFromAttributes(testCase.attributes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewObjectValueFrom(t *testing.T) {
 	t.Parallel()
 
@@ -61,6 +61,26 @@ func NewSetUnknown(elementType attr.Type) SetValue {
 	}
 }
 
+// NewSetUnknownWithLength creates a Set with a known value containing the
+// given number of unknown elements. This is intended for plan modification of
+// computed values where the number of elements is known during planning, but
+// the element values are not, so Terraform shows each element as known after
+// apply instead of the entire Set. Access the value via the Set type
+// Elements method.
+func NewSetUnknownWithLength(ctx context.Context, elementType attr.Type, length int) (SetValue, diag.Diagnostics) {
+	elements, diags := unknownElements(ctx, elementType, length)
+
+	if diags.HasError() {
+		return NewSetUnknown(elementType), diags
+	}
+
+	return SetValue{
+		elementType: elementType,
+		elements:    elements,
+		state:       attr.ValueStateKnown,
+	}, diags
+}
+
 // NewSetValue creates a Set with a known value. Access the value via the Set
 // type Elements or ElementsAs methods.
 func NewSetValue(elementType attr.Type, elements []attr.Value) (SetValue, diag.Diagnostics) {
@@ -366,6 +366,82 @@ func TestNewSetValue(t *testing.T) {
 	}
 }
 
+func TestNewSetUnknownWithLength(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"test_attr": StringType{},
+		},
+	}
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		length        int
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"zero": {
+			elementType: StringType{},
+			length:      0,
+			expected:    NewSetValueMust(StringType{}, []attr.Value{}),
+		},
+		"string": {
+			elementType: StringType{},
+			length:      2,
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringUnknown(),
+				},
+			),
+		},
+		"object": {
+			elementType: testObjectType,
+			length:      2,
+			expected: NewSetValueMust(
+				testObjectType,
+				[]attr.Value{
+					NewObjectUnknown(testObjectType.AttrTypes),
+					NewObjectUnknown(testObjectType.AttrTypes),
+				},
+			),
+		},
+		"negative": {
+			elementType: StringType{},
+			length:      -1,
+			expected:    NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Collection Length",
+					"While creating a collection value with unknown elements, an invalid length was given. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Length: -1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewSetUnknownWithLength(context.Background(), testCase.elementType, testCase.length)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewSetValueFrom(t *testing.T) {
 	t.Parallel()
 
@@ -18,8 +18,8 @@ var (
 )
 
 // TupleType implements a tuple type definition. This type intentionally includes less functionality
-// than other types in the type system as it has limited real world application and therefore
-// is not exposed to provider developers.
+// than other types in the type system, such as no custom type support, as it has limited real world
+// application. Schemas expose this type via TupleAttribute.
 type TupleType struct {
 	// ElemTypes is an ordered list of element types for the tuple.
 	ElemTypes []attr.Type
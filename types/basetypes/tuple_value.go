@@ -108,8 +108,8 @@ func NewTupleValueMust(elementTypes []attr.Type, elements []attr.Value) TupleVal
 }
 
 // TupleValue represents an ordered list of attr.Value, with an attr.Type for each element. This type intentionally
-// includes less functionality than other types in the type system as it has limited real world application and therefore
-// is not exposed to provider developers.
+// includes less functionality than other types in the type system, such as no custom value support, as it has limited
+// real world application. Schemas expose this type via TupleAttribute.
 type TupleValue struct {
 	// elements is the ordered list of known element values for the tuple.
 	elements []attr.Value
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// unknownElements returns the given number of unknown values of the element
+// type. The values are created via the element type ValueFromTerraform
+// method, so custom types return their own value implementation.
+func unknownElements(ctx context.Context, elementType attr.Type, length int) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if length < 0 {
+		diags.AddError(
+			"Invalid Collection Length",
+			"While creating a collection value with unknown elements, an invalid length was given. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				fmt.Sprintf("Length: %d", length),
+		)
+
+		return nil, diags
+	}
+
+	unknownValue := tftypes.NewValue(elementType.TerraformType(ctx), tftypes.UnknownValue)
+	elements := make([]attr.Value, 0, length)
+
+	for i := 0; i < length; i++ {
+		element, err := elementType.ValueFromTerraform(ctx, unknownValue)
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Create Unknown Element",
+				"While creating a collection value with unknown elements, an unexpected error occurred creating an element value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Element Type: %s\n", elementType)+
+					fmt.Sprintf("Error: %s", err),
+			)
+
+			return nil, diags
+		}
+
+		elements = append(elements, element)
+	}
+
+	return elements, diags
+}
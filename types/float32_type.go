@@ -0,0 +1,8 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import "github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+var Float32Type = basetypes.Float32Type{}
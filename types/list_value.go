@@ -25,6 +25,16 @@ func ListUnknown(elementType attr.Type) basetypes.ListValue {
 	return basetypes.NewListUnknown(elementType)
 }
 
+// ListUnknownWithLength creates a List with a known value containing the
+// given number of unknown elements. This is intended for plan modification of
+// computed values where the number of elements is known during planning, but
+// the element values are not, so Terraform shows each element as known after
+// apply instead of the entire List. Access the value via the List type
+// Elements method.
+func ListUnknownWithLength(ctx context.Context, elementType attr.Type, length int) (basetypes.ListValue, diag.Diagnostics) {
+	return basetypes.NewListUnknownWithLength(ctx, elementType, length)
+}
+
 // ListValue creates a List with a known value. Access the value via the List
 // type Elements or ElementsAs methods.
 func ListValue(elementType attr.Type, elements []attr.Value) (basetypes.ListValue, diag.Diagnostics) {
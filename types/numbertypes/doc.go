@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package numbertypes contains custom number types, which extend the
+// framework-defined number type with additional behaviors.
+package numbertypes
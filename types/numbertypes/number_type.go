@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbertypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.NumberTypable = NumberType{}
+)
+
+// NumberType is an attribute type that represents an arbitrary precision
+// number, where values are compared by numeric value rather than by
+// representation or precision. Use this type in place of types.NumberType
+// to prevent differences such as values read from a remote system API at a
+// lower precision than the configuration from causing resource drift or
+// Terraform data consistency errors.
+//
+// Semantic equality logic is defined for NumberType such that values are
+// considered equal when they are numerically equal after rounding to the
+// lower precision of the two values.
+type NumberType struct {
+	basetypes.NumberType
+}
+
+// String returns a human readable string of the type name.
+func (t NumberType) String() string {
+	return "numbertypes.NumberType"
+}
+
+// ValueType returns the Value type.
+func (t NumberType) ValueType(ctx context.Context) attr.Value {
+	return Number{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t NumberType) Equal(o attr.Type) bool {
+	other, ok := o.(NumberType)
+
+	if !ok {
+		return false
+	}
+
+	return t.NumberType.Equal(other.NumberType)
+}
+
+// ValueFromNumber returns a NumberValuable type given a basetypes.NumberValue.
+func (t NumberType) ValueFromNumber(ctx context.Context, in basetypes.NumberValue) (basetypes.NumberValuable, diag.Diagnostics) {
+	return Number{
+		NumberValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider
+// to consume the data with.
+func (t NumberType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.NumberType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	numberValue, ok := attrValue.(basetypes.NumberValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	numberValuable, diags := t.ValueFromNumber(ctx, numberValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting NumberValue to NumberValuable: %v", diags)
+	}
+
+	return numberValuable, nil
+}
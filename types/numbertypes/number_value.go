@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbertypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.NumberValuableWithSemanticEquals = Number{}
+)
+
+// Number represents a valid arbitrary precision number, where semantic
+// equality compares values by numeric value rather than by representation or
+// precision.
+type Number struct {
+	basetypes.NumberValue
+}
+
+// Type returns a NumberType.
+func (v Number) Type(_ context.Context) attr.Type {
+	return NumberType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Number) Equal(o attr.Value) bool {
+	other, ok := o.(Number)
+
+	if !ok {
+		return false
+	}
+
+	return v.NumberValue.Equal(other.NumberValue)
+}
+
+// NumberSemanticEquals returns true if the given number value is numerically
+// equal to the current number value. When the values have differing
+// precision, such as configuration values parsed by Terraform at a high
+// precision and state values read from a remote system API as a float64, both
+// values are rounded to the lower precision before comparison.
+func (v Number) NumberSemanticEquals(_ context.Context, newValuable basetypes.NumberValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Number)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return numbersEqual(v.ValueBigFloat(), newValue.ValueBigFloat()), diags
+}
+
+// numbersEqual returns true if the numbers are equal after rounding to the
+// lower precision of the two numbers.
+func numbersEqual(a, b *big.Float) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	prec := min(a.Prec(), b.Prec())
+
+	// A precision of 0 indicates an exact zero or infinity value, which has
+	// no rounding.
+	if prec == 0 {
+		return a.Cmp(b) == 0
+	}
+
+	roundedA := new(big.Float).SetPrec(prec).Set(a)
+	roundedB := new(big.Float).SetPrec(prec).Set(b)
+
+	return roundedA.Cmp(roundedB) == 0
+}
+
+// NewNumberNull creates a Number with a null value. Determine whether the
+// value is null via IsNull method.
+func NewNumberNull() Number {
+	return Number{
+		NumberValue: basetypes.NewNumberNull(),
+	}
+}
+
+// NewNumberUnknown creates a Number with an unknown value. Determine whether
+// the value is unknown via IsUnknown method.
+func NewNumberUnknown() Number {
+	return Number{
+		NumberValue: basetypes.NewNumberUnknown(),
+	}
+}
+
+// NewNumberValue creates a Number with a known value. Access the value via
+// ValueBigFloat method. A nil value will return a null Number.
+func NewNumberValue(value *big.Float) Number {
+	return Number{
+		NumberValue: basetypes.NewNumberValue(value),
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package numbertypes_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/numbertypes"
+)
+
+func TestNumberNumberSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	// Terraform parses configuration numbers with 512 bits of precision.
+	configNumber := func(s string) *big.Float {
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			panic(err)
+		}
+
+		return f
+	}
+
+	testCases := map[string]struct {
+		currentValue  numbertypes.Number
+		givenValue    basetypes.NumberValuable
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"equal-same-precision": {
+			currentValue: numbertypes.NewNumberValue(big.NewFloat(1.5)),
+			givenValue:   numbertypes.NewNumberValue(big.NewFloat(1.5)),
+			expected:     true,
+		},
+		"equal-differing-representation": {
+			currentValue: numbertypes.NewNumberValue(configNumber("1.00")),
+			givenValue:   numbertypes.NewNumberValue(configNumber("1")),
+			expected:     true,
+		},
+		"equal-differing-precision": {
+			currentValue: numbertypes.NewNumberValue(configNumber("0.1")),
+			givenValue:   numbertypes.NewNumberValue(big.NewFloat(0.1)),
+			expected:     true,
+		},
+		"equal-differing-precision-reversed": {
+			currentValue: numbertypes.NewNumberValue(big.NewFloat(0.1)),
+			givenValue:   numbertypes.NewNumberValue(configNumber("0.1")),
+			expected:     true,
+		},
+		"equal-zero-precision": {
+			currentValue: numbertypes.NewNumberValue(new(big.Float)),
+			givenValue:   numbertypes.NewNumberValue(big.NewFloat(0)),
+			expected:     true,
+		},
+		"not-equal-same-precision": {
+			currentValue: numbertypes.NewNumberValue(big.NewFloat(1.5)),
+			givenValue:   numbertypes.NewNumberValue(big.NewFloat(2.5)),
+			expected:     false,
+		},
+		"not-equal-differing-precision": {
+			currentValue: numbertypes.NewNumberValue(configNumber("0.1000000001")),
+			givenValue:   numbertypes.NewNumberValue(big.NewFloat(0.1)),
+			expected:     false,
+		},
+		"not-equal-zero-precision": {
+			currentValue: numbertypes.NewNumberValue(new(big.Float)),
+			givenValue:   numbertypes.NewNumberValue(big.NewFloat(0.1)),
+			expected:     false,
+		},
+		"error-not-expected-type": {
+			currentValue: numbertypes.NewNumberValue(big.NewFloat(1.5)),
+			givenValue:   basetypes.NewNumberValue(big.NewFloat(1.5)),
+			expected:     false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: numbertypes.Number\n"+
+						"Got Value Type: basetypes.NumberValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.currentValue.NumberSemanticEquals(context.Background(), testCase.givenValue)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNumberType(t *testing.T) {
+	t.Parallel()
+
+	got := numbertypes.NewNumberValue(big.NewFloat(1)).Type(context.Background())
+
+	if diff := cmp.Diff(got, numbertypes.NumberType{}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -38,6 +38,13 @@ func ObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type, a
 	return basetypes.NewObjectValueFrom(ctx, attributeTypes, attributes)
 }
 
+// ObjectValueFromAttributes creates a Object with a known value, inferring the
+// attribute types from the given attribute values. Access the value via the
+// Object type Attributes or As methods.
+func ObjectValueFromAttributes(attributes map[string]attr.Value) (basetypes.ObjectValue, diag.Diagnostics) {
+	return basetypes.NewObjectValueFromAttributes(attributes)
+}
+
 // ObjectValueMust creates a Object with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Object
 // type Attributes or As methods.
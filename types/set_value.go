@@ -25,6 +25,16 @@ func SetUnknown(elementType attr.Type) basetypes.SetValue {
 	return basetypes.NewSetUnknown(elementType)
 }
 
+// SetUnknownWithLength creates a Set with a known value containing the
+// given number of unknown elements. This is intended for plan modification of
+// computed values where the number of elements is known during planning, but
+// the element values are not, so Terraform shows each element as known after
+// apply instead of the entire Set. Access the value via the Set type
+// Elements method.
+func SetUnknownWithLength(ctx context.Context, elementType attr.Type, length int) (basetypes.SetValue, diag.Diagnostics) {
+	return basetypes.NewSetUnknownWithLength(ctx, elementType, length)
+}
+
 // SetValue creates a Set with a known value. Access the value via the Set
 // type Elements or ElementsAs methods.
 func SetValue(elementType attr.Type, elements []attr.Value) (basetypes.SetValue, diag.Diagnostics) {
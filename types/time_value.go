@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+type Time = timetypes.Time
+
+// TimeNull creates a Time with a null value. Determine whether the value is
+// null via the Time type IsNull method.
+func TimeNull() timetypes.Time {
+	return timetypes.NewTimeNull()
+}
+
+// TimeUnknown creates a Time with an unknown value. Determine whether the
+// value is unknown via the Time type IsUnknown method.
+func TimeUnknown() timetypes.Time {
+	return timetypes.NewTimeUnknown()
+}
+
+// TimeValue creates a Time with a known value formatted as an RFC 3339
+// string. Access the value via the Time type ValueTime method.
+func TimeValue(value time.Time) timetypes.Time {
+	return timetypes.NewTimeTimeValue(value)
+}
+
+// TimePointerValue creates a Time with a null value if nil or a known value.
+func TimePointerValue(value *time.Time) timetypes.Time {
+	return timetypes.NewTimePointerValue(value)
+}
@@ -0,0 +1,7 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package timetypes contains custom string types for timestamps, which
+// extend the framework-defined string type with parsing, validation, and
+// semantic equality.
+package timetypes
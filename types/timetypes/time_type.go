@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringTypable = TimeType{}
+	_ xattr.TypeWithValidate  = TimeType{}
+)
+
+// TimeType is an attribute type that represents a timestamp, which is
+// stored in Terraform as a string in RFC 3339 format, such as
+// "2006-01-02T15:04:05Z" or "2006-01-02T15:04:05+07:00". Use this type in
+// place of types.StringType to validate timestamp values and access them as
+// time.Time.
+//
+// Semantic equality logic is defined for TimeType such that values are
+// considered equal when they represent the same instant in time, regardless
+// of the time zone offset used in the string representation.
+type TimeType struct {
+	basetypes.StringType
+}
+
+// String returns a human readable string of the type name.
+func (t TimeType) String() string {
+	return "timetypes.TimeType"
+}
+
+// ValueType returns the Value type.
+func (t TimeType) ValueType(ctx context.Context) attr.Value {
+	return Time{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t TimeType) Equal(o attr.Type) bool {
+	other, ok := o.(TimeType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+// Validate returns an error diagnostic if a known string value is not a
+// valid RFC 3339 timestamp.
+func (t TimeType) Validate(ctx context.Context, in tftypes.Value, valuePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.String) {
+		diags.AddAttributeError(
+			valuePath,
+			"Time Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value string
+
+	err := in.As(&value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Time Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+
+		return diags
+	}
+
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid RFC 3339 String Value",
+			"A string value was provided that is not valid RFC 3339 string format, such as 2006-01-02T15:04:05Z.\n\n"+
+				"Given Value: "+value+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// ValueFromString returns a StringValuable type given a
+// basetypes.StringValue.
+func (t TimeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Time{
+		StringValue: in,
+	}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider
+// to consume the data with.
+func (t TimeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
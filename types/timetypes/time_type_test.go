@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestTimeTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			other:    timetypes.TimeType{},
+			expected: true,
+		},
+		"basetypes-StringType": {
+			other:    basetypes.StringType{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.TimeType{}.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestTimeTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"null": {
+			input: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			input: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"utc": {
+			input: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+		},
+		"offset": {
+			input: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05-07:00"),
+		},
+		"fractional-seconds": {
+			input: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.999999999Z"),
+		},
+		"invalid-format": {
+			input: tftypes.NewValue(tftypes.String, "2006-01-02 15:04:05"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC 3339 String Value",
+					"A string value was provided that is not valid RFC 3339 string format, such as 2006-01-02T15:04:05Z.\n\n"+
+						"Given Value: 2006-01-02 15:04:05\n"+
+						"Error: parsing time \"2006-01-02 15:04:05\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \" 15:04:05\" as \"T\"",
+				),
+			},
+		},
+		"wrong-type": {
+			input: tftypes.NewValue(tftypes.Number, 123),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Time Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.TimeType{}.Validate(context.Background(), testCase.input, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"value": {
+			input:    tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			expected: timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: timetypes.NewTimeUnknown(),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: timetypes.NewTimeNull(),
+		},
+		"wrongType": {
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't unmarshal tftypes.Number into *string, expected string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := timetypes.TimeType{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
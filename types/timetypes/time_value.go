@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ basetypes.StringValuableWithSemanticEquals = Time{}
+)
+
+// Time represents a valid RFC 3339 timestamp string, where semantic equality
+// compares the instants in time rather than the string representation.
+type Time struct {
+	basetypes.StringValue
+}
+
+// Type returns a TimeType.
+func (v Time) Type(_ context.Context) attr.Type {
+	return TimeType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v Time) Equal(o attr.Value) bool {
+	other, ok := o.(Time)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals returns true if the given time value represents the
+// same instant in time as the current time value, such as
+// "2006-01-02T15:04:05Z" and "2006-01-02T08:04:05-07:00".
+func (v Time) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Time)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Values are already validated at this point, so ignore parsing errors
+	// and fall back to string comparison.
+	priorTime, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	newTime, err := time.Parse(time.RFC3339, newValue.ValueString())
+
+	if err != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+
+	return priorTime.Equal(newTime), diags
+}
+
+// ValueTime parses the known value as a time.Time. A zero time.Time is
+// returned for null or unknown values. An error diagnostic is returned if the
+// value is not a valid RFC 3339 timestamp.
+func (v Time) ValueTime() (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return time.Time{}, diags
+	}
+
+	result, err := time.Parse(time.RFC3339, v.ValueString())
+
+	if err != nil {
+		diags.AddError(
+			"Time ValueTime Error",
+			"An unexpected error occurred while converting a string value to time.Time. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Given Value: "+v.ValueString()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return time.Time{}, diags
+	}
+
+	return result, diags
+}
+
+// NewTimeNull creates a Time with a null value. Determine whether the value
+// is null via IsNull method.
+func NewTimeNull() Time {
+	return Time{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+// NewTimeUnknown creates a Time with an unknown value. Determine whether the
+// value is unknown via IsUnknown method.
+func NewTimeUnknown() Time {
+	return Time{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+// NewTimeValue creates a Time with a known RFC 3339 string value. Access the
+// value via ValueTime or ValueString methods. The string is not validated
+// until the value is used in a schema or the ValueTime method is called.
+func NewTimeValue(value string) Time {
+	return Time{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
+
+// NewTimeTimeValue creates a Time with a known value formatted as an
+// RFC 3339 string, including fractional seconds if non-zero. Access the
+// value via ValueTime or ValueString methods.
+func NewTimeTimeValue(value time.Time) Time {
+	return Time{
+		StringValue: basetypes.NewStringValue(value.Format(time.RFC3339Nano)),
+	}
+}
+
+// NewTimePointerValue creates a Time with a null value if nil or a known
+// value formatted as an RFC 3339 string.
+func NewTimePointerValue(value *time.Time) Time {
+	if value == nil {
+		return NewTimeNull()
+	}
+
+	return NewTimeTimeValue(*value)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+func TestTimeStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		currentTime   timetypes.Time
+		givenTime     basetypes.StringValuable
+		expectedMatch bool
+		expectedDiags diag.Diagnostics
+	}{
+		"equal": {
+			currentTime:   timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			givenTime:     timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			expectedMatch: true,
+		},
+		"semantically-equal-offset": {
+			currentTime:   timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			givenTime:     timetypes.NewTimeValue("2006-01-02T08:04:05-07:00"),
+			expectedMatch: true,
+		},
+		"semantically-equal-fractional-seconds": {
+			currentTime:   timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			givenTime:     timetypes.NewTimeValue("2006-01-02T15:04:05.000Z"),
+			expectedMatch: true,
+		},
+		"not-equal": {
+			currentTime:   timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			givenTime:     timetypes.NewTimeValue("2006-01-02T15:04:05-07:00"),
+			expectedMatch: false,
+		},
+		"invalid-string-equal": {
+			currentTime:   timetypes.NewTimeValue("not-a-time"),
+			givenTime:     timetypes.NewTimeValue("not-a-time"),
+			expectedMatch: true,
+		},
+		"invalid-string-not-equal": {
+			currentTime:   timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			givenTime:     timetypes.NewTimeValue("not-a-time"),
+			expectedMatch: false,
+		},
+		"wrong-type": {
+			currentTime:   timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			givenTime:     basetypes.NewStringValue("2006-01-02T15:04:05Z"),
+			expectedMatch: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.Time\n"+
+						"Got Value Type: basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			match, diags := testCase.currentTime.StringSemanticEquals(context.Background(), testCase.givenTime)
+
+			if testCase.expectedMatch != match {
+				t.Errorf("Expected StringSemanticEquals to return: %t, but got: %t", testCase.expectedMatch, match)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeValueTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         timetypes.Time
+		expected      time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			value:    timetypes.NewTimeNull(),
+			expected: time.Time{},
+		},
+		"unknown": {
+			value:    timetypes.NewTimeUnknown(),
+			expected: time.Time{},
+		},
+		"value": {
+			value:    timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"time-value": {
+			value:    timetypes.NewTimeTimeValue(time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC)),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 123000000, time.UTC),
+		},
+		"invalid": {
+			value:    timetypes.NewTimeValue("not-a-time"),
+			expected: time.Time{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Time ValueTime Error",
+					"An unexpected error occurred while converting a string value to time.Time. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Given Value: not-a-time\n"+
+						"Error: parsing time \"not-a-time\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"not-a-time\" as \"2006\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.ValueTime()
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewTimeTimeValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    time.Time
+		expected timetypes.Time
+	}{
+		"utc": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+			expected: timetypes.NewTimeValue("2006-01-02T15:04:05Z"),
+		},
+		"offset": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 0, time.FixedZone("test", -7*60*60)),
+			expected: timetypes.NewTimeValue("2006-01-02T15:04:05-07:00"),
+		},
+		"fractional-seconds": {
+			value:    time.Date(2006, 1, 2, 15, 4, 5, 500000000, time.UTC),
+			expected: timetypes.NewTimeValue("2006-01-02T15:04:05.5Z"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.NewTimeTimeValue(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}